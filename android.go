@@ -0,0 +1,300 @@
+package gothememe
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// AndroidOptions configures the Android resource and Jetpack Compose output.
+type AndroidOptions struct {
+	// Prefix of the color resource names (default: "theme"), so the
+	// text_primary role becomes @color/theme_text_primary.
+	Prefix string
+
+	// ThemeName is the name of the Material 3 theme style
+	// (default: "Theme.App"). Set it to the theme your manifest references.
+	ThemeName string
+
+	// Package is the Kotlin package of generated Compose source. No package
+	// declaration is written when it is empty.
+	Package string
+}
+
+// DefaultAndroidOptions returns sensible default Android options.
+func DefaultAndroidOptions() AndroidOptions {
+	return AndroidOptions{
+		Prefix:    "theme",
+		ThemeName: "Theme.App",
+	}
+}
+
+// withDefaults fills in the defaults of unset options.
+func (o AndroidOptions) withDefaults() AndroidOptions {
+	def := DefaultAndroidOptions()
+	if o.Prefix == "" {
+		o.Prefix = def.Prefix
+	}
+	if o.ThemeName == "" {
+		o.ThemeName = def.ThemeName
+	}
+	return o
+}
+
+// materialColors maps the Material 3 color roles, named as ColorScheme
+// parameters and as the theme attributes of Material Components for Android,
+// to the theme roles they take. The names differ for some roles, such as
+// inverseSurface and colorSurfaceInverse.
+var materialColors = []struct {
+	name string
+	attr string
+	role string
+}{
+	{"primary", "colorPrimary", "accent"},
+	{"onPrimary", "colorOnPrimary", "text_inverted"},
+	{"secondary", "colorSecondary", "accent_secondary"},
+	{"onSecondary", "colorOnSecondary", "text_inverted"},
+	{"tertiary", "colorTertiary", "brand"},
+	{"onTertiary", "colorOnTertiary", "text_inverted"},
+	{"background", "android:colorBackground", "background"},
+	{"onBackground", "colorOnBackground", "text_primary"},
+	{"surface", "colorSurface", "background"},
+	{"onSurface", "colorOnSurface", "text_primary"},
+	{"surfaceVariant", "colorSurfaceVariant", "surface"},
+	{"onSurfaceVariant", "colorOnSurfaceVariant", "text_secondary"},
+	{"surfaceContainerLowest", "colorSurfaceContainerLowest", "background"},
+	{"surfaceContainerLow", "colorSurfaceContainerLow", "background_secondary"},
+	{"surfaceContainer", "colorSurfaceContainer", "surface"},
+	{"surfaceContainerHigh", "colorSurfaceContainerHigh", "surface_secondary"},
+	{"surfaceContainerHighest", "colorSurfaceContainerHighest", "surface_secondary"},
+	{"inverseSurface", "colorSurfaceInverse", "text_primary"},
+	{"inverseOnSurface", "colorOnSurfaceInverse", "background"},
+	{"outline", "colorOutline", "border"},
+	{"outlineVariant", "colorOutlineVariant", "border_subtle"},
+	{"error", "colorError", "error_text"},
+	{"onError", "colorOnError", "text_inverted"},
+	{"errorContainer", "colorErrorContainer", "error_background"},
+	{"onErrorContainer", "colorOnErrorContainer", "error_text"},
+}
+
+// androidResources is the root element of an Android values resource file.
+type androidResources struct {
+	XMLName xml.Name       `xml:"resources"`
+	Colors  []androidValue `xml:"color,omitempty"`
+	Styles  []androidStyle `xml:"style,omitempty"`
+}
+
+// androidValue is a named resource value, such as a color or a style item.
+type androidValue struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
+}
+
+// androidStyle is a style resource.
+type androidStyle struct {
+	Name   string         `xml:"name,attr"`
+	Parent string         `xml:"parent,attr"`
+	Items  []androidValue `xml:"item"`
+}
+
+// WriteAndroidColors writes t to w as an Android res/values/colors.xml
+// resource file with a color for every role, named after the role with
+// the options' Prefix, such as theme_text_primary. Translucent colors keep
+// their alpha as #AARRGGBB.
+func WriteAndroidColors(w io.Writer, t Theme, opts AndroidOptions) error {
+	opts = opts.withDefaults()
+	res := androidResources{}
+	for _, role := range colorRoles {
+		if c := role.get(t); !c.IsEmpty() {
+			res.Colors = append(res.Colors, androidValue{opts.Prefix + "_" + role.key, androidColor(c)})
+		}
+	}
+	return writeAndroidResources(w, res)
+}
+
+// WriteAndroidTheme writes t to w as an Android res/values/themes.xml
+// resource file with a Material 3 theme whose color attributes, such as
+// colorPrimary and colorOnSurface, reference the colors of
+// [WriteAndroidColors]. The theme extends the Material 3 light or dark
+// theme to match t.
+func WriteAndroidTheme(w io.Writer, t Theme, opts AndroidOptions) error {
+	return writeAndroidTheme(w, t, nil, opts)
+}
+
+// writeAndroidTheme writes the Material 3 theme of t. Roles t leaves empty
+// are still referenced when fallback sets them, as their colors resolve to
+// the resources of fallback.
+func writeAndroidTheme(w io.Writer, t, fallback Theme, opts AndroidOptions) error {
+	opts = opts.withDefaults()
+	parent := "Theme.Material3.Light.NoActionBar"
+	if t.IsDark() {
+		parent = "Theme.Material3.Dark.NoActionBar"
+	}
+	style := androidStyle{Name: opts.ThemeName, Parent: parent}
+	for _, m := range materialColors {
+		role, _ := lookupColorRole(m.role) //nolint:errcheck // materialColors holds valid role keys
+		if role.get(t).IsEmpty() && (fallback == nil || role.get(fallback).IsEmpty()) {
+			continue
+		}
+		style.Items = append(style.Items, androidValue{m.attr, "@color/" + opts.Prefix + "_" + role.key})
+	}
+	return writeAndroidResources(w, androidResources{Styles: []androidStyle{style}})
+}
+
+// WriteAndroidResources writes the colors and Material 3 theme of t to the
+// res/values folder under dir, which is created if needed. When dark is not
+// nil, its colors and theme are written to res/values-night, so the app
+// follows the system dark mode. The night theme still references the
+// roles dark leaves empty, which fall back to the colors of t.
+func WriteAndroidResources(dir string, opts AndroidOptions, t, dark Theme) error {
+	if t == nil {
+		return ErrNoThemes
+	}
+	folders := []struct {
+		name     string
+		theme    Theme
+		fallback Theme
+	}{{"values", t, nil}, {"values-night", dark, t}}
+
+	for _, folder := range folders {
+		if folder.theme == nil {
+			continue
+		}
+		path := filepath.Join(dir, "res", folder.name)
+		if err := os.MkdirAll(path, 0o755); err != nil { //nolint:gosec // G301: resource folders are meant to be shared
+			return fmt.Errorf("writing Android resources: %w", err)
+		}
+		for name, write := range map[string]func(io.Writer) error{
+			"colors.xml": func(w io.Writer) error { return WriteAndroidColors(w, folder.theme, opts) },
+			"themes.xml": func(w io.Writer) error { return writeAndroidTheme(w, folder.theme, folder.fallback, opts) },
+		} {
+			var sb strings.Builder
+			if err := write(&sb); err != nil {
+				return err
+			}
+			if err := os.WriteFile(filepath.Join(path, name), []byte(sb.String()), 0o644); err != nil { //nolint:gosec // G306: resource files are meant to be shared
+				return fmt.Errorf("writing Android resources: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// writeAndroidResources writes res as an indented resource file.
+func writeAndroidResources(w io.Writer, res androidResources) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "    ")
+	if err := enc.Encode(res); err != nil {
+		return fmt.Errorf("encoding Android resources: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// androidColor formats c as an Android color value: #RRGGBB when opaque
+// and #AARRGGBB otherwise.
+func androidColor(c Color) string {
+	r, g, b, a := c.RGBAComponents()
+	if a == 255 {
+		return fmt.Sprintf("#%02X%02X%02X", r, g, b)
+	}
+	return fmt.Sprintf("#%02X%02X%02X%02X", a, r, g, b)
+}
+
+// WriteComposeColors writes the themes to w as Kotlin source for Jetpack
+// Compose. Each theme gets an object of Color constants named after the
+// roles, such as CatppuccinMochaColors.TextPrimary, and a Material 3
+// ColorScheme, such as CatppuccinMochaColorScheme, built with
+// lightColorScheme or darkColorScheme to match the theme. Pass the scheme
+// to MaterialTheme, picking the dark one when isSystemInDarkTheme() is
+// true.
+func WriteComposeColors(w io.Writer, opts AndroidOptions, themes ...Theme) error {
+	var (
+		body     strings.Builder
+		anyDark  bool
+		anyLight bool
+	)
+	for _, t := range themes {
+		if t == nil {
+			continue
+		}
+		name := pascalCase(t.ID())
+		fmt.Fprintf(&body, "\n/** %s colors. */\nobject %sColors {\n", t.DisplayName(), name)
+		for _, role := range colorRoles {
+			if c := role.get(t); !c.IsEmpty() {
+				fmt.Fprintf(&body, "    val %s = Color(%s)\n", pascalCase(role.key), composeColor(c))
+			}
+		}
+		body.WriteString("}\n")
+
+		builder := "lightColorScheme"
+		if t.IsDark() {
+			builder, anyDark = "darkColorScheme", true
+		} else {
+			anyLight = true
+		}
+		fmt.Fprintf(&body, "\n/** %s as a Material 3 color scheme. */\n", t.DisplayName())
+		fmt.Fprintf(&body, "val %sColorScheme: ColorScheme = %s(\n", name, builder)
+		for _, m := range materialColors {
+			role, _ := lookupColorRole(m.role) //nolint:errcheck // materialColors holds valid role keys
+			if !role.get(t).IsEmpty() {
+				fmt.Fprintf(&body, "    %s = %sColors.%s,\n", m.name, name, pascalCase(role.key))
+			}
+		}
+		body.WriteString(")\n")
+	}
+	if body.Len() == 0 {
+		return ErrNoThemes
+	}
+
+	var sb strings.Builder
+	sb.WriteString("// Generated by gothememe. Do not edit.\n\n")
+	if opts.Package != "" {
+		fmt.Fprintf(&sb, "package %s\n\n", opts.Package)
+	}
+	sb.WriteString("import androidx.compose.material3.ColorScheme\n")
+	if anyDark {
+		sb.WriteString("import androidx.compose.material3.darkColorScheme\n")
+	}
+	if anyLight {
+		sb.WriteString("import androidx.compose.material3.lightColorScheme\n")
+	}
+	sb.WriteString("import androidx.compose.ui.graphics.Color\n")
+	sb.WriteString(body.String())
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// composeColor formats c as the ARGB literal Compose's Color takes.
+func composeColor(c Color) string {
+	r, g, b, a := c.RGBAComponents()
+	return fmt.Sprintf("0x%02X%02X%02X%02X", a, r, g, b)
+}
+
+// exportAndroidColors writes the colors.xml of a single theme.
+func exportAndroidColors(w io.Writer, themes []Theme, opts ExportOptions) error {
+	if len(themes) > 1 {
+		return fmt.Errorf("%w: got %d", ErrTooManyThemes, len(themes))
+	}
+	return WriteAndroidColors(w, themes[0], opts.Android)
+}
+
+// exportAndroidTheme writes the themes.xml of a single theme.
+func exportAndroidTheme(w io.Writer, themes []Theme, opts ExportOptions) error {
+	if len(themes) > 1 {
+		return fmt.Errorf("%w: got %d", ErrTooManyThemes, len(themes))
+	}
+	return WriteAndroidTheme(w, themes[0], opts.Android)
+}
+
+// exportCompose writes the Compose colors of the themes.
+func exportCompose(w io.Writer, themes []Theme, opts ExportOptions) error {
+	return WriteComposeColors(w, opts.Android, themes...)
+}
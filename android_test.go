@@ -0,0 +1,244 @@
+package gothememe
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteAndroidColors(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	var sb strings.Builder
+	if err := WriteAndroidColors(&sb, theme, AndroidOptions{}); err != nil {
+		t.Fatalf("WriteAndroidColors() error: %v", err)
+	}
+
+	var res androidResources
+	if err := xml.Unmarshal([]byte(sb.String()), &res); err != nil {
+		t.Fatalf("output is not valid XML: %v\n%s", err, sb.String())
+	}
+	colors := map[string]string{}
+	for _, c := range res.Colors {
+		colors[c.Name] = c.Value
+	}
+	for name, want := range map[string]string{
+		"theme_background":         "#1E1E2E",
+		"theme_text_primary":       "#CDD6F4",
+		"theme_success_background": "#19A6E3A1",
+	} {
+		if got := colors[name]; got != want {
+			t.Errorf("color %s = %q, want %q", name, got, want)
+		}
+	}
+	if len(res.Colors) != len(colorRoles) {
+		t.Errorf("got %d colors, want %d", len(res.Colors), len(colorRoles))
+	}
+
+	sb.Reset()
+	if err := WriteAndroidColors(&sb, theme, AndroidOptions{Prefix: "acme"}); err != nil {
+		t.Fatalf("WriteAndroidColors() error: %v", err)
+	}
+	if !strings.Contains(sb.String(), `<color name="acme_accent">#89B4FA</color>`) {
+		t.Errorf("custom prefix not used:\n%s", sb.String())
+	}
+}
+
+func TestWriteAndroidTheme(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		theme  Theme
+		parent string
+	}{
+		{"dark", fullTestTheme(t), `parent="Theme.Material3.Dark.NoActionBar"`},
+		{"light", NewThemeBuilder("paper", "Paper").
+			WithBackground(Hex("#fdf6e3")).
+			WithTextPrimary(Hex("#657b83")).
+			Build(), `parent="Theme.Material3.Light.NoActionBar"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var sb strings.Builder
+			if err := WriteAndroidTheme(&sb, tt.theme, AndroidOptions{}); err != nil {
+				t.Fatalf("WriteAndroidTheme() error: %v", err)
+			}
+			out := sb.String()
+			for _, want := range []string{
+				`<style name="Theme.App" ` + tt.parent + `>`,
+				`<item name="android:colorBackground">@color/theme_background</item>`,
+				`<item name="colorOnSurface">@color/theme_text_primary</item>`,
+				`<item name="colorSurfaceInverse">@color/theme_text_primary</item>`,
+				`<item name="colorOnSurfaceInverse">@color/theme_background</item>`,
+			} {
+				if !strings.Contains(out, want) {
+					t.Errorf("output lacks %q:\n%s", want, out)
+				}
+			}
+		})
+	}
+
+	// Attributes of empty roles are left out rather than referencing
+	// colors that don't exist.
+	var sb strings.Builder
+	if err := WriteAndroidTheme(&sb, tests[1].theme, AndroidOptions{ThemeName: "Theme.Acme"}); err != nil {
+		t.Fatalf("WriteAndroidTheme() error: %v", err)
+	}
+	if !strings.Contains(sb.String(), `<style name="Theme.Acme"`) {
+		t.Errorf("custom theme name not used:\n%s", sb.String())
+	}
+	if strings.Contains(sb.String(), "colorPrimary") {
+		t.Errorf("output references an empty role:\n%s", sb.String())
+	}
+}
+
+func TestWriteAndroidResources(t *testing.T) {
+	t.Parallel()
+
+	dark := fullTestTheme(t)
+	light := NewThemeBuilder("paper", "Paper").
+		WithBackground(Hex("#fdf6e3")).
+		WithTextPrimary(Hex("#657b83")).
+		Build()
+
+	dir := t.TempDir()
+	if err := WriteAndroidResources(dir, DefaultAndroidOptions(), light, dark); err != nil {
+		t.Fatalf("WriteAndroidResources() error: %v", err)
+	}
+	for path, want := range map[string]string{
+		"res/values/colors.xml":       `<color name="theme_background">#FDF6E3</color>`,
+		"res/values/themes.xml":       "Theme.Material3.Light.NoActionBar",
+		"res/values-night/colors.xml": `<color name="theme_background">#1E1E2E</color>`,
+		"res/values-night/themes.xml": "Theme.Material3.Dark.NoActionBar",
+	} {
+		data, err := os.ReadFile(filepath.Join(dir, path))
+		if err != nil {
+			t.Fatalf("reading %s: %v", path, err)
+		}
+		if !strings.Contains(string(data), want) {
+			t.Errorf("%s lacks %q:\n%s", path, want, data)
+		}
+	}
+
+	// The night theme references the roles it leaves empty, which resolve
+	// to the colors of the day theme.
+	dir = t.TempDir()
+	if !light.Accent().IsEmpty() {
+		t.Fatal("paper should have no accent")
+	}
+	if err := WriteAndroidResources(dir, DefaultAndroidOptions(), dark, light); err != nil {
+		t.Fatalf("WriteAndroidResources() error: %v", err)
+	}
+	night, err := os.ReadFile(filepath.Join(dir, "res", "values-night", "themes.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(night), `<item name="colorPrimary">@color/theme_accent</item>`) {
+		t.Errorf("night theme lacks colorPrimary:\n%s", night)
+	}
+	nightColors, err := os.ReadFile(filepath.Join(dir, "res", "values-night", "colors.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(nightColors), `"theme_accent"`) {
+		t.Error("night colors define the accent paper leaves empty")
+	}
+	for _, m := range materialColors {
+		if !strings.Contains(string(night), `name="`+m.attr+`"`) {
+			t.Errorf("night theme lacks %s", m.attr)
+		}
+	}
+
+	dir = t.TempDir()
+	if err := WriteAndroidResources(dir, DefaultAndroidOptions(), dark, nil); err != nil {
+		t.Fatalf("WriteAndroidResources() without dark theme error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "res", "values-night")); !os.IsNotExist(err) {
+		t.Errorf("values-night written without a dark theme: %v", err)
+	}
+
+	if err := WriteAndroidResources(t.TempDir(), DefaultAndroidOptions(), nil, dark); err == nil {
+		t.Error("WriteAndroidResources() without a theme should fail")
+	}
+}
+
+func TestWriteComposeColors(t *testing.T) {
+	t.Parallel()
+
+	dark := fullTestTheme(t)
+	light := NewThemeBuilder("3024_day", "3024 Day").
+		WithBackground(Hex("#f7f7f7")).
+		WithTextPrimary(Hex("#4a4543")).
+		Build()
+
+	var sb strings.Builder
+	if err := WriteComposeColors(&sb, AndroidOptions{Package: "com.acme.ui.theme"}, dark, nil, light); err != nil {
+		t.Fatalf("WriteComposeColors() error: %v", err)
+	}
+	out := sb.String()
+	for _, want := range []string{
+		"package com.acme.ui.theme\n",
+		"import androidx.compose.material3.darkColorScheme\n",
+		"import androidx.compose.material3.lightColorScheme\n",
+		"object CatppuccinMochaColors {\n",
+		"    val TextPrimary = Color(0xFFCDD6F4)\n",
+		"    val SuccessBackground = Color(0x19A6E3A1)\n",
+		"val CatppuccinMochaColorScheme: ColorScheme = darkColorScheme(\n",
+		"    primary = CatppuccinMochaColors.Accent,\n",
+		"val Theme3024DayColorScheme: ColorScheme = lightColorScheme(\n",
+		"    background = Theme3024DayColors.Background,\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output lacks %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "    primary = Theme3024DayColors") {
+		t.Error("color scheme references an empty role")
+	}
+
+	sb.Reset()
+	if err := WriteComposeColors(&sb, AndroidOptions{}, light); err != nil {
+		t.Fatalf("WriteComposeColors() error: %v", err)
+	}
+	if strings.Contains(sb.String(), "package ") || strings.Contains(sb.String(), "darkColorScheme") {
+		t.Errorf("unexpected package or import:\n%s", sb.String())
+	}
+
+	if err := WriteComposeColors(&sb, AndroidOptions{}); err == nil {
+		t.Error("WriteComposeColors() without themes should fail")
+	}
+}
+
+func TestExportAndroid(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	for path, want := range map[string]OutputFormat{
+		"app/src/main/res/values/colors.xml": FormatAndroidColors,
+		"res/values-night/themes.xml":        FormatAndroidTheme,
+		"Color.kt":                           FormatCompose,
+	} {
+		if got, err := OutputFormatFromPath(path); err != nil || got != want {
+			t.Errorf("OutputFormatFromPath(%q) = %s, %v, want %s", path, got, err, want)
+		}
+	}
+
+	opts := DefaultExportOptions()
+	opts.Android.Prefix = "acme"
+	var sb strings.Builder
+	if err := ExportWithOptions(&sb, FormatAndroidColors, opts, theme); err != nil {
+		t.Fatalf("Export(android-colors) error: %v", err)
+	}
+	if !strings.Contains(sb.String(), `name="acme_background"`) {
+		t.Errorf("export ignores the Android options:\n%s", sb.String())
+	}
+	if err := Export(&sb, FormatAndroidTheme, theme, theme); err == nil {
+		t.Error("Export(android-theme) with two themes should fail")
+	}
+}
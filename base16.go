@@ -0,0 +1,295 @@
+package gothememe
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// base16Slots and base24Slots name the palette entries of each system.
+var (
+	base16Slots = []string{
+		"base00", "base01", "base02", "base03", "base04", "base05", "base06", "base07",
+		"base08", "base09", "base0A", "base0B", "base0C", "base0D", "base0E", "base0F",
+	}
+	base24Slots = append(append([]string{}, base16Slots...),
+		"base10", "base11", "base12", "base13", "base14", "base15", "base16", "base17")
+)
+
+// base16Roles maps color roles to palette slots following the base16 styling
+// guidelines: base00-base07 run from background to foreground, base08-base0F
+// are red, orange, yellow, green, cyan, blue, magenta and brown. The ANSI
+// assignments match base16-shell.
+var base16Roles = []struct {
+	role string
+	slot string
+}{
+	{"background", "base00"},
+	{"background_secondary", "base01"},
+	{"surface", "base01"},
+	{"surface_secondary", "base02"},
+	{"text_primary", "base05"},
+	{"text_secondary", "base04"},
+	{"text_muted", "base03"},
+	{"text_inverted", "base00"},
+	{"accent", "base0D"},
+	{"accent_secondary", "base0E"},
+	{"brand", "base0D"},
+	{"border", "base02"},
+	{"border_subtle", "base01"},
+	{"border_strong", "base03"},
+	{"success_text", "base0B"},
+	{"warning_text", "base0A"},
+	{"error_text", "base08"},
+	{"info_text", "base0C"},
+	{"black", "base00"},
+	{"red", "base08"},
+	{"green", "base0B"},
+	{"yellow", "base0A"},
+	{"blue", "base0D"},
+	{"purple", "base0E"},
+	{"cyan", "base0C"},
+	{"white", "base05"},
+	{"bright_black", "base03"},
+	{"bright_red", "base08"},
+	{"bright_green", "base0B"},
+	{"bright_yellow", "base0A"},
+	{"bright_blue", "base0D"},
+	{"bright_purple", "base0E"},
+	{"bright_cyan", "base0C"},
+	{"bright_white", "base07"},
+	{"code_background", "base00"},
+	{"code_text", "base05"},
+	{"code_comment", "base03"},
+	{"code_keyword", "base0E"},
+	{"code_string", "base0B"},
+	{"code_number", "base09"},
+	{"code_function", "base0D"},
+	{"code_operator", "base05"},
+	{"code_punctuation", "base05"},
+	{"code_variable", "base08"},
+	{"code_constant", "base09"},
+	{"code_type", "base0A"},
+}
+
+// base24BrightRoles overrides the bright ANSI colors with base24's
+// dedicated slots.
+var base24BrightRoles = []struct {
+	role string
+	slot string
+}{
+	{"bright_red", "base12"},
+	{"bright_yellow", "base13"},
+	{"bright_green", "base14"},
+	{"bright_cyan", "base15"},
+	{"bright_blue", "base16"},
+	{"bright_purple", "base17"},
+}
+
+// baseScheme is a decoded base16 or base24 scheme.
+type baseScheme struct {
+	name        string
+	slug        string
+	author      string
+	description string
+	variant     string
+	palette     map[string]Color
+}
+
+// FromBase16 reads a base16 scheme in tinted-theming YAML and maps it onto a
+// Theme following the base16 styling guidelines. Both the current format
+// (a "palette" mapping with "name" and "variant") and the legacy flat format
+// (top-level "scheme" and baseXX keys) are accepted. Extra base24 slots are
+// ignored.
+func FromBase16(r io.Reader) (Theme, error) {
+	s, err := decodeBaseScheme(r, base16Slots)
+	if err != nil {
+		return nil, fmt.Errorf("base16: %w", err)
+	}
+	return s.theme(false), nil
+}
+
+// FromBase24 reads a base24 scheme in tinted-theming YAML. It maps like
+// [FromBase16] and additionally takes the bright ANSI colors from
+// base12-base17.
+func FromBase24(r io.Reader) (Theme, error) {
+	s, err := decodeBaseScheme(r, base24Slots)
+	if err != nil {
+		return nil, fmt.Errorf("base24: %w", err)
+	}
+	return s.theme(true), nil
+}
+
+// WriteBase16 writes t to w as a base16 scheme in tinted-theming YAML.
+// Translucent colors are composited over the background, since base16
+// palettes are opaque.
+func WriteBase16(w io.Writer, t Theme) error {
+	return writeBaseScheme(w, t, "base16", base16Slots)
+}
+
+// WriteBase24 writes t to w as a base24 scheme in tinted-theming YAML.
+func WriteBase24(w io.Writer, t Theme) error {
+	return writeBaseScheme(w, t, "base24", base24Slots)
+}
+
+// decodeBaseScheme parses a scheme and checks that every slot is present.
+// Values are read as raw YAML scalars, so unquoted hex such as 586e75 is not
+// mistaken for a number.
+func decodeBaseScheme(r io.Reader, slots []string) (baseScheme, error) {
+	var doc map[string]yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return baseScheme{}, fmt.Errorf("decoding scheme: %w", err)
+	}
+
+	str := func(key string) string {
+		return doc[key].Value
+	}
+	s := baseScheme{
+		name:        str("name"),
+		slug:        str("slug"),
+		author:      str("author"),
+		description: str("description"),
+		variant:     str("variant"),
+		palette:     make(map[string]Color, len(slots)),
+	}
+	if s.name == "" {
+		s.name = str("scheme")
+	}
+
+	palette := doc
+	if node, ok := doc["palette"]; ok {
+		palette = nil
+		if err := node.Decode(&palette); err != nil {
+			return baseScheme{}, fmt.Errorf("decoding palette: %w", err)
+		}
+	}
+
+	for _, slot := range slots {
+		node, ok := palette[slot]
+		if !ok {
+			// Schemes use both base0A and base0a.
+			node, ok = palette[strings.ToLower(slot)]
+		}
+		if !ok {
+			return baseScheme{}, fmt.Errorf("missing %s", slot)
+		}
+		c, err := ParseColor("#" + strings.TrimPrefix(node.Value, "#"))
+		if err != nil {
+			return baseScheme{}, fmt.Errorf("%s: %w", slot, err)
+		}
+		s.palette[slot] = c
+	}
+	return s, nil
+}
+
+// theme builds the Theme. base24 selects the dedicated bright ANSI slots.
+func (s *baseScheme) theme(base24 bool) Theme {
+	name := s.name
+	if name == "" {
+		name = "imported"
+	}
+	id := importedThemeID(s.slug, s.name)
+
+	b := NewThemeBuilder(id, name).
+		WithAuthor(s.author).
+		WithDescription(s.description)
+
+	set := func(roleKey, slot string) {
+		role, _ := lookupColorRole(roleKey) //nolint:errcheck // role keys are fixed in this file
+		role.set(b, s.palette[slot])
+	}
+	for _, m := range base16Roles {
+		set(m.role, m.slot)
+	}
+	if base24 {
+		for _, m := range base24BrightRoles {
+			set(m.role, m.slot)
+		}
+	}
+
+	if strings.EqualFold(s.variant, "dark") {
+		b.WithIsDark(true)
+	}
+	return b.Build()
+}
+
+// baseSchemePalette picks a color for every slot from t. Slots with a
+// matching role take it directly. Slots without one are filled from nearby
+// colors: base06 from ANSI white, base0F from a darkened red and the base24
+// background shades base10 and base11 from the background.
+func baseSchemePalette(t Theme) map[string]Color {
+	bg := t.Background()
+	darker := func(amount float64) Color {
+		if t.IsDark() {
+			return bg.Darken(amount)
+		}
+		return bg.Lighten(amount)
+	}
+
+	palette := map[string]Color{
+		"base00": bg,
+		"base01": t.BackgroundSecondary(),
+		"base02": t.SurfaceSecondary(),
+		"base03": t.CodeComment(),
+		"base04": t.TextSecondary(),
+		"base05": t.TextPrimary(),
+		"base06": t.White(),
+		"base07": t.BrightWhite(),
+		"base08": t.Red(),
+		"base09": t.CodeNumber(),
+		"base0A": t.Yellow(),
+		"base0B": t.Green(),
+		"base0C": t.Cyan(),
+		"base0D": t.Blue(),
+		"base0E": t.Purple(),
+		"base0F": t.Red().Darken(0.15),
+		"base10": darker(0.03),
+		"base11": darker(0.06),
+		"base12": t.BrightRed(),
+		"base13": t.BrightYellow(),
+		"base14": t.BrightGreen(),
+		"base15": t.BrightCyan(),
+		"base16": t.BrightBlue(),
+		"base17": t.BrightPurple(),
+	}
+	for slot, c := range palette {
+		palette[slot] = c.opaque(bg)
+	}
+	return palette
+}
+
+// writeBaseScheme writes the tinted-theming YAML for the given system.
+func writeBaseScheme(w io.Writer, t Theme, system string, slots []string) error {
+	variant := "light"
+	if t.IsDark() {
+		variant = "dark"
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "system: %q\n", system)
+	fmt.Fprintf(&buf, "name: %s\n", quoteFileString(t.DisplayName()))
+	fmt.Fprintf(&buf, "slug: %q\n", strings.ReplaceAll(t.ID(), "_", "-"))
+	if t.Author() != "" {
+		fmt.Fprintf(&buf, "author: %s\n", quoteFileString(t.Author()))
+	}
+	if t.Description() != "" {
+		fmt.Fprintf(&buf, "description: %s\n", quoteFileString(t.Description()))
+	}
+	fmt.Fprintf(&buf, "variant: %q\n", variant)
+	buf.WriteString("palette:\n")
+
+	palette := baseSchemePalette(t)
+	for _, slot := range slots {
+		c := palette[slot]
+		if c.IsEmpty() {
+			return fmt.Errorf("%s: theme %q has no color for %s", system, t.ID(), slot)
+		}
+		fmt.Fprintf(&buf, "  %s: %q\n", slot, c.Hex())
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
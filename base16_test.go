@@ -0,0 +1,304 @@
+package gothememe
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const testBase16Scheme = `system: "base16"
+name: "Default Dark"
+slug: "default-dark"
+author: "Chris Kempson (https://github.com/chriskempson)"
+variant: "dark"
+palette:
+  base00: "#181818"
+  base01: "#282828"
+  base02: "#383838"
+  base03: "#585858"
+  base04: "#b8b8b8"
+  base05: "#d8d8d8"
+  base06: "#e8e8e8"
+  base07: "#f8f8f8"
+  base08: "#ab4642"
+  base09: "#dc9656"
+  base0A: "#f7ca88"
+  base0B: "#a1b56c"
+  base0C: "#86c1b9"
+  base0D: "#7cafc2"
+  base0E: "#ba8baf"
+  base0F: "#a16946"
+`
+
+// testLegacyBase16Scheme uses the flat pre-0.11 layout with unquoted values.
+const testLegacyBase16Scheme = `scheme: "Solarized Light"
+author: "Ethan Schoonover"
+base00: fdf6e3
+base01: eee8d5
+base02: 93a1a1
+base03: 839496
+base04: 657b83
+base05: 586e75
+base06: 073642
+base07: 002b36
+base08: dc322f
+base09: cb4b16
+base0a: b58900
+base0b: 859900
+base0c: 2aa198
+base0d: 268bd2
+base0e: 6c71c4
+base0f: d33682
+`
+
+func TestFromBase16(t *testing.T) {
+	t.Parallel()
+
+	theme, err := FromBase16(strings.NewReader(testBase16Scheme))
+	if err != nil {
+		t.Fatalf("FromBase16() error: %v", err)
+	}
+
+	if theme.ID() != "default_dark" || theme.DisplayName() != "Default Dark" {
+		t.Errorf("identity = %q/%q, want default_dark/Default Dark", theme.ID(), theme.DisplayName())
+	}
+	if !strings.HasPrefix(theme.Author(), "Chris Kempson") {
+		t.Errorf("Author() = %q", theme.Author())
+	}
+	if !theme.IsDark() {
+		t.Error("IsDark() = false, want true")
+	}
+
+	tests := []struct {
+		name string
+		got  Color
+		want string
+	}{
+		{"background", theme.Background(), "#181818"},
+		{"surface", theme.Surface(), "#282828"},
+		{"text primary", theme.TextPrimary(), "#d8d8d8"},
+		{"text muted", theme.TextMuted(), "#585858"},
+		{"accent", theme.Accent(), "#7cafc2"},
+		{"error", theme.Error().Text, "#ab4642"},
+		{"ansi black", theme.Black(), "#181818"},
+		{"ansi bright black", theme.BrightBlack(), "#585858"},
+		{"ansi bright red reuses base08", theme.BrightRed(), "#ab4642"},
+		{"ansi bright white", theme.BrightWhite(), "#f8f8f8"},
+		{"comment", theme.CodeComment(), "#585858"},
+		{"keyword", theme.CodeKeyword(), "#ba8baf"},
+		{"string", theme.CodeString(), "#a1b56c"},
+		{"number", theme.CodeNumber(), "#dc9656"},
+		{"variable", theme.CodeVariable(), "#ab4642"},
+		{"type", theme.CodeType(), "#f7ca88"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if tt.got.Hex() != tt.want {
+				t.Errorf("got %s, want %s", tt.got.Hex(), tt.want)
+			}
+		})
+	}
+}
+
+func TestFromBase16Legacy(t *testing.T) {
+	t.Parallel()
+
+	theme, err := FromBase16(strings.NewReader(testLegacyBase16Scheme))
+	if err != nil {
+		t.Fatalf("FromBase16() error: %v", err)
+	}
+	if theme.ID() != "solarized_light" || theme.DisplayName() != "Solarized Light" {
+		t.Errorf("identity = %q/%q", theme.ID(), theme.DisplayName())
+	}
+	if theme.IsDark() {
+		t.Error("IsDark() = true, want false")
+	}
+	if theme.Blue().Hex() != "#268bd2" {
+		t.Errorf("Blue() = %s, want #268bd2 from lowercase base0d", theme.Blue().Hex())
+	}
+	if theme.BrightWhite().Hex() != "#002b36" {
+		t.Errorf("BrightWhite() = %s, want #002b36", theme.BrightWhite().Hex())
+	}
+}
+
+func TestFromBase16NonASCIIName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"name: \"夜空\"\nslug: \"night-sky\"\n", "night_sky"},
+		{"name: \"Night Sky\"\nslug: \"夜空\"\n", "night_sky"},
+		{"name: \"夜空\"\n", "imported"},
+		{"scheme: \"夜空\"\n", "imported"},
+	}
+	palette := testBase16Scheme[strings.Index(testBase16Scheme, "palette:"):]
+	for _, tt := range tests {
+		src := tt.header + palette
+		if strings.HasPrefix(tt.header, "scheme:") {
+			src = tt.header + testLegacyBase16Scheme[strings.Index(testLegacyBase16Scheme, "base00"):]
+		}
+		theme, err := FromBase16(strings.NewReader(src))
+		if err != nil {
+			t.Fatalf("FromBase16(%q) error: %v", tt.header, err)
+		}
+		if theme.ID() != tt.want {
+			t.Errorf("FromBase16(%q) ID() = %q, want %q", tt.header, theme.ID(), tt.want)
+		}
+	}
+}
+
+func TestFromBase24(t *testing.T) {
+	t.Parallel()
+
+	scheme := strings.Replace(testBase16Scheme, `system: "base16"`, `system: "base24"`, 1) +
+		"  base10: \"#101010\"\n  base11: \"#080808\"\n  base12: \"#ff5555\"\n  base13: \"#ffff55\"\n" +
+		"  base14: \"#55ff55\"\n  base15: \"#55ffff\"\n  base16: \"#5555ff\"\n  base17: \"#ff55ff\"\n"
+
+	theme, err := FromBase24(strings.NewReader(scheme))
+	if err != nil {
+		t.Fatalf("FromBase24() error: %v", err)
+	}
+
+	bright := map[string]Color{
+		"#ff5555": theme.BrightRed(),
+		"#ffff55": theme.BrightYellow(),
+		"#55ff55": theme.BrightGreen(),
+		"#55ffff": theme.BrightCyan(),
+		"#5555ff": theme.BrightBlue(),
+		"#ff55ff": theme.BrightPurple(),
+	}
+	for want, got := range bright {
+		if got.Hex() != want {
+			t.Errorf("bright color = %s, want %s", got.Hex(), want)
+		}
+	}
+
+	// base16 reading of the same file ignores the extra slots.
+	b16, err := FromBase16(strings.NewReader(scheme))
+	if err != nil {
+		t.Fatalf("FromBase16() error: %v", err)
+	}
+	if b16.BrightRed().Hex() != "#ab4642" {
+		t.Errorf("FromBase16 BrightRed() = %s, want #ab4642", b16.BrightRed().Hex())
+	}
+
+	if _, err := FromBase24(strings.NewReader(testBase16Scheme)); err == nil || !strings.Contains(err.Error(), "missing base10") {
+		t.Errorf("FromBase24() on base16 scheme error = %v, want missing base10", err)
+	}
+}
+
+func TestFromBase16Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"invalid yaml", "palette: [", "decoding scheme"},
+		{"missing slot", strings.Replace(testBase16Scheme, "  base0F: \"#a16946\"\n", "", 1), "missing base0F"},
+		{"invalid color", strings.Replace(testBase16Scheme, `"#a16946"`, `"zzzzzz"`, 1), "base0F"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := FromBase16(strings.NewReader(tt.input))
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWriteBase16RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	original, err := FromBase16(strings.NewReader(testBase16Scheme))
+	if err != nil {
+		t.Fatalf("FromBase16() error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteBase16(&buf, original); err != nil {
+		t.Fatalf("WriteBase16() error: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{`system: "base16"`, `slug: "default-dark"`, `variant: "dark"`, `base0D: "#7cafc2"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("WriteBase16() output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "base10") {
+		t.Error("WriteBase16() should not write base24 slots")
+	}
+
+	got, err := FromBase16(strings.NewReader(out))
+	if err != nil {
+		t.Fatalf("FromBase16() on written scheme error: %v", err)
+	}
+	assertSameColors(t, original, got)
+}
+
+func TestWriteBase24(t *testing.T) {
+	t.Parallel()
+
+	theme := NewThemeBuilder("glass", "Glass").
+		WithBackground(Hex("#ffffff")).
+		WithTextPrimary(Hex("#202020")).
+		WithRed(Hex("#cc0000")).
+		WithGreen(Hex("#00aa00")).
+		WithYellow(Hex("#aaaa00")).
+		WithBlue(Hex("#0000cc")).
+		WithPurple(Hex("#aa00aa")).
+		WithCyan(Hex("#00aaaa")).
+		WithWhite(Hex("#dddddd")).
+		WithBrightRed(Hex("#ff0000")).
+		WithBrightGreen(Hex("#00ff00")).
+		WithBrightYellow(Hex("#ffff00")).
+		WithBrightBlue(Hex("#0000ff")).
+		WithBrightPurple(Hex("#ff00ff")).
+		WithBrightCyan(Hex("#00ffff")).
+		WithBrightWhite(Hex("#ffffff")).
+		Build()
+
+	var buf bytes.Buffer
+	if err := WriteBase24(&buf, theme); err != nil {
+		t.Fatalf("WriteBase24() error: %v", err)
+	}
+
+	got, err := FromBase24(&buf)
+	if err != nil {
+		t.Fatalf("FromBase24() on written scheme error: %v", err)
+	}
+	if got.BrightBlue().Hex() != "#0000ff" {
+		t.Errorf("BrightBlue() = %s, want #0000ff", got.BrightBlue().Hex())
+	}
+	// Translucent roles are flattened onto the background.
+	if _, _, _, a := got.TextSecondary().RGBAComponents(); a != 255 {
+		t.Errorf("TextSecondary() alpha = %d, want opaque", a)
+	}
+	if got.IsDark() {
+		t.Error("IsDark() = true, want false")
+	}
+}
+
+func TestWriteBase16MissingColors(t *testing.T) {
+	t.Parallel()
+
+	theme := NewThemeBuilder("bare", "Bare").WithBackground(Hex("#000000")).Build()
+
+	var buf bytes.Buffer
+	err := WriteBase16(&buf, theme)
+	if err == nil || !strings.Contains(err.Error(), "no color for") {
+		t.Errorf("WriteBase16() error = %v, want missing color error", err)
+	}
+}
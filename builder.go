@@ -12,6 +12,10 @@ package gothememe
 //	    Build()
 type ThemeBuilder struct {
 	theme *BaseTheme
+
+	// modeSet disables dark mode detection, so a light theme stays light
+	// whatever its background.
+	modeSet bool
 }
 
 // NewThemeBuilder creates a new ThemeBuilder with the given ID and display name.
@@ -34,12 +38,20 @@ func (b *ThemeBuilder) Build() Theme {
 	return b.theme
 }
 
+// withMode sets whether the theme is dark and turns off detection from the
+// background, which [ThemeBuilder.WithIsDark] keeps for false.
+func (b *ThemeBuilder) withMode(dark bool) *ThemeBuilder {
+	b.theme.isDark = dark
+	b.modeSet = true
+	return b
+}
+
 // deriveMissingColors fills in unset colors based on the colors that are set.
 func (b *ThemeBuilder) deriveMissingColors() {
 	t := b.theme
 
 	// Detect dark mode if not explicitly set
-	if !t.background.IsEmpty() && !t.isDark {
+	if !t.background.IsEmpty() && !t.isDark && !b.modeSet {
 		t.isDark = t.background.IsDark()
 	}
 
@@ -336,8 +348,15 @@ func applyOverride(b *ThemeBuilder, name string, c Color) {
 
 // deriveSemanticColor creates a SemanticColor from a base color if the existing
 // color is empty. Uses the baseColor if available, otherwise falls back to fallback.
+// When only the text color is set, the background and border are derived from it.
 func deriveSemanticColor(existing SemanticColor, baseColor, fallback Color) SemanticColor {
 	if !existing.Text.IsEmpty() {
+		if existing.Background.IsEmpty() {
+			existing.Background = existing.Text.WithAlpha(0.1)
+		}
+		if existing.Border.IsEmpty() {
+			existing.Border = existing.Text.WithAlpha(0.3)
+		}
 		return existing
 	}
 
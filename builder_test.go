@@ -221,3 +221,21 @@ func TestThemeBuilderSemanticColors(t *testing.T) {
 		t.Errorf("Success().Text = %q, want #155724", theme.Success().Text.Hex())
 	}
 }
+
+func TestThemeBuilderSemanticTextOnly(t *testing.T) {
+	t.Parallel()
+
+	theme := NewThemeBuilder("semantic-text", "Semantic Text").
+		WithBackground(Hex("#ffffff")).
+		WithTextPrimary(Hex("#212529")).
+		WithWarning(SemanticColor{Text: Hex("#b45309")}).
+		Build()
+
+	warning := theme.Warning()
+	if warning.Background.Hex() != Hex("#b45309").WithAlpha(0.1).Hex() {
+		t.Errorf("Warning().Background = %q, want text color at 10%% alpha", warning.Background.Hex())
+	}
+	if warning.Border.Hex() != Hex("#b45309").WithAlpha(0.3).Hex() {
+		t.Errorf("Warning().Border = %q, want text color at 30%% alpha", warning.Border.Hex())
+	}
+}
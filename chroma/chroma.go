@@ -0,0 +1,158 @@
+package chroma
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/styles"
+
+	"github.com/tj-smith47/gothememe"
+)
+
+// Format writes a chroma XML style with [WriteStyle]. It holds a single
+// theme.
+var Format = gothememe.RegisterExporter(singleThemeExporter("chroma", WriteStyle, ".chroma.xml", ".xml"))
+
+// entries returns the style entries of t for every chroma token category.
+// Subtypes not listed inherit from their category, so every token type is
+// covered. Chroma colors are opaque, so translucent colors are composited
+// over the code background.
+func entries(t gothememe.Theme) map[chroma.TokenType]chroma.StyleEntry {
+	bg := t.CodeBackground()
+	if bg.IsEmpty() {
+		bg = t.Background()
+	}
+	text := t.CodeText()
+	if text.IsEmpty() {
+		text = t.TextPrimary()
+	}
+	colour := func(c gothememe.Color) chroma.Colour {
+		return chroma.ParseColour(opaque(c, bg).Hex())
+	}
+	fg := func(c gothememe.Color) chroma.StyleEntry {
+		return chroma.StyleEntry{Colour: colour(c)}
+	}
+	success, errs := t.Success(), t.Error()
+
+	return map[chroma.TokenType]chroma.StyleEntry{
+		chroma.Background:       {Colour: colour(text), Background: colour(bg)},
+		chroma.LineNumbers:      fg(t.TextMuted()),
+		chroma.LineNumbersTable: fg(t.TextMuted()),
+		chroma.LineHighlight:    {Background: colour(t.Surface())},
+		chroma.Error:            fg(errs.Text),
+
+		chroma.Keyword:         fg(t.CodeKeyword()),
+		chroma.KeywordConstant: fg(t.CodeConstant()),
+		chroma.KeywordType:     fg(t.CodeType()),
+
+		chroma.Name:              fg(text),
+		chroma.NameAttribute:     fg(t.CodeFunction()),
+		chroma.NameBuiltin:       fg(t.CodeFunction()),
+		chroma.NameClass:         fg(t.CodeType()),
+		chroma.NameConstant:      fg(t.CodeConstant()),
+		chroma.NameDecorator:     fg(t.CodeFunction()),
+		chroma.NameEntity:        fg(t.CodeConstant()),
+		chroma.NameException:     fg(t.CodeType()),
+		chroma.NameFunction:      fg(t.CodeFunction()),
+		chroma.NameFunctionMagic: fg(t.CodeFunction()),
+		chroma.NameLabel:         fg(t.CodeConstant()),
+		chroma.NameNamespace:     fg(t.CodeType()),
+		chroma.NameProperty:      fg(t.CodeVariable()),
+		chroma.NameTag:           fg(t.CodeKeyword()),
+		chroma.NameVariable:      fg(t.CodeVariable()),
+
+		chroma.Literal:               fg(t.CodeString()),
+		chroma.LiteralDate:           fg(t.CodeNumber()),
+		chroma.LiteralString:         fg(t.CodeString()),
+		chroma.LiteralStringDoc:      fg(t.CodeComment()),
+		chroma.LiteralStringEscape:   fg(t.CodeConstant()),
+		chroma.LiteralStringInterpol: fg(t.CodeVariable()),
+		chroma.LiteralStringRegex:    fg(t.CodeConstant()),
+		chroma.LiteralStringSymbol:   fg(t.CodeConstant()),
+		chroma.LiteralNumber:         fg(t.CodeNumber()),
+
+		chroma.Operator:     fg(t.CodeOperator()),
+		chroma.OperatorWord: fg(t.CodeKeyword()),
+		chroma.Punctuation:  fg(t.CodePunctuation()),
+
+		chroma.Comment:        {Colour: colour(t.CodeComment()), Italic: chroma.Yes},
+		chroma.CommentPreproc: {Colour: colour(t.CodeKeyword()), Italic: chroma.No},
+
+		chroma.Generic:           fg(text),
+		chroma.GenericDeleted:    {Colour: colour(errs.Text), Background: colour(errs.Background)},
+		chroma.GenericEmph:       {Italic: chroma.Yes},
+		chroma.GenericError:      fg(errs.Text),
+		chroma.GenericHeading:    {Colour: colour(t.Accent()), Bold: chroma.Yes},
+		chroma.GenericInserted:   {Colour: colour(success.Text), Background: colour(success.Background)},
+		chroma.GenericOutput:     fg(t.TextMuted()),
+		chroma.GenericPrompt:     fg(t.TextMuted()),
+		chroma.GenericStrong:     {Bold: chroma.Yes},
+		chroma.GenericSubheading: fg(t.AccentSecondary()),
+		chroma.GenericTraceback:  fg(errs.Text),
+		chroma.GenericUnderline:  {Underline: chroma.Yes},
+
+		chroma.Text:           fg(text),
+		chroma.TextWhitespace: fg(t.TextMuted()),
+	}
+}
+
+// Style returns t as a chroma style named after the theme ID, for chroma's
+// inline-style HTML and terminal formatters and anything else that takes a
+// *chroma.Style. It covers every token category, unlike the class rules of
+// gothememe.SyntaxChroma.
+func Style(t gothememe.Theme) *chroma.Style {
+	styleEntries := chroma.StyleEntries{}
+	for ttype, entry := range entries(t) {
+		if !entry.IsZero() {
+			styleEntries[ttype] = entry.String()
+		}
+	}
+	// The entries are rendered by chroma itself, so they always parse.
+	return chroma.MustNewStyle(t.ID(), styleEntries)
+}
+
+// RegisterStyles adds the chroma style of each theme to chroma's
+// styles.Registry under its theme ID, replacing any style of the same name,
+// so libraries that look styles up by name, such as goldmark-highlighting,
+// can use them. Call it during initialization: the registry is not safe for
+// concurrent use. Nil themes are skipped.
+func RegisterStyles(themes ...gothememe.Theme) {
+	for _, t := range themes {
+		if t != nil {
+			styles.Register(Style(t))
+		}
+	}
+}
+
+// WriteStyle writes the chroma style of t to w as XML, the format of
+// chroma's built-in styles, which chroma.NewXMLStyle reads back.
+func WriteStyle(w io.Writer, t gothememe.Theme) error {
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(Style(t)); err != nil {
+		return fmt.Errorf("encoding chroma style: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// opaque composites c over bg, as chroma colors have no alpha. An empty c
+// stays empty.
+func opaque(c, bg gothememe.Color) gothememe.Color {
+	if c.IsEmpty() {
+		return gothememe.Color{}
+	}
+	r, g, b, a := c.RGBAComponents()
+	if a == 255 {
+		return gothememe.RGB(r, g, b)
+	}
+	br, bgG, bb := bg.RGB()
+	alpha := float64(a) / 255
+	blend := func(fg, back uint8) uint8 {
+		return uint8(math.Round(math.Max(0, math.Min(255, float64(fg)*alpha+float64(back)*(1-alpha)))))
+	}
+	return gothememe.RGB(blend(r, br), blend(g, bgG), blend(b, bb))
+}
@@ -0,0 +1,120 @@
+package chroma
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/styles"
+
+	"github.com/tj-smith47/gothememe"
+	"github.com/tj-smith47/gothememe/themes"
+)
+
+func TestStyle(t *testing.T) {
+	t.Parallel()
+
+	theme := themes.ThemeCatppuccinMocha
+	style := Style(theme)
+	if style.Name != theme.ID() {
+		t.Errorf("Name = %q, want %q", style.Name, theme.ID())
+	}
+
+	tests := []struct {
+		ttype chroma.TokenType
+		want  gothememe.Color
+	}{
+		{chroma.Keyword, theme.CodeKeyword()},
+		{chroma.KeywordDeclaration, theme.CodeKeyword()},
+		{chroma.LiteralStringDouble, theme.CodeString()},
+		{chroma.LiteralNumberHex, theme.CodeNumber()},
+		{chroma.NameFunction, theme.CodeFunction()},
+		{chroma.NameVariableGlobal, theme.CodeVariable()},
+		{chroma.NameClass, theme.CodeType()},
+		{chroma.OperatorWord, theme.CodeKeyword()},
+		{chroma.Punctuation, theme.CodePunctuation()},
+		{chroma.CommentSingle, theme.CodeComment()},
+		{chroma.GenericDeleted, theme.Error().Text},
+		{chroma.Error, theme.Error().Text},
+	}
+	for _, tt := range tests {
+		if got := style.Get(tt.ttype).Colour.String(); got != opaque(tt.want, theme.CodeBackground()).Hex() {
+			t.Errorf("%s colour = %s, want %s", tt.ttype, got, tt.want.Hex())
+		}
+	}
+
+	bg := style.Get(chroma.Background)
+	if bg.Background.String() != theme.CodeBackground().Hex() || bg.Colour.String() != theme.CodeText().Hex() {
+		t.Errorf("Background = %s, want %s on %s", bg, theme.CodeText().Hex(), theme.CodeBackground().Hex())
+	}
+	if style.Get(chroma.CommentSingle).Italic != chroma.Yes {
+		t.Error("comments are not italic")
+	}
+	if style.Get(chroma.CommentPreproc).Italic == chroma.Yes {
+		t.Error("preprocessor comments inherit italic")
+	}
+
+	// Translucent semantic backgrounds are composited over the code background.
+	inserted := style.Get(chroma.GenericInserted).Background.String()
+	if want := opaque(theme.Success().Background, theme.CodeBackground()).Hex(); inserted != want {
+		t.Errorf("GenericInserted background = %s, want %s", inserted, want)
+	}
+}
+
+func TestRegisterStyles(t *testing.T) {
+	t.Parallel()
+
+	theme := gothememe.NewThemeBuilder("gothememe_chroma_test", "Chroma Test").
+		WithBackground(gothememe.Hex("#101010")).
+		WithTextPrimary(gothememe.Hex("#eeeeee")).
+		WithPurple(gothememe.Hex("#c678dd")).
+		Build()
+	RegisterStyles(theme, nil)
+
+	style := styles.Get("gothememe_chroma_test")
+	if style.Name != "gothememe_chroma_test" {
+		t.Fatalf("styles.Get() = %q, want the registered style", style.Name)
+	}
+	if got := style.Get(chroma.Keyword).Colour.String(); got != "#c678dd" {
+		t.Errorf("Keyword colour = %s, want #c678dd", got)
+	}
+}
+
+func TestWriteStyle(t *testing.T) {
+	t.Parallel()
+
+	theme := themes.ThemeCatppuccinMocha
+	var sb strings.Builder
+	if err := WriteStyle(&sb, theme); err != nil {
+		t.Fatalf("WriteStyle() error: %v", err)
+	}
+	if !strings.HasPrefix(sb.String(), `<style name="catppuccin_mocha">`) {
+		t.Errorf("output does not start with the style element:\n%s", sb.String())
+	}
+
+	parsed, err := chroma.NewXMLStyle(strings.NewReader(sb.String()))
+	if err != nil {
+		t.Fatalf("NewXMLStyle() error: %v\n%s", err, sb.String())
+	}
+	want := Style(theme)
+	for _, ttype := range want.Types() {
+		if got, w := parsed.Get(ttype).String(), want.Get(ttype).String(); got != w {
+			t.Errorf("%s = %q, want %q", ttype, got, w)
+		}
+	}
+
+	if format, err := gothememe.OutputFormatFromPath("catppuccin.chroma.xml"); err != nil || format != Format {
+		t.Errorf("OutputFormatFromPath() = %s, %v, want chroma", format, err)
+	}
+	var exported strings.Builder
+	if err := gothememe.Export(&exported, Format, theme); err != nil {
+		t.Fatalf("Export() error: %v", err)
+	}
+	if exported.String() != sb.String() {
+		t.Error("Export() output differs from WriteStyle()")
+	}
+	if err := gothememe.Export(&exported, Format, theme, themes.ThemeNord); !errors.Is(err, gothememe.ErrTooManyThemes) {
+		t.Errorf("Export() with two themes error = %v, want ErrTooManyThemes", err)
+	}
+}
@@ -0,0 +1,15 @@
+// Package chroma converts gothememe themes to native styles for the chroma
+// syntax highlighter, and to Pygments styles and stylesheets, which take the
+// same token colors.
+//
+// It is a separate package so that programs using the core theme API don't
+// compile chroma and its style registry. Importing it registers the chroma
+// and Pygments exporters with gothememe under [Format], [FormatPygments] and
+// [FormatPygmentsCSS]:
+//
+//	import gothemechroma "github.com/tj-smith47/gothememe/chroma"
+//
+//	style := gothemechroma.Style(themes.ThemeDracula)
+//	gothemechroma.RegisterStyles(themes.ThemeDracula, themes.ThemeNord)
+//	err := gothememe.Export(f, gothemechroma.Format, theme)
+package chroma
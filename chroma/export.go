@@ -0,0 +1,34 @@
+package chroma
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/tj-smith47/gothememe"
+)
+
+// exporterFunc adapts a function to the gothememe.Exporter interface.
+type exporterFunc struct {
+	name       string
+	extensions []string
+	export     func(w io.Writer, themes []gothememe.Theme, opts gothememe.ExportOptions) error
+}
+
+func (e exporterFunc) Name() string         { return e.name }
+func (e exporterFunc) Extensions() []string { return slices.Clone(e.extensions) }
+
+func (e exporterFunc) Export(w io.Writer, themes []gothememe.Theme, opts gothememe.ExportOptions) error {
+	return e.export(w, themes, opts)
+}
+
+// singleThemeExporter returns the exporter for a format that holds a single
+// theme and is written by write.
+func singleThemeExporter(name string, write func(io.Writer, gothememe.Theme) error, extensions ...string) exporterFunc {
+	return exporterFunc{name, extensions, func(w io.Writer, themes []gothememe.Theme, _ gothememe.ExportOptions) error {
+		if len(themes) > 1 {
+			return fmt.Errorf("%w: got %d", gothememe.ErrTooManyThemes, len(themes))
+		}
+		return write(w, themes[0])
+	}}
+}
@@ -0,0 +1,268 @@
+package chroma
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/alecthomas/chroma/v2"
+
+	"github.com/tj-smith47/gothememe"
+)
+
+// FormatPygments writes a Python module with a Pygments Style class with
+// [WritePygmentsStyle]. It holds a single theme.
+var FormatPygments = gothememe.RegisterExporter(singleThemeExporter("pygments", WritePygmentsStyle, ".py"))
+
+// FormatPygmentsCSS writes the CSS of Pygments' HTML formatter with
+// [GeneratePygmentsCSS].
+var FormatPygmentsCSS = gothememe.RegisterExporter(exporterFunc{"pygments-css", []string{".pygments.css"}, exportPygmentsCSS})
+
+// pygmentsTokens lists the Pygments token types in hierarchy order with
+// their short CSS class and the chroma token type, a port of the same
+// hierarchy, whose style they take.
+var pygmentsTokens = []struct {
+	name   string
+	class  string
+	chroma chroma.TokenType
+}{
+	{"Text", "", chroma.Text},
+	{"Text.Whitespace", "w", chroma.TextWhitespace},
+	{"Error", "err", chroma.Error},
+	{"Other", "x", chroma.Other},
+
+	{"Keyword", "k", chroma.Keyword},
+	{"Keyword.Constant", "kc", chroma.KeywordConstant},
+	{"Keyword.Declaration", "kd", chroma.KeywordDeclaration},
+	{"Keyword.Namespace", "kn", chroma.KeywordNamespace},
+	{"Keyword.Pseudo", "kp", chroma.KeywordPseudo},
+	{"Keyword.Reserved", "kr", chroma.KeywordReserved},
+	{"Keyword.Type", "kt", chroma.KeywordType},
+
+	{"Name", "n", chroma.Name},
+	{"Name.Attribute", "na", chroma.NameAttribute},
+	{"Name.Builtin", "nb", chroma.NameBuiltin},
+	{"Name.Builtin.Pseudo", "bp", chroma.NameBuiltinPseudo},
+	{"Name.Class", "nc", chroma.NameClass},
+	{"Name.Constant", "no", chroma.NameConstant},
+	{"Name.Decorator", "nd", chroma.NameDecorator},
+	{"Name.Entity", "ni", chroma.NameEntity},
+	{"Name.Exception", "ne", chroma.NameException},
+	{"Name.Function", "nf", chroma.NameFunction},
+	{"Name.Function.Magic", "fm", chroma.NameFunctionMagic},
+	{"Name.Property", "py", chroma.NameProperty},
+	{"Name.Label", "nl", chroma.NameLabel},
+	{"Name.Namespace", "nn", chroma.NameNamespace},
+	{"Name.Other", "nx", chroma.NameOther},
+	{"Name.Tag", "nt", chroma.NameTag},
+	{"Name.Variable", "nv", chroma.NameVariable},
+	{"Name.Variable.Class", "vc", chroma.NameVariableClass},
+	{"Name.Variable.Global", "vg", chroma.NameVariableGlobal},
+	{"Name.Variable.Instance", "vi", chroma.NameVariableInstance},
+	{"Name.Variable.Magic", "vm", chroma.NameVariableMagic},
+
+	{"Literal", "l", chroma.Literal},
+	{"Literal.Date", "ld", chroma.LiteralDate},
+	{"Literal.String", "s", chroma.LiteralString},
+	{"Literal.String.Affix", "sa", chroma.LiteralStringAffix},
+	{"Literal.String.Backtick", "sb", chroma.LiteralStringBacktick},
+	{"Literal.String.Char", "sc", chroma.LiteralStringChar},
+	{"Literal.String.Delimiter", "dl", chroma.LiteralStringDelimiter},
+	{"Literal.String.Doc", "sd", chroma.LiteralStringDoc},
+	{"Literal.String.Double", "s2", chroma.LiteralStringDouble},
+	{"Literal.String.Escape", "se", chroma.LiteralStringEscape},
+	{"Literal.String.Heredoc", "sh", chroma.LiteralStringHeredoc},
+	{"Literal.String.Interpol", "si", chroma.LiteralStringInterpol},
+	{"Literal.String.Other", "sx", chroma.LiteralStringOther},
+	{"Literal.String.Regex", "sr", chroma.LiteralStringRegex},
+	{"Literal.String.Single", "s1", chroma.LiteralStringSingle},
+	{"Literal.String.Symbol", "ss", chroma.LiteralStringSymbol},
+	{"Literal.Number", "m", chroma.LiteralNumber},
+	{"Literal.Number.Bin", "mb", chroma.LiteralNumberBin},
+	{"Literal.Number.Float", "mf", chroma.LiteralNumberFloat},
+	{"Literal.Number.Hex", "mh", chroma.LiteralNumberHex},
+	{"Literal.Number.Integer", "mi", chroma.LiteralNumberInteger},
+	{"Literal.Number.Integer.Long", "il", chroma.LiteralNumberIntegerLong},
+	{"Literal.Number.Oct", "mo", chroma.LiteralNumberOct},
+
+	{"Operator", "o", chroma.Operator},
+	{"Operator.Word", "ow", chroma.OperatorWord},
+
+	{"Punctuation", "p", chroma.Punctuation},
+	{"Punctuation.Marker", "pm", chroma.Punctuation},
+
+	{"Comment", "c", chroma.Comment},
+	{"Comment.Hashbang", "ch", chroma.CommentHashbang},
+	{"Comment.Multiline", "cm", chroma.CommentMultiline},
+	{"Comment.Preproc", "cp", chroma.CommentPreproc},
+	{"Comment.PreprocFile", "cpf", chroma.CommentPreprocFile},
+	{"Comment.Single", "c1", chroma.CommentSingle},
+	{"Comment.Special", "cs", chroma.CommentSpecial},
+
+	{"Generic", "g", chroma.Generic},
+	{"Generic.Deleted", "gd", chroma.GenericDeleted},
+	{"Generic.Emph", "ge", chroma.GenericEmph},
+	{"Generic.Error", "gr", chroma.GenericError},
+	{"Generic.Heading", "gh", chroma.GenericHeading},
+	{"Generic.Inserted", "gi", chroma.GenericInserted},
+	{"Generic.Output", "go", chroma.GenericOutput},
+	{"Generic.Prompt", "gp", chroma.GenericPrompt},
+	{"Generic.Strong", "gs", chroma.GenericStrong},
+	{"Generic.Subheading", "gu", chroma.GenericSubheading},
+	{"Generic.Traceback", "gt", chroma.GenericTraceback},
+	{"Generic.Underline", "gl", chroma.GenericUnderline},
+}
+
+// WritePygmentsStyle writes t to w as a Python module defining a Pygments
+// Style subclass, with the same token colors as [Style].
+// Pygments resolves the token hierarchy itself, so subtypes such as
+// Literal.String.Doc without their own entry inherit from their parent.
+// Load it in Sphinx with pygments_style = "<module>.<Class>", where Class is
+// the theme ID in CamelCase followed by "Style".
+func WritePygmentsStyle(w io.Writer, t gothememe.Theme) error {
+	entries := entries(t)
+	bg := entries[chroma.Background]
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "\"\"\"%s Pygments style, generated by gothememe.\"\"\"\n\n", pythonDocString(t.DisplayName()))
+	sb.WriteString("from pygments.style import Style\nfrom pygments.token import Token\n\n\n")
+	fmt.Fprintf(&sb, "class %s(Style):\n", pygmentsClassName(t.ID()))
+	fmt.Fprintf(&sb, "    name = %q\n\n", t.ID())
+	for _, attr := range []struct {
+		name string
+		c    chroma.Colour
+	}{
+		{"background_color", bg.Background},
+		{"highlight_color", entries[chroma.LineHighlight].Background},
+		{"line_number_color", entries[chroma.LineNumbers].Colour},
+	} {
+		if attr.c.IsSet() {
+			fmt.Fprintf(&sb, "    %s = %q\n", attr.name, attr.c.String())
+		}
+	}
+
+	sb.WriteString("\n    styles = {\n")
+	if bg.Colour.IsSet() {
+		fmt.Fprintf(&sb, "        Token: %q,\n", bg.Colour.String())
+	}
+	for _, tok := range pygmentsTokens {
+		if entry := entries[tok.chroma]; !entry.IsZero() {
+			fmt.Fprintf(&sb, "        Token.%s: %q,\n", tok.name, entry.String())
+		}
+	}
+	sb.WriteString("    }\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// pygmentsClassName returns the Python class name of a theme's style.
+func pygmentsClassName(id string) string {
+	return pascalCase(id) + "Style"
+}
+
+// pascalCase converts a theme ID to a PascalCase identifier, such as
+// "catppuccin_mocha" to "CatppuccinMocha". Any rune other than a letter or
+// digit separates words, and a leading digit is prefixed with "Theme" so the
+// result is a valid identifier.
+func pascalCase(s string) string {
+	var sb strings.Builder
+	for _, word := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		r := []rune(word)
+		sb.WriteString(string(unicode.ToUpper(r[0])) + string(r[1:]))
+	}
+	name := sb.String()
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "Theme" + name
+	}
+	return name
+}
+
+// pythonDocString escapes s for a triple-quoted Python string.
+func pythonDocString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, `"`, `\"`)
+}
+
+// GeneratePygmentsCSS generates the stylesheet Pygments' HtmlFormatter
+// expects, with a rule for every class of the token hierarchy, such as
+// ".highlight .c1" for Comment.Single. CSS classes don't inherit from their
+// parent token, so each rule holds the fully resolved style. The selector
+// defaults to ".highlight".
+func GeneratePygmentsCSS(t gothememe.Theme, selector string) string {
+	if selector == "" {
+		selector = ".highlight"
+	}
+	style := Style(t)
+	bg := style.Get(chroma.Background)
+
+	var sb strings.Builder
+	if hl := style.Get(chroma.LineHighlight).Background; hl.IsSet() {
+		fmt.Fprintf(&sb, "%s .hll { background-color: %s }\n", selector, hl)
+	}
+	fmt.Fprintf(&sb, "%s { %s }\n", selector, pygmentsDeclarations(chroma.StyleEntry{Colour: bg.Colour, Background: bg.Background}))
+	if ln := style.Get(chroma.LineNumbers).Colour; ln.IsSet() {
+		fmt.Fprintf(&sb, "%s td.linenos .normal, %s span.linenos { color: %s; background-color: transparent; padding-left: 5px; padding-right: 5px; }\n",
+			selector, selector, ln)
+	}
+
+	for _, tok := range pygmentsTokens {
+		if tok.class == "" {
+			continue
+		}
+		entry := style.Get(tok.chroma)
+		if entry.Background == bg.Background {
+			entry.Background = 0
+		}
+		if decl := pygmentsDeclarations(entry); decl != "" {
+			fmt.Fprintf(&sb, "%s .%s { %s } /* %s */\n", selector, tok.class, decl, tok.name)
+		}
+	}
+	return sb.String()
+}
+
+// pygmentsDeclarations renders a style entry as CSS declarations, in the
+// order Pygments writes them.
+func pygmentsDeclarations(e chroma.StyleEntry) string {
+	var decls []string
+	if e.Colour.IsSet() {
+		decls = append(decls, "color: "+e.Colour.String())
+	}
+	if e.Background.IsSet() {
+		decls = append(decls, "background-color: "+e.Background.String())
+	}
+	if e.Bold == chroma.Yes {
+		decls = append(decls, "font-weight: bold")
+	}
+	if e.Italic == chroma.Yes {
+		decls = append(decls, "font-style: italic")
+	}
+	if e.Underline == chroma.Yes {
+		decls = append(decls, "text-decoration: underline")
+	}
+	if e.Border.IsSet() {
+		decls = append(decls, "border: 1px solid "+e.Border.String())
+	}
+	return strings.Join(decls, "; ")
+}
+
+// exportPygmentsCSS writes the Pygments CSS of one theme, or of several
+// themes scoped to their [data-theme] attribute.
+func exportPygmentsCSS(w io.Writer, themes []gothememe.Theme, _ gothememe.ExportOptions) error {
+	if len(themes) == 1 {
+		_, err := io.WriteString(w, GeneratePygmentsCSS(themes[0], ""))
+		return err
+	}
+	var sb strings.Builder
+	for i, t := range themes {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(GeneratePygmentsCSS(t, fmt.Sprintf("[data-theme=%q] .highlight", t.ID())))
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
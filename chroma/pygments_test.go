@@ -0,0 +1,127 @@
+package chroma
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/tj-smith47/gothememe"
+	"github.com/tj-smith47/gothememe/themes"
+)
+
+func TestWritePygmentsStyle(t *testing.T) {
+	t.Parallel()
+
+	theme := themes.ThemeCatppuccinMocha
+	var sb strings.Builder
+	if err := WritePygmentsStyle(&sb, theme); err != nil {
+		t.Fatalf("WritePygmentsStyle() error: %v", err)
+	}
+	out := sb.String()
+
+	for _, want := range []string{
+		"from pygments.style import Style\n",
+		"class CatppuccinMochaStyle(Style):\n",
+		`    background_color = "#1e1e2e"` + "\n",
+		`        Token: "#cdd6f4",` + "\n",
+		`        Token.Keyword: "` + theme.CodeKeyword().Hex() + `",` + "\n",
+		`        Token.Name.Function: "` + theme.CodeFunction().Hex() + `",` + "\n",
+		`        Token.Literal.String.Doc: "` + theme.CodeComment().Hex() + `",` + "\n",
+		`        Token.Comment: "italic ` + theme.CodeComment().Hex() + `",` + "\n",
+		`        Token.Comment.Preproc: "noitalic ` + theme.CodeKeyword().Hex() + `",` + "\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output lacks %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestPygmentsClassName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"catppuccin_mocha":  "CatppuccinMochaStyle",
+		"github-light":      "GithubLightStyle",
+		"3024_night":        "Theme3024NightStyle",
+		"":                  "ThemeStyle",
+		"solarized.dark v2": "SolarizedDarkV2Style",
+	}
+	for id, want := range tests {
+		if got := pygmentsClassName(id); got != want {
+			t.Errorf("pygmentsClassName(%q) = %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestGeneratePygmentsCSS(t *testing.T) {
+	t.Parallel()
+
+	theme := themes.ThemeCatppuccinMocha
+	css := GeneratePygmentsCSS(theme, "")
+
+	for _, want := range []string{
+		".highlight { color: #cdd6f4; background-color: #1e1e2e }\n",
+		".highlight .k { color: " + theme.CodeKeyword().Hex() + " } /* Keyword */\n",
+		// Subtypes without their own entry get the resolved parent style.
+		".highlight .kd { color: " + theme.CodeKeyword().Hex() + " } /* Keyword.Declaration */\n",
+		".highlight .c1 { color: " + theme.CodeComment().Hex() + "; font-style: italic } /* Comment.Single */\n",
+		".highlight .cpf { color: " + theme.CodeKeyword().Hex() + " } /* Comment.PreprocFile */\n",
+		".highlight .s2 { color: " + theme.CodeString().Hex() + " } /* Literal.String.Double */\n",
+		".highlight .il { color: " + theme.CodeNumber().Hex() + " } /* Literal.Number.Integer.Long */\n",
+		".highlight .gh { color: " + theme.Accent().Hex() + "; font-weight: bold } /* Generic.Heading */\n",
+	} {
+		if !strings.Contains(css, want) {
+			t.Errorf("CSS lacks %q:\n%s", want, css)
+		}
+	}
+	for _, tok := range pygmentsTokens {
+		if tok.class != "" && !strings.Contains(css, ".highlight ."+tok.class+" {") {
+			t.Errorf("CSS lacks a rule for %s", tok.name)
+		}
+	}
+	if strings.Contains(css, ".highlight .k { color: "+theme.CodeKeyword().Hex()+"; background-color") {
+		t.Error("token rules repeat the background color")
+	}
+
+	if custom := GeneratePygmentsCSS(theme, ".codehilite"); !strings.Contains(custom, ".codehilite .k {") {
+		t.Errorf("custom selector not used:\n%s", custom)
+	}
+}
+
+func TestExportPygments(t *testing.T) {
+	t.Parallel()
+
+	dark := themes.ThemeCatppuccinMocha
+	light := gothememe.NewThemeBuilder("paper", "Paper").
+		WithBackground(gothememe.Hex("#fdf6e3")).
+		WithTextPrimary(gothememe.Hex("#657b83")).
+		Build()
+
+	var css strings.Builder
+	if err := gothememe.Export(&css, FormatPygmentsCSS, dark); err != nil {
+		t.Fatalf("Export(pygments-css) error: %v", err)
+	}
+	if css.String() != GeneratePygmentsCSS(dark, "") {
+		t.Error("Export(pygments-css) output differs from GeneratePygmentsCSS()")
+	}
+
+	css.Reset()
+	if err := gothememe.Export(&css, FormatPygmentsCSS, dark, light); err != nil {
+		t.Fatalf("Export(pygments-css) with two themes error: %v", err)
+	}
+	if !strings.Contains(css.String(), `[data-theme="paper"] .highlight .k {`) {
+		t.Errorf("multi-theme CSS lacks scoped rules:\n%s", css.String())
+	}
+
+	for path, want := range map[string]gothememe.OutputFormat{
+		"acme_style.py":          FormatPygments,
+		"docs/acme.pygments.css": FormatPygmentsCSS,
+	} {
+		if got, err := gothememe.OutputFormatFromPath(path); err != nil || got != want {
+			t.Errorf("OutputFormatFromPath(%q) = %s, %v, want %s", path, got, err, want)
+		}
+	}
+	if err := gothememe.Export(&css, FormatPygments, dark, light); !errors.Is(err, gothememe.ErrTooManyThemes) {
+		t.Errorf("Export(pygments) with two themes error = %v, want ErrTooManyThemes", err)
+	}
+}
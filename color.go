@@ -1,10 +1,12 @@
 package gothememe
 
 import (
+	"errors"
 	"fmt"
 	"image/color"
 	"math"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/lucasb-eyer/go-colorful"
@@ -30,6 +32,10 @@ type SemanticColor struct {
 
 var hexPattern = regexp.MustCompile(`^#?([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
 
+// ErrInvalidColor is returned (wrapped) by ParseColor when a string cannot be
+// interpreted as a color.
+var ErrInvalidColor = errors.New("invalid color")
+
 // Hex creates a Color from a hex string.
 // Accepts formats: "#RGB", "#RRGGBB", "#RRGGBBAA", "RGB", "RRGGBB", "RRGGBBAA".
 // Returns an empty color if the input is invalid.
@@ -65,10 +71,11 @@ func HSL(h, s, l float64) Color {
 
 // OKLCH creates a Color from OKLCH color space values.
 // L is lightness (0-1), C is chroma (typically 0-0.4), H is hue (0-360).
+// Colors outside the sRGB gamut keep their lightness and hue and are
+// reduced in chroma until they fit.
 func OKLCH(l, c, h float64) Color {
-	// Convert OKLCH to sRGB via Lab
-	col := colorful.LuvLCh(l*100, c*100, h)
-	return Color{value: strings.TrimPrefix(col.Clamped().Hex(), "#")}
+	rad := h * math.Pi / 180
+	return oklab{l, c * math.Cos(rad), c * math.Sin(rad)}.color()
 }
 
 // IsEmpty returns true if the color has no value.
@@ -114,9 +121,13 @@ func (c Color) HSLValues() (h, s, l float64) {
 // OKLCHValues returns the OKLCH color space values.
 // L is lightness (0-1), C is chroma (typically 0-0.4), H is hue (0-360).
 func (c Color) OKLCHValues() (l, ch, h float64) {
-	col := c.colorful()
-	ll, cc, hh := col.LuvLCh()
-	return ll / 100, cc / 100, hh
+	lab := newOKLab(c)
+	ch = lab.chroma()
+	if ch < 1e-4 {
+		// Grays have no meaningful hue.
+		return lab.l, 0, 0
+	}
+	return lab.l, ch, lab.hue()
 }
 
 // CSS returns the color formatted for CSS.
@@ -264,3 +275,176 @@ func FromStdColor(c color.Color) Color {
 	// color.RGBA() returns 16-bit values, convert to 8-bit
 	return RGBA(uint8(r>>8), uint8(g>>8), uint8(b>>8), uint8(a>>8))
 }
+
+// ParseColor parses a color string as written in CSS or theme files.
+// Accepted forms are hex ("#RGB", "#RRGGBB", "#RRGGBBAA", with or without the
+// "#" prefix) and the rgb(), rgba(), hsl(), hsla() and oklch() functions
+// with either comma- or space-separated arguments. oklch() values are read
+// with the same conversion as [OKLCH] and [Color.OKLCHValues], so CSS
+// written with [ColorSpaceOKLCH] parses back to the original colors.
+//
+// Unlike [Hex], ParseColor reports malformed input as an error wrapping
+// [ErrInvalidColor] instead of returning an empty color.
+func ParseColor(s string) (Color, error) {
+	s = strings.TrimSpace(s)
+	open := strings.IndexByte(s, '(')
+	if open < 0 {
+		if c := Hex(s); !c.IsEmpty() {
+			return c, nil
+		}
+		return Color{}, fmt.Errorf("%w: %q", ErrInvalidColor, s)
+	}
+	if !strings.HasSuffix(s, ")") {
+		return Color{}, fmt.Errorf("%w: %q", ErrInvalidColor, s)
+	}
+
+	fn := strings.ToLower(strings.TrimSpace(s[:open]))
+	args := splitColorArgs(s[open+1 : len(s)-1])
+
+	var c Color
+	var err error
+	switch fn {
+	case "rgb", "rgba":
+		c, err = parseRGBArgs(args)
+	case "hsl", "hsla":
+		c, err = parseHSLArgs(args)
+	case "oklch":
+		c, err = parseOKLCHArgs(args)
+	default:
+		err = fmt.Errorf("unsupported color function %q", fn)
+	}
+	if err != nil {
+		return Color{}, fmt.Errorf("%w: %q: %w", ErrInvalidColor, s, err)
+	}
+	return c, nil
+}
+
+// splitColorArgs splits CSS color function arguments on commas, whitespace
+// and the "/" alpha separator.
+func splitColorArgs(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == '/' || r == ' ' || r == '\t' || r == '\n'
+	})
+}
+
+// parseRGBArgs builds a color from rgb() arguments.
+func parseRGBArgs(args []string) (Color, error) {
+	if len(args) != 3 && len(args) != 4 {
+		return Color{}, fmt.Errorf("rgb() takes 3 or 4 arguments, got %d", len(args))
+	}
+	var ch [3]uint8
+	for i := range 3 {
+		v, err := parseColorNumber(args[i], 255)
+		if err != nil {
+			return Color{}, err
+		}
+		ch[i] = clampByte(v)
+	}
+	c := RGB(ch[0], ch[1], ch[2])
+	return applyAlphaArg(c, args)
+}
+
+// parseHSLArgs builds a color from hsl() arguments.
+func parseHSLArgs(args []string) (Color, error) {
+	if len(args) != 3 && len(args) != 4 {
+		return Color{}, fmt.Errorf("hsl() takes 3 or 4 arguments, got %d", len(args))
+	}
+	h, err := strconv.ParseFloat(strings.TrimSuffix(args[0], "deg"), 64)
+	if err != nil {
+		return Color{}, fmt.Errorf("invalid hue %q", args[0])
+	}
+	s, err := parseColorNumber(args[1], 1)
+	if err != nil {
+		return Color{}, err
+	}
+	l, err := parseColorNumber(args[2], 1)
+	if err != nil {
+		return Color{}, err
+	}
+	c := HSL(math.Mod(math.Mod(h, 360)+360, 360), clampUnit(s), clampUnit(l))
+	return applyAlphaArg(c, args)
+}
+
+// parseOKLCHArgs builds a color from oklch() arguments. A percentage
+// lightness maps 100% to 1 and a percentage chroma maps 100% to 0.4, as in
+// CSS Color 4.
+func parseOKLCHArgs(args []string) (Color, error) {
+	if len(args) != 3 && len(args) != 4 {
+		return Color{}, fmt.Errorf("oklch() takes 3 or 4 arguments, got %d", len(args))
+	}
+	l, err := parseColorNumber(args[0], 1)
+	if err != nil {
+		return Color{}, err
+	}
+	ch, err := parseColorNumber(args[1], 0.4)
+	if err != nil {
+		return Color{}, err
+	}
+	h, err := strconv.ParseFloat(strings.TrimSuffix(args[2], "deg"), 64)
+	if err != nil {
+		return Color{}, fmt.Errorf("invalid hue %q", args[2])
+	}
+	c := OKLCH(clampUnit(l), math.Max(0, ch), math.Mod(math.Mod(h, 360)+360, 360))
+	return applyAlphaArg(c, args)
+}
+
+// applyAlphaArg applies the optional fourth (alpha) argument to c.
+func applyAlphaArg(c Color, args []string) (Color, error) {
+	if len(args) < 4 {
+		return c, nil
+	}
+	a, err := parseColorNumber(args[3], 1)
+	if err != nil {
+		return Color{}, err
+	}
+	if a >= 1 {
+		return c, nil
+	}
+	return c.WithAlpha(a), nil
+}
+
+// parseColorNumber parses a plain number or a percentage. Percentages are
+// scaled to the range [0, scale].
+func parseColorNumber(s string, scale float64) (float64, error) {
+	if pct, ok := strings.CutSuffix(s, "%"); ok {
+		v, err := strconv.ParseFloat(pct, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid percentage %q", s)
+		}
+		return v / 100 * scale, nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return v, nil
+}
+
+// clampByte rounds v to the nearest integer in the range 0-255.
+func clampByte(v float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(255, v))))
+}
+
+// clampUnit limits v to the range 0-1.
+func clampUnit(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
+
+// opaque composites c over bg and returns the resulting opaque color, for
+// formats that cannot express transparency. Opaque colors are returned
+// unchanged, an empty c stays empty and an empty bg composites over black.
+func (c Color) opaque(bg Color) Color {
+	if c.IsEmpty() {
+		return Color{}
+	}
+	r, g, b, a := c.RGBAComponents()
+	if a == 255 {
+		return RGB(r, g, b)
+	}
+	br, bgG, bb := bg.RGB()
+	alpha := float64(a) / 255
+	blend := func(fg, back uint8) uint8 {
+		return clampByte(float64(fg)*alpha + float64(back)*(1-alpha))
+	}
+	return RGB(blend(r, br), blend(g, bgG), blend(b, bb))
+}
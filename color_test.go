@@ -1,6 +1,9 @@
 package gothememe
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"testing"
 )
 
@@ -179,6 +182,13 @@ func TestOKLCH(t *testing.T) {
 	if c.IsEmpty() {
 		t.Error("OKLCH() should not return empty color")
 	}
+	if got := OKLCH(0.628, 0.2577, 29.23).Hex(); got != "#ff0000" {
+		t.Errorf("OKLCH(0.628, 0.2577, 29.23) = %s, want #ff0000", got)
+	}
+	// Out-of-gamut chroma is reduced rather than clipped per channel.
+	if l, _, h := OKLCH(0.7, 0.5, 145).OKLCHValues(); math.Abs(l-0.7) > 0.01 || math.Abs(h-145) > 2 {
+		t.Errorf("OKLCH(0.7, 0.5, 145) lost lightness or hue: L=%.3f H=%.1f", l, h)
+	}
 }
 
 func TestHexNoPrefix(t *testing.T) {
@@ -215,7 +225,14 @@ func TestOKLCHValues(t *testing.T) {
 	if ch < 0 {
 		t.Errorf("OKLCHValues() chroma = %f, want >= 0", ch)
 	}
-	_ = h // Hue can be any value
+	// Reference values for sRGB red from the CSS Color 4 specification.
+	if math.Abs(l-0.628) > 0.001 || math.Abs(ch-0.2577) > 0.001 || math.Abs(h-29.23) > 0.05 {
+		t.Errorf("OKLCHValues() = (%.4f, %.4f, %.2f), want (0.628, 0.2577, 29.23)", l, ch, h)
+	}
+
+	if _, gray, _ := Hex("#808080").OKLCHValues(); gray != 0 {
+		t.Errorf("OKLCHValues() chroma of gray = %f, want 0", gray)
+	}
 }
 
 func TestCSS(t *testing.T) {
@@ -535,3 +552,111 @@ func FuzzColorManipulation(f *testing.F) {
 		_ = c.Mix(other, amount)
 	})
 }
+
+func TestParseColor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"hex with hash", "#FF5555", "#ff5555", false},
+		{"hex without hash", "ff5555", "#ff5555", false},
+		{"hex shorthand", "#f55", "#ff5555", false},
+		{"hex with alpha", "#ff555580", "#ff555580", false},
+		{"rgb commas", "rgb(255, 85, 85)", "#ff5555", false},
+		{"rgb spaces", "rgb(255 85 85)", "#ff5555", false},
+		{"rgb percentages", "rgb(100%, 0%, 0%)", "#ff0000", false},
+		{"rgba", "rgba(255, 85, 85, 0.5)", "#ff55557f", false},
+		{"rgb slash alpha", "rgb(255 85 85 / 50%)", "#ff55557f", false},
+		{"rgba opaque", "rgba(255, 85, 85, 1)", "#ff5555", false},
+		{"hsl", "hsl(0, 100%, 50%)", "#ff0000", false},
+		{"hsl deg", "hsl(120deg 100% 50%)", "#00ff00", false},
+		{"hsla", "hsla(240, 100%, 50%, 0.5)", "#0000ff7f", false},
+		{"oklch", "oklch(1.000 0.000 0.0)", "#ffffff", false},
+		{"oklch percent", "oklch(0% 0 0deg)", "#000000", false},
+		{"oklch alpha", "oklch(1 0 0 / 0.5)", "#ffffff7f", false},
+		{"whitespace", "  #282a36  ", "#282a36", false},
+		{"empty", "", "", true},
+		{"invalid hex", "#gggggg", "", true},
+		{"unknown function", "lab(50 20 20)", "", true},
+		{"missing paren", "rgb(1, 2, 3", "", true},
+		{"wrong arity", "rgb(1, 2)", "", true},
+		{"oklch wrong arity", "oklch(0.5 0.1)", "", true},
+		{"bad number", "rgb(a, b, c)", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c, err := ParseColor(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseColor(%q) = %q, want error", tt.input, c.Hex())
+				} else if !errors.Is(err, ErrInvalidColor) {
+					t.Errorf("ParseColor(%q) error %v does not wrap ErrInvalidColor", tt.input, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseColor(%q) error: %v", tt.input, err)
+			}
+			if c.Hex() != tt.want {
+				t.Errorf("ParseColor(%q) = %q, want %q", tt.input, c.Hex(), tt.want)
+			}
+		})
+	}
+}
+
+func TestColorOpaque(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		c    Color
+		bg   Color
+		want string
+	}{
+		{"opaque unchanged", Hex("#ff0000"), Hex("#ffffff"), "#ff0000"},
+		{"half over white", Hex("#000000").WithAlpha(0.5), Hex("#ffffff"), "#808080"},
+		{"transparent shows background", Hex("#ff0000").WithAlpha(0), Hex("#123456"), "#123456"},
+		{"empty background is black", Hex("#ffffff").WithAlpha(0.5), Color{}, "#7f7f7f"},
+		{"empty stays empty", Color{}, Hex("#ffffff"), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.c.opaque(tt.bg).Hex(); got != tt.want {
+				t.Errorf("opaque() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseColorOKLCHRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, hex := range []string{"#282a36", "#ff5555", "#50fa7b", "#bd93f9", "#f8f8f2"} {
+		c := Hex(hex)
+		l, ch, h := c.OKLCHValues()
+		got, err := ParseColor(fmt.Sprintf("oklch(%.3f %.3f %.1f)", l, ch, h))
+		if err != nil {
+			t.Fatalf("ParseColor() error: %v", err)
+		}
+		r1, g1, b1 := c.RGB()
+		r2, g2, b2 := got.RGB()
+		if absDiff(r1, r2) > 2 || absDiff(g1, g2) > 2 || absDiff(b1, b2) > 2 {
+			t.Errorf("oklch round trip of %s = %s", hex, got.Hex())
+		}
+	}
+}
+
+// absDiff returns the absolute difference of two channel values.
+func absDiff(a, b uint8) int {
+	if a > b {
+		return int(a - b)
+	}
+	return int(b - a)
+}
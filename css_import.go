@@ -0,0 +1,417 @@
+package gothememe
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// cssDataThemePattern matches a [data-theme="id"] attribute selector.
+var cssDataThemePattern = regexp.MustCompile(`\[\s*data-theme\s*=\s*(?:"([^"]*)"|'([^']*)'|([\w-]+))\s*\]`)
+
+// cssRootSelectors are the selectors whose custom properties apply to every
+// theme in the stylesheet.
+var cssRootSelectors = map[string]bool{":root": true, "html": true, "body": true, ":host": true, "*": true}
+
+// Metadata comments written by GenerateCSS.
+var (
+	cssThemeComment   = regexp.MustCompile(`^Theme:\s*(.*?)\s*\(([^()]*)\)$`)
+	cssAuthorComment  = regexp.MustCompile(`^Author:\s*(.*)$`)
+	cssLicenseComment = regexp.MustCompile(`^License:\s*(.*)$`)
+)
+
+// cssRule is a style rule with the comments that precede it.
+type cssRule struct {
+	selector string
+	comments []string
+	decls    []cssDecl
+}
+
+// cssDecl is a single custom property declaration.
+type cssDecl struct {
+	name  string
+	value string
+	line  int
+}
+
+// cssScope collects the custom properties of one theme.
+type cssScope struct {
+	id      string
+	name    string
+	author  string
+	license string
+	props   map[string]cssDecl
+}
+
+// applyComments reads GenerateCSS metadata comments into the scope.
+func (s *cssScope) applyComments(comments []string) {
+	for _, c := range comments {
+		if m := cssThemeComment.FindStringSubmatch(c); m != nil {
+			s.name, s.id = m[1], m[2]
+		} else if m := cssAuthorComment.FindStringSubmatch(c); m != nil {
+			s.author = m[1]
+		} else if m := cssLicenseComment.FindStringSubmatch(c); m != nil {
+			s.license = m[1]
+		}
+	}
+}
+
+// ParseCSSTheme reads CSS custom properties named --{prefix}-{role}, such as
+// those written by [GenerateCSS] and [GenerateAllThemesCSS], back into
+// themes. Each [data-theme="id"] rule becomes a theme with that ID, and
+// properties declared on :root (or html, body and :host) are shared by all
+// of them. A :root rule that declares role properties is a theme of its own
+// when the stylesheet has no [data-theme] rules, or when the metadata
+// comment GenerateCSS writes above it names a different ID; its ID is then
+// taken from that comment, or "imported". Rules inside at-rules such as
+// @media are read as if unconditional; other selectors are ignored.
+//
+// Values may be in any [ColorSpace] encoding and may reference other custom
+// properties with var(), including fallbacks. "transparent" leaves a role
+// unset so the builder derives it. An empty prefix means "theme". Themes
+// are returned in the order they first appear.
+func ParseCSSTheme(r io.Reader, prefix string) ([]Theme, error) {
+	if prefix == "" {
+		prefix = "theme"
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading CSS: %w", err)
+	}
+
+	rules, err := parseCSSRules(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("css: %w", err)
+	}
+
+	root := &cssScope{props: make(map[string]cssDecl)}
+	var scopes []*cssScope
+	byID := make(map[string]*cssScope)
+	for _, rule := range rules {
+		targets := cssRuleScopes(rule.selector, root, func(id string) *cssScope {
+			if s, ok := byID[id]; ok {
+				return s
+			}
+			s := &cssScope{id: id, props: make(map[string]cssDecl)}
+			byID[id] = s
+			scopes = append(scopes, s)
+			return s
+		})
+		for _, s := range targets {
+			if s != root {
+				// The ID comes from the selector; the comment only names it.
+				id := s.id
+				s.applyComments(rule.comments)
+				s.id = id
+			} else {
+				s.applyComments(rule.comments)
+			}
+			for _, d := range rule.decls {
+				s.props[d.name] = d
+			}
+		}
+	}
+
+	rolePrefix := "--" + prefix + "-"
+	hasRoles := func(s *cssScope) bool {
+		for name := range s.props {
+			if role, ok := strings.CutPrefix(name, rolePrefix); ok {
+				if _, known := lookupColorRole(role); known {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	// The :root rule is a theme of its own when it declares roles and either
+	// stands alone or names an ID no [data-theme] rule uses. Otherwise it
+	// only provides shared defaults.
+	if hasRoles(root) && (len(scopes) == 0 || root.id != "") {
+		if root.id == "" {
+			root.id = "imported"
+		}
+		if _, taken := byID[root.id]; !taken {
+			scopes = append([]*cssScope{root}, scopes...)
+		}
+	}
+
+	var themes []Theme
+	for _, s := range scopes {
+		if s != root && !hasRoles(s) && !hasRoles(root) {
+			continue
+		}
+		t, err := s.theme(root, rolePrefix)
+		if err != nil {
+			return nil, fmt.Errorf("css: theme %q: %w", s.id, err)
+		}
+		themes = append(themes, t)
+	}
+	if len(themes) == 0 {
+		return nil, fmt.Errorf("css: no %s* color properties found", rolePrefix)
+	}
+	return themes, nil
+}
+
+// cssRuleScopes returns the scopes a rule's selector list applies to.
+func cssRuleScopes(selector string, root *cssScope, scope func(id string) *cssScope) []*cssScope {
+	var targets []*cssScope
+	for _, sel := range strings.Split(selector, ",") {
+		sel = strings.TrimSpace(sel)
+		if m := cssDataThemePattern.FindStringSubmatch(sel); m != nil {
+			targets = append(targets, scope(m[1]+m[2]+m[3]))
+		} else if cssRootSelectors[strings.ToLower(sel)] {
+			targets = append(targets, root)
+		}
+	}
+	return targets
+}
+
+// theme resolves the scope's role properties, falling back to the shared
+// :root properties, and builds the Theme.
+func (s *cssScope) theme(root *cssScope, rolePrefix string) (Theme, error) {
+	lookup := func(name string) (cssDecl, bool) {
+		if d, ok := s.props[name]; ok {
+			return d, true
+		}
+		d, ok := root.props[name]
+		return d, ok
+	}
+
+	name := s.name
+	if name == "" {
+		name = s.id
+	}
+	b := NewThemeBuilder(s.id, name).
+		WithAuthor(s.author).
+		WithLicense(s.license)
+
+	for _, role := range colorRoles {
+		d, ok := lookup(rolePrefix + strings.ReplaceAll(role.key, "_", "-"))
+		if !ok {
+			continue
+		}
+		value, err := resolveCSSVars(d.value, lookup, map[string]bool{d.name: true})
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s: %w", d.line, d.name, err)
+		}
+		if strings.EqualFold(value, "transparent") {
+			continue
+		}
+		c, err := ParseColor(value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s: %w", d.line, d.name, err)
+		}
+		role.set(b, c)
+	}
+	return b.Build(), nil
+}
+
+// resolveCSSVars substitutes var() references in value. seen holds the
+// properties being resolved, to detect cycles.
+func resolveCSSVars(value string, lookup func(string) (cssDecl, bool), seen map[string]bool) (string, error) {
+	for {
+		start := strings.Index(value, "var(")
+		if start < 0 {
+			return strings.TrimSpace(value), nil
+		}
+		end := matchingParen(value, start+len("var"))
+		if end < 0 {
+			return "", fmt.Errorf("unterminated var() in %q", value)
+		}
+
+		name, fallback, hasFallback := strings.Cut(value[start+len("var("):end], ",")
+		name = strings.TrimSpace(name)
+
+		var sub string
+		if d, ok := lookup(name); ok {
+			if seen[name] {
+				return "", fmt.Errorf("circular var() reference to %s", name)
+			}
+			seen[name] = true
+			resolved, err := resolveCSSVars(d.value, lookup, seen)
+			delete(seen, name)
+			if err != nil {
+				return "", err
+			}
+			sub = resolved
+		} else if hasFallback {
+			resolved, err := resolveCSSVars(fallback, lookup, seen)
+			if err != nil {
+				return "", err
+			}
+			sub = resolved
+		} else {
+			return "", fmt.Errorf("undefined property %s", name)
+		}
+		value = value[:start] + sub + value[end+1:]
+	}
+}
+
+// matchingParen returns the index of the parenthesis closing the one at
+// open, or -1.
+func matchingParen(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseCSSRules splits a stylesheet into style rules, keeping only custom
+// property declarations. Declarations outside any rule, as GenerateCSS
+// writes without a selector, are collected under ":root". Nested blocks,
+// whether at-rules or CSS nesting, are read as top-level rules.
+func parseCSSRules(src string) ([]cssRule, error) {
+	p := cssParser{src: src}
+	bare := cssRule{selector: ":root"}
+	if err := p.block(0, len(src), &bare); err != nil {
+		return nil, err
+	}
+	if len(bare.decls) > 0 {
+		p.rules = append([]cssRule{bare}, p.rules...)
+	}
+	return p.rules, nil
+}
+
+// cssParser is a minimal CSS reader that tracks offsets for line numbers.
+type cssParser struct {
+	src      string
+	rules    []cssRule
+	comments []string
+}
+
+// line returns the 1-based line number of offset.
+func (p *cssParser) line(offset int) int {
+	return strings.Count(p.src[:offset], "\n") + 1
+}
+
+// block reads the statements between start and end. Declarations are added
+// to owner.
+func (p *cssParser) block(start, end int, owner *cssRule) error {
+	i := start
+	for i < end {
+		// Skip whitespace and collect comments.
+		for i < end && strings.ContainsRune(" \t\r\n", rune(p.src[i])) {
+			i++
+		}
+		if i >= end {
+			break
+		}
+		if strings.HasPrefix(p.src[i:end], "/*") {
+			closeAt := strings.Index(p.src[i+2:end], "*/")
+			if closeAt < 0 {
+				return fmt.Errorf("line %d: unterminated comment", p.line(i))
+			}
+			p.comments = append(p.comments, strings.TrimSpace(p.src[i+2:i+2+closeAt]))
+			i += closeAt + 4
+			continue
+		}
+
+		stop, err := p.scan(i, end)
+		if err != nil {
+			return err
+		}
+		switch {
+		case stop == end || p.src[stop] == ';':
+			if name, value, ok := strings.Cut(p.src[i:stop], ":"); ok {
+				name = strings.TrimSpace(name)
+				if strings.HasPrefix(name, "--") {
+					value = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(value), "!important"))
+					owner.decls = append(owner.decls, cssDecl{name: name, value: value, line: p.line(i)})
+					if owner.comments == nil {
+						owner.comments = p.comments
+						p.comments = nil
+					}
+				}
+			}
+			i = stop + 1
+		case p.src[stop] == '{':
+			closeAt, err := p.closing(stop, end)
+			if err != nil {
+				return err
+			}
+			rule := cssRule{selector: strings.TrimSpace(p.src[i:stop]), comments: p.comments}
+			p.comments = nil
+			index := len(p.rules)
+			p.rules = append(p.rules, rule)
+			if err := p.block(stop+1, closeAt, &rule); err != nil {
+				return err
+			}
+			p.rules[index] = rule
+			p.comments = nil
+			i = closeAt + 1
+		default: // '}'
+			return fmt.Errorf("line %d: unexpected '}'", p.line(stop))
+		}
+	}
+	return nil
+}
+
+// scan returns the offset of the next '{', '}' or ';' outside strings,
+// parentheses and comments, or end.
+func (p *cssParser) scan(start, end int) (int, error) {
+	depth := 0
+	for i := start; i < end; i++ {
+		switch c := p.src[i]; c {
+		case '"', '\'':
+			closeAt := strings.IndexByte(p.src[i+1:end], c)
+			if closeAt < 0 {
+				return 0, fmt.Errorf("line %d: unterminated string", p.line(i))
+			}
+			i += closeAt + 1
+		case '/':
+			if i+1 < end && p.src[i+1] == '*' {
+				closeAt := strings.Index(p.src[i+2:end], "*/")
+				if closeAt < 0 {
+					return 0, fmt.Errorf("line %d: unterminated comment", p.line(i))
+				}
+				i += closeAt + 3
+			}
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case '{', '}', ';':
+			if depth <= 0 {
+				return i, nil
+			}
+		}
+	}
+	return end, nil
+}
+
+// closing returns the offset of the '}' matching the '{' at open.
+func (p *cssParser) closing(open, end int) (int, error) {
+	i := open + 1
+	for {
+		stop, err := p.scan(i, end)
+		if err != nil {
+			return 0, err
+		}
+		if stop == end {
+			return 0, fmt.Errorf("line %d: unclosed '{'", p.line(open))
+		}
+		switch p.src[stop] {
+		case '}':
+			return stop, nil
+		case '{':
+			inner, err := p.closing(stop, end)
+			if err != nil {
+				return 0, err
+			}
+			i = inner + 1
+		default:
+			i = stop + 1
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package gothememe
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCSSThemeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	theme := NewThemeBuilder("acme", "Acme Dark").
+		WithAuthor("Acme Design").
+		WithLicense("MIT").
+		WithBackground(Hex("#1e1e2e")).
+		WithTextPrimary(Hex("#cdd6f4")).
+		WithAccent(Hex("#89b4fa")).
+		WithRed(Hex("#f38ba8")).
+		Build()
+
+	themes, err := ParseCSSTheme(strings.NewReader(GenerateCSS(theme, DefaultCSSOptions())), "")
+	if err != nil {
+		t.Fatalf("ParseCSSTheme() error: %v", err)
+	}
+	if len(themes) != 1 {
+		t.Fatalf("ParseCSSTheme() returned %d themes, want 1", len(themes))
+	}
+	got := themes[0]
+	if got.ID() != "acme" || got.DisplayName() != "Acme Dark" {
+		t.Errorf("ID, DisplayName = %q, %q, want acme, Acme Dark", got.ID(), got.DisplayName())
+	}
+	if got.Author() != "Acme Design" || got.License() != "MIT" {
+		t.Errorf("Author, License = %q, %q, want Acme Design, MIT", got.Author(), got.License())
+	}
+	if !got.IsDark() {
+		t.Error("IsDark() = false, want true")
+	}
+	assertSameColors(t, theme, got)
+}
+
+func TestParseCSSThemeColorSpaces(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	for _, space := range []ColorSpace{ColorSpaceHex, ColorSpaceRGB, ColorSpaceHSL, ColorSpaceOKLCH} {
+		for _, minify := range []bool{false, true} {
+			opts := CSSOptions{Prefix: "app", IncludeRoot: true, ColorSpace: space, Minify: minify}
+			themes, err := ParseCSSTheme(strings.NewReader(GenerateCSS(theme, opts)), "app")
+			if err != nil {
+				t.Fatalf("ParseCSSTheme(space %d, minify %v) error: %v", space, minify, err)
+			}
+			if len(themes) != 1 {
+				t.Fatalf("ParseCSSTheme(space %d) returned %d themes, want 1", space, len(themes))
+			}
+
+			// rgb(), hsl() and oklch() output drops alpha and rounds, so
+			// compare channels with a small tolerance.
+			for _, role := range colorRoles {
+				r1, g1, b1 := role.get(theme).RGB()
+				r2, g2, b2 := role.get(themes[0]).RGB()
+				if absDiff(r1, r2) > 2 || absDiff(g1, g2) > 2 || absDiff(b1, b2) > 2 {
+					t.Errorf("space %d: %s = %s, want %s", space, role.key, role.get(themes[0]).Hex(), role.get(theme).Hex())
+				}
+			}
+		}
+	}
+}
+
+func TestParseCSSThemeAllThemes(t *testing.T) {
+	t.Parallel()
+
+	dark := fullTestTheme(t)
+	light := NewThemeBuilder("paper", "Paper").
+		WithBackground(Hex("#fdf6e3")).
+		WithTextPrimary(Hex("#657b83")).
+		WithAccent(Hex("#268bd2")).
+		Build()
+
+	css := GenerateAllThemesCSS([]Theme{dark, light}, DefaultCSSOptions())
+	themes, err := ParseCSSTheme(strings.NewReader(css), "theme")
+	if err != nil {
+		t.Fatalf("ParseCSSTheme() error: %v", err)
+	}
+	if len(themes) != 2 {
+		t.Fatalf("ParseCSSTheme() returned %d themes, want 2", len(themes))
+	}
+	if themes[0].ID() != "catppuccin_mocha" || themes[1].ID() != "paper" {
+		t.Errorf("IDs = %q, %q, want catppuccin_mocha, paper", themes[0].ID(), themes[1].ID())
+	}
+	if themes[1].DisplayName() != "Paper" {
+		t.Errorf("DisplayName() = %q, want Paper from the metadata comment", themes[1].DisplayName())
+	}
+	if themes[1].IsDark() {
+		t.Error("paper IsDark() = true, want false")
+	}
+	assertSameColors(t, dark, themes[0])
+	assertSameColors(t, light, themes[1])
+}
+
+func TestParseCSSThemeVarReferences(t *testing.T) {
+	t.Parallel()
+
+	css := `
+/* Brand palette shared by every theme */
+:root {
+  --brand-navy: #0b1f3a;
+  --brand-sky: rgb(56 189 248);
+  --theme-accent: var(--brand-sky);
+  --theme-text-primary: var(--ink, #f1f5f9);
+}
+
+@media (min-width: 0) {
+  html[data-theme='night'] {
+    --theme-background: var(--brand-navy) !important;
+    --theme-border: var(--theme-accent);
+  }
+}
+
+[data-theme=day] {
+  --brand-sky: #0369a1;
+  --ink: hsl(222 47% 11%);
+  --theme-background: #ffffff;
+  --theme-success-text: transparent;
+  --theme-font-family: "Inter", sans-serif;
+}
+`
+	themes, err := ParseCSSTheme(strings.NewReader(css), "theme")
+	if err != nil {
+		t.Fatalf("ParseCSSTheme() error: %v", err)
+	}
+	if len(themes) != 2 {
+		t.Fatalf("ParseCSSTheme() returned %d themes, want 2", len(themes))
+	}
+
+	night, day := themes[0], themes[1]
+	checks := []struct {
+		name string
+		got  Color
+		want string
+	}{
+		{"night background", night.Background(), "#0b1f3a"},
+		{"night accent from :root", night.Accent(), "#38bdf8"},
+		{"night border through theme var", night.Border(), "#38bdf8"},
+		{"night text from fallback", night.TextPrimary(), "#f1f5f9"},
+		{"day accent sees overridden var", day.Accent(), "#0369a1"},
+		{"day text from theme var", day.TextPrimary(), "#0f1729"},
+		{"day background", day.Background(), "#ffffff"},
+	}
+	for _, c := range checks {
+		if c.got.Hex() != c.want {
+			t.Errorf("%s = %s, want %s", c.name, c.got.Hex(), c.want)
+		}
+	}
+	if day.Success().Text.IsEmpty() {
+		t.Error("transparent success text was not derived")
+	}
+	if night.ID() != "night" || night.DisplayName() != "night" {
+		t.Errorf("night ID, DisplayName = %q, %q", night.ID(), night.DisplayName())
+	}
+}
+
+func TestParseCSSThemeBareDeclarations(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	opts := DefaultCSSOptions()
+	opts.IncludeRoot = false
+	themes, err := ParseCSSTheme(strings.NewReader(GenerateCSS(theme, opts)), "")
+	if err != nil {
+		t.Fatalf("ParseCSSTheme() error: %v", err)
+	}
+	if len(themes) != 1 || themes[0].ID() != "catppuccin_mocha" {
+		t.Fatalf("ParseCSSTheme() = %d themes, want catppuccin_mocha", len(themes))
+	}
+	assertSameColors(t, theme, themes[0])
+}
+
+func TestParseCSSThemeErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		css     string
+		wantErr string
+	}{
+		{"no properties", ".btn { color: red; }", "no --theme-* color properties"},
+		{"other prefix", ":root { --app-background: #000; }", "no --theme-* color properties"},
+		{"invalid color", ":root {\n  --theme-background: nope;\n}", "line 2: --theme-background"},
+		{"undefined var", ":root { --theme-accent: var(--missing); }", "undefined property --missing"},
+		{"circular var", ":root { --a: var(--b); --b: var(--a); --theme-accent: var(--a); }", "circular var()"},
+		{"self reference", ":root { --theme-accent: var(--theme-accent); }", "circular var()"},
+		{"unclosed block", ":root { --theme-accent: #fff;", "unclosed '{'"},
+		{"stray brace", "}", "unexpected '}'"},
+		{"unterminated comment", "/* oops", "unterminated comment"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseCSSTheme(strings.NewReader(tt.css), "")
+			if err == nil {
+				t.Fatal("ParseCSSTheme() error = nil, want error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseCSSTheme() error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -2,8 +2,8 @@
 //
 // GoThemeMe is the web counterpart to [bubbletint], offering 450+ pre-built themes
 // with full CSS variable support, syntax highlighting, and WCAG accessibility
-// validation. It generates CSS custom properties, SCSS variables, JSON, and
-// DTCG-compliant design tokens.
+// validation. It generates CSS custom properties, SCSS, Less and Stylus
+// variables, JSON, and DTCG-compliant design tokens.
 //
 // # Quick Start
 //
@@ -64,6 +64,29 @@
 //	    Accent:     gothememe.Hex("#e94560"),
 //	})
 //
+// [ThemeFromImage] derives such a palette from a logo or wallpaper, keeping
+// the text and accent colors at WCAG AA contrast against the background.
+//
+// # Theme Files
+//
+// Themes can also be loaded from JSON, YAML or TOML files, so they can be
+// shipped without writing Go. Missing roles are derived by the builder:
+//
+//	theme, err := gothememe.LoadThemeFile("themes/acme_dark.yaml")
+//
+// Use [WriteThemeFile] to write any theme back out in the same schema.
+//
+// Themes from other tools can be imported as well, such as VS Code color
+// themes with [LoadVSCodeThemeFile] and iTerm2 color presets with
+// [LoadITermColorsFile], and base16/base24 schemes with [FromBase16] and
+// [FromBase24]. Terminal emulator configurations (Alacritty, Kitty, WezTerm,
+// Ghostty, foot and Xresources) are read by [ParseTerminalTheme], which
+// detects the format from the content. [FromWindowsTerminal] converts a
+// Windows Terminal scheme with the mapping used for the built-in themes.
+// [ParseCSSTheme] reads CSS custom properties back into themes, one per
+// [data-theme] block, and [LoadVimColorschemeFile] maps the highlight groups
+// of a Vimscript or Neovim Lua colorscheme onto the UI and code roles.
+//
 // # Output Formats
 //
 // Generate output in multiple formats for different use cases:
@@ -74,12 +97,41 @@
 //	// SCSS variables
 //	scss := gothememe.GenerateSCSS(theme, gothememe.CSSOptions{Prefix: "app"})
 //
+//	// A Sass $themes map with theme() and theme-color() helpers
+//	scss, _ = gothememe.GenerateSCSSMap([]gothememe.Theme{dark, light}, gothememe.CSSOptions{Prefix: "app"})
+//
+//	// Less and Stylus variables
+//	less := gothememe.GenerateLess(theme, gothememe.CSSOptions{Prefix: "app"})
+//	styl := gothememe.GenerateStylus(theme, gothememe.CSSOptions{Prefix: "app"})
+//
 //	// JSON for JavaScript consumption
 //	json := gothememe.GenerateJSON(theme, gothememe.CSSOptions{})
 //
 //	// DTCG design tokens for Figma/Style Dictionary
 //	tokens, _ := gothememe.GenerateDesignTokens(theme, gothememe.DefaultTokenOptions())
 //
+// Each format is also registered as an [Exporter], so [Export] can write
+// themes to an [io.Writer] in a format chosen at runtime with
+// [ParseOutputFormat] or [OutputFormatFromPath]. [RegisterExporter] adds
+// formats of your own. [GenerateTailwind] writes a Tailwind v4 @theme block
+// or v3 preset whose colors reference the theme variables, and
+// [WriteTerminalTheme] writes terminal emulator color configurations.
+// [WriteVSCodeTheme] writes a VS Code color theme, and [WriteVSCodeExtension]
+// a complete extension folder ready for "vsce package".
+// [WriteNeovimColorscheme] writes a self-contained Neovim Lua colorscheme,
+// and [WriteTMTheme] and [WriteSublimeColorScheme] write TextMate and Sublime
+// Text color schemes. [WriteAndroidResources] writes Android colors and a
+// Material 3 theme with a night variant, [WriteAssetCatalog] writes Xcode
+// color sets with dark appearances, and [WriteSwiftColors] and
+// [WriteComposeColors] generate SwiftUI and Jetpack Compose source.
+// [WriteGIMPPalette], [WriteASE], [WriteSketchPalette] and
+// [WriteProcreateSwatches] write palettes for design tools.
+// [GenerateFigmaVariables] and [WriteTokensStudioSets] write themes as the
+// modes of a Figma variable collection, and [WriteDesignTokenSet] writes
+// per-theme DTCG files with a resolver document.
+// [GenerateTypeScript] writes a typed TypeScript module of the themes and
+// their CSS variable names.
+//
 // # Syntax Highlighting
 //
 // Generate syntax highlighting CSS compatible with popular libraries:
@@ -95,6 +147,10 @@
 //	    Format: gothememe.SyntaxHighlightJS,
 //	})
 //
+// The gothememe/chroma package converts a theme to a native chroma style for
+// inline-style and terminal output, and writes the same colors as a Pygments
+// Style class for Sphinx or as the stylesheet of Pygments' HTML formatter.
+//
 // # Multi-Theme CSS
 //
 // Generate CSS for all themes using data-theme attribute selectors:
@@ -4,6 +4,12 @@ go 1.25.5
 
 require github.com/tj-smith47/gothememe v0.0.0
 
-require github.com/lucasb-eyer/go-colorful v1.2.0 // indirect
+require (
+	github.com/BurntSushi/toml v1.5.0 // indirect
+	github.com/alecthomas/chroma/v2 v2.20.0 // indirect
+	github.com/dlclark/regexp2 v1.11.5 // indirect
+	github.com/lucasb-eyer/go-colorful v1.2.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
 
 replace github.com/tj-smith47/gothememe => ../../..
@@ -1,16 +1,15 @@
-// Generate CSS and JSON for React application.
+// Generate CSS and a TypeScript module for React application.
 //
 // Run from the examples/react directory:
 //
 //	go run ./generate
 //
-// Or with custom output:
+// Or with custom output directories for the CSS and the module:
 //
-//	go run ./generate ./custom-dir
+//	go run ./generate ./custom-dir ./custom-src
 package main
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -19,24 +18,22 @@ import (
 	"github.com/tj-smith47/gothememe/themes"
 )
 
-// ThemeData represents the JSON structure for theme metadata.
-type ThemeData struct {
-	ID          string `json:"id"`
-	DisplayName string `json:"displayName"`
-	IsDark      bool   `json:"isDark"`
-}
-
 func main() {
-	// Get output directory (default: public/ relative to current directory)
-	outDir := "public"
+	// Get output directories (default: public/ and src/ relative to current directory)
+	outDir, srcDir := "public", "src"
 	if len(os.Args) > 1 {
 		outDir = os.Args[1]
 	}
+	if len(os.Args) > 2 {
+		srcDir = os.Args[2]
+	}
 
-	// Create output directory if it doesn't exist
-	if err := os.MkdirAll(outDir, 0o755); err != nil {
-		fmt.Fprintf(os.Stderr, "Error creating output directory: %v\n", err)
-		os.Exit(1)
+	// Create output directories if they don't exist
+	for _, dir := range []string{outDir, srcDir} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating output directory: %v\n", err)
+			os.Exit(1)
+		}
 	}
 
 	// Select popular themes for the demo (keeps bundle size reasonable)
@@ -46,16 +43,10 @@ func main() {
 		"catppuccin_mocha", "tokyonight", "github_dark", "monokai_pro",
 	}
 	var selectedThemes []gothememe.Theme
-	var themeData []ThemeData
 
 	for _, id := range ids {
 		if t := themes.ByID(id); t != nil {
 			selectedThemes = append(selectedThemes, t)
-			themeData = append(themeData, ThemeData{
-				ID:          t.ID(),
-				DisplayName: t.DisplayName(),
-				IsDark:      t.IsDark(),
-			})
 		}
 	}
 
@@ -72,19 +63,21 @@ func main() {
 	}
 	fmt.Printf("Generated %s\n", cssPath)
 
-	// Generate JSON for theme metadata
-	jsonData, err := json.MarshalIndent(themeData, "", "  ")
+	// Generate a typed module with the theme metadata, colors and variable names
+	ts, err := gothememe.GenerateTypeScript(selectedThemes, gothememe.TypeScriptOptions{
+		Prefix: "theme",
+	})
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error marshaling JSON: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Error generating TypeScript: %v\n", err)
 		os.Exit(1)
 	}
 
-	jsonPath := filepath.Join(outDir, "themes.json")
-	if err := os.WriteFile(jsonPath, jsonData, 0o644); err != nil {
-		fmt.Fprintf(os.Stderr, "Error writing JSON: %v\n", err)
+	tsPath := filepath.Join(srcDir, "themes.ts")
+	if err := os.WriteFile(tsPath, []byte(ts), 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing TypeScript: %v\n", err)
 		os.Exit(1)
 	}
-	fmt.Printf("Generated %s\n", jsonPath)
+	fmt.Printf("Generated %s\n", tsPath)
 
 	fmt.Printf("\nGenerated %d themes\n", len(selectedThemes))
 }
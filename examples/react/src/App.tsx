@@ -1,5 +1,6 @@
 import React from 'react';
 import { useTheme } from './ThemeContext';
+import { cssVar, type CssVar } from './themes';
 import { ThemeSwitcher, ThemeIndicator } from './ThemeSwitcher';
 import './App.css';
 
@@ -83,12 +84,12 @@ function App() {
   );
 }
 
-function ColorSwatch({ name, varName }: { name: string; varName: string }) {
+function ColorSwatch({ name, varName }: { name: string; varName: CssVar }) {
   return (
     <div className="color-swatch">
       <div
         className="swatch-color"
-        style={{ backgroundColor: `var(${varName})` }}
+        style={{ backgroundColor: cssVar(varName) }}
       />
       <div className="swatch-info">
         <span className="swatch-name">{name}</span>
@@ -1,10 +1,7 @@
 import React, { createContext, useContext, useState, useEffect, ReactNode } from 'react';
+import { themes as themesById, type Theme } from './themes';
 
-interface Theme {
-  id: string;
-  displayName: string;
-  isDark: boolean;
-}
+const themes: Theme[] = Object.values(themesById);
 
 interface ThemeContextType {
   currentTheme: Theme | null;
@@ -28,7 +25,6 @@ export function ThemeProvider({
   defaultTheme = 'dracula',
   storageKey = 'gothememe-theme',
 }: ThemeProviderProps) {
-  const [themes, setThemes] = useState<Theme[]>([]);
   const [currentThemeId, setCurrentThemeId] = useState<string>(() => {
     if (typeof window !== 'undefined') {
       return localStorage.getItem(storageKey) || defaultTheme;
@@ -36,14 +32,6 @@ export function ThemeProvider({
     return defaultTheme;
   });
 
-  // Load themes from JSON
-  useEffect(() => {
-    fetch('/themes.json')
-      .then((res) => res.json())
-      .then((data: Theme[]) => setThemes(data))
-      .catch((err) => console.error('Failed to load themes:', err));
-  }, []);
-
   // Apply theme to document
   useEffect(() => {
     document.documentElement.setAttribute('data-theme', currentThemeId);
@@ -0,0 +1,840 @@
+// Generated by gothememe. Do not edit.
+
+/** The ID of a generated theme. */
+export type ThemeId =
+  | "dracula"
+  | "nord"
+  | "gruvbox_dark"
+  | "atom_one_dark"
+  | "builtin_solarized_light"
+  | "builtin_solarized_dark"
+  | "catppuccin_mocha"
+  | "tokyonight"
+  | "github_dark"
+  | "monokai_pro";
+
+/** The name of a theme CSS variable. */
+export type CssVar =
+  | "--theme-background"
+  | "--theme-background-secondary"
+  | "--theme-surface"
+  | "--theme-surface-secondary"
+  | "--theme-text-primary"
+  | "--theme-text-secondary"
+  | "--theme-text-muted"
+  | "--theme-text-inverted"
+  | "--theme-accent"
+  | "--theme-accent-secondary"
+  | "--theme-brand"
+  | "--theme-border"
+  | "--theme-border-subtle"
+  | "--theme-border-strong"
+  | "--theme-success-background"
+  | "--theme-success-border"
+  | "--theme-success-text"
+  | "--theme-warning-background"
+  | "--theme-warning-border"
+  | "--theme-warning-text"
+  | "--theme-error-background"
+  | "--theme-error-border"
+  | "--theme-error-text"
+  | "--theme-info-background"
+  | "--theme-info-border"
+  | "--theme-info-text"
+  | "--theme-black"
+  | "--theme-red"
+  | "--theme-green"
+  | "--theme-yellow"
+  | "--theme-blue"
+  | "--theme-purple"
+  | "--theme-cyan"
+  | "--theme-white"
+  | "--theme-bright-black"
+  | "--theme-bright-red"
+  | "--theme-bright-green"
+  | "--theme-bright-yellow"
+  | "--theme-bright-blue"
+  | "--theme-bright-purple"
+  | "--theme-bright-cyan"
+  | "--theme-bright-white"
+  | "--theme-code-background"
+  | "--theme-code-text"
+  | "--theme-code-comment"
+  | "--theme-code-keyword"
+  | "--theme-code-string"
+  | "--theme-code-number"
+  | "--theme-code-function"
+  | "--theme-code-operator"
+  | "--theme-code-punctuation"
+  | "--theme-code-variable"
+  | "--theme-code-constant"
+  | "--theme-code-type";
+
+/** The colors of a theme, keyed by role. */
+export interface ThemeColors {
+  /** var(--theme-background) */
+  readonly background: string;
+  /** var(--theme-background-secondary) */
+  readonly backgroundSecondary: string;
+  /** var(--theme-surface) */
+  readonly surface: string;
+  /** var(--theme-surface-secondary) */
+  readonly surfaceSecondary: string;
+  /** var(--theme-text-primary) */
+  readonly textPrimary: string;
+  /** var(--theme-text-secondary) */
+  readonly textSecondary: string;
+  /** var(--theme-text-muted) */
+  readonly textMuted: string;
+  /** var(--theme-text-inverted) */
+  readonly textInverted: string;
+  /** var(--theme-accent) */
+  readonly accent: string;
+  /** var(--theme-accent-secondary) */
+  readonly accentSecondary: string;
+  /** var(--theme-brand) */
+  readonly brand: string;
+  /** var(--theme-border) */
+  readonly border: string;
+  /** var(--theme-border-subtle) */
+  readonly borderSubtle: string;
+  /** var(--theme-border-strong) */
+  readonly borderStrong: string;
+  /** var(--theme-success-background) */
+  readonly successBackground: string;
+  /** var(--theme-success-border) */
+  readonly successBorder: string;
+  /** var(--theme-success-text) */
+  readonly successText: string;
+  /** var(--theme-warning-background) */
+  readonly warningBackground: string;
+  /** var(--theme-warning-border) */
+  readonly warningBorder: string;
+  /** var(--theme-warning-text) */
+  readonly warningText: string;
+  /** var(--theme-error-background) */
+  readonly errorBackground: string;
+  /** var(--theme-error-border) */
+  readonly errorBorder: string;
+  /** var(--theme-error-text) */
+  readonly errorText: string;
+  /** var(--theme-info-background) */
+  readonly infoBackground: string;
+  /** var(--theme-info-border) */
+  readonly infoBorder: string;
+  /** var(--theme-info-text) */
+  readonly infoText: string;
+  /** var(--theme-black) */
+  readonly black: string;
+  /** var(--theme-red) */
+  readonly red: string;
+  /** var(--theme-green) */
+  readonly green: string;
+  /** var(--theme-yellow) */
+  readonly yellow: string;
+  /** var(--theme-blue) */
+  readonly blue: string;
+  /** var(--theme-purple) */
+  readonly purple: string;
+  /** var(--theme-cyan) */
+  readonly cyan: string;
+  /** var(--theme-white) */
+  readonly white: string;
+  /** var(--theme-bright-black) */
+  readonly brightBlack: string;
+  /** var(--theme-bright-red) */
+  readonly brightRed: string;
+  /** var(--theme-bright-green) */
+  readonly brightGreen: string;
+  /** var(--theme-bright-yellow) */
+  readonly brightYellow: string;
+  /** var(--theme-bright-blue) */
+  readonly brightBlue: string;
+  /** var(--theme-bright-purple) */
+  readonly brightPurple: string;
+  /** var(--theme-bright-cyan) */
+  readonly brightCyan: string;
+  /** var(--theme-bright-white) */
+  readonly brightWhite: string;
+  /** var(--theme-code-background) */
+  readonly codeBackground: string;
+  /** var(--theme-code-text) */
+  readonly codeText: string;
+  /** var(--theme-code-comment) */
+  readonly codeComment: string;
+  /** var(--theme-code-keyword) */
+  readonly codeKeyword: string;
+  /** var(--theme-code-string) */
+  readonly codeString: string;
+  /** var(--theme-code-number) */
+  readonly codeNumber: string;
+  /** var(--theme-code-function) */
+  readonly codeFunction: string;
+  /** var(--theme-code-operator) */
+  readonly codeOperator: string;
+  /** var(--theme-code-punctuation) */
+  readonly codePunctuation: string;
+  /** var(--theme-code-variable) */
+  readonly codeVariable: string;
+  /** var(--theme-code-constant) */
+  readonly codeConstant: string;
+  /** var(--theme-code-type) */
+  readonly codeType: string;
+}
+
+/** A generated theme. */
+export interface Theme {
+  readonly id: ThemeId;
+  readonly displayName: string;
+  readonly isDark: boolean;
+  readonly colors: ThemeColors;
+}
+
+/** Dracula+ */
+export const draculaTheme: Theme = {
+  id: "dracula",
+  displayName: "Dracula+",
+  isDark: true,
+  colors: {
+    background: "#212121",
+    backgroundSecondary: "#21222c",
+    surface: "#f8f8f2",
+    surfaceSecondary: "#545454",
+    textPrimary: "#f8f8f2",
+    textSecondary: "#f8f8f2",
+    textMuted: "#545454",
+    textInverted: "#212121",
+    accent: "#82aaff",
+    accentSecondary: "#c792ea",
+    brand: "#82aaff",
+    border: "#545454",
+    borderSubtle: "#21222c",
+    borderStrong: "#f8f8f2",
+    successBackground: "#50fa7b19",
+    successBorder: "#50fa7b4c",
+    successText: "#50fa7b",
+    warningBackground: "#ffcb6b19",
+    warningBorder: "#ffcb6b4c",
+    warningText: "#ffcb6b",
+    errorBackground: "#ff555519",
+    errorBorder: "#ff55554c",
+    errorText: "#ff5555",
+    infoBackground: "#8be9fd19",
+    infoBorder: "#8be9fd4c",
+    infoText: "#8be9fd",
+    black: "#21222c",
+    red: "#ff5555",
+    green: "#50fa7b",
+    yellow: "#ffcb6b",
+    blue: "#82aaff",
+    purple: "#c792ea",
+    cyan: "#8be9fd",
+    white: "#f8f8f2",
+    brightBlack: "#545454",
+    brightRed: "#ff6e6e",
+    brightGreen: "#69ff94",
+    brightYellow: "#ffcb6b",
+    brightBlue: "#d6acff",
+    brightPurple: "#ff92df",
+    brightCyan: "#a4ffff",
+    brightWhite: "#f8f8f2",
+    codeBackground: "#212121",
+    codeText: "#f8f8f2",
+    codeComment: "#545454",
+    codeKeyword: "#c792ea",
+    codeString: "#50fa7b",
+    codeNumber: "#ffcb6b",
+    codeFunction: "#82aaff",
+    codeOperator: "#ff5555",
+    codePunctuation: "#f8f8f2",
+    codeVariable: "#8be9fd",
+    codeConstant: "#ffcb6b",
+    codeType: "#a4ffff",
+  },
+};
+
+/** Nord */
+export const nordTheme: Theme = {
+  id: "nord",
+  displayName: "Nord",
+  isDark: true,
+  colors: {
+    background: "#2e3440",
+    backgroundSecondary: "#3b4252",
+    surface: "#eceff4",
+    surfaceSecondary: "#596377",
+    textPrimary: "#d8dee9",
+    textSecondary: "#e5e9f0",
+    textMuted: "#596377",
+    textInverted: "#2e3440",
+    accent: "#81a1c1",
+    accentSecondary: "#b48ead",
+    brand: "#81a1c1",
+    border: "#596377",
+    borderSubtle: "#3b4252",
+    borderStrong: "#e5e9f0",
+    successBackground: "#a3be8c19",
+    successBorder: "#a3be8c4c",
+    successText: "#a3be8c",
+    warningBackground: "#ebcb8b19",
+    warningBorder: "#ebcb8b4c",
+    warningText: "#ebcb8b",
+    errorBackground: "#bf616a19",
+    errorBorder: "#bf616a4c",
+    errorText: "#bf616a",
+    infoBackground: "#88c0d019",
+    infoBorder: "#88c0d04c",
+    infoText: "#88c0d0",
+    black: "#3b4252",
+    red: "#bf616a",
+    green: "#a3be8c",
+    yellow: "#ebcb8b",
+    blue: "#81a1c1",
+    purple: "#b48ead",
+    cyan: "#88c0d0",
+    white: "#e5e9f0",
+    brightBlack: "#596377",
+    brightRed: "#bf616a",
+    brightGreen: "#a3be8c",
+    brightYellow: "#ebcb8b",
+    brightBlue: "#81a1c1",
+    brightPurple: "#b48ead",
+    brightCyan: "#8fbcbb",
+    brightWhite: "#eceff4",
+    codeBackground: "#2e3440",
+    codeText: "#d8dee9",
+    codeComment: "#596377",
+    codeKeyword: "#b48ead",
+    codeString: "#a3be8c",
+    codeNumber: "#ebcb8b",
+    codeFunction: "#81a1c1",
+    codeOperator: "#bf616a",
+    codePunctuation: "#d8dee9",
+    codeVariable: "#88c0d0",
+    codeConstant: "#ebcb8b",
+    codeType: "#8fbcbb",
+  },
+};
+
+/** Gruvbox Dark */
+export const gruvboxDarkTheme: Theme = {
+  id: "gruvbox_dark",
+  displayName: "Gruvbox Dark",
+  isDark: true,
+  colors: {
+    background: "#282828",
+    backgroundSecondary: "#282828",
+    surface: "#665c54",
+    surfaceSecondary: "#928374",
+    textPrimary: "#ebdbb2",
+    textSecondary: "#a89984",
+    textMuted: "#928374",
+    textInverted: "#282828",
+    accent: "#458588",
+    accentSecondary: "#b16286",
+    brand: "#458588",
+    border: "#928374",
+    borderSubtle: "#282828",
+    borderStrong: "#a89984",
+    successBackground: "#98971a19",
+    successBorder: "#98971a4c",
+    successText: "#98971a",
+    warningBackground: "#d7992119",
+    warningBorder: "#d799214c",
+    warningText: "#d79921",
+    errorBackground: "#cc241d19",
+    errorBorder: "#cc241d4c",
+    errorText: "#cc241d",
+    infoBackground: "#689d6a19",
+    infoBorder: "#689d6a4c",
+    infoText: "#689d6a",
+    black: "#282828",
+    red: "#cc241d",
+    green: "#98971a",
+    yellow: "#d79921",
+    blue: "#458588",
+    purple: "#b16286",
+    cyan: "#689d6a",
+    white: "#a89984",
+    brightBlack: "#928374",
+    brightRed: "#fb4934",
+    brightGreen: "#b8bb26",
+    brightYellow: "#fabd2f",
+    brightBlue: "#83a598",
+    brightPurple: "#d3869b",
+    brightCyan: "#8ec07c",
+    brightWhite: "#ebdbb2",
+    codeBackground: "#282828",
+    codeText: "#ebdbb2",
+    codeComment: "#928374",
+    codeKeyword: "#b16286",
+    codeString: "#98971a",
+    codeNumber: "#d79921",
+    codeFunction: "#458588",
+    codeOperator: "#cc241d",
+    codePunctuation: "#ebdbb2",
+    codeVariable: "#689d6a",
+    codeConstant: "#fabd2f",
+    codeType: "#8ec07c",
+  },
+};
+
+/** Atom One Dark */
+export const atomOneDarkTheme: Theme = {
+  id: "atom_one_dark",
+  displayName: "Atom One Dark",
+  isDark: true,
+  colors: {
+    background: "#21252b",
+    backgroundSecondary: "#21252b",
+    surface: "#323844",
+    surfaceSecondary: "#767676",
+    textPrimary: "#abb2bf",
+    textSecondary: "#abb2bf",
+    textMuted: "#767676",
+    textInverted: "#21252b",
+    accent: "#61afef",
+    accentSecondary: "#c678dd",
+    brand: "#61afef",
+    border: "#767676",
+    borderSubtle: "#21252b",
+    borderStrong: "#abb2bf",
+    successBackground: "#98c37919",
+    successBorder: "#98c3794c",
+    successText: "#98c379",
+    warningBackground: "#e5c07b19",
+    warningBorder: "#e5c07b4c",
+    warningText: "#e5c07b",
+    errorBackground: "#e06c7519",
+    errorBorder: "#e06c754c",
+    errorText: "#e06c75",
+    infoBackground: "#56b6c219",
+    infoBorder: "#56b6c24c",
+    infoText: "#56b6c2",
+    black: "#21252b",
+    red: "#e06c75",
+    green: "#98c379",
+    yellow: "#e5c07b",
+    blue: "#61afef",
+    purple: "#c678dd",
+    cyan: "#56b6c2",
+    white: "#abb2bf",
+    brightBlack: "#767676",
+    brightRed: "#e06c75",
+    brightGreen: "#98c379",
+    brightYellow: "#e5c07b",
+    brightBlue: "#61afef",
+    brightPurple: "#c678dd",
+    brightCyan: "#56b6c2",
+    brightWhite: "#abb2bf",
+    codeBackground: "#21252b",
+    codeText: "#abb2bf",
+    codeComment: "#767676",
+    codeKeyword: "#c678dd",
+    codeString: "#98c379",
+    codeNumber: "#e5c07b",
+    codeFunction: "#61afef",
+    codeOperator: "#e06c75",
+    codePunctuation: "#abb2bf",
+    codeVariable: "#56b6c2",
+    codeConstant: "#e5c07b",
+    codeType: "#56b6c2",
+  },
+};
+
+/** Builtin Solarized Light */
+export const builtinSolarizedLightTheme: Theme = {
+  id: "builtin_solarized_light",
+  displayName: "Builtin Solarized Light",
+  isDark: false,
+  colors: {
+    background: "#fdf6e3",
+    backgroundSecondary: "#073642",
+    surface: "#eee8d5",
+    surfaceSecondary: "#002b36",
+    textPrimary: "#657b83",
+    textSecondary: "#bbb5a2",
+    textMuted: "#002b36",
+    textInverted: "#fdf6e3",
+    accent: "#268bd2",
+    accentSecondary: "#d33682",
+    brand: "#268bd2",
+    border: "#002b36",
+    borderSubtle: "#073642",
+    borderStrong: "#bbb5a2",
+    successBackground: "#85990019",
+    successBorder: "#8599004c",
+    successText: "#859900",
+    warningBackground: "#b5890019",
+    warningBorder: "#b589004c",
+    warningText: "#b58900",
+    errorBackground: "#dc322f19",
+    errorBorder: "#dc322f4c",
+    errorText: "#dc322f",
+    infoBackground: "#2aa19819",
+    infoBorder: "#2aa1984c",
+    infoText: "#2aa198",
+    black: "#073642",
+    red: "#dc322f",
+    green: "#859900",
+    yellow: "#b58900",
+    blue: "#268bd2",
+    purple: "#d33682",
+    cyan: "#2aa198",
+    white: "#bbb5a2",
+    brightBlack: "#002b36",
+    brightRed: "#cb4b16",
+    brightGreen: "#586e75",
+    brightYellow: "#657b83",
+    brightBlue: "#839496",
+    brightPurple: "#6c71c4",
+    brightCyan: "#93a1a1",
+    brightWhite: "#fdf6e3",
+    codeBackground: "#fdf6e3",
+    codeText: "#657b83",
+    codeComment: "#002b36",
+    codeKeyword: "#d33682",
+    codeString: "#859900",
+    codeNumber: "#b58900",
+    codeFunction: "#268bd2",
+    codeOperator: "#dc322f",
+    codePunctuation: "#657b83",
+    codeVariable: "#2aa198",
+    codeConstant: "#657b83",
+    codeType: "#93a1a1",
+  },
+};
+
+/** Builtin Solarized Dark */
+export const builtinSolarizedDarkTheme: Theme = {
+  id: "builtin_solarized_dark",
+  displayName: "Builtin Solarized Dark",
+  isDark: true,
+  colors: {
+    background: "#002b36",
+    backgroundSecondary: "#073642",
+    surface: "#073642",
+    surfaceSecondary: "#335e69",
+    textPrimary: "#839496",
+    textSecondary: "#eee8d5",
+    textMuted: "#335e69",
+    textInverted: "#002b36",
+    accent: "#268bd2",
+    accentSecondary: "#d33682",
+    brand: "#268bd2",
+    border: "#335e69",
+    borderSubtle: "#073642",
+    borderStrong: "#eee8d5",
+    successBackground: "#85990019",
+    successBorder: "#8599004c",
+    successText: "#859900",
+    warningBackground: "#b5890019",
+    warningBorder: "#b589004c",
+    warningText: "#b58900",
+    errorBackground: "#dc322f19",
+    errorBorder: "#dc322f4c",
+    errorText: "#dc322f",
+    infoBackground: "#2aa19819",
+    infoBorder: "#2aa1984c",
+    infoText: "#2aa198",
+    black: "#073642",
+    red: "#dc322f",
+    green: "#859900",
+    yellow: "#b58900",
+    blue: "#268bd2",
+    purple: "#d33682",
+    cyan: "#2aa198",
+    white: "#eee8d5",
+    brightBlack: "#335e69",
+    brightRed: "#cb4b16",
+    brightGreen: "#586e75",
+    brightYellow: "#657b83",
+    brightBlue: "#839496",
+    brightPurple: "#6c71c4",
+    brightCyan: "#93a1a1",
+    brightWhite: "#fdf6e3",
+    codeBackground: "#002b36",
+    codeText: "#839496",
+    codeComment: "#335e69",
+    codeKeyword: "#d33682",
+    codeString: "#859900",
+    codeNumber: "#b58900",
+    codeFunction: "#268bd2",
+    codeOperator: "#dc322f",
+    codePunctuation: "#839496",
+    codeVariable: "#2aa198",
+    codeConstant: "#657b83",
+    codeType: "#93a1a1",
+  },
+};
+
+/** Catppuccin Mocha */
+export const catppuccinMochaTheme: Theme = {
+  id: "catppuccin_mocha",
+  displayName: "Catppuccin Mocha",
+  isDark: true,
+  colors: {
+    background: "#1e1e2e",
+    backgroundSecondary: "#45475a",
+    surface: "#585b70",
+    surfaceSecondary: "#585b70",
+    textPrimary: "#cdd6f4",
+    textSecondary: "#a6adc8",
+    textMuted: "#585b70",
+    textInverted: "#1e1e2e",
+    accent: "#89b4fa",
+    accentSecondary: "#f5c2e7",
+    brand: "#89b4fa",
+    border: "#585b70",
+    borderSubtle: "#45475a",
+    borderStrong: "#a6adc8",
+    successBackground: "#a6e3a119",
+    successBorder: "#a6e3a14c",
+    successText: "#a6e3a1",
+    warningBackground: "#f9e2af19",
+    warningBorder: "#f9e2af4c",
+    warningText: "#f9e2af",
+    errorBackground: "#f38ba819",
+    errorBorder: "#f38ba84c",
+    errorText: "#f38ba8",
+    infoBackground: "#94e2d519",
+    infoBorder: "#94e2d54c",
+    infoText: "#94e2d5",
+    black: "#45475a",
+    red: "#f38ba8",
+    green: "#a6e3a1",
+    yellow: "#f9e2af",
+    blue: "#89b4fa",
+    purple: "#f5c2e7",
+    cyan: "#94e2d5",
+    white: "#a6adc8",
+    brightBlack: "#585b70",
+    brightRed: "#f37799",
+    brightGreen: "#89d88b",
+    brightYellow: "#ebd391",
+    brightBlue: "#74a8fc",
+    brightPurple: "#f2aede",
+    brightCyan: "#6bd7ca",
+    brightWhite: "#bac2de",
+    codeBackground: "#1e1e2e",
+    codeText: "#cdd6f4",
+    codeComment: "#585b70",
+    codeKeyword: "#f5c2e7",
+    codeString: "#a6e3a1",
+    codeNumber: "#f9e2af",
+    codeFunction: "#89b4fa",
+    codeOperator: "#f38ba8",
+    codePunctuation: "#cdd6f4",
+    codeVariable: "#94e2d5",
+    codeConstant: "#ebd391",
+    codeType: "#6bd7ca",
+  },
+};
+
+/** TokyoNight */
+export const tokyonightTheme: Theme = {
+  id: "tokyonight",
+  displayName: "TokyoNight",
+  isDark: true,
+  colors: {
+    background: "#1a1b26",
+    backgroundSecondary: "#15161e",
+    surface: "#33467c",
+    surfaceSecondary: "#414868",
+    textPrimary: "#c0caf5",
+    textSecondary: "#a9b1d6",
+    textMuted: "#414868",
+    textInverted: "#1a1b26",
+    accent: "#7aa2f7",
+    accentSecondary: "#bb9af7",
+    brand: "#7aa2f7",
+    border: "#414868",
+    borderSubtle: "#15161e",
+    borderStrong: "#a9b1d6",
+    successBackground: "#9ece6a19",
+    successBorder: "#9ece6a4c",
+    successText: "#9ece6a",
+    warningBackground: "#e0af6819",
+    warningBorder: "#e0af684c",
+    warningText: "#e0af68",
+    errorBackground: "#f7768e19",
+    errorBorder: "#f7768e4c",
+    errorText: "#f7768e",
+    infoBackground: "#7dcfff19",
+    infoBorder: "#7dcfff4c",
+    infoText: "#7dcfff",
+    black: "#15161e",
+    red: "#f7768e",
+    green: "#9ece6a",
+    yellow: "#e0af68",
+    blue: "#7aa2f7",
+    purple: "#bb9af7",
+    cyan: "#7dcfff",
+    white: "#a9b1d6",
+    brightBlack: "#414868",
+    brightRed: "#f7768e",
+    brightGreen: "#9ece6a",
+    brightYellow: "#e0af68",
+    brightBlue: "#7aa2f7",
+    brightPurple: "#bb9af7",
+    brightCyan: "#7dcfff",
+    brightWhite: "#c0caf5",
+    codeBackground: "#1a1b26",
+    codeText: "#c0caf5",
+    codeComment: "#414868",
+    codeKeyword: "#bb9af7",
+    codeString: "#9ece6a",
+    codeNumber: "#e0af68",
+    codeFunction: "#7aa2f7",
+    codeOperator: "#f7768e",
+    codePunctuation: "#c0caf5",
+    codeVariable: "#7dcfff",
+    codeConstant: "#e0af68",
+    codeType: "#7dcfff",
+  },
+};
+
+/** GitHub Dark */
+export const githubDarkTheme: Theme = {
+  id: "github_dark",
+  displayName: "GitHub Dark",
+  isDark: true,
+  colors: {
+    background: "#101216",
+    backgroundSecondary: "#000000",
+    surface: "#3b5070",
+    surfaceSecondary: "#4d4d4d",
+    textPrimary: "#8b949e",
+    textSecondary: "#ffffff",
+    textMuted: "#4d4d4d",
+    textInverted: "#101216",
+    accent: "#6ca4f8",
+    accentSecondary: "#db61a2",
+    brand: "#6ca4f8",
+    border: "#4d4d4d",
+    borderSubtle: "#000000",
+    borderStrong: "#ffffff",
+    successBackground: "#56d36419",
+    successBorder: "#56d3644c",
+    successText: "#56d364",
+    warningBackground: "#e3b34119",
+    warningBorder: "#e3b3414c",
+    warningText: "#e3b341",
+    errorBackground: "#f7816619",
+    errorBorder: "#f781664c",
+    errorText: "#f78166",
+    infoBackground: "#2b748919",
+    infoBorder: "#2b74894c",
+    infoText: "#2b7489",
+    black: "#000000",
+    red: "#f78166",
+    green: "#56d364",
+    yellow: "#e3b341",
+    blue: "#6ca4f8",
+    purple: "#db61a2",
+    cyan: "#2b7489",
+    white: "#ffffff",
+    brightBlack: "#4d4d4d",
+    brightRed: "#f78166",
+    brightGreen: "#56d364",
+    brightYellow: "#e3b341",
+    brightBlue: "#6ca4f8",
+    brightPurple: "#db61a2",
+    brightCyan: "#2b7489",
+    brightWhite: "#ffffff",
+    codeBackground: "#101216",
+    codeText: "#8b949e",
+    codeComment: "#4d4d4d",
+    codeKeyword: "#db61a2",
+    codeString: "#56d364",
+    codeNumber: "#e3b341",
+    codeFunction: "#6ca4f8",
+    codeOperator: "#f78166",
+    codePunctuation: "#8b949e",
+    codeVariable: "#2b7489",
+    codeConstant: "#e3b341",
+    codeType: "#2b7489",
+  },
+};
+
+/** Monokai Pro */
+export const monokaiProTheme: Theme = {
+  id: "monokai_pro",
+  displayName: "Monokai Pro",
+  isDark: true,
+  colors: {
+    background: "#2d2a2e",
+    backgroundSecondary: "#2d2a2e",
+    surface: "#5b595c",
+    surfaceSecondary: "#727072",
+    textPrimary: "#fcfcfa",
+    textSecondary: "#fcfcfa",
+    textMuted: "#727072",
+    textInverted: "#2d2a2e",
+    accent: "#fc9867",
+    accentSecondary: "#ab9df2",
+    brand: "#fc9867",
+    border: "#727072",
+    borderSubtle: "#2d2a2e",
+    borderStrong: "#fcfcfa",
+    successBackground: "#a9dc7619",
+    successBorder: "#a9dc764c",
+    successText: "#a9dc76",
+    warningBackground: "#ffd86619",
+    warningBorder: "#ffd8664c",
+    warningText: "#ffd866",
+    errorBackground: "#ff618819",
+    errorBorder: "#ff61884c",
+    errorText: "#ff6188",
+    infoBackground: "#78dce819",
+    infoBorder: "#78dce84c",
+    infoText: "#78dce8",
+    black: "#2d2a2e",
+    red: "#ff6188",
+    green: "#a9dc76",
+    yellow: "#ffd866",
+    blue: "#fc9867",
+    purple: "#ab9df2",
+    cyan: "#78dce8",
+    white: "#fcfcfa",
+    brightBlack: "#727072",
+    brightRed: "#ff6188",
+    brightGreen: "#a9dc76",
+    brightYellow: "#ffd866",
+    brightBlue: "#fc9867",
+    brightPurple: "#ab9df2",
+    brightCyan: "#78dce8",
+    brightWhite: "#fcfcfa",
+    codeBackground: "#2d2a2e",
+    codeText: "#fcfcfa",
+    codeComment: "#727072",
+    codeKeyword: "#ab9df2",
+    codeString: "#a9dc76",
+    codeNumber: "#ffd866",
+    codeFunction: "#fc9867",
+    codeOperator: "#ff6188",
+    codePunctuation: "#fcfcfa",
+    codeVariable: "#78dce8",
+    codeConstant: "#ffd866",
+    codeType: "#78dce8",
+  },
+};
+
+/** Every generated theme, keyed by ID. */
+export const themes: Readonly<Record<ThemeId, Theme>> = {
+  "dracula": draculaTheme,
+  "nord": nordTheme,
+  "gruvbox_dark": gruvboxDarkTheme,
+  "atom_one_dark": atomOneDarkTheme,
+  "builtin_solarized_light": builtinSolarizedLightTheme,
+  "builtin_solarized_dark": builtinSolarizedDarkTheme,
+  "catppuccin_mocha": catppuccinMochaTheme,
+  "tokyonight": tokyonightTheme,
+  "github_dark": githubDarkTheme,
+  "monokai_pro": monokaiProTheme,
+};
+
+/** Returns a var() reference to a theme CSS variable, such as var(--theme-accent). */
+export function cssVar(name: CssVar): string {
+  return `var(${name})`;
+}
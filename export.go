@@ -0,0 +1,314 @@
+package gothememe
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Exporter writes themes in one output format. Implement it to add a
+// format to [Export] with [RegisterExporter].
+type Exporter interface {
+	// Name returns the lowercase name of the format, such as "css".
+	Name() string
+
+	// Extensions returns the file extensions the format is written with,
+	// most common first, such as ".css".
+	Extensions() []string
+
+	// Export writes the themes to w. Formats that hold a single theme return
+	// [ErrTooManyThemes] when given more than one.
+	Export(w io.Writer, themes []Theme, opts ExportOptions) error
+}
+
+// ExportOptions configures the built-in exporters. Each exporter reads the
+// options for its own format and ignores the rest.
+type ExportOptions struct {
+	// CSS configures the CSS, SCSS, Less, Stylus and JSON exporters.
+	CSS CSSOptions
+
+	// Tokens configures the design token exporter.
+	Tokens TokenOptions
+
+	// Tailwind configures the Tailwind exporters. They reference the
+	// variables by the Tailwind Prefix, or by the CSS Prefix when it is
+	// empty, as it is in DefaultExportOptions.
+	Tailwind TailwindOptions
+
+	// Android configures the Android resource and Compose exporters.
+	Android AndroidOptions
+
+	// Figma configures the Figma Variables exporter.
+	Figma FigmaOptions
+}
+
+// DefaultExportOptions returns the default options of every built-in exporter.
+func DefaultExportOptions() ExportOptions {
+	return ExportOptions{
+		CSS:      DefaultCSSOptions(),
+		Tokens:   DefaultTokenOptions(),
+		Tailwind: TailwindOptions{Version: TailwindV4},
+		Android:  DefaultAndroidOptions(),
+		Figma:    DefaultFigmaOptions(),
+	}
+}
+
+// ErrUnknownOutputFormat is returned when no exporter is registered for a format.
+var ErrUnknownOutputFormat = errors.New("unknown output format")
+
+// ErrNoThemes is returned when there are no themes to export.
+var ErrNoThemes = errors.New("no themes to export")
+
+// ErrTooManyThemes is returned by exporters whose format holds a single theme
+// when they are given more than one.
+var ErrTooManyThemes = errors.New("format holds a single theme")
+
+// firstCustomFormat is the OutputFormat given to the first exporter
+// registered under a new name, leaving room for built-in formats.
+const firstCustomFormat OutputFormat = 1000
+
+// exporters holds the registered exporters. It is safe for concurrent use.
+var exporters = struct {
+	sync.RWMutex
+	byFormat map[OutputFormat]Exporter
+	next     OutputFormat
+}{
+	byFormat: map[OutputFormat]Exporter{
+		FormatCSS:                    exporterFunc{"css", []string{".css"}, exportCSS},
+		FormatSCSS:                   exporterFunc{"scss", []string{".scss"}, exportSCSS},
+		FormatJSON:                   exporterFunc{"json", []string{".json"}, exportJSON},
+		FormatDesignTokens:           exporterFunc{"tokens", []string{".tokens.json", ".tokens"}, exportDesignTokens},
+		FormatTailwind:               exporterFunc{"tailwind", []string{".tailwind.css"}, exportTailwind},
+		FormatTailwindConfig:         exporterFunc{"tailwind-config", []string{".tailwind.js", ".tailwind.json"}, exportTailwindConfig},
+		FormatAlacritty:              terminalExporter(TerminalFormatAlacritty, ".alacritty.toml"),
+		FormatKitty:                  terminalExporter(TerminalFormatKitty, ".kitty.conf"),
+		FormatWezTerm:                terminalExporter(TerminalFormatWezTerm, ".wezterm.toml"),
+		FormatGhostty:                terminalExporter(TerminalFormatGhostty, ".ghostty"),
+		FormatWindowsTerminal:        terminalExporter(TerminalFormatWindowsTerminal, ".windows-terminal.json"),
+		FormatFoot:                   terminalExporter(TerminalFormatFoot, ".foot.ini"),
+		FormatXresources:             terminalExporter(TerminalFormatXresources, ".Xresources"),
+		FormatVSCode:                 singleThemeExporter("vscode", WriteVSCodeTheme, "-color-theme.json"),
+		FormatNeovim:                 singleThemeExporter("neovim", WriteNeovimColorscheme, ".lua"),
+		FormatTMTheme:                singleThemeExporter("tmtheme", WriteTMTheme, ".tmTheme"),
+		FormatSublime:                singleThemeExporter("sublime-color-scheme", WriteSublimeColorScheme, ".sublime-color-scheme"),
+		FormatAndroidColors:          exporterFunc{"android-colors", []string{"colors.xml"}, exportAndroidColors},
+		FormatAndroidTheme:           exporterFunc{"android-theme", []string{"themes.xml"}, exportAndroidTheme},
+		FormatCompose:                exporterFunc{"compose", []string{".kt"}, exportCompose},
+		FormatSwift:                  exporterFunc{"swift", []string{".swift"}, exportSwift},
+		FormatGIMP:                   singleThemeExporter("gimp", WriteGIMPPalette, ".gpl"),
+		FormatASE:                    singleThemeExporter("ase", WriteASE, ".ase"),
+		FormatSketch:                 singleThemeExporter("sketch", WriteSketchPalette, ".sketchpalette"),
+		FormatProcreate:              singleThemeExporter("procreate", WriteProcreateSwatches, ".swatches"),
+		FormatFigmaVariables:         exporterFunc{"figma-variables", []string{".figma.json"}, exportFigmaVariables},
+		FormatTypeScript:             exporterFunc{"typescript", []string{".ts"}, exportTypeScript},
+		FormatTypeScriptDeclarations: exporterFunc{"typescript-declarations", []string{".d.ts"}, exportTypeScriptDeclarations},
+		FormatLess:                   exporterFunc{"less", []string{".less"}, exportLess},
+		FormatStylus:                 exporterFunc{"stylus", []string{".styl"}, exportStylus},
+	},
+	next: firstCustomFormat,
+}
+
+// RegisterExporter adds an exporter and returns the format to pass to
+// [Export]. Registering an exporter under the name of an existing one,
+// built-in or not, replaces it and returns its format. RegisterExporter
+// panics if e is nil or its name is empty.
+func RegisterExporter(e Exporter) OutputFormat {
+	if e == nil || e.Name() == "" {
+		panic("gothememe: RegisterExporter called with a nil or unnamed exporter")
+	}
+
+	exporters.Lock()
+	defer exporters.Unlock()
+
+	for format, existing := range exporters.byFormat {
+		if strings.EqualFold(existing.Name(), e.Name()) {
+			exporters.byFormat[format] = e
+			return format
+		}
+	}
+	format := exporters.next
+	exporters.next++
+	exporters.byFormat[format] = e
+	return format
+}
+
+// LookupExporter returns the exporter registered for format.
+func LookupExporter(format OutputFormat) (Exporter, bool) {
+	exporters.RLock()
+	defer exporters.RUnlock()
+
+	e, ok := exporters.byFormat[format]
+	return e, ok
+}
+
+// OutputFormats returns every registered format in ascending order, built-in
+// formats first.
+func OutputFormats() []OutputFormat {
+	exporters.RLock()
+	defer exporters.RUnlock()
+
+	formats := make([]OutputFormat, 0, len(exporters.byFormat))
+	for format := range exporters.byFormat {
+		formats = append(formats, format)
+	}
+	slices.Sort(formats)
+	return formats
+}
+
+// ParseOutputFormat returns the registered format with the given name, such
+// as "css" or "tokens". Names are matched case-insensitively.
+func ParseOutputFormat(name string) (OutputFormat, error) {
+	for _, format := range OutputFormats() {
+		if e, ok := LookupExporter(format); ok && strings.EqualFold(e.Name(), name) {
+			return format, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", ErrUnknownOutputFormat, name)
+}
+
+// OutputFormatFromPath returns the registered format whose extension path
+// ends with. The longest matching extension wins, so "theme.tokens.json"
+// selects design tokens rather than JSON.
+func OutputFormatFromPath(path string) (OutputFormat, error) {
+	base := strings.ToLower(filepath.Base(path))
+	best, bestLen := OutputFormat(0), 0
+	for _, format := range OutputFormats() {
+		e, _ := LookupExporter(format) //nolint:errcheck // format comes from OutputFormats
+		for _, ext := range e.Extensions() {
+			if len(ext) > bestLen && strings.HasSuffix(base, strings.ToLower(ext)) {
+				best, bestLen = format, len(ext)
+			}
+		}
+	}
+	if bestLen == 0 {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownOutputFormat, path)
+	}
+	return best, nil
+}
+
+// String returns the name of the format's exporter, or "unknown" when none
+// is registered.
+func (f OutputFormat) String() string {
+	if e, ok := LookupExporter(f); ok {
+		return e.Name()
+	}
+	return "unknown"
+}
+
+// Export writes the themes to w in the given format with the default
+// options. Any registered format can be used, so a CLI or HTTP handler can
+// support every format without its own switch:
+//
+//	format, err := gothememe.ParseOutputFormat(r.URL.Query().Get("format"))
+//	if err != nil {
+//	    return err
+//	}
+//	err = gothememe.Export(w, format, themes.ThemeDracula, themes.ThemeNord)
+func Export(w io.Writer, format OutputFormat, themes ...Theme) error {
+	return ExportWithOptions(w, format, DefaultExportOptions(), themes...)
+}
+
+// ExportWithOptions writes the themes to w in the given format. Nil themes
+// are skipped.
+func ExportWithOptions(w io.Writer, format OutputFormat, opts ExportOptions, themes ...Theme) error {
+	e, ok := LookupExporter(format)
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrUnknownOutputFormat, format)
+	}
+
+	themes = slices.DeleteFunc(slices.Clone(themes), func(t Theme) bool { return t == nil })
+	if len(themes) == 0 {
+		return fmt.Errorf("%s: %w", e.Name(), ErrNoThemes)
+	}
+	if err := e.Export(w, themes, opts); err != nil {
+		return fmt.Errorf("%s: %w", e.Name(), err)
+	}
+	return nil
+}
+
+// exporterFunc adapts a function to the Exporter interface for the built-in
+// formats.
+type exporterFunc struct {
+	name       string
+	extensions []string
+	export     func(w io.Writer, themes []Theme, opts ExportOptions) error
+}
+
+func (e exporterFunc) Name() string         { return e.name }
+func (e exporterFunc) Extensions() []string { return slices.Clone(e.extensions) }
+
+func (e exporterFunc) Export(w io.Writer, themes []Theme, opts ExportOptions) error {
+	return e.export(w, themes, opts)
+}
+
+// singleThemeExporter returns the exporter for a format that holds a single
+// theme and is written by write.
+func singleThemeExporter(name string, write func(io.Writer, Theme) error, extensions ...string) exporterFunc {
+	return exporterFunc{name, extensions, func(w io.Writer, themes []Theme, _ ExportOptions) error {
+		if len(themes) > 1 {
+			return fmt.Errorf("%w: got %d", ErrTooManyThemes, len(themes))
+		}
+		return write(w, themes[0])
+	}}
+}
+
+// exportCSS writes one theme with the configured selector, or several
+// themes as [data-theme] blocks.
+func exportCSS(w io.Writer, themes []Theme, opts ExportOptions) error {
+	if len(themes) == 1 {
+		_, err := io.WriteString(w, GenerateCSS(themes[0], opts.CSS))
+		return err
+	}
+	_, err := io.WriteString(w, GenerateAllThemesCSS(themes, opts.CSS))
+	return err
+}
+
+// exportJSON writes the JSON object of a single theme, or an object of them
+// keyed by theme ID.
+func exportJSON(w io.Writer, themes []Theme, opts ExportOptions) error {
+	if len(themes) == 1 {
+		_, err := io.WriteString(w, GenerateJSON(themes[0], opts.CSS)+"\n")
+		return err
+	}
+
+	nl, indent := "\n", "    "
+	if opts.CSS.Minify {
+		nl, indent = "", ""
+	}
+	var sb strings.Builder
+	sb.WriteString("{" + nl)
+	for i, t := range themes {
+		body := strings.ReplaceAll(GenerateJSON(t, opts.CSS), "\n", "\n"+indent)
+		sb.WriteString(fmt.Sprintf("%s%q: %s", indent, t.ID(), body))
+		if i < len(themes)-1 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(nl)
+	}
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// exportDesignTokens writes the DTCG tokens of one theme, or a collection
+// keyed by theme ID.
+func exportDesignTokens(w io.Writer, themes []Theme, opts ExportOptions) error {
+	var (
+		tokens string
+		err    error
+	)
+	if len(themes) == 1 {
+		tokens, err = GenerateDesignTokens(themes[0], opts.Tokens)
+	} else {
+		tokens, err = GenerateAllDesignTokens(themes, opts.Tokens)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, tokens+"\n")
+	return err
+}
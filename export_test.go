@@ -0,0 +1,215 @@
+package gothememe
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestExportBuiltinFormats(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	tokens, err := GenerateDesignTokens(theme, DefaultTokenOptions())
+	if err != nil {
+		t.Fatalf("GenerateDesignTokens() error: %v", err)
+	}
+
+	tests := []struct {
+		format OutputFormat
+		name   string
+		want   string
+	}{
+		{FormatCSS, "css", GenerateCSS(theme, DefaultCSSOptions())},
+		{FormatSCSS, "scss", GenerateSCSS(theme, DefaultCSSOptions())},
+		{FormatJSON, "json", GenerateJSON(theme, DefaultCSSOptions()) + "\n"},
+		{FormatDesignTokens, "tokens", tokens + "\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.format.String(); got != tt.name {
+				t.Errorf("String() = %q, want %q", got, tt.name)
+			}
+			var sb strings.Builder
+			if err := Export(&sb, tt.format, theme); err != nil {
+				t.Fatalf("Export() error: %v", err)
+			}
+			if sb.String() != tt.want {
+				t.Errorf("Export() output differs from the generator:\n%s", sb.String())
+			}
+		})
+	}
+}
+
+func TestExportMultipleThemes(t *testing.T) {
+	t.Parallel()
+
+	dark := fullTestTheme(t)
+	light := NewThemeBuilder("paper", "Paper").
+		WithBackground(Hex("#fdf6e3")).
+		WithTextPrimary(Hex("#657b83")).
+		Build()
+
+	var css strings.Builder
+	if err := Export(&css, FormatCSS, dark, light); err != nil {
+		t.Fatalf("Export(css) error: %v", err)
+	}
+	if !strings.Contains(css.String(), `[data-theme="catppuccin_mocha"]`) || !strings.Contains(css.String(), `[data-theme="paper"]`) {
+		t.Errorf("Export(css) output lacks a data-theme block:\n%s", css.String())
+	}
+
+	for _, minify := range []bool{false, true} {
+		opts := DefaultExportOptions()
+		opts.CSS.Minify = minify
+		var sb strings.Builder
+		if err := ExportWithOptions(&sb, FormatJSON, opts, dark, light); err != nil {
+			t.Fatalf("Export(json) error: %v", err)
+		}
+		var got map[string]map[string]string
+		if err := json.Unmarshal([]byte(sb.String()), &got); err != nil {
+			t.Fatalf("Export(json, minify %v) is not valid JSON: %v\n%s", minify, err, sb.String())
+		}
+		if got["paper"]["background"] != "#fdf6e3" || got["catppuccin_mocha"]["background"] != "#1e1e2e" {
+			t.Errorf("Export(json, minify %v) backgrounds = %q, %q", minify, got["paper"]["background"], got["catppuccin_mocha"]["background"])
+		}
+	}
+
+	var tokens strings.Builder
+	if err := Export(&tokens, FormatDesignTokens, dark, light); err != nil {
+		t.Fatalf("Export(tokens) error: %v", err)
+	}
+	if !strings.Contains(tokens.String(), `"paper"`) {
+		t.Error("Export(tokens) lacks the paper theme")
+	}
+
+	var scss strings.Builder
+	if err := Export(&scss, FormatSCSS, dark, light); err != nil {
+		t.Fatalf("Export(scss) error: %v", err)
+	}
+	want, err := GenerateSCSSMap([]Theme{dark, light}, DefaultCSSOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scss.String() != want {
+		t.Error("Export(scss) with two themes should write the Sass map module")
+	}
+}
+
+func TestExportErrors(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	if err := Export(io.Discard, OutputFormat(-1), theme); !errors.Is(err, ErrUnknownOutputFormat) {
+		t.Errorf("Export(unknown format) error = %v, want ErrUnknownOutputFormat", err)
+	}
+	if err := Export(io.Discard, FormatCSS); !errors.Is(err, ErrNoThemes) {
+		t.Errorf("Export() without themes error = %v, want ErrNoThemes", err)
+	}
+	if err := Export(io.Discard, FormatCSS, nil); !errors.Is(err, ErrNoThemes) {
+		t.Errorf("Export(nil) error = %v, want ErrNoThemes", err)
+	}
+	if OutputFormat(-1).String() != "unknown" {
+		t.Errorf("String() = %q, want unknown", OutputFormat(-1).String())
+	}
+}
+
+// upperExporter writes theme IDs in upper case, one per line.
+type upperExporter struct{ name string }
+
+func (e upperExporter) Name() string         { return e.name }
+func (e upperExporter) Extensions() []string { return []string{".upper.txt"} }
+
+func (e upperExporter) Export(w io.Writer, themes []Theme, _ ExportOptions) error {
+	for _, t := range themes {
+		if _, err := io.WriteString(w, strings.ToUpper(t.ID())+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestRegisterExporter(t *testing.T) {
+	t.Parallel()
+
+	format := RegisterExporter(upperExporter{"upper-test"})
+	if format < firstCustomFormat {
+		t.Errorf("RegisterExporter() = %d, want a custom format", format)
+	}
+	if again := RegisterExporter(upperExporter{"Upper-Test"}); again != format {
+		t.Errorf("re-registering returned %d, want %d", again, format)
+	}
+	if format.String() != "Upper-Test" {
+		t.Errorf("String() = %q, want the replacement's name", format.String())
+	}
+
+	parsed, err := ParseOutputFormat("UPPER-TEST")
+	if err != nil || parsed != format {
+		t.Errorf("ParseOutputFormat() = %d, %v, want %d", parsed, err, format)
+	}
+
+	var sb strings.Builder
+	if err := Export(&sb, format, fullTestTheme(t), nil); err != nil {
+		t.Fatalf("Export() error: %v", err)
+	}
+	if sb.String() != "CATPPUCCIN_MOCHA\n" {
+		t.Errorf("Export() = %q, want CATPPUCCIN_MOCHA", sb.String())
+	}
+
+	failing := RegisterExporter(exporterFunc{"failing-test", nil, func(io.Writer, []Theme, ExportOptions) error {
+		return errors.New("boom")
+	}})
+	if err := Export(io.Discard, failing, fullTestTheme(t)); err == nil || err.Error() != "failing-test: boom" {
+		t.Errorf("Export() error = %v, want it prefixed with the exporter name", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("RegisterExporter(nil) did not panic")
+		}
+	}()
+	RegisterExporter(nil)
+}
+
+func TestOutputFormatFromPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path    string
+		want    OutputFormat
+		wantErr bool
+	}{
+		{"dist/theme.css", FormatCSS, false},
+		{"styles/_theme.SCSS", FormatSCSS, false},
+		{"theme.json", FormatJSON, false},
+		{"theme.tokens.json", FormatDesignTokens, false},
+		{"theme.tokens", FormatDesignTokens, false},
+		{"app.tailwind.css", FormatTailwind, false},
+		{"gothememe.tailwind.js", FormatTailwindConfig, false},
+		{"theme.txt", 0, true},
+		{"css", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := OutputFormatFromPath(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("OutputFormatFromPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrUnknownOutputFormat) {
+				t.Errorf("OutputFormatFromPath() error = %v, want ErrUnknownOutputFormat", err)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("OutputFormatFromPath() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParseOutputFormat("nope"); !errors.Is(err, ErrUnknownOutputFormat) {
+		t.Errorf("ParseOutputFormat(nope) error = %v, want ErrUnknownOutputFormat", err)
+	}
+}
@@ -0,0 +1,303 @@
+package gothememe
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FigmaOptions configures Figma Variables and Tokens Studio output.
+type FigmaOptions struct {
+	// Collection names the variable collection that holds the themes as
+	// modes (default: "Themes"). In Tokens Studio it is the theme group.
+	Collection string
+
+	// Prefix of the CSS variables set as each variable's web code syntax
+	// (default: "theme"), so Dev Mode shows var(--theme-accent) for the
+	// accent. It must match the Prefix the theme CSS was generated with.
+	Prefix string
+}
+
+// DefaultFigmaOptions returns sensible default Figma options.
+func DefaultFigmaOptions() FigmaOptions {
+	return FigmaOptions{
+		Collection: "Themes",
+		Prefix:     "theme",
+	}
+}
+
+// withDefaults fills in the defaults of unset options.
+func (o FigmaOptions) withDefaults() FigmaOptions {
+	def := DefaultFigmaOptions()
+	if o.Collection == "" {
+		o.Collection = def.Collection
+	}
+	if o.Prefix == "" {
+		o.Prefix = def.Prefix
+	}
+	return o
+}
+
+// figmaVariablesRequest is the body of a POST /v1/files/:file_key/variables
+// request of the Figma REST API.
+type figmaVariablesRequest struct {
+	VariableCollections []figmaCollection `json:"variableCollections"`
+	VariableModes       []figmaMode       `json:"variableModes"`
+	Variables           []figmaVariable   `json:"variables"`
+	VariableModeValues  []figmaModeValue  `json:"variableModeValues"`
+}
+
+// figmaCollection creates a variable collection.
+type figmaCollection struct {
+	Action        string `json:"action"`
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	InitialModeID string `json:"initialModeId"`
+}
+
+// figmaMode creates or renames a mode of a collection.
+type figmaMode struct {
+	Action               string `json:"action"`
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	VariableCollectionID string `json:"variableCollectionId"`
+}
+
+// figmaVariable creates a variable.
+type figmaVariable struct {
+	Action               string            `json:"action"`
+	ID                   string            `json:"id"`
+	Name                 string            `json:"name"`
+	VariableCollectionID string            `json:"variableCollectionId"`
+	ResolvedType         string            `json:"resolvedType"`
+	CodeSyntax           map[string]string `json:"codeSyntax,omitempty"`
+}
+
+// figmaModeValue sets the value of a variable in one mode, either a
+// figmaColor or a figmaAlias.
+type figmaModeValue struct {
+	VariableID string `json:"variableId"`
+	ModeID     string `json:"modeId"`
+	Value      any    `json:"value"`
+}
+
+// figmaColor is a Figma RGBA color with components from 0 to 1.
+type figmaColor struct {
+	R float64 `json:"r"`
+	G float64 `json:"g"`
+	B float64 `json:"b"`
+	A float64 `json:"a"`
+}
+
+// figmaAlias is a variable value that references another variable.
+type figmaAlias struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// GenerateFigmaVariables generates the body of a Figma REST API request
+// (POST /v1/files/:file_key/variables) that creates one variable
+// collection with a mode per theme and a color variable per role, named
+// after the role's design token path, such as text/primary. Roles a theme
+// gives the same color as the role they usually repeat, such as brand and
+// the accent, are kept as aliases of that variable in the theme's mode.
+// The first theme becomes the collection's default mode. Figma plans limit
+// the number of modes per collection.
+func GenerateFigmaVariables(themes []Theme, opts FigmaOptions) (string, error) {
+	opts = opts.withDefaults()
+	themes, err := uniqueThemes(themes)
+	if err != nil {
+		return "", err
+	}
+
+	const collectionID = "collection"
+	req := figmaVariablesRequest{}
+	for i, t := range themes {
+		mode := figmaMode{
+			Action:               "CREATE",
+			ID:                   figmaModeID(t),
+			Name:                 t.DisplayName(),
+			VariableCollectionID: collectionID,
+		}
+		if i == 0 {
+			// The collection's initial mode exists once it is created, so
+			// the first theme renames it.
+			mode.Action = "UPDATE"
+			req.VariableCollections = append(req.VariableCollections, figmaCollection{
+				Action:        "CREATE",
+				ID:            collectionID,
+				Name:          opts.Collection,
+				InitialModeID: mode.ID,
+			})
+		}
+		req.VariableModes = append(req.VariableModes, mode)
+	}
+
+	for _, role := range colorRoles {
+		var values []figmaModeValue
+		for _, t := range themes {
+			c := role.get(t)
+			if c.IsEmpty() {
+				continue
+			}
+			value := figmaModeValue{VariableID: figmaVariableID(role.key), ModeID: figmaModeID(t)}
+			if source, ok := roleAlias(t, role.key); ok {
+				value.Value = figmaAlias{Type: "VARIABLE_ALIAS", ID: figmaVariableID(source)}
+			} else {
+				r, g, b, a := c.RGBAComponents()
+				value.Value = figmaColor{unitComponent(r), unitComponent(g), unitComponent(b), unitComponent(a)}
+			}
+			values = append(values, value)
+		}
+		if len(values) == 0 {
+			continue
+		}
+		req.Variables = append(req.Variables, figmaVariable{
+			Action:               "CREATE",
+			ID:                   figmaVariableID(role.key),
+			Name:                 strings.ReplaceAll(strings.TrimPrefix(roleTokenPaths[role.key], "color."), ".", "/"),
+			VariableCollectionID: collectionID,
+			ResolvedType:         "COLOR",
+			CodeSyntax:           map[string]string{"WEB": fmt.Sprintf("var(--%s-%s)", opts.Prefix, strings.ReplaceAll(role.key, "_", "-"))},
+		})
+		req.VariableModeValues = append(req.VariableModeValues, values...)
+	}
+
+	data, err := json.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Figma variables: %w", err)
+	}
+	return string(data), nil
+}
+
+// figmaModeID returns the temporary ID of a theme's mode.
+func figmaModeID(t Theme) string {
+	return "mode_" + t.ID()
+}
+
+// figmaVariableID returns the temporary ID of a role's variable.
+func figmaVariableID(key string) string {
+	return "var_" + key
+}
+
+// uniqueThemes returns the themes without nil entries, failing with
+// [ErrNoThemes] when none are left and on duplicate IDs, which would
+// collide as modes or token sets.
+func uniqueThemes(themes []Theme) ([]Theme, error) {
+	var list []Theme
+	seen := map[string]bool{}
+	for _, t := range themes {
+		if t == nil {
+			continue
+		}
+		if seen[t.ID()] {
+			return nil, fmt.Errorf("duplicate theme ID %q", t.ID())
+		}
+		seen[t.ID()] = true
+		list = append(list, t)
+	}
+	if len(list) == 0 {
+		return nil, ErrNoThemes
+	}
+	return list, nil
+}
+
+// roleTokenTree returns the design tokens of t's color roles as nested
+// groups under their token paths, such as color.text.primary. Roles t gives
+// the same color as the role they usually repeat are written as aliases of
+// its token, such as "{color.accent.primary}" for color.brand.
+func roleTokenTree(t Theme) map[string]any {
+	tree := map[string]any{}
+	for _, role := range colorRoles {
+		c := role.get(t)
+		if c.IsEmpty() {
+			continue
+		}
+		value := c.Hex()
+		if source, ok := roleAlias(t, role.key); ok {
+			value = "{" + roleTokenPaths[source] + "}"
+		}
+
+		group := tree
+		parts := strings.Split(roleTokenPaths[role.key], ".")
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := group[part].(map[string]any)
+			if !ok {
+				next = map[string]any{}
+				group[part] = next
+			}
+			group = next
+		}
+		group[parts[len(parts)-1]] = map[string]any{"$value": value, "$type": "color"}
+	}
+	return tree
+}
+
+// tokensStudioTheme is one entry of a Tokens Studio $themes.json.
+type tokensStudioTheme struct {
+	ID                string            `json:"id"`
+	Name              string            `json:"name"`
+	Group             string            `json:"group"`
+	SelectedTokenSets map[string]string `json:"selectedTokenSets"`
+}
+
+// WriteTokensStudioSets writes the themes to dir as a Tokens Studio
+// multi-file project for Git or folder sync: a token set per theme, such
+// as dracula.json, with the same token paths as [GenerateDesignTokens], plus
+// $themes.json and $metadata.json. Each theme enables its own set and all
+// share the opts' Collection as their group, so exporting to Figma creates
+// one collection with a mode per theme. Roles a theme gives the same color
+// as the role they usually repeat are kept as aliases such as
+// "{color.accent.primary}". The directory is created if needed.
+func WriteTokensStudioSets(dir string, opts FigmaOptions, themes ...Theme) error {
+	opts = opts.withDefaults()
+	themes, err := uniqueThemes(themes)
+	if err != nil {
+		return err
+	}
+
+	files := map[string]any{}
+	var (
+		order   []string
+		entries []tokensStudioTheme
+	)
+	for _, t := range themes {
+		files[t.ID()+".json"] = roleTokenTree(t)
+		order = append(order, t.ID())
+		entries = append(entries, tokensStudioTheme{
+			ID:                t.ID(),
+			Name:              t.DisplayName(),
+			Group:             opts.Collection,
+			SelectedTokenSets: map[string]string{t.ID(): "enabled"},
+		})
+	}
+	files["$themes.json"] = entries
+	files["$metadata.json"] = map[string]any{"tokenSetOrder": order}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec // G301: token folders are meant to be shared
+		return fmt.Errorf("writing Tokens Studio sets: %w", err)
+	}
+	for name, content := range files {
+		data, err := json.MarshalIndent(content, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding %s: %w", name, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), append(data, '\n'), 0o644); err != nil { //nolint:gosec // G306: token files are meant to be shared
+			return fmt.Errorf("writing Tokens Studio sets: %w", err)
+		}
+	}
+	return nil
+}
+
+// exportFigmaVariables writes the Figma Variables request of the themes.
+func exportFigmaVariables(w io.Writer, themes []Theme, opts ExportOptions) error {
+	body, err := GenerateFigmaVariables(themes, opts.Figma)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, body+"\n")
+	return err
+}
@@ -0,0 +1,233 @@
+package gothememe
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// figmaTestThemes returns a dark and a light theme for the multi-mode tests.
+func figmaTestThemes(t *testing.T) (dark, light Theme) {
+	t.Helper()
+	return fullTestTheme(t), NewThemeBuilder("paper", "Paper").
+		WithBackground(Hex("#fdf6e3")).
+		WithTextPrimary(Hex("#657b83")).
+		WithAccent(Hex("#268bd2")).
+		WithBrand(Hex("#d33682")).
+		Build()
+}
+
+func TestRoleAlias(t *testing.T) {
+	t.Parallel()
+
+	dark, light := figmaTestThemes(t)
+	tests := []struct {
+		theme  Theme
+		key    string
+		source string
+		ok     bool
+	}{
+		{dark, "brand", "accent", true},
+		{dark, "success_text", "green", true},
+		{light, "brand", "", false},
+		{light, "text_inverted", "background", true},
+		{dark, "accent", "", false},
+	}
+	for _, tt := range tests {
+		if source, ok := roleAlias(tt.theme, tt.key); source != tt.source || ok != tt.ok {
+			t.Errorf("roleAlias(%s, %q) = %q, %v, want %q, %v", tt.theme.ID(), tt.key, source, ok, tt.source, tt.ok)
+		}
+	}
+	for key, source := range roleAliases {
+		if _, ok := lookupColorRole(key); !ok {
+			t.Errorf("roleAliases key %q is not a role", key)
+		}
+		if _, ok := lookupColorRole(source); !ok {
+			t.Errorf("roleAliases source %q is not a role", source)
+		}
+		if _, ok := roleAliases[source]; ok {
+			t.Errorf("roleAliases source %q is itself an alias", source)
+		}
+	}
+}
+
+func TestGenerateFigmaVariables(t *testing.T) {
+	t.Parallel()
+
+	dark, light := figmaTestThemes(t)
+	body, err := GenerateFigmaVariables([]Theme{dark, nil, light}, FigmaOptions{Collection: "Acme"})
+	if err != nil {
+		t.Fatalf("GenerateFigmaVariables() error: %v", err)
+	}
+
+	var req struct {
+		VariableCollections []figmaCollection `json:"variableCollections"`
+		VariableModes       []figmaMode       `json:"variableModes"`
+		Variables           []figmaVariable   `json:"variables"`
+		VariableModeValues  []struct {
+			VariableID string         `json:"variableId"`
+			ModeID     string         `json:"modeId"`
+			Value      map[string]any `json:"value"`
+		} `json:"variableModeValues"`
+	}
+	if err := json.Unmarshal([]byte(body), &req); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+
+	wantCollections := []figmaCollection{{"CREATE", "collection", "Acme", "mode_catppuccin_mocha"}}
+	if !reflect.DeepEqual(req.VariableCollections, wantCollections) {
+		t.Errorf("collections = %+v, want %+v", req.VariableCollections, wantCollections)
+	}
+	wantModes := []figmaMode{
+		{"UPDATE", "mode_catppuccin_mocha", "Catppuccin Mocha", "collection"},
+		{"CREATE", "mode_paper", "Paper", "collection"},
+	}
+	if !reflect.DeepEqual(req.VariableModes, wantModes) {
+		t.Errorf("modes = %+v, want %+v", req.VariableModes, wantModes)
+	}
+
+	variables := map[string]figmaVariable{}
+	for _, v := range req.Variables {
+		variables[v.ID] = v
+	}
+	if len(variables) != len(colorRoles) {
+		t.Errorf("got %d variables, want %d", len(variables), len(colorRoles))
+	}
+	wantVar := figmaVariable{
+		Action:               "CREATE",
+		ID:                   "var_text_primary",
+		Name:                 "text/primary",
+		VariableCollectionID: "collection",
+		ResolvedType:         "COLOR",
+		CodeSyntax:           map[string]string{"WEB": "var(--theme-text-primary)"},
+	}
+	if got := variables["var_text_primary"]; !reflect.DeepEqual(got, wantVar) {
+		t.Errorf("text_primary variable = %+v, want %+v", got, wantVar)
+	}
+	if got := variables["var_bright_red"].Name; got != "ansi/bright-red" {
+		t.Errorf("bright_red variable name = %q", got)
+	}
+
+	values := map[string]map[string]any{}
+	for _, v := range req.VariableModeValues {
+		values[v.VariableID+"@"+v.ModeID] = v.Value
+	}
+	for key, want := range map[string]map[string]any{
+		"var_background@mode_paper":            {"r": 0.9922, "g": 0.9647, "b": 0.8902, "a": 1.0},
+		"var_success_background@mode_paper":    {"r": 0.1333, "g": 0.7725, "b": 0.3686, "a": 0.098},
+		"var_brand@mode_catppuccin_mocha":      {"type": "VARIABLE_ALIAS", "id": "var_accent"},
+		"var_brand@mode_paper":                 {"r": 0.8275, "g": 0.2118, "b": 0.5098, "a": 1.0},
+		"var_code_keyword@mode_paper":          nil,
+		"var_text_inverted@mode_paper":         {"type": "VARIABLE_ALIAS", "id": "var_background"},
+		"var_error_text@mode_catppuccin_mocha": {"type": "VARIABLE_ALIAS", "id": "var_red"},
+	} {
+		if got := values[key]; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+
+	// Every alias references a variable with a value in the same mode.
+	for key, v := range values {
+		if v["type"] == "VARIABLE_ALIAS" {
+			mode := key[strings.Index(key, "@"):]
+			if id, _ := v["id"].(string); values[id+mode] == nil { //nolint:errcheck // a missing id fails the lookup
+				t.Errorf("%s aliases %v, which has no value in that mode", key, v["id"])
+			}
+		}
+	}
+}
+
+func TestGenerateFigmaVariablesErrors(t *testing.T) {
+	t.Parallel()
+
+	dark, _ := figmaTestThemes(t)
+	if _, err := GenerateFigmaVariables(nil, FigmaOptions{}); !errors.Is(err, ErrNoThemes) {
+		t.Errorf("GenerateFigmaVariables(nil) error = %v, want ErrNoThemes", err)
+	}
+	if _, err := GenerateFigmaVariables([]Theme{dark, dark}, FigmaOptions{}); err == nil {
+		t.Error("GenerateFigmaVariables() with duplicate IDs should fail")
+	}
+}
+
+func TestWriteTokensStudioSets(t *testing.T) {
+	t.Parallel()
+
+	dark, light := figmaTestThemes(t)
+	dir := filepath.Join(t.TempDir(), "tokens")
+	if err := WriteTokensStudioSets(dir, FigmaOptions{}, dark, light); err != nil {
+		t.Fatalf("WriteTokensStudioSets() error: %v", err)
+	}
+
+	read := func(name string) []byte {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("reading %s: %v", name, err)
+		}
+		return data
+	}
+
+	var themes []tokensStudioTheme
+	if err := json.Unmarshal(read("$themes.json"), &themes); err != nil {
+		t.Fatalf("$themes.json is not valid JSON: %v", err)
+	}
+	wantThemes := []tokensStudioTheme{
+		{"catppuccin_mocha", "Catppuccin Mocha", "Themes", map[string]string{"catppuccin_mocha": "enabled"}},
+		{"paper", "Paper", "Themes", map[string]string{"paper": "enabled"}},
+	}
+	if !reflect.DeepEqual(themes, wantThemes) {
+		t.Errorf("$themes.json = %+v, want %+v", themes, wantThemes)
+	}
+
+	var metadata struct {
+		TokenSetOrder []string `json:"tokenSetOrder"`
+	}
+	if err := json.Unmarshal(read("$metadata.json"), &metadata); err != nil {
+		t.Fatalf("$metadata.json is not valid JSON: %v", err)
+	}
+	if !reflect.DeepEqual(metadata.TokenSetOrder, []string{"catppuccin_mocha", "paper"}) {
+		t.Errorf("tokenSetOrder = %v", metadata.TokenSetOrder)
+	}
+
+	set := string(read("catppuccin_mocha.json"))
+	if !strings.Contains(set, `"$value": "{color.accent.primary}"`) {
+		t.Errorf("set lacks the brand alias:\n%s", set)
+	}
+
+	// Each set reads back with its aliases resolved.
+	for _, want := range []Theme{dark, light} {
+		f, err := os.Open(filepath.Join(dir, want.ID()+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ParseDesignTokensWithOptions(f, TokenImportOptions{ID: want.ID()})
+		f.Close() //nolint:errcheck,gosec // read-only file
+		if err != nil {
+			t.Fatalf("ParseDesignTokens(%s) error: %v", want.ID(), err)
+		}
+		assertSameColors(t, want, got)
+	}
+}
+
+func TestExportFigmaVariables(t *testing.T) {
+	t.Parallel()
+
+	dark, light := figmaTestThemes(t)
+	var sb strings.Builder
+	if err := Export(&sb, FormatFigmaVariables, dark, light); err != nil {
+		t.Fatalf("Export(figma-variables) error: %v", err)
+	}
+	want, err := GenerateFigmaVariables([]Theme{dark, light}, DefaultFigmaOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sb.String() != want+"\n" {
+		t.Error("Export(figma-variables) output differs from GenerateFigmaVariables()")
+	}
+	if got, err := OutputFormatFromPath("themes.figma.json"); err != nil || got != FormatFigmaVariables {
+		t.Errorf("OutputFormatFromPath(.figma.json) = %s, %v", got, err)
+	}
+}
@@ -0,0 +1,447 @@
+package gothememe
+
+import (
+	"errors"
+	"image"
+	"math"
+	"sort"
+
+	"github.com/tj-smith47/gothememe/internal/colorutil"
+	"github.com/tj-smith47/gothememe/pkg/contrast"
+)
+
+// ImageVariant selects whether [ThemeFromImage] builds a dark or a light
+// theme.
+type ImageVariant int
+
+const (
+	// ImageVariantAuto picks dark or light from the image's average
+	// lightness.
+	ImageVariantAuto ImageVariant = iota
+
+	// ImageVariantDark always builds a dark theme.
+	ImageVariantDark
+
+	// ImageVariantLight always builds a light theme.
+	ImageVariantLight
+)
+
+// ImageThemeOptions configures [ThemeFromImage].
+type ImageThemeOptions struct {
+	// ID and Name identify the theme. They default to "image" and "Image".
+	ID   string
+	Name string
+
+	// Colors is the number of swatches the image is quantized to.
+	// Defaults to 16.
+	Colors int
+
+	// Variant selects a dark or light theme. Defaults to ImageVariantAuto.
+	Variant ImageVariant
+
+	// MaxSamples limits how many pixels are read from large images; the
+	// image is sampled on an even grid. Defaults to 65536.
+	MaxSamples int
+}
+
+// Defaults for ImageThemeOptions.
+const (
+	defaultImageColors     = 16
+	defaultImageMaxSamples = 1 << 16
+)
+
+// ErrNoImageColors is returned by [ThemeFromImage] when the image has no
+// opaque pixels to take colors from.
+var ErrNoImageColors = errors.New("image has no opaque pixels")
+
+// Thresholds used when picking colors from the swatches. Lightness and
+// chroma are in OKLab units.
+const (
+	imageTextContrast  = 4.5  // WCAG AA for normal text
+	imageMutedContrast = 3.0  // WCAG AA for large text and UI components
+	imageNeutralChroma = 0.06 // swatches at or below this read as gray
+	imageChromaticMin  = 0.04 // swatches above this carry a usable hue
+	imageMaxBgChroma   = 0.04 // keep backgrounds close to neutral
+)
+
+// ansiHues are the OKLCh hue angles of the sRGB primaries and secondaries,
+// keyed by their index in the ANSI palette.
+var ansiHues = []struct {
+	index int
+	hue   float64
+}{
+	{1, 29.2},  // red
+	{3, 109.8}, // yellow
+	{2, 142.5}, // green
+	{6, 194.8}, // cyan
+	{4, 264.1}, // blue
+	{5, 328.4}, // magenta
+}
+
+// ThemeFromImage builds a theme whose colors are taken from img, such as a
+// logo or wallpaper. The image is quantized in OKLab with median cut refined
+// by k-means. The background is the most common swatch on the dark or light
+// side, and the foreground and accent are the most common neutral and the
+// most vivid swatch that reach WCAG AA contrast against it; when none does,
+// the nearest candidate is adjusted in lightness until it passes. The
+// remaining swatches fill the ANSI colors by nearest hue angle, and hues the
+// image lacks are synthesized at the image's typical chroma. The result is
+// built with [GenerateThemeFromPalette].
+func ThemeFromImage(img image.Image, opts ImageThemeOptions) (Theme, error) {
+	if img == nil {
+		return nil, errors.New("image: nil image")
+	}
+	if opts.ID == "" {
+		opts.ID = "image"
+	}
+	if opts.Name == "" {
+		opts.Name = "Image"
+	}
+	if opts.Colors <= 0 {
+		opts.Colors = defaultImageColors
+	}
+	if opts.MaxSamples <= 0 {
+		opts.MaxSamples = defaultImageMaxSamples
+	}
+
+	pixels := sampleImage(img, opts.MaxSamples)
+	if len(pixels) == 0 {
+		return nil, ErrNoImageColors
+	}
+	swatches := quantizeOKLab(pixels, opts.Colors)
+
+	dark := opts.Variant == ImageVariantDark
+	if opts.Variant == ImageVariantAuto {
+		var lightness float64
+		for _, s := range swatches {
+			lightness += s.l * s.weight
+		}
+		dark = lightness < 0.6
+	}
+
+	return GenerateThemeFromPalette(opts.ID, opts.Name, imagePalette(swatches, dark)), nil
+}
+
+// swatch is a quantized image color and the share of sampled pixels it
+// covers.
+type swatch struct {
+	oklab
+	weight float64
+}
+
+// sampleImage reads up to maxSamples pixels of img on an even grid and
+// converts them to OKLab. Pixels that are more than half transparent are
+// skipped, so logos on transparent backgrounds contribute only their ink.
+func sampleImage(img image.Image, maxSamples int) []oklab {
+	bounds := img.Bounds()
+	total := bounds.Dx() * bounds.Dy()
+	if total <= 0 {
+		return nil
+	}
+	step := max(1, int(math.Ceil(math.Sqrt(float64(total)/float64(maxSamples)))))
+
+	var pixels []oklab
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += step {
+		for x := bounds.Min.X; x < bounds.Max.X; x += step {
+			r, g, b, a := img.At(x, y).RGBA()
+			if a < 0x8000 {
+				continue
+			}
+			// RGBA returns alpha-premultiplied 16-bit components.
+			alpha := float64(a)
+			l, la, lb := colorutil.SRGBToOKLab(float64(r)/alpha, float64(g)/alpha, float64(b)/alpha)
+			pixels = append(pixels, oklab{l, la, lb})
+		}
+	}
+	return pixels
+}
+
+// quantizeOKLab reduces pixels to at most k swatches. Median cut gives a
+// deterministic starting point, which a few k-means iterations then refine.
+// Swatches are returned most common first.
+func quantizeOKLab(pixels []oklab, k int) []swatch {
+	centroids := medianCut(pixels, k)
+
+	counts := make([]int, len(centroids))
+	for range 8 {
+		sums := make([]oklab, len(centroids))
+		counts = make([]int, len(centroids))
+		for _, p := range pixels {
+			nearest := 0
+			for i, c := range centroids {
+				if p.distance(c) < p.distance(centroids[nearest]) {
+					nearest = i
+				}
+			}
+			sums[nearest].l += p.l
+			sums[nearest].a += p.a
+			sums[nearest].b += p.b
+			counts[nearest]++
+		}
+
+		moved := false
+		for i, n := range counts {
+			if n == 0 {
+				continue
+			}
+			next := oklab{sums[i].l / float64(n), sums[i].a / float64(n), sums[i].b / float64(n)}
+			if next.distance(centroids[i]) > 1e-10 {
+				moved = true
+			}
+			centroids[i] = next
+		}
+		if !moved {
+			break
+		}
+	}
+
+	swatches := make([]swatch, 0, len(centroids))
+	for i, c := range centroids {
+		if counts[i] > 0 {
+			swatches = append(swatches, swatch{c, float64(counts[i]) / float64(len(pixels))})
+		}
+	}
+	sort.SliceStable(swatches, func(i, j int) bool {
+		return swatches[i].weight > swatches[j].weight
+	})
+	return swatches
+}
+
+// medianCut splits pixels into at most k boxes and returns their means. The
+// box whose widest axis spans the most, weighted by its size, is split at
+// the median of that axis until k boxes exist or none can be split.
+func medianCut(pixels []oklab, k int) []oklab {
+	axis := func(p oklab, i int) float64 {
+		switch i {
+		case 0:
+			return p.l
+		case 1:
+			return p.a
+		default:
+			return p.b
+		}
+	}
+	widest := func(box []oklab) (int, float64) {
+		best, bestRange := 0, 0.0
+		for i := range 3 {
+			lo, hi := math.Inf(1), math.Inf(-1)
+			for _, p := range box {
+				lo, hi = math.Min(lo, axis(p, i)), math.Max(hi, axis(p, i))
+			}
+			if hi-lo > bestRange {
+				best, bestRange = i, hi-lo
+			}
+		}
+		return best, bestRange
+	}
+
+	boxes := [][]oklab{append([]oklab(nil), pixels...)}
+	for len(boxes) < k {
+		split, splitAxis, score := -1, 0, 0.0
+		for i, box := range boxes {
+			if len(box) < 2 {
+				continue
+			}
+			a, r := widest(box)
+			if s := r * math.Sqrt(float64(len(box))); s > score {
+				split, splitAxis, score = i, a, s
+			}
+		}
+		if split < 0 {
+			break
+		}
+
+		box := boxes[split]
+		sort.Slice(box, func(i, j int) bool {
+			return axis(box[i], splitAxis) < axis(box[j], splitAxis)
+		})
+		mid := len(box) / 2
+		boxes[split] = box[:mid]
+		boxes = append(boxes, box[mid:])
+	}
+
+	means := make([]oklab, len(boxes))
+	for i, box := range boxes {
+		for _, p := range box {
+			means[i].l += p.l
+			means[i].a += p.a
+			means[i].b += p.b
+		}
+		n := float64(len(box))
+		means[i] = oklab{means[i].l / n, means[i].a / n, means[i].b / n}
+	}
+	return means
+}
+
+// imagePalette picks the palette colors from the swatches.
+func imagePalette(swatches []swatch, dark bool) Palette {
+	bg, bgIndex := imageBackground(swatches, dark)
+	bgColor := bg.color()
+
+	// ensure moves c away from the background in lightness until it reaches
+	// the required contrast ratio.
+	ensure := func(c oklab, ratio float64) Color {
+		step := 0.02
+		if !dark {
+			step = -step
+		}
+		col := c.color()
+		for range 60 {
+			if contrast.RatioHex(col.Hex(), bgColor.Hex()) >= ratio {
+				break
+			}
+			c = c.withLightness(c.l + step)
+			col = c.color()
+		}
+		return col
+	}
+
+	// tint is a near-neutral color with the background's hue.
+	tint := func(l float64) oklab {
+		return bg.withChroma(math.Min(bg.chroma(), 0.02)).withLightness(l)
+	}
+
+	var fg oklab
+	fgIndex := -1
+	for i, s := range swatches {
+		if i != bgIndex && s.chroma() <= imageNeutralChroma &&
+			contrast.RatioHex(s.color().Hex(), bgColor.Hex()) >= imageTextContrast {
+			fg, fgIndex = s.oklab, i
+			break
+		}
+	}
+	if fgIndex < 0 {
+		fg = tint(0.3)
+		if dark {
+			fg = tint(0.92)
+		}
+	}
+	fgColor := ensure(fg, imageTextContrast)
+
+	var chromatic []swatch
+	for i, s := range swatches {
+		if s.chroma() > imageChromaticMin && i != bgIndex && i != fgIndex {
+			chromatic = append(chromatic, s)
+		}
+	}
+
+	// Synthesized hues use the image's typical chroma, or a moderate one
+	// for grayscale images.
+	typicalChroma := 0.12
+	if len(chromatic) > 0 {
+		chromas := make([]float64, len(chromatic))
+		for i, s := range chromatic {
+			chromas[i] = s.chroma()
+		}
+		sort.Float64s(chromas)
+		typicalChroma = math.Max(0.08, chromas[len(chromas)/2])
+	}
+	synthLightness := 0.55
+	if dark {
+		synthLightness = 0.72
+	}
+	synthesize := func(hue float64) oklab {
+		rad := hue * math.Pi / 180
+		return oklab{synthLightness, typicalChroma * math.Cos(rad), typicalChroma * math.Sin(rad)}
+	}
+
+	accent := synthesize(264.1)
+	bestScore := 0.0
+	for _, s := range chromatic {
+		if score := s.chroma() * math.Sqrt(s.weight); score > bestScore {
+			accent, bestScore = s.oklab, score
+		}
+	}
+
+	// Each chromatic swatch goes to the ANSI hue nearest its own; each hue
+	// keeps its most vivid, most common swatch.
+	var hues [8]oklab
+	var scores [8]float64
+	for _, s := range chromatic {
+		nearest, nearestDist := 0, math.Inf(1)
+		for _, h := range ansiHues {
+			d := math.Abs(s.hue() - h.hue)
+			d = math.Min(d, 360-d)
+			if d < nearestDist {
+				nearest, nearestDist = h.index, d
+			}
+		}
+		if score := s.chroma() * math.Sqrt(s.weight); score > scores[nearest] {
+			hues[nearest], scores[nearest] = s.oklab, score
+		}
+	}
+	for _, h := range ansiHues {
+		if scores[h.index] == 0 {
+			hues[h.index] = synthesize(h.hue)
+		}
+	}
+
+	// Bright variants sit further from the background with a little more
+	// chroma.
+	brighten := func(c oklab) oklab {
+		shift := -0.08
+		if dark {
+			shift = 0.08
+		}
+		return c.withChroma(c.chroma() * 1.1).withLightness(c.l + shift)
+	}
+
+	p := Palette{
+		Background:  bgColor,
+		Foreground:  fgColor,
+		Accent:      ensure(accent, imageTextContrast),
+		BrightBlack: ensure(tint(0.5), imageMutedContrast),
+	}
+	normal := []*Color{nil, &p.Red, &p.Green, &p.Yellow, &p.Blue, &p.Purple, &p.Cyan}
+	bright := []*Color{nil, &p.BrightRed, &p.BrightGreen, &p.BrightYellow, &p.BrightBlue, &p.BrightPurple, &p.BrightCyan}
+	for _, h := range ansiHues {
+		*normal[h.index] = ensure(hues[h.index], imageTextContrast)
+		*bright[h.index] = ensure(brighten(hues[h.index]), imageTextContrast)
+	}
+
+	fgLab := newOKLab(fgColor)
+	if dark {
+		p.Black = tint(bg.l + 0.1).color()
+		p.White = ensure(fgLab.withLightness(fgLab.l-0.1), imageTextContrast)
+		p.BrightWhite = fgLab.withLightness(fgLab.l + 0.05).color()
+	} else {
+		p.Black = fgColor
+		p.White = tint(bg.l - 0.1).color()
+		p.BrightWhite = bgColor
+	}
+	return p
+}
+
+// imageBackground picks the most common swatch on the requested side of the
+// lightness range, falling back to the darkest or lightest swatch, and
+// pushes it far enough toward black or white, and toward neutral, to work
+// as a background. It also returns the index of the chosen swatch, or -1
+// when the fallback only lent its hue and stays available for other roles.
+func imageBackground(swatches []swatch, dark bool) (oklab, int) {
+	for i, s := range swatches {
+		if (dark && s.l < 0.4) || (!dark && s.l > 0.85) {
+			return backgroundFrom(s.oklab, dark), i
+		}
+	}
+
+	bg := swatches[0].oklab
+	for _, s := range swatches[1:] {
+		if (dark && s.l < bg.l) || (!dark && s.l > bg.l) {
+			bg = s.oklab
+		}
+	}
+	return backgroundFrom(bg, dark), -1
+}
+
+// backgroundFrom pushes c toward black or white and toward neutral.
+func backgroundFrom(bg oklab, dark bool) oklab {
+	if dark {
+		bg = bg.withLightness(math.Min(bg.l, 0.25))
+	} else {
+		bg = bg.withLightness(math.Max(bg.l, 0.96))
+	}
+	if bg.chroma() > imageMaxBgChroma {
+		bg = bg.withChroma(imageMaxBgChroma)
+	}
+	return bg
+}
@@ -0,0 +1,228 @@
+package gothememe
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/tj-smith47/gothememe/pkg/contrast"
+)
+
+// testImage returns a w×h image filled with the given colors in horizontal
+// bands, each taking the given share of the rows.
+func testImage(w, h int, bands []color.Color, shares []float64) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	y := 0
+	for i, c := range bands {
+		rows := int(math.Round(shares[i] * float64(h)))
+		if i == len(bands)-1 {
+			rows = h - y
+		}
+		for ; rows > 0 && y < h; rows-- {
+			for x := range w {
+				img.Set(x, y, c)
+			}
+			y++
+		}
+	}
+	return img
+}
+
+// wallpaper is a dark navy image with orange, teal and pink highlights.
+func wallpaper() *image.RGBA {
+	return testImage(120, 100, []color.Color{
+		color.RGBA{0x14, 0x1a, 0x2e, 0xff}, // navy
+		color.RGBA{0xf2, 0x8c, 0x28, 0xff}, // orange
+		color.RGBA{0x2a, 0xb7, 0xa9, 0xff}, // teal
+		color.RGBA{0xe0, 0x4f, 0x8a, 0xff}, // pink
+		color.RGBA{0xe8, 0xe6, 0xe3, 0xff}, // off-white
+	}, []float64{0.6, 0.15, 0.1, 0.05, 0.1})
+}
+
+func TestThemeFromImage(t *testing.T) {
+	t.Parallel()
+
+	theme, err := ThemeFromImage(wallpaper(), ImageThemeOptions{ID: "wallpaper", Name: "Wallpaper"})
+	if err != nil {
+		t.Fatalf("ThemeFromImage() error: %v", err)
+	}
+
+	if theme.ID() != "wallpaper" || theme.DisplayName() != "Wallpaper" {
+		t.Errorf("ID, DisplayName = %q, %q, want wallpaper, Wallpaper", theme.ID(), theme.DisplayName())
+	}
+	if !theme.IsDark() {
+		t.Error("IsDark() = false, want true for a mostly navy image")
+	}
+
+	bg := theme.Background().Hex()
+	for name, c := range map[string]Color{
+		"foreground": theme.TextPrimary(),
+		"accent":     theme.Accent(),
+		"red":        theme.Red(),
+		"green":      theme.Green(),
+		"yellow":     theme.Yellow(),
+		"blue":       theme.Blue(),
+		"purple":     theme.Purple(),
+		"cyan":       theme.Cyan(),
+		"white":      theme.White(),
+	} {
+		if ratio := contrast.RatioHex(c.Hex(), bg); ratio < 4.5 {
+			t.Errorf("%s %s on %s: contrast %.2f, want at least 4.5", name, c.Hex(), bg, ratio)
+		}
+	}
+
+	// The background keeps the navy hue.
+	if h := newOKLab(theme.Background()).hue(); h < 230 || h > 290 {
+		t.Errorf("background hue = %.0f, want navy (230-290)", h)
+	}
+	// The vivid orange is the accent, and the image hues land on the
+	// nearest ANSI colors.
+	hueChecks := []struct {
+		name   string
+		c      Color
+		lo, hi float64
+	}{
+		{"accent (orange)", theme.Accent(), 40, 80},
+		{"cyan (teal)", theme.Cyan(), 170, 210},
+		{"purple (pink)", theme.Purple(), 330, 360},
+	}
+	for _, hc := range hueChecks {
+		if h := newOKLab(hc.c).hue(); h < hc.lo || h > hc.hi {
+			t.Errorf("%s hue = %.0f, want %.0f-%.0f", hc.name, h, hc.lo, hc.hi)
+		}
+	}
+
+	// Every ANSI color is filled, including hues the image lacks.
+	for i, role := range ansiRoles {
+		if role.get(theme).IsEmpty() {
+			t.Errorf("ANSI color %d is empty", i)
+		}
+	}
+}
+
+func TestThemeFromImageDeterministic(t *testing.T) {
+	t.Parallel()
+
+	a, err := ThemeFromImage(wallpaper(), ImageThemeOptions{})
+	if err != nil {
+		t.Fatalf("ThemeFromImage() error: %v", err)
+	}
+	b, err := ThemeFromImage(wallpaper(), ImageThemeOptions{})
+	if err != nil {
+		t.Fatalf("ThemeFromImage() error: %v", err)
+	}
+	assertSameColors(t, a, b)
+	if a.ID() != "image" {
+		t.Errorf("ID() = %q, want default image", a.ID())
+	}
+}
+
+func TestThemeFromImageVariants(t *testing.T) {
+	t.Parallel()
+
+	logo := testImage(80, 80, []color.Color{
+		color.RGBA{0xfa, 0xfa, 0xf7, 0xff}, // paper
+		color.RGBA{0x1d, 0x4e, 0xd8, 0xff}, // brand blue
+	}, []float64{0.8, 0.2})
+
+	light, err := ThemeFromImage(logo, ImageThemeOptions{})
+	if err != nil {
+		t.Fatalf("ThemeFromImage() error: %v", err)
+	}
+	if light.IsDark() {
+		t.Error("auto variant of a light image is dark, want light")
+	}
+	if ratio := contrast.RatioHex(light.TextPrimary().Hex(), light.Background().Hex()); ratio < 4.5 {
+		t.Errorf("light foreground contrast = %.2f, want at least 4.5", ratio)
+	}
+
+	dark, err := ThemeFromImage(logo, ImageThemeOptions{Variant: ImageVariantDark})
+	if err != nil {
+		t.Fatalf("ThemeFromImage() error: %v", err)
+	}
+	if !dark.IsDark() {
+		t.Error("ImageVariantDark built a light theme")
+	}
+	if h := newOKLab(dark.Accent()).hue(); h < 240 || h > 290 {
+		t.Errorf("accent hue = %.0f, want the brand blue (240-290)", h)
+	}
+
+	forcedLight, err := ThemeFromImage(wallpaper(), ImageThemeOptions{Variant: ImageVariantLight})
+	if err != nil {
+		t.Fatalf("ThemeFromImage() error: %v", err)
+	}
+	if forcedLight.IsDark() {
+		t.Error("ImageVariantLight built a dark theme")
+	}
+}
+
+func TestThemeFromImageGrayscale(t *testing.T) {
+	t.Parallel()
+
+	gray := testImage(40, 40, []color.Color{
+		color.Gray{Y: 0x20},
+		color.Gray{Y: 0xd0},
+	}, []float64{0.7, 0.3})
+
+	theme, err := ThemeFromImage(gray, ImageThemeOptions{})
+	if err != nil {
+		t.Fatalf("ThemeFromImage() error: %v", err)
+	}
+	// With no hues to take, the ANSI colors are synthesized and still
+	// distinct and readable.
+	if theme.Red() == theme.Blue() {
+		t.Errorf("red and blue are both %s, want distinct synthesized hues", theme.Red().Hex())
+	}
+	if ratio := contrast.RatioHex(theme.Green().Hex(), theme.Background().Hex()); ratio < 4.5 {
+		t.Errorf("green contrast = %.2f, want at least 4.5", ratio)
+	}
+}
+
+func TestThemeFromImageTransparency(t *testing.T) {
+	t.Parallel()
+
+	// A red mark on a transparent canvas: only the mark is sampled.
+	img := image.NewNRGBA(image.Rect(0, 0, 20, 20))
+	for y := 5; y < 10; y++ {
+		for x := 5; x < 10; x++ {
+			img.Set(x, y, color.NRGBA{0xd0, 0x20, 0x20, 0xff})
+		}
+	}
+	theme, err := ThemeFromImage(img, ImageThemeOptions{Variant: ImageVariantDark})
+	if err != nil {
+		t.Fatalf("ThemeFromImage() error: %v", err)
+	}
+	if h := newOKLab(theme.Accent()).hue(); h > 45 && h < 345 {
+		t.Errorf("accent hue = %.0f, want red", h)
+	}
+
+	_, err = ThemeFromImage(image.NewNRGBA(image.Rect(0, 0, 4, 4)), ImageThemeOptions{})
+	if !errors.Is(err, ErrNoImageColors) {
+		t.Errorf("fully transparent image error = %v, want ErrNoImageColors", err)
+	}
+	if _, err := ThemeFromImage(nil, ImageThemeOptions{}); err == nil {
+		t.Error("ThemeFromImage(nil) error = nil, want error")
+	}
+}
+
+func TestQuantizeOKLab(t *testing.T) {
+	t.Parallel()
+
+	var pixels []oklab
+	for range 300 {
+		pixels = append(pixels, oklab{0.2, 0, 0})
+	}
+	for range 100 {
+		pixels = append(pixels, oklab{0.7, 0.15, 0.05})
+	}
+
+	swatches := quantizeOKLab(pixels, 8)
+	if len(swatches) != 2 {
+		t.Fatalf("quantizeOKLab() returned %d swatches, want 2 for two distinct colors", len(swatches))
+	}
+	if math.Abs(swatches[0].l-0.2) > 1e-9 || math.Abs(swatches[0].weight-0.75) > 1e-9 {
+		t.Errorf("first swatch = %+v, want the dark color with weight 0.75", swatches[0])
+	}
+}
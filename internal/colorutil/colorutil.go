@@ -116,3 +116,62 @@ func ParseHexByte(hex string) uint8 {
 	}
 	return uint8(v)
 }
+
+// delinearize converts a linear RGB channel value back to sRGB encoding.
+func delinearize(v float64) float64 {
+	if v <= 0.0031308 {
+		return v * 12.92
+	}
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
+
+// DisplayP3ToSRGB converts Display P3 components (0-1) to sRGB components
+// (0-1). Both spaces share the sRGB transfer function and D65 white point,
+// so only the primaries differ. Colors outside the sRGB gamut are clipped.
+func DisplayP3ToSRGB(r, g, b float64) (sr, sg, sb float64) {
+	lr, lg, lb := linearize(r), linearize(g), linearize(b)
+
+	sr = 1.2249401*lr - 0.2249404*lg
+	sg = -0.0420569*lr + 1.0420571*lg
+	sb = -0.0196376*lr - 0.0786361*lg + 1.0982735*lb
+
+	clip := func(v float64) float64 { return delinearize(math.Max(0, math.Min(1, v))) }
+	return clip(sr), clip(sg), clip(sb)
+}
+
+// SRGBToOKLab converts sRGB components (0-1) to OKLab lightness and
+// opponent axes.
+func SRGBToOKLab(r, g, b float64) (l, a, bb float64) {
+	lr, lg, lb := linearize(r), linearize(g), linearize(b)
+
+	lms0 := math.Cbrt(0.4122214708*lr + 0.5363325363*lg + 0.0514459929*lb)
+	lms1 := math.Cbrt(0.2119034982*lr + 0.6806995451*lg + 0.1073969566*lb)
+	lms2 := math.Cbrt(0.0883024619*lr + 0.2817188376*lg + 0.6299787005*lb)
+
+	l = 0.2104542553*lms0 + 0.7936177850*lms1 - 0.0040720468*lms2
+	a = 1.9779984951*lms0 - 2.4285922050*lms1 + 0.4505937099*lms2
+	bb = 0.0259040371*lms0 + 0.7827717662*lms1 - 0.8086757660*lms2
+	return l, a, bb
+}
+
+// OKLabToSRGB converts OKLab values to sRGB components. The result is not
+// clipped, so components outside 0-1 indicate a color outside the sRGB
+// gamut.
+func OKLabToSRGB(l, a, bb float64) (r, g, b float64) {
+	lms0 := l + 0.3963377774*a + 0.2158037573*bb
+	lms1 := l - 0.1055613458*a - 0.0638541728*bb
+	lms2 := l - 0.0894841775*a - 1.2914855480*bb
+	lms0, lms1, lms2 = lms0*lms0*lms0, lms1*lms1*lms1, lms2*lms2*lms2
+
+	r = 4.0767416621*lms0 - 3.3077115913*lms1 + 0.2309699292*lms2
+	g = -1.2684380046*lms0 + 2.6097574011*lms1 - 0.3413193965*lms2
+	b = -0.0041960863*lms0 - 0.7034186147*lms1 + 1.7076147010*lms2
+
+	encode := func(v float64) float64 {
+		if v < 0 {
+			return -delinearize(-v)
+		}
+		return delinearize(v)
+	}
+	return encode(r), encode(g), encode(b)
+}
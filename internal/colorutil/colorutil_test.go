@@ -234,3 +234,67 @@ func BenchmarkContrastRatioHex(b *testing.B) {
 		ContrastRatioHex("#282a36", "#f8f8f2")
 	}
 }
+
+func TestDisplayP3ToSRGB(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		r, g, b    float64
+		wr, wg, wb float64
+	}{
+		{"black", 0, 0, 0, 0, 0, 0},
+		{"white", 1, 1, 1, 1, 1, 1},
+		{"gray is unchanged", 0.5, 0.5, 0.5, 0.5, 0.5, 0.5},
+		{"p3 red clips to srgb red", 1, 0, 0, 1, 0, 0},
+		{"in-gamut color", 0.4, 0.6, 0.8, 0.3338, 0.6066, 0.8184},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r, g, b := DisplayP3ToSRGB(tt.r, tt.g, tt.b)
+			if math.Abs(r-tt.wr) > 0.002 || math.Abs(g-tt.wg) > 0.002 || math.Abs(b-tt.wb) > 0.002 {
+				t.Errorf("DisplayP3ToSRGB(%v, %v, %v) = (%.4f, %.4f, %.4f), want (%.4f, %.4f, %.4f)",
+					tt.r, tt.g, tt.b, r, g, b, tt.wr, tt.wg, tt.wb)
+			}
+		})
+	}
+}
+
+func TestSRGBToOKLab(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		r, g, b    float64
+		wl, wa, wb float64
+	}{
+		{"black", 0, 0, 0, 0, 0, 0},
+		{"white", 1, 1, 1, 1, 0, 0},
+		{"red", 1, 0, 0, 0.6280, 0.2249, 0.1258},
+		{"blue", 0, 0, 1, 0.4520, -0.0325, -0.3115},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l, a, b := SRGBToOKLab(tt.r, tt.g, tt.b)
+			if math.Abs(l-tt.wl) > 0.001 || math.Abs(a-tt.wa) > 0.001 || math.Abs(b-tt.wb) > 0.001 {
+				t.Errorf("SRGBToOKLab(%v, %v, %v) = (%.4f, %.4f, %.4f), want (%.4f, %.4f, %.4f)",
+					tt.r, tt.g, tt.b, l, a, b, tt.wl, tt.wa, tt.wb)
+			}
+
+			r, g, bb := OKLabToSRGB(l, a, b)
+			if math.Abs(r-tt.r) > 0.002 || math.Abs(g-tt.g) > 0.002 || math.Abs(bb-tt.b) > 0.002 {
+				t.Errorf("OKLabToSRGB round trip = (%.4f, %.4f, %.4f), want (%v, %v, %v)", r, g, bb, tt.r, tt.g, tt.b)
+			}
+		})
+	}
+
+	t.Run("out of gamut", func(t *testing.T) {
+		t.Parallel()
+		r, g, b := OKLabToSRGB(0.7, 0.4, 0)
+		if r <= 1 && g >= 0 && b >= 0 {
+			t.Errorf("OKLabToSRGB(0.7, 0.4, 0) = (%.4f, %.4f, %.4f), want a component outside 0-1", r, g, b)
+		}
+	})
+}
@@ -11,6 +11,8 @@ import (
 	"strings"
 	"text/template"
 	"unicode"
+
+	"github.com/tj-smith47/gothememe"
 )
 
 // Generator creates Go theme files from fetched theme data.
@@ -126,6 +128,12 @@ func (g *Generator) GenerateMarkdown(outputPath string) error {
 			seenVarNames[varName] = 1
 		}
 
+		colors, err := t.Theme()
+		if err != nil {
+			fmt.Printf("Warning: failed to convert %s: %v\n", t.Name, err)
+			continue
+		}
+
 		// Write SVG file and get path
 		svgPath := fmt.Sprintf("themes/%s.svg", id)
 		svgFilePath := filepath.Join(filepath.Dir(outputPath), svgPath)
@@ -138,7 +146,7 @@ func (g *Generator) GenerateMarkdown(outputPath string) error {
 			ID:          id,
 			VarName:     varName,
 			DisplayName: t.Name,
-			IsDark:      isDarkTheme(t.Background),
+			IsDark:      colors.IsDark(),
 			SVGPreview:  svgPath,
 		})
 	}
@@ -229,17 +237,20 @@ func (g *Generator) generateThemeFile(t *WindowsTerminalTheme, seenIDs, seenVarN
 	typeName := "theme" + varName
 	filename := id + ".go"
 
-	isDark := isDarkTheme(t.Background)
-
 	info := themeInfo{
 		ID:           id,
 		VarName:      varName,
 		InstanceName: instanceName,
 		DisplayName:  t.Name,
 		FileName:     filename,
-		IsDark:       isDark,
 	}
 
+	colors, err := t.Theme()
+	if err != nil {
+		return info, err
+	}
+	info.IsDark = colors.IsDark()
+
 	data := struct {
 		ID           string
 		VarName      string
@@ -247,15 +258,15 @@ func (g *Generator) generateThemeFile(t *WindowsTerminalTheme, seenIDs, seenVarN
 		TypeName     string
 		DisplayName  string
 		IsDark       bool
-		Theme        *WindowsTerminalTheme
+		Colors       gothememe.Theme
 	}{
 		ID:           id,
 		VarName:      varName,
 		InstanceName: instanceName,
 		TypeName:     typeName,
 		DisplayName:  t.Name,
-		IsDark:       isDark,
-		Theme:        t,
+		IsDark:       info.IsDark,
+		Colors:       colors,
 	}
 
 	var buf bytes.Buffer
@@ -372,57 +383,6 @@ func toVarName(name string) string {
 	return result.String()
 }
 
-// isDarkTheme determines if a theme is dark based on background luminance.
-func isDarkTheme(background string) bool {
-	bg := strings.TrimPrefix(background, "#")
-	if len(bg) != 6 {
-		return true // Default to dark
-	}
-
-	var r, g, b uint8
-	if _, err := fmt.Sscanf(bg, "%02x%02x%02x", &r, &g, &b); err != nil {
-		return true // Default to dark on parse error
-	}
-
-	// Calculate relative luminance
-	rs := float64(r) / 255.0
-	gs := float64(g) / 255.0
-	bs := float64(b) / 255.0
-
-	var rLinear, gLinear, bLinear float64
-	if rs <= 0.03928 {
-		rLinear = rs / 12.92
-	} else {
-		rLinear = pow((rs+0.055)/1.055, 2.4)
-	}
-	if gs <= 0.03928 {
-		gLinear = gs / 12.92
-	} else {
-		gLinear = pow((gs+0.055)/1.055, 2.4)
-	}
-	if bs <= 0.03928 {
-		bLinear = bs / 12.92
-	} else {
-		bLinear = pow((bs+0.055)/1.055, 2.4)
-	}
-
-	luminance := 0.2126*rLinear + 0.7152*gLinear + 0.0722*bLinear
-	return luminance < 0.5
-}
-
-func pow(base, exp float64) float64 {
-	result := 1.0
-	for i := 0; i < int(exp*10); i++ {
-		result *= base
-	}
-	// This is a rough approximation, use math.Pow in real code
-	// But we want to avoid importing math just for this
-	if exp == 2.4 {
-		return base * base * pow(base, 0.4)
-	}
-	return result
-}
-
 // generateCustomThemeSVG creates a placeholder SVG for custom themes.
 func generateCustomThemeSVG(displayName string) string {
 	return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="400" height="80" viewBox="0 0 400 80">
@@ -454,91 +414,91 @@ func (t *{{.TypeName}}) Source() string      { return "https://github.com/mbadol
 func (t *{{.TypeName}}) IsDark() bool        { return {{.IsDark}} }
 
 // Background colors
-func (t *{{.TypeName}}) Background() gothememe.Color          { return gothememe.Hex("{{.Theme.Background}}") }
-func (t *{{.TypeName}}) BackgroundSecondary() gothememe.Color { return gothememe.Hex("{{.Theme.Black}}") }
-func (t *{{.TypeName}}) Surface() gothememe.Color             { return gothememe.Hex("{{.Theme.SelectionBackground}}") }
-func (t *{{.TypeName}}) SurfaceSecondary() gothememe.Color    { return gothememe.Hex("{{.Theme.BrightBlack}}") }
+func (t *{{.TypeName}}) Background() gothememe.Color          { return gothememe.Hex("{{.Colors.Background.Hex}}") }
+func (t *{{.TypeName}}) BackgroundSecondary() gothememe.Color { return gothememe.Hex("{{.Colors.BackgroundSecondary.Hex}}") }
+func (t *{{.TypeName}}) Surface() gothememe.Color             { return gothememe.Hex("{{.Colors.Surface.Hex}}") }
+func (t *{{.TypeName}}) SurfaceSecondary() gothememe.Color    { return gothememe.Hex("{{.Colors.SurfaceSecondary.Hex}}") }
 
 // Text colors
-func (t *{{.TypeName}}) TextPrimary() gothememe.Color   { return gothememe.Hex("{{.Theme.Foreground}}") }
-func (t *{{.TypeName}}) TextSecondary() gothememe.Color { return gothememe.Hex("{{.Theme.White}}") }
-func (t *{{.TypeName}}) TextMuted() gothememe.Color     { return gothememe.Hex("{{.Theme.BrightBlack}}") }
-func (t *{{.TypeName}}) TextInverted() gothememe.Color  { return gothememe.Hex("{{.Theme.Background}}") }
+func (t *{{.TypeName}}) TextPrimary() gothememe.Color   { return gothememe.Hex("{{.Colors.TextPrimary.Hex}}") }
+func (t *{{.TypeName}}) TextSecondary() gothememe.Color { return gothememe.Hex("{{.Colors.TextSecondary.Hex}}") }
+func (t *{{.TypeName}}) TextMuted() gothememe.Color     { return gothememe.Hex("{{.Colors.TextMuted.Hex}}") }
+func (t *{{.TypeName}}) TextInverted() gothememe.Color  { return gothememe.Hex("{{.Colors.TextInverted.Hex}}") }
 
 // Accent/Brand colors
-func (t *{{.TypeName}}) Accent() gothememe.Color          { return gothememe.Hex("{{.Theme.Blue}}") }
-func (t *{{.TypeName}}) AccentSecondary() gothememe.Color { return gothememe.Hex("{{.Theme.Purple}}") }
-func (t *{{.TypeName}}) Brand() gothememe.Color           { return gothememe.Hex("{{.Theme.Blue}}") }
+func (t *{{.TypeName}}) Accent() gothememe.Color          { return gothememe.Hex("{{.Colors.Accent.Hex}}") }
+func (t *{{.TypeName}}) AccentSecondary() gothememe.Color { return gothememe.Hex("{{.Colors.AccentSecondary.Hex}}") }
+func (t *{{.TypeName}}) Brand() gothememe.Color           { return gothememe.Hex("{{.Colors.Brand.Hex}}") }
 
 // Border colors
-func (t *{{.TypeName}}) Border() gothememe.Color       { return gothememe.Hex("{{.Theme.BrightBlack}}") }
-func (t *{{.TypeName}}) BorderSubtle() gothememe.Color { return gothememe.Hex("{{.Theme.Black}}") }
-func (t *{{.TypeName}}) BorderStrong() gothememe.Color { return gothememe.Hex("{{.Theme.White}}") }
+func (t *{{.TypeName}}) Border() gothememe.Color       { return gothememe.Hex("{{.Colors.Border.Hex}}") }
+func (t *{{.TypeName}}) BorderSubtle() gothememe.Color { return gothememe.Hex("{{.Colors.BorderSubtle.Hex}}") }
+func (t *{{.TypeName}}) BorderStrong() gothememe.Color { return gothememe.Hex("{{.Colors.BorderStrong.Hex}}") }
 
 // Semantic colors
 func (t *{{.TypeName}}) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("{{.Theme.Green}}").WithAlpha(0.1),
-		Border:     gothememe.Hex("{{.Theme.Green}}").WithAlpha(0.3),
-		Text:       gothememe.Hex("{{.Theme.Green}}"),
+		Background: gothememe.Hex("{{.Colors.Success.Background.Hex}}"),
+		Border:     gothememe.Hex("{{.Colors.Success.Border.Hex}}"),
+		Text:       gothememe.Hex("{{.Colors.Success.Text.Hex}}"),
 	}
 }
 
 func (t *{{.TypeName}}) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("{{.Theme.Yellow}}").WithAlpha(0.1),
-		Border:     gothememe.Hex("{{.Theme.Yellow}}").WithAlpha(0.3),
-		Text:       gothememe.Hex("{{.Theme.Yellow}}"),
+		Background: gothememe.Hex("{{.Colors.Warning.Background.Hex}}"),
+		Border:     gothememe.Hex("{{.Colors.Warning.Border.Hex}}"),
+		Text:       gothememe.Hex("{{.Colors.Warning.Text.Hex}}"),
 	}
 }
 
 func (t *{{.TypeName}}) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("{{.Theme.Red}}").WithAlpha(0.1),
-		Border:     gothememe.Hex("{{.Theme.Red}}").WithAlpha(0.3),
-		Text:       gothememe.Hex("{{.Theme.Red}}"),
+		Background: gothememe.Hex("{{.Colors.Error.Background.Hex}}"),
+		Border:     gothememe.Hex("{{.Colors.Error.Border.Hex}}"),
+		Text:       gothememe.Hex("{{.Colors.Error.Text.Hex}}"),
 	}
 }
 
 func (t *{{.TypeName}}) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("{{.Theme.Cyan}}").WithAlpha(0.1),
-		Border:     gothememe.Hex("{{.Theme.Cyan}}").WithAlpha(0.3),
-		Text:       gothememe.Hex("{{.Theme.Cyan}}"),
+		Background: gothememe.Hex("{{.Colors.Info.Background.Hex}}"),
+		Border:     gothememe.Hex("{{.Colors.Info.Border.Hex}}"),
+		Text:       gothememe.Hex("{{.Colors.Info.Text.Hex}}"),
 	}
 }
 
 // ANSI colors
-func (t *{{.TypeName}}) Black() gothememe.Color        { return gothememe.Hex("{{.Theme.Black}}") }
-func (t *{{.TypeName}}) Red() gothememe.Color          { return gothememe.Hex("{{.Theme.Red}}") }
-func (t *{{.TypeName}}) Green() gothememe.Color        { return gothememe.Hex("{{.Theme.Green}}") }
-func (t *{{.TypeName}}) Yellow() gothememe.Color       { return gothememe.Hex("{{.Theme.Yellow}}") }
-func (t *{{.TypeName}}) Blue() gothememe.Color         { return gothememe.Hex("{{.Theme.Blue}}") }
-func (t *{{.TypeName}}) Purple() gothememe.Color       { return gothememe.Hex("{{.Theme.Purple}}") }
-func (t *{{.TypeName}}) Cyan() gothememe.Color         { return gothememe.Hex("{{.Theme.Cyan}}") }
-func (t *{{.TypeName}}) White() gothememe.Color        { return gothememe.Hex("{{.Theme.White}}") }
-func (t *{{.TypeName}}) BrightBlack() gothememe.Color  { return gothememe.Hex("{{.Theme.BrightBlack}}") }
-func (t *{{.TypeName}}) BrightRed() gothememe.Color    { return gothememe.Hex("{{.Theme.BrightRed}}") }
-func (t *{{.TypeName}}) BrightGreen() gothememe.Color  { return gothememe.Hex("{{.Theme.BrightGreen}}") }
-func (t *{{.TypeName}}) BrightYellow() gothememe.Color { return gothememe.Hex("{{.Theme.BrightYellow}}") }
-func (t *{{.TypeName}}) BrightBlue() gothememe.Color   { return gothememe.Hex("{{.Theme.BrightBlue}}") }
-func (t *{{.TypeName}}) BrightPurple() gothememe.Color { return gothememe.Hex("{{.Theme.BrightPurple}}") }
-func (t *{{.TypeName}}) BrightCyan() gothememe.Color   { return gothememe.Hex("{{.Theme.BrightCyan}}") }
-func (t *{{.TypeName}}) BrightWhite() gothememe.Color  { return gothememe.Hex("{{.Theme.BrightWhite}}") }
+func (t *{{.TypeName}}) Black() gothememe.Color        { return gothememe.Hex("{{.Colors.Black.Hex}}") }
+func (t *{{.TypeName}}) Red() gothememe.Color          { return gothememe.Hex("{{.Colors.Red.Hex}}") }
+func (t *{{.TypeName}}) Green() gothememe.Color        { return gothememe.Hex("{{.Colors.Green.Hex}}") }
+func (t *{{.TypeName}}) Yellow() gothememe.Color       { return gothememe.Hex("{{.Colors.Yellow.Hex}}") }
+func (t *{{.TypeName}}) Blue() gothememe.Color         { return gothememe.Hex("{{.Colors.Blue.Hex}}") }
+func (t *{{.TypeName}}) Purple() gothememe.Color       { return gothememe.Hex("{{.Colors.Purple.Hex}}") }
+func (t *{{.TypeName}}) Cyan() gothememe.Color         { return gothememe.Hex("{{.Colors.Cyan.Hex}}") }
+func (t *{{.TypeName}}) White() gothememe.Color        { return gothememe.Hex("{{.Colors.White.Hex}}") }
+func (t *{{.TypeName}}) BrightBlack() gothememe.Color  { return gothememe.Hex("{{.Colors.BrightBlack.Hex}}") }
+func (t *{{.TypeName}}) BrightRed() gothememe.Color    { return gothememe.Hex("{{.Colors.BrightRed.Hex}}") }
+func (t *{{.TypeName}}) BrightGreen() gothememe.Color  { return gothememe.Hex("{{.Colors.BrightGreen.Hex}}") }
+func (t *{{.TypeName}}) BrightYellow() gothememe.Color { return gothememe.Hex("{{.Colors.BrightYellow.Hex}}") }
+func (t *{{.TypeName}}) BrightBlue() gothememe.Color   { return gothememe.Hex("{{.Colors.BrightBlue.Hex}}") }
+func (t *{{.TypeName}}) BrightPurple() gothememe.Color { return gothememe.Hex("{{.Colors.BrightPurple.Hex}}") }
+func (t *{{.TypeName}}) BrightCyan() gothememe.Color   { return gothememe.Hex("{{.Colors.BrightCyan.Hex}}") }
+func (t *{{.TypeName}}) BrightWhite() gothememe.Color  { return gothememe.Hex("{{.Colors.BrightWhite.Hex}}") }
 
 // Code/Syntax highlighting colors
-func (t *{{.TypeName}}) CodeBackground() gothememe.Color  { return gothememe.Hex("{{.Theme.Background}}") }
-func (t *{{.TypeName}}) CodeText() gothememe.Color        { return gothememe.Hex("{{.Theme.Foreground}}") }
-func (t *{{.TypeName}}) CodeComment() gothememe.Color     { return gothememe.Hex("{{.Theme.BrightBlack}}") }
-func (t *{{.TypeName}}) CodeKeyword() gothememe.Color     { return gothememe.Hex("{{.Theme.Purple}}") }
-func (t *{{.TypeName}}) CodeString() gothememe.Color      { return gothememe.Hex("{{.Theme.Green}}") }
-func (t *{{.TypeName}}) CodeNumber() gothememe.Color      { return gothememe.Hex("{{.Theme.Yellow}}") }
-func (t *{{.TypeName}}) CodeFunction() gothememe.Color    { return gothememe.Hex("{{.Theme.Blue}}") }
-func (t *{{.TypeName}}) CodeOperator() gothememe.Color    { return gothememe.Hex("{{.Theme.Red}}") }
-func (t *{{.TypeName}}) CodePunctuation() gothememe.Color { return gothememe.Hex("{{.Theme.Foreground}}") }
-func (t *{{.TypeName}}) CodeVariable() gothememe.Color    { return gothememe.Hex("{{.Theme.Cyan}}") }
-func (t *{{.TypeName}}) CodeConstant() gothememe.Color    { return gothememe.Hex("{{.Theme.BrightYellow}}") }
-func (t *{{.TypeName}}) CodeType() gothememe.Color        { return gothememe.Hex("{{.Theme.BrightCyan}}") }
+func (t *{{.TypeName}}) CodeBackground() gothememe.Color  { return gothememe.Hex("{{.Colors.CodeBackground.Hex}}") }
+func (t *{{.TypeName}}) CodeText() gothememe.Color        { return gothememe.Hex("{{.Colors.CodeText.Hex}}") }
+func (t *{{.TypeName}}) CodeComment() gothememe.Color     { return gothememe.Hex("{{.Colors.CodeComment.Hex}}") }
+func (t *{{.TypeName}}) CodeKeyword() gothememe.Color     { return gothememe.Hex("{{.Colors.CodeKeyword.Hex}}") }
+func (t *{{.TypeName}}) CodeString() gothememe.Color      { return gothememe.Hex("{{.Colors.CodeString.Hex}}") }
+func (t *{{.TypeName}}) CodeNumber() gothememe.Color      { return gothememe.Hex("{{.Colors.CodeNumber.Hex}}") }
+func (t *{{.TypeName}}) CodeFunction() gothememe.Color    { return gothememe.Hex("{{.Colors.CodeFunction.Hex}}") }
+func (t *{{.TypeName}}) CodeOperator() gothememe.Color    { return gothememe.Hex("{{.Colors.CodeOperator.Hex}}") }
+func (t *{{.TypeName}}) CodePunctuation() gothememe.Color { return gothememe.Hex("{{.Colors.CodePunctuation.Hex}}") }
+func (t *{{.TypeName}}) CodeVariable() gothememe.Color    { return gothememe.Hex("{{.Colors.CodeVariable.Hex}}") }
+func (t *{{.TypeName}}) CodeConstant() gothememe.Color    { return gothememe.Hex("{{.Colors.CodeConstant.Hex}}") }
+func (t *{{.TypeName}}) CodeType() gothememe.Color        { return gothememe.Hex("{{.Colors.CodeType.Hex}}") }
 `))
 
 var themesFileTemplate = template.Must(template.New("themes").Parse(`// Code generated by themegen. DO NOT EDIT.
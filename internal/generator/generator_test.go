@@ -93,37 +93,6 @@ func TestToVarName(t *testing.T) {
 	}
 }
 
-func TestIsDarkTheme(t *testing.T) {
-	t.Parallel()
-
-	tests := []struct {
-		background string
-		wantDark   bool
-	}{
-		{"#000000", true},
-		{"#282a36", true},  // Dracula
-		{"#1e1e1e", true},  // VS Code Dark
-		{"#2e3440", true},  // Nord
-		{"#ffffff", false}, // White
-		{"#f8f8f2", false}, // Light
-		{"#fdf6e3", false}, // Solarized Light
-		{"#808080", true},  // Gray - luminance ~0.2, still considered dark by threshold
-		{"invalid", true},  // Invalid defaults to dark
-		{"#ff", true},      // Too short defaults to dark
-		{"", true},         // Empty defaults to dark
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.background, func(t *testing.T) {
-			t.Parallel()
-			got := isDarkTheme(tt.background)
-			if got != tt.wantDark {
-				t.Errorf("isDarkTheme(%q) = %v, want %v", tt.background, got, tt.wantDark)
-			}
-		})
-	}
-}
-
 func TestNewGenerator(t *testing.T) {
 	t.Parallel()
 
@@ -750,6 +719,25 @@ func TestGenerateMarkdown_WithDuplicates(t *testing.T) {
 	}
 }
 
+func TestGenerateMarkdown_Mode(t *testing.T) {
+	t.Parallel()
+
+	outputDir := t.TempDir()
+	gen := NewGenerator(outputDir)
+
+	// A light background is listed as light whatever the text colors are.
+	gen.SetThemes([]*WindowsTerminalTheme{createTestTheme("Belafonte Day", "#d5ccba")})
+
+	outputPath := filepath.Join(outputDir, "DEFAULT_THEMES.md")
+	if err := gen.GenerateMarkdown(outputPath); err != nil {
+		t.Fatalf("GenerateMarkdown() error = %v", err)
+	}
+	content := string(testReadFile(t, outputPath))
+	if !strings.Contains(content, "| `belafonte_day` | Belafonte Day | ☀️ Light |") {
+		t.Error("markdown should list Belafonte Day as light")
+	}
+}
+
 func TestGenerateThemeFile_Success(t *testing.T) {
 	t.Parallel()
 
@@ -810,6 +798,46 @@ func TestGenerateThemeFile_Duplicates(t *testing.T) {
 	}
 }
 
+func TestGenerateThemeFile_SharedMapping(t *testing.T) {
+	t.Parallel()
+
+	outputDir := t.TempDir()
+	gen := NewGenerator(outputDir)
+
+	theme := createTestTheme("Dracula", "#282a36")
+	if _, err := gen.generateThemeFile(theme, map[string]int{}, map[string]int{}); err != nil {
+		t.Fatalf("generateThemeFile() error = %v", err)
+	}
+	content := string(testReadFile(t, filepath.Join(outputDir, "dracula.go")))
+
+	want, err := theme.Theme()
+	if err != nil {
+		t.Fatalf("Theme() error = %v", err)
+	}
+	for _, line := range []string{
+		`func (t *themeDracula) Surface() gothememe.Color             { return gothememe.Hex("` + want.Surface().Hex() + `") }`,
+		`func (t *themeDracula) AccentSecondary() gothememe.Color { return gothememe.Hex("` + want.AccentSecondary().Hex() + `") }`,
+		`Background: gothememe.Hex("` + want.Error().Background.Hex() + `"),`,
+		`func (t *themeDracula) CodeType() gothememe.Color        { return gothememe.Hex("` + want.CodeType().Hex() + `") }`,
+	} {
+		if !strings.Contains(content, line) {
+			t.Errorf("theme file missing %q", line)
+		}
+	}
+}
+
+func TestGenerateThemeFile_InvalidColor(t *testing.T) {
+	t.Parallel()
+
+	gen := NewGenerator(t.TempDir())
+	theme := createTestTheme("Broken", "#282a36")
+	theme.Red = "not-a-color"
+
+	if _, err := gen.generateThemeFile(theme, map[string]int{}, map[string]int{}); err == nil {
+		t.Error("generateThemeFile() error = nil, want error for an invalid color")
+	}
+}
+
 func TestGenerateThemesFile_ValidThemes(t *testing.T) {
 	t.Parallel()
 
@@ -1,6 +1,13 @@
 // Package generator provides theme generation from external sources.
 package generator
 
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tj-smith47/gothememe"
+)
+
 // WindowsTerminalTheme represents the JSON structure from iTerm2-Color-Schemes.
 type WindowsTerminalTheme struct {
 	Name                string `json:"name"`
@@ -26,6 +33,16 @@ type WindowsTerminalTheme struct {
 	BrightWhite         string `json:"brightWhite"`
 }
 
+// Theme converts the scheme with [gothememe.FromWindowsTerminal], so
+// generated themes use the same role mapping as runtime conversion.
+func (t *WindowsTerminalTheme) Theme() (gothememe.Theme, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return nil, fmt.Errorf("encoding scheme: %w", err)
+	}
+	return gothememe.FromWindowsTerminal(data)
+}
+
 // ThemeMetadata contains additional metadata for generated themes.
 type ThemeMetadata struct {
 	ID          string
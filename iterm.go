@@ -0,0 +1,180 @@
+package gothememe
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tj-smith47/gothememe/internal/colorutil"
+)
+
+// iTerm2 color keys for the non-ANSI colors.
+const (
+	itermBackground    = "Background Color"
+	itermForeground    = "Foreground Color"
+	itermCursor        = "Cursor Color"
+	itermCursorText    = "Cursor Text Color"
+	itermSelection     = "Selection Color"
+	itermSelectionText = "Selected Text Color"
+	itermBold          = "Bold Color"
+	itermLink          = "Link Color"
+)
+
+// ParseITermColors reads an iTerm2 .itermcolors property list and maps it
+// onto a Theme with the same role assignments themegen uses. Components in
+// the P3 color space are converted to sRGB; calibrated (Generic RGB) and
+// untagged components are used as-is. The file carries no name, so the
+// theme ID is "imported"; use [LoadITermColorsFile] to name it after the file.
+func ParseITermColors(r io.Reader) (Theme, error) {
+	s, err := decodeITermColors(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.theme("imported", "imported"), nil
+}
+
+// LoadITermColorsFile reads the .itermcolors file at path. The theme name is
+// the file name without its extension, and the ID its snake_case form, or
+// "imported" when the name has no ASCII letters or digits.
+func LoadITermColorsFile(path string) (Theme, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: loading a caller-specified theme file is the purpose of this function
+	if err != nil {
+		return nil, fmt.Errorf("reading iTerm2 colors: %w", err)
+	}
+
+	s, err := decodeITermColors(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return s.theme(importedThemeID(name, ""), name), nil
+}
+
+// WriteITermColors writes t to w as an iTerm2 .itermcolors property list
+// with sRGB components. The cursor and bold colors use the primary text
+// color, the selection uses the surface color and links use the accent.
+func WriteITermColors(w io.Writer, t Theme) error {
+	s := terminalSchemeFromTheme(t)
+
+	// ANSI colors come first, then the remaining keys in alphabetical order.
+	var dict plistDict
+	for i, c := range s.ansi {
+		dict = append(dict, plistEntry{fmt.Sprintf("Ansi %d Color", i), itermColorDict(c)})
+	}
+	for _, e := range []struct {
+		key string
+		c   Color
+	}{
+		{itermBackground, s.background},
+		{itermBold, s.bold},
+		{itermCursor, s.cursor},
+		{itermCursorText, s.cursorText},
+		{itermForeground, s.foreground},
+		{itermLink, s.link},
+		{itermSelectionText, s.selectionText},
+		{itermSelection, s.selection},
+	} {
+		if e.c.IsEmpty() {
+			continue
+		}
+		dict = append(dict, plistEntry{e.key, itermColorDict(e.c)})
+	}
+
+	return writePlist(w, dict)
+}
+
+// itermColorDict encodes a color as an iTerm2 color dictionary.
+func itermColorDict(c Color) plistDict {
+	r, g, b, a := c.RGBAComponents()
+	component := func(v uint8) float64 {
+		return math.Round(float64(v)/255*1e6) / 1e6
+	}
+	return plistDict{
+		{"Alpha Component", component(a)},
+		{"Blue Component", component(b)},
+		{"Color Space", "sRGB"},
+		{"Green Component", component(g)},
+		{"Red Component", component(r)},
+	}
+}
+
+// decodeITermColors parses the property list into a terminal scheme.
+func decodeITermColors(r io.Reader) (terminalScheme, error) {
+	v, err := decodePlist(r)
+	if err != nil {
+		return terminalScheme{}, fmt.Errorf("parsing iTerm2 colors: %w", err)
+	}
+	root, ok := v.(map[string]any)
+	if !ok {
+		return terminalScheme{}, fmt.Errorf("parsing iTerm2 colors: root is %T, want dictionary", v)
+	}
+
+	var s terminalScheme
+	targets := map[string]*Color{
+		itermBackground:    &s.background,
+		itermForeground:    &s.foreground,
+		itermCursor:        &s.cursor,
+		itermCursorText:    &s.cursorText,
+		itermSelection:     &s.selection,
+		itermSelectionText: &s.selectionText,
+		itermBold:          &s.bold,
+		itermLink:          &s.link,
+	}
+	for i := range s.ansi {
+		targets[fmt.Sprintf("Ansi %d Color", i)] = &s.ansi[i]
+	}
+
+	for key, dst := range targets {
+		raw, found := root[key]
+		if !found {
+			continue
+		}
+		c, err := itermColor(raw)
+		if err != nil {
+			return terminalScheme{}, fmt.Errorf("%s: %w", key, err)
+		}
+		*dst = c
+	}
+
+	if s.background.IsEmpty() && s.foreground.IsEmpty() {
+		return terminalScheme{}, fmt.Errorf("parsing iTerm2 colors: missing %q and %q", itermBackground, itermForeground)
+	}
+	return s, nil
+}
+
+// itermColor decodes an iTerm2 color dictionary.
+func itermColor(v any) (Color, error) {
+	dict, ok := v.(map[string]any)
+	if !ok {
+		return Color{}, fmt.Errorf("color is %T, want dictionary", v)
+	}
+
+	var rgb [3]float64
+	for i, key := range []string{"Red Component", "Green Component", "Blue Component"} {
+		f, ok := dict[key].(float64)
+		if !ok {
+			return Color{}, fmt.Errorf("missing %q", key)
+		}
+		rgb[i] = f
+	}
+
+	space, _ := dict["Color Space"].(string) //nolint:errcheck // untagged colors are treated as sRGB
+	switch strings.ToLower(space) {
+	case "p3", "displayp3":
+		rgb[0], rgb[1], rgb[2] = colorutil.DisplayP3ToSRGB(rgb[0], rgb[1], rgb[2])
+	case "", "srgb", "calibrated", "device":
+	default:
+		return Color{}, fmt.Errorf("unsupported color space %q", space)
+	}
+
+	c := RGB(clampByte(rgb[0]*255), clampByte(rgb[1]*255), clampByte(rgb[2]*255))
+	if alpha, ok := dict["Alpha Component"].(float64); ok && alpha < 1 {
+		c = c.WithAlpha(math.Max(0, alpha))
+	}
+	return c, nil
+}
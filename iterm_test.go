@@ -0,0 +1,196 @@
+package gothememe
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testITermColors is a trimmed .itermcolors file as exported by iTerm2.
+const testITermColors = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Ansi 1 Color</key>
+	<dict>
+		<key>Blue Component</key>
+		<real>0.3333333432674408</real>
+		<key>Color Space</key>
+		<string>sRGB</string>
+		<key>Green Component</key>
+		<real>0.3333333432674408</real>
+		<key>Red Component</key>
+		<real>1</real>
+	</dict>
+	<key>Ansi 4 Color</key>
+	<dict>
+		<key>Blue Component</key>
+		<real>0.9764705896377563</real>
+		<key>Color Space</key>
+		<string>Calibrated</string>
+		<key>Green Component</key>
+		<real>0.5764706134796143</real>
+		<key>Red Component</key>
+		<real>0.7411764860153198</real>
+	</dict>
+	<key>Ansi 2 Color</key>
+	<dict>
+		<key>Blue Component</key>
+		<real>0</real>
+		<key>Color Space</key>
+		<string>P3</string>
+		<key>Green Component</key>
+		<real>1</real>
+		<key>Red Component</key>
+		<real>0</real>
+	</dict>
+	<key>Background Color</key>
+	<dict>
+		<key>Alpha Component</key>
+		<real>1</real>
+		<key>Blue Component</key>
+		<real>0.21176470816135406</real>
+		<key>Color Space</key>
+		<string>sRGB</string>
+		<key>Green Component</key>
+		<real>0.16470588743686676</real>
+		<key>Red Component</key>
+		<real>0.15686275064945221</real>
+	</dict>
+	<key>Foreground Color</key>
+	<dict>
+		<key>Blue Component</key>
+		<real>0.94901961088180542</real>
+		<key>Green Component</key>
+		<real>0.97254902124404907</real>
+		<key>Red Component</key>
+		<real>0.97254902124404907</real>
+	</dict>
+	<key>Selection Color</key>
+	<dict>
+		<key>Blue Component</key>
+		<real>0.35294118523597717</real>
+		<key>Color Space</key>
+		<string>sRGB</string>
+		<key>Green Component</key>
+		<real>0.27843138575553894</real>
+		<key>Red Component</key>
+		<real>0.26666668057441711</real>
+	</dict>
+</dict>
+</plist>
+`
+
+func TestParseITermColors(t *testing.T) {
+	t.Parallel()
+
+	theme, err := ParseITermColors(strings.NewReader(testITermColors))
+	if err != nil {
+		t.Fatalf("ParseITermColors() error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  Color
+		want string
+	}{
+		{"background", theme.Background(), "#282a36"},
+		{"foreground without color space", theme.TextPrimary(), "#f8f8f2"},
+		{"selection as surface", theme.Surface(), "#44475a"},
+		{"srgb red", theme.Red(), "#ff5555"},
+		{"calibrated blue", theme.Blue(), "#bd93f9"},
+		{"p3 green converted", theme.Green(), "#00ff00"},
+		{"accent from blue", theme.Accent(), "#bd93f9"},
+		{"operator from red", theme.CodeOperator(), "#ff5555"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if tt.got.Hex() != tt.want {
+				t.Errorf("got %s, want %s", tt.got.Hex(), tt.want)
+			}
+		})
+	}
+
+	if !theme.IsDark() {
+		t.Error("IsDark() = false, want true")
+	}
+}
+
+func TestITermColorsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	original := testTerminalScheme().theme("mocha", "Mocha")
+
+	var buf bytes.Buffer
+	if err := WriteITermColors(&buf, original); err != nil {
+		t.Fatalf("WriteITermColors() error: %v", err)
+	}
+
+	out := buf.String()
+	for _, key := range []string{"Ansi 0 Color", "Ansi 15 Color", "Cursor Color", "Cursor Text Color",
+		"Selection Color", "Selected Text Color", "Bold Color", "Link Color", "<string>sRGB</string>"} {
+		if !strings.Contains(out, key) {
+			t.Errorf("WriteITermColors() output missing %q", key)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "Catppuccin Mocha.itermcolors")
+	writeTestFile(t, path, out)
+
+	got, err := LoadITermColorsFile(path)
+	if err != nil {
+		t.Fatalf("LoadITermColorsFile() error: %v", err)
+	}
+	if got.ID() != "catppuccin_mocha" || got.DisplayName() != "Catppuccin Mocha" {
+		t.Errorf("identity = %q/%q, want catppuccin_mocha/Catppuccin Mocha", got.ID(), got.DisplayName())
+	}
+	assertSameColors(t, original, got)
+
+	path = filepath.Join(t.TempDir(), "夜空.itermcolors")
+	writeTestFile(t, path, out)
+	got, err = LoadITermColorsFile(path)
+	if err != nil {
+		t.Fatalf("LoadITermColorsFile() error: %v", err)
+	}
+	if got.ID() != "imported" || got.DisplayName() != "夜空" {
+		t.Errorf("identity = %q/%q, want imported/夜空", got.ID(), got.DisplayName())
+	}
+}
+
+func TestParseITermColorsErrors(t *testing.T) {
+	t.Parallel()
+
+	wrap := func(body string) string {
+		return `<?xml version="1.0"?><plist version="1.0"><dict>` + body + `</dict></plist>`
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"not a plist", "{}", "parsing iTerm2 colors"},
+		{"root not dict", `<plist><array></array></plist>`, "want dictionary"},
+		{"no colors", wrap(""), "missing"},
+		{"missing component", wrap(`<key>Background Color</key><dict><key>Red Component</key><real>1</real></dict>`), `missing "Green Component"`},
+		{"unknown color space", wrap(`<key>Background Color</key><dict>
+			<key>Red Component</key><real>1</real><key>Green Component</key><real>1</real>
+			<key>Blue Component</key><real>1</real><key>Color Space</key><string>Lab</string></dict>`), `unsupported color space "Lab"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseITermColors(strings.NewReader(tt.input))
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package gothememe
+
+// stripJSONC converts JSON with comments (the dialect VS Code and Windows
+// Terminal accept for settings and theme files) into plain JSON. Line and
+// block comments are removed and trailing commas before a closing bracket are
+// dropped. String literals are left untouched. Removed characters are
+// replaced by spaces, and newlines are kept, so decoder offsets and line
+// numbers still point at the original text.
+func stripJSONC(data []byte) []byte {
+	out := make([]byte, len(data))
+	copy(out, data)
+
+	// pendingComma is the index of a comma that may turn out to be trailing.
+	pendingComma := -1
+	for i := 0; i < len(out); i++ {
+		switch c := out[i]; {
+		case c == '"':
+			pendingComma = -1
+			i = skipJSONString(out, i)
+		case c == '/' && i+1 < len(out) && out[i+1] == '/':
+			for ; i < len(out) && out[i] != '\n'; i++ {
+				out[i] = ' '
+			}
+		case c == '/' && i+1 < len(out) && out[i+1] == '*':
+			out[i], out[i+1] = ' ', ' '
+			for i += 2; i < len(out); i++ {
+				if out[i] == '*' && i+1 < len(out) && out[i+1] == '/' {
+					out[i], out[i+1] = ' ', ' '
+					i++
+					break
+				}
+				if out[i] != '\n' {
+					out[i] = ' '
+				}
+			}
+		case c == ',':
+			pendingComma = i
+		case c == '}' || c == ']':
+			if pendingComma >= 0 {
+				out[pendingComma] = ' '
+			}
+			pendingComma = -1
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+		default:
+			pendingComma = -1
+		}
+	}
+	return out
+}
+
+// skipJSONString returns the index of the closing quote of the string that
+// starts at data[start].
+func skipJSONString(data []byte, start int) int {
+	for i := start + 1; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return len(data)
+}
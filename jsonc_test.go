@@ -0,0 +1,58 @@
+package gothememe
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStripJSONC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"plain", `{"a": 1}`, `{"a":1}`},
+		{"line comment", "{\n// note\n\"a\": 1 // trailing\n}", `{"a":1}`},
+		{"block comment", `{/* x */"a": /* y */ 1}`, `{"a":1}`},
+		{"trailing comma object", `{"a": 1,}`, `{"a":1}`},
+		{"trailing comma array", `{"a": [1, 2, ], }`, `{"a":[1,2]}`},
+		{"trailing comma before comment", "{\"a\": 1, // c\n}", `{"a":1}`},
+		{"comment markers in strings", `{"url": "http://x/*y*/", "c": ","}`, `{"c":",","url":"http://x/*y*/"}`},
+		{"escaped quote", `{"a": "say \"//hi\"",}`, `{"a":"say \"//hi\""}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var v any
+			if err := json.Unmarshal(stripJSONC([]byte(tt.input)), &v); err != nil {
+				t.Fatalf("stripJSONC() produced invalid JSON: %v", err)
+			}
+			got, err := json.Marshal(v)
+			if err != nil {
+				t.Fatalf("json.Marshal() error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("stripJSONC() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripJSONCPreservesLines(t *testing.T) {
+	t.Parallel()
+
+	input := "{\n/* a\nb */\n\"a\": 1\n}"
+	got := stripJSONC([]byte(input))
+	if len(got) != len(input) {
+		t.Fatalf("length changed: %d != %d", len(got), len(input))
+	}
+	for i := range input {
+		if (input[i] == '\n') != (got[i] == '\n') {
+			t.Fatalf("newline at offset %d not preserved", i)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package gothememe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// GenerateLess generates Less variables from a theme, such as
+// @theme-text-primary.
+func GenerateLess(t Theme, opts CSSOptions) string {
+	if opts.Prefix == "" {
+		opts.Prefix = "theme"
+	}
+
+	var sb strings.Builder
+
+	// Add metadata comment
+	if opts.IncludeMetadata && !opts.Minify {
+		sb.WriteString(fmt.Sprintf("// Theme: %s (%s)\n", t.DisplayName(), t.ID()))
+		if t.Author() != "" {
+			sb.WriteString(fmt.Sprintf("// Author: %s\n", t.Author()))
+		}
+		sb.WriteString("\n")
+	}
+
+	vars := generateVariables(t, opts)
+	for _, v := range vars {
+		if opts.Minify {
+			sb.WriteString(fmt.Sprintf("@%s-%s:%s;", opts.Prefix, v.name, v.value))
+		} else {
+			sb.WriteString(fmt.Sprintf("@%s-%s: %s;\n", opts.Prefix, v.name, v.value))
+		}
+	}
+
+	return sb.String()
+}
+
+// GenerateLessMixins generates Less for several themes: a .theme(@id)
+// mixin per theme, guarded by its quoted ID, that writes the theme's custom
+// properties, and a [data-theme] block per theme that includes it, so
+// .theme("dracula") applies Dracula anywhere. Roles a theme leaves empty are
+// transparent, as in its CSS.
+func GenerateLessMixins(themes []Theme, opts CSSOptions) (string, error) {
+	if opts.Prefix == "" {
+		opts.Prefix = "theme"
+	}
+	themes, err := uniqueThemes(themes)
+	if err != nil {
+		return "", err
+	}
+
+	nl, indent := "\n", "  "
+	if opts.Minify {
+		nl, indent = "", ""
+	}
+
+	var sb strings.Builder
+	if !opts.Minify {
+		sb.WriteString("// Generated by gothememe. Do not edit.\n")
+	}
+	for _, t := range themes {
+		if !opts.Minify {
+			sb.WriteString("\n")
+			if opts.IncludeMetadata {
+				fmt.Fprintf(&sb, "// Theme: %s (%s)\n", t.DisplayName(), t.ID())
+			}
+		}
+		fmt.Fprintf(&sb, ".theme(@id) when (@id = %q) {%s", t.ID(), nl)
+		for _, v := range generateVariables(t, opts) {
+			fmt.Fprintf(&sb, "%s--%s-%s: %s;%s", indent, opts.Prefix, v.name, v.value, nl)
+		}
+		sb.WriteString("}" + nl)
+	}
+
+	if !opts.Minify {
+		sb.WriteString("\n")
+	}
+	for _, t := range themes {
+		fmt.Fprintf(&sb, "[data-theme=%q] {%s%s.theme(%q);%s}%s", t.ID(), nl, indent, t.ID(), nl, nl)
+	}
+	return sb.String(), nil
+}
+
+// exportLess writes the Less variables of a single theme, or the guard
+// mixins of several.
+func exportLess(w io.Writer, themes []Theme, opts ExportOptions) error {
+	if len(themes) == 1 {
+		_, err := io.WriteString(w, GenerateLess(themes[0], opts.CSS))
+		return err
+	}
+	less, err := GenerateLessMixins(themes, opts.CSS)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, less)
+	return err
+}
@@ -0,0 +1,133 @@
+package gothememe
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGenerateLess(t *testing.T) {
+	t.Parallel()
+
+	theme := NewThemeBuilder("test", "Test Theme").
+		WithIsDark(true).
+		WithBackground(Hex("#282a36")).
+		WithTextPrimary(Hex("#f8f8f2")).
+		WithAuthor("Jane").
+		Build()
+
+	tests := []struct {
+		name     string
+		opts     CSSOptions
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "defaults",
+			opts:     CSSOptions{},
+			contains: []string{"@theme-background: #282a36;\n", "@theme-text-primary: #f8f8f2;\n"},
+			excludes: []string{"// Theme:"},
+		},
+		{
+			name:     "with metadata",
+			opts:     CSSOptions{Prefix: "app", IncludeMetadata: true},
+			contains: []string{"// Theme: Test Theme (test)\n// Author: Jane\n\n", "@app-background: #282a36;\n"},
+		},
+		{
+			name:     "rgb",
+			opts:     CSSOptions{ColorSpace: ColorSpaceRGB},
+			contains: []string{"@theme-background: rgb(40, 42, 54);\n"},
+		},
+		{
+			name:     "minified",
+			opts:     CSSOptions{Minify: true, IncludeMetadata: true},
+			contains: []string{"@theme-background:#282a36;@theme-background-secondary:"},
+			excludes: []string{"\n", "//"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			less := GenerateLess(theme, tt.opts)
+			for _, want := range tt.contains {
+				if !strings.Contains(less, want) {
+					t.Errorf("GenerateLess() missing %q\nGot:\n%s", want, less)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(less, unwanted) {
+					t.Errorf("GenerateLess() contains %q\nGot:\n%s", unwanted, less)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateLessMixins(t *testing.T) {
+	t.Parallel()
+
+	dark, light := figmaTestThemes(t)
+	less, err := GenerateLessMixins([]Theme{dark, light}, CSSOptions{IncludeMetadata: true})
+	if err != nil {
+		t.Fatalf("GenerateLessMixins() error: %v", err)
+	}
+	for _, want := range []string{
+		"// Theme: Catppuccin Mocha (catppuccin_mocha)\n.theme(@id) when (@id = \"catppuccin_mocha\") {\n  --theme-background: #1e1e2e;\n",
+		".theme(@id) when (@id = \"paper\") {\n  --theme-background: #fdf6e3;\n",
+		"  --theme-red: transparent;\n",
+		"[data-theme=\"catppuccin_mocha\"] {\n  .theme(\"catppuccin_mocha\");\n}\n",
+		"[data-theme=\"paper\"] {\n  .theme(\"paper\");\n}\n",
+	} {
+		if !strings.Contains(less, want) {
+			t.Errorf("GenerateLessMixins() missing %q", want)
+		}
+	}
+	if strings.Count(less, "{") != strings.Count(less, "}") {
+		t.Error("GenerateLessMixins() output is unbalanced")
+	}
+
+	minified, err := GenerateLessMixins([]Theme{dark, light}, CSSOptions{Prefix: "ui", Minify: true, IncludeMetadata: true})
+	if err != nil {
+		t.Fatalf("GenerateLessMixins(minify) error: %v", err)
+	}
+	if strings.ContainsAny(minified, "\n") || strings.Contains(minified, "//") {
+		t.Errorf("GenerateLessMixins(minify) = %q", minified)
+	}
+	if !strings.Contains(minified, `.theme(@id) when (@id = "paper") {--ui-background: #fdf6e3;`) {
+		t.Errorf("GenerateLessMixins(minify) = %q", minified)
+	}
+
+	if _, err := GenerateLessMixins(nil, CSSOptions{}); !errors.Is(err, ErrNoThemes) {
+		t.Errorf("GenerateLessMixins(nil) error = %v, want ErrNoThemes", err)
+	}
+}
+
+func TestExportLess(t *testing.T) {
+	t.Parallel()
+
+	dark, light := figmaTestThemes(t)
+	if got, err := OutputFormatFromPath("styles/theme.less"); err != nil || got != FormatLess {
+		t.Errorf("OutputFormatFromPath(.less) = %v, %v", got, err)
+	}
+
+	var single strings.Builder
+	if err := Export(&single, FormatLess, dark); err != nil {
+		t.Fatalf("Export(less) error: %v", err)
+	}
+	if single.String() != GenerateLess(dark, DefaultCSSOptions()) {
+		t.Error("Export(less) with one theme should write its variables")
+	}
+
+	var multi strings.Builder
+	if err := Export(&multi, FormatLess, dark, light); err != nil {
+		t.Fatalf("Export(less) error: %v", err)
+	}
+	want, err := GenerateLessMixins([]Theme{dark, light}, DefaultCSSOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if multi.String() != want {
+		t.Error("Export(less) with two themes should write the guard mixins")
+	}
+}
@@ -0,0 +1,517 @@
+package gothememe
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// FileFormat identifies the serialization used by a theme file.
+type FileFormat int
+
+const (
+	// FileFormatJSON reads and writes theme files as JSON.
+	FileFormatJSON FileFormat = iota
+
+	// FileFormatYAML reads and writes theme files as YAML.
+	FileFormatYAML
+
+	// FileFormatTOML reads and writes theme files as TOML.
+	FileFormatTOML
+)
+
+// String returns the lowercase name of the format.
+func (f FileFormat) String() string {
+	switch f {
+	case FileFormatJSON:
+		return "json"
+	case FileFormatYAML:
+		return "yaml"
+	case FileFormatTOML:
+		return "toml"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrUnknownFileFormat is returned when a theme file format cannot be determined.
+var ErrUnknownFileFormat = errors.New("unknown theme file format")
+
+// ErrThemeNotFound is returned when a referenced theme ID is not registered.
+var ErrThemeNotFound = errors.New("theme not found")
+
+// FileFormatFromPath returns the theme file format implied by a file extension.
+// Recognized extensions are .json, .yaml, .yml and .toml.
+func FileFormatFromPath(path string) (FileFormat, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FileFormatJSON, nil
+	case ".yaml", ".yml":
+		return FileFormatYAML, nil
+	case ".toml":
+		return FileFormatTOML, nil
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrUnknownFileFormat, path)
+	}
+}
+
+// ThemeFile is the schema of a theme file. The same structure is used for
+// JSON, YAML and TOML; only the syntax differs.
+//
+// A minimal YAML theme:
+//
+//	id: acme_dark
+//	name: Acme Dark
+//	colors:
+//	  background: "#1a1a2e"
+//	  text_primary: "#e4e4e4"
+//	  accent: "#e94560"
+//
+// Any color role left out of Colors is derived by [ThemeBuilder] exactly as
+// if the theme had been built in Go. See [ColorRoles] for the full list of keys.
+type ThemeFile struct {
+	// ID is the unique theme identifier. LoadThemeFile defaults it to the
+	// file name without its extension.
+	ID string `json:"id,omitempty" yaml:"id,omitempty" toml:"id,omitempty"`
+
+	// Name is the human-readable display name. Defaults to ID.
+	Name string `json:"name,omitempty" yaml:"name,omitempty" toml:"name,omitempty"`
+
+	// Description is a brief description of the theme.
+	Description string `json:"description,omitempty" yaml:"description,omitempty" toml:"description,omitempty"`
+
+	// Author is the theme author or maintainer.
+	Author string `json:"author,omitempty" yaml:"author,omitempty" toml:"author,omitempty"`
+
+	// License is the license under which the theme is distributed.
+	License string `json:"license,omitempty" yaml:"license,omitempty" toml:"license,omitempty"`
+
+	// Source is a URL or reference to the original theme.
+	Source string `json:"source,omitempty" yaml:"source,omitempty" toml:"source,omitempty"`
+
+	// Extends is the ID of a registered theme to start from. Its metadata and
+	// colors are copied first and then overridden by this file.
+	Extends string `json:"extends,omitempty" yaml:"extends,omitempty" toml:"extends,omitempty"`
+
+	// Dark marks the theme as dark or light. When omitted, the mode is
+	// detected from the background color.
+	Dark *bool `json:"dark,omitempty" yaml:"dark,omitempty" toml:"dark,omitempty"`
+
+	// Colors maps role keys (e.g. "background", "text_primary",
+	// "success_text", "code_keyword") to color values. Keys may use hyphens
+	// instead of underscores. Values accept any form understood by [ParseColor].
+	Colors map[string]string `json:"colors,omitempty" yaml:"colors,omitempty" toml:"colors,omitempty"`
+}
+
+// ThemeFileError describes a problem found while loading a theme file.
+// File and Line are set when known.
+type ThemeFileError struct {
+	File string // Path of the theme file, empty when loaded from a reader
+	Line int    // 1-based line number, 0 when unknown
+	Err  error  // Underlying error
+}
+
+// Error implements the error interface.
+func (e *ThemeFileError) Error() string {
+	switch {
+	case e.File != "" && e.Line > 0:
+		return fmt.Sprintf("%s:%d: %v", e.File, e.Line, e.Err)
+	case e.File != "":
+		return fmt.Sprintf("%s: %v", e.File, e.Err)
+	case e.Line > 0:
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	default:
+		return e.Err.Error()
+	}
+}
+
+// Unwrap returns the underlying error.
+func (e *ThemeFileError) Unwrap() error {
+	return e.Err
+}
+
+// LoadTheme reads a theme file from r and builds a Theme.
+// An "extends" key is resolved against the default registry; see
+// [Registry.LoadTheme] to resolve against a specific registry.
+func LoadTheme(r io.Reader, format FileFormat) (Theme, error) {
+	return loadTheme(r, format, "", "", GetTheme)
+}
+
+// LoadThemeFile reads the theme file at path and builds a Theme.
+// The format is chosen from the file extension and the theme ID defaults to
+// the file name without its extension. An "extends" key is resolved against
+// the default registry.
+func LoadThemeFile(path string) (Theme, error) {
+	return loadThemeFile(path, GetTheme)
+}
+
+// LoadTheme reads a theme file from rd and builds a Theme, resolving an
+// "extends" key against the themes in this registry. The loaded theme is
+// not registered; call [Registry.Register] to add it.
+func (r *Registry) LoadTheme(rd io.Reader, format FileFormat) (Theme, error) {
+	return loadTheme(rd, format, "", "", r.GetTheme)
+}
+
+// LoadThemeFile reads the theme file at path and builds a Theme, resolving an
+// "extends" key against the themes in this registry. The loaded theme is
+// not registered; call [Registry.Register] to add it.
+func (r *Registry) LoadThemeFile(path string) (Theme, error) {
+	return loadThemeFile(path, r.GetTheme)
+}
+
+// themeLookup resolves a theme ID, typically Registry.GetTheme.
+type themeLookup func(id string) (Theme, bool)
+
+// loadThemeFile reads a theme file from disk.
+func loadThemeFile(path string, lookup themeLookup) (Theme, error) {
+	format, err := FileFormatFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // G304: loading a caller-specified theme file is the purpose of this function
+	if err != nil {
+		return nil, fmt.Errorf("reading theme file: %w", err)
+	}
+
+	defaultID := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return loadTheme(bytes.NewReader(data), format, path, defaultID, lookup)
+}
+
+// loadTheme decodes a theme file and builds the resulting theme.
+func loadTheme(r io.Reader, format FileFormat, path, defaultID string, lookup themeLookup) (Theme, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading theme file: %w", err)
+	}
+
+	file, line, err := decodeThemeFile(data, format)
+	if err != nil {
+		return nil, &ThemeFileError{File: path, Line: line, Err: err}
+	}
+	if file.ID == "" {
+		file.ID = defaultID
+	}
+
+	theme, key, err := file.build(lookup)
+	if err != nil {
+		return nil, &ThemeFileError{File: path, Line: findKeyLine(data, key...), Err: err}
+	}
+	return theme, nil
+}
+
+// yamlLinePattern extracts the line number from yaml.v3 error messages.
+var yamlLinePattern = regexp.MustCompile(`line (\d+)`)
+
+// decodeThemeFile parses data in the given format. On failure it returns the
+// line number of the problem when it can be determined.
+func decodeThemeFile(data []byte, format FileFormat) (ThemeFile, int, error) {
+	var file ThemeFile
+
+	switch format {
+	case FileFormatJSON:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&file); err != nil {
+			return file, jsonErrorLine(data, err), fmt.Errorf("invalid JSON: %w", err)
+		}
+
+	case FileFormatYAML:
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+			line := 0
+			if m := yamlLinePattern.FindStringSubmatch(err.Error()); m != nil {
+				if n, aerr := strconv.Atoi(m[1]); aerr == nil {
+					line = n
+				}
+			}
+			return file, line, fmt.Errorf("invalid YAML: %w", err)
+		}
+
+	case FileFormatTOML:
+		md, err := toml.NewDecoder(bytes.NewReader(data)).Decode(&file)
+		if err != nil {
+			var perr toml.ParseError
+			if errors.As(err, &perr) {
+				return file, perr.Position.Line, fmt.Errorf("invalid TOML: %s", perr.Message)
+			}
+			return file, 0, fmt.Errorf("invalid TOML: %w", err)
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			key := undecoded[0]
+			return file, findKeyLine(data, key...), fmt.Errorf("unknown key %q", key.String())
+		}
+
+	default:
+		return file, 0, fmt.Errorf("%w: %d", ErrUnknownFileFormat, format)
+	}
+
+	return file, 0, nil
+}
+
+// jsonErrorLine converts the byte offset carried by encoding/json errors
+// into a line number. Unknown-field errors carry no offset, so the field
+// name is searched for instead.
+func jsonErrorLine(data []byte, err error) int {
+	var offset int64
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	default:
+		if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+			if name, uerr := strconv.Unquote(field); uerr == nil {
+				return findKeyLine(data, name)
+			}
+		}
+		return 0
+	}
+	if offset <= 0 || offset > int64(len(data)) {
+		return 0
+	}
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+// findKeyLine returns the 1-based line on which the key path first appears,
+// searching for each path element after the line of the previous one.
+// It understands "key:", "key =", quoted keys and TOML table headers, which
+// is enough to locate keys in JSON, YAML and TOML theme files.
+// Returns 0 if the path cannot be found.
+func findKeyLine(data []byte, path ...string) int {
+	if len(path) == 0 {
+		return 0
+	}
+	lines := strings.Split(string(data), "\n")
+	start := 0
+	found := 0
+	for _, key := range path {
+		q := regexp.QuoteMeta(key)
+		pattern := regexp.MustCompile(`(?:^|[\s{,.\[])(?:"` + q + `"|'` + q + `'|` + q + `)\s*(?:[:=]|\])`)
+		found = 0
+		for i := start; i < len(lines); i++ {
+			if pattern.MatchString(lines[i]) {
+				found = i + 1
+				start = i
+				break
+			}
+		}
+		if found == 0 {
+			return 0
+		}
+	}
+	return found
+}
+
+// build converts the decoded file into a Theme. On failure it also returns
+// the key path responsible, so the caller can report its line.
+func (f *ThemeFile) build(lookup themeLookup) (Theme, []string, error) {
+	if f.ID == "" {
+		return nil, []string{"id"}, errors.New("theme id is required")
+	}
+	name := f.Name
+	if name == "" {
+		name = f.ID
+	}
+
+	b := NewThemeBuilder(f.ID, name)
+
+	if f.Extends != "" {
+		base, ok := lookup(f.Extends)
+		if !ok || base == nil {
+			return nil, []string{"extends"}, fmt.Errorf("%w: extends %q", ErrThemeNotFound, f.Extends)
+		}
+		b.WithDescription(base.Description()).
+			WithAuthor(base.Author()).
+			WithLicense(base.License()).
+			WithSource(base.Source()).
+			withMode(base.IsDark())
+		copyAllColors(b, base)
+	}
+
+	if f.Description != "" {
+		b.WithDescription(f.Description)
+	}
+	if f.Author != "" {
+		b.WithAuthor(f.Author)
+	}
+	if f.License != "" {
+		b.WithLicense(f.License)
+	}
+	if f.Source != "" {
+		b.WithSource(f.Source)
+	}
+
+	// Apply colors in a stable order so the first invalid key is reported
+	// consistently.
+	for _, key := range slices.Sorted(maps.Keys(f.Colors)) {
+		role, ok := lookupColorRole(key)
+		if !ok {
+			return nil, []string{"colors", key}, fmt.Errorf("unknown color role %q", key)
+		}
+		c, err := ParseColor(f.Colors[key])
+		if err != nil {
+			return nil, []string{"colors", key}, fmt.Errorf("color %q: %w", key, err)
+		}
+		role.set(b, c)
+	}
+
+	switch {
+	case f.Dark != nil:
+		b.withMode(*f.Dark)
+	case f.Extends != "" && hasColorKey(f.Colors, "background"):
+		// A new background on an extended theme decides its mode again.
+		b.withMode(b.theme.background.IsDark())
+	}
+
+	return b.Build(), nil, nil
+}
+
+// hasColorKey reports whether colors contains the role key in any spelling.
+func hasColorKey(colors map[string]string, role string) bool {
+	for key := range colors {
+		if normalizeRoleKey(key) == role {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteTheme writes t to w as a theme file in the given format.
+// Every color role is written explicitly, so loading the output with
+// [LoadTheme] reproduces the theme exactly.
+func WriteTheme(w io.Writer, t Theme, format FileFormat) error {
+	var sb strings.Builder
+
+	meta := []struct{ key, value string }{
+		{"id", t.ID()},
+		{"name", t.DisplayName()},
+		{"description", t.Description()},
+		{"author", t.Author()},
+		{"license", t.License()},
+		{"source", t.Source()},
+	}
+
+	var colors []struct{ key, value string }
+	for _, role := range colorRoles {
+		if c := role.get(t); !c.IsEmpty() {
+			colors = append(colors, struct{ key, value string }{role.key, c.Hex()})
+		}
+	}
+
+	switch format {
+	case FileFormatJSON:
+		sb.WriteString("{\n")
+		for _, m := range meta {
+			if m.value != "" {
+				fmt.Fprintf(&sb, "  %q: %s,\n", m.key, quoteFileString(m.value))
+			}
+		}
+		fmt.Fprintf(&sb, "  \"dark\": %t,\n", t.IsDark())
+		sb.WriteString("  \"colors\": {")
+		for i, c := range colors {
+			if i > 0 {
+				sb.WriteString(",")
+			}
+			fmt.Fprintf(&sb, "\n    %q: %q", c.key, c.value)
+		}
+		sb.WriteString("\n  }\n}\n")
+
+	case FileFormatYAML:
+		for _, m := range meta {
+			if m.value != "" {
+				fmt.Fprintf(&sb, "%s: %s\n", m.key, quoteFileString(m.value))
+			}
+		}
+		fmt.Fprintf(&sb, "dark: %t\n", t.IsDark())
+		sb.WriteString("colors:\n")
+		for _, c := range colors {
+			fmt.Fprintf(&sb, "  %s: %q\n", c.key, c.value)
+		}
+
+	case FileFormatTOML:
+		for _, m := range meta {
+			if m.value != "" {
+				fmt.Fprintf(&sb, "%s = %s\n", m.key, quoteFileString(m.value))
+			}
+		}
+		fmt.Fprintf(&sb, "dark = %t\n", t.IsDark())
+		sb.WriteString("\n[colors]\n")
+		for _, c := range colors {
+			fmt.Fprintf(&sb, "%s = %q\n", c.key, c.value)
+		}
+
+	default:
+		return fmt.Errorf("%w: %d", ErrUnknownFileFormat, format)
+	}
+
+	if _, err := io.WriteString(w, sb.String()); err != nil {
+		return fmt.Errorf("writing theme file: %w", err)
+	}
+	return nil
+}
+
+// WriteThemeFile writes t to path, choosing the format from the file extension.
+func WriteThemeFile(path string, t Theme) error {
+	format, err := FileFormatFromPath(path)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTheme(&buf, t, format); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil { //nolint:gosec // G306: theme files are meant to be shared
+		return fmt.Errorf("writing theme file: %w", err)
+	}
+	return nil
+}
+
+// quoteFileString quotes s so it is a valid string in JSON, YAML and TOML.
+// JSON string escapes are a subset of what YAML double-quoted strings and
+// TOML basic strings accept.
+func quoteFileString(s string) string {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return strconv.Quote(s)
+	}
+	return string(data)
+}
+
+// nonIDChars matches runs of characters that are not allowed in theme IDs.
+var nonIDChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// themeIDFromName converts a display name to the snake_case ID style used by
+// the built-in themes, e.g. "Tokyo Night" becomes "tokyo_night".
+func themeIDFromName(name string) string {
+	return strings.Trim(nonIDChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+}
+
+// importedThemeID returns the ID of an imported theme: the snake_case form
+// of name, or of fallback, usually the file name, when name has no ASCII
+// letters or digits, such as a CJK name. It is "imported" when neither has.
+func importedThemeID(name, fallback string) string {
+	for _, s := range []string{name, fallback} {
+		if id := themeIDFromName(s); id != "" {
+			return id
+		}
+	}
+	return "imported"
+}
@@ -0,0 +1,438 @@
+package gothememe
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// loaderTestTheme returns a fully derived theme used for round-trip tests.
+func loaderTestTheme() Theme {
+	return NewThemeBuilder("round_trip", "Round \"Trip\" Theme").
+		WithDescription("A theme used for round-trip tests").
+		WithAuthor("Test Author").
+		WithLicense("MIT").
+		WithSource("https://example.com/theme").
+		WithBackground(Hex("#1a1b26")).
+		WithTextPrimary(Hex("#c0caf5")).
+		WithAccent(Hex("#7aa2f7")).
+		WithRed(Hex("#f7768e")).
+		WithGreen(Hex("#9ece6a")).
+		WithYellow(Hex("#e0af68")).
+		WithBlue(Hex("#7aa2f7")).
+		WithPurple(Hex("#bb9af7")).
+		WithCyan(Hex("#7dcfff")).
+		Build()
+}
+
+func TestWriteThemeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	formats := []FileFormat{FileFormatJSON, FileFormatYAML, FileFormatTOML}
+	for _, format := range formats {
+		t.Run(format.String(), func(t *testing.T) {
+			t.Parallel()
+
+			want := loaderTestTheme()
+			var buf bytes.Buffer
+			if err := WriteTheme(&buf, want, format); err != nil {
+				t.Fatalf("WriteTheme() error: %v", err)
+			}
+
+			got, err := LoadTheme(&buf, format)
+			if err != nil {
+				t.Fatalf("LoadTheme() error: %v\n%s", err, buf.String())
+			}
+
+			if got.ID() != want.ID() || got.DisplayName() != want.DisplayName() {
+				t.Errorf("identity = (%q, %q), want (%q, %q)", got.ID(), got.DisplayName(), want.ID(), want.DisplayName())
+			}
+			if got.Description() != want.Description() || got.Author() != want.Author() ||
+				got.License() != want.License() || got.Source() != want.Source() {
+				t.Error("metadata did not round-trip")
+			}
+			if got.IsDark() != want.IsDark() {
+				t.Errorf("IsDark() = %v, want %v", got.IsDark(), want.IsDark())
+			}
+			assertSameColors(t, want, got)
+		})
+	}
+}
+
+func TestLoadThemeDerivesMissingRoles(t *testing.T) {
+	t.Parallel()
+
+	src := `
+id: acme
+name: Acme
+colors:
+  background: "#1a1a2e"
+  text-primary: "#e4e4e4"
+  accent: rgb(233, 69, 96)
+  success_text: "#22c55e"
+`
+	theme, err := LoadTheme(strings.NewReader(src), FileFormatYAML)
+	if err != nil {
+		t.Fatalf("LoadTheme() error: %v", err)
+	}
+
+	want := NewThemeBuilder("acme", "Acme").
+		WithBackground(Hex("#1a1a2e")).
+		WithTextPrimary(Hex("#e4e4e4")).
+		WithAccent(Hex("#e94560")).
+		WithSuccess(SemanticColor{Text: Hex("#22c55e")}).
+		Build()
+
+	assertSameColors(t, want, theme)
+	if !theme.IsDark() {
+		t.Error("IsDark() = false, want true for dark background")
+	}
+}
+
+func TestLoadThemeFormats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		format FileFormat
+		src    string
+	}{
+		{"json", FileFormatJSON, `{"id": "x", "dark": false, "colors": {"background": "#ffffff", "text_primary": "#111111"}}`},
+		{"yaml", FileFormatYAML, "id: x\ndark: false\ncolors:\n  background: '#ffffff'\n  text_primary: '#111111'\n"},
+		{"toml", FileFormatTOML, "id = \"x\"\ndark = false\n[colors]\nbackground = \"#ffffff\"\ntext_primary = \"#111111\"\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			theme, err := LoadTheme(strings.NewReader(tt.src), tt.format)
+			if err != nil {
+				t.Fatalf("LoadTheme() error: %v", err)
+			}
+			if theme.DisplayName() != "x" {
+				t.Errorf("DisplayName() = %q, want ID fallback %q", theme.DisplayName(), "x")
+			}
+			if theme.IsDark() {
+				t.Error("IsDark() = true, want false")
+			}
+			if theme.Background().Hex() != "#ffffff" {
+				t.Errorf("Background() = %q, want #ffffff", theme.Background().Hex())
+			}
+		})
+	}
+}
+
+func TestLoadThemeExplicitMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		src  string
+		dark bool
+	}{
+		{"light over dark background", "id: x\ndark: false\ncolors:\n  background: \"#101010\"\n", false},
+		{"dark over light background", "id: x\ndark: true\ncolors:\n  background: \"#fafafa\"\n", true},
+		{"detected", "id: x\ncolors:\n  background: \"#101010\"\n", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			theme, err := LoadTheme(strings.NewReader(tt.src), FileFormatYAML)
+			if err != nil {
+				t.Fatalf("LoadTheme() error: %v", err)
+			}
+			if theme.IsDark() != tt.dark {
+				t.Errorf("IsDark() = %v, want %v", theme.IsDark(), tt.dark)
+			}
+		})
+	}
+}
+
+func TestLoadThemeErrorLines(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		format   FileFormat
+		src      string
+		wantLine int
+		wantMsg  string
+	}{
+		{
+			name:     "json syntax",
+			format:   FileFormatJSON,
+			src:      "{\n  \"id\": \"x\",\n  \"colors\": {\n    \"background\": \"#000\"\n    \"accent\": \"#fff\"\n  }\n}",
+			wantLine: 5,
+			wantMsg:  "invalid JSON",
+		},
+		{
+			name:     "json unknown field",
+			format:   FileFormatJSON,
+			src:      "{\n  \"id\": \"x\",\n  \"colour\": {}\n}",
+			wantLine: 3,
+			wantMsg:  "unknown field",
+		},
+		{
+			name:     "yaml invalid color",
+			format:   FileFormatYAML,
+			src:      "id: x\ncolors:\n  background: \"#000000\"\n  accent: \"#zzz\"\n",
+			wantLine: 4,
+			wantMsg:  "invalid color",
+		},
+		{
+			name:     "yaml unknown role",
+			format:   FileFormatYAML,
+			src:      "id: x\ncolors:\n  background: \"#000000\"\n  sparkle: \"#ffffff\"\n",
+			wantLine: 4,
+			wantMsg:  "unknown color role",
+		},
+		{
+			name:     "yaml syntax",
+			format:   FileFormatYAML,
+			src:      "id: x\ncolors:\n  background: \"#000000\n",
+			wantLine: 3,
+			wantMsg:  "invalid YAML",
+		},
+		{
+			name:     "yaml unknown field",
+			format:   FileFormatYAML,
+			src:      "id: x\ncolours:\n  background: \"#000000\"\n",
+			wantLine: 2,
+			wantMsg:  "invalid YAML",
+		},
+		{
+			name:     "toml syntax",
+			format:   FileFormatTOML,
+			src:      "id = \"x\"\n\n[colors]\nbackground = #000000\n",
+			wantLine: 4,
+			wantMsg:  "invalid TOML",
+		},
+		{
+			name:     "toml unknown key",
+			format:   FileFormatTOML,
+			src:      "id = \"x\"\nflavor = \"dark\"\n",
+			wantLine: 2,
+			wantMsg:  "unknown key",
+		},
+		{
+			name:     "toml invalid color",
+			format:   FileFormatTOML,
+			src:      "id = \"x\"\n\n[colors]\nbackground = \"#000000\"\ntext_primary = \"nope\"\n",
+			wantLine: 5,
+			wantMsg:  "invalid color",
+		},
+		{
+			name:     "missing id",
+			format:   FileFormatYAML,
+			src:      "colors:\n  background: \"#000000\"\n",
+			wantLine: 0,
+			wantMsg:  "theme id is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := LoadTheme(strings.NewReader(tt.src), tt.format)
+			if err == nil {
+				t.Fatal("LoadTheme() expected error")
+			}
+			var fileErr *ThemeFileError
+			if !errors.As(err, &fileErr) {
+				t.Fatalf("error %T is not a *ThemeFileError: %v", err, err)
+			}
+			if fileErr.Line != tt.wantLine {
+				t.Errorf("Line = %d, want %d (%v)", fileErr.Line, tt.wantLine, err)
+			}
+			if !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("error %q does not contain %q", err.Error(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestRegistryLoadThemeExtends(t *testing.T) {
+	t.Parallel()
+
+	base := loaderTestTheme()
+	registry := NewRegistry(base)
+
+	src := `{
+  "id": "round_trip_warm",
+  "name": "Round Trip Warm",
+  "extends": "round_trip",
+  "colors": {
+    "accent": "#ff9e64"
+  }
+}`
+	theme, err := registry.LoadTheme(strings.NewReader(src), FileFormatJSON)
+	if err != nil {
+		t.Fatalf("LoadTheme() error: %v", err)
+	}
+
+	if theme.Accent().Hex() != "#ff9e64" {
+		t.Errorf("Accent() = %q, want override #ff9e64", theme.Accent().Hex())
+	}
+	if theme.Background() != base.Background() {
+		t.Errorf("Background() = %q, want inherited %q", theme.Background().Hex(), base.Background().Hex())
+	}
+	if theme.Author() != base.Author() {
+		t.Errorf("Author() = %q, want inherited %q", theme.Author(), base.Author())
+	}
+	if theme.Brand() != base.Brand() {
+		t.Errorf("Brand() = %q, want inherited %q", theme.Brand().Hex(), base.Brand().Hex())
+	}
+}
+
+func TestRegistryLoadThemeExtendsModeDetection(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(loaderTestTheme())
+	src := "id: light\nextends: round_trip\ncolors:\n  background: \"#fafafa\"\n"
+
+	theme, err := registry.LoadTheme(strings.NewReader(src), FileFormatYAML)
+	if err != nil {
+		t.Fatalf("LoadTheme() error: %v", err)
+	}
+	if theme.IsDark() {
+		t.Error("IsDark() = true, want false after overriding with a light background")
+	}
+}
+
+func TestRegistryLoadThemeExtendsKeepsMode(t *testing.T) {
+	t.Parallel()
+
+	// A light theme whose background reads as dark stays light when extended.
+	base := NewThemeBuilder("sepia", "Sepia").
+		WithBackground(Hex("#4a4540")).
+		WithTextPrimary(Hex("#f0e6d2")).
+		withMode(false).
+		Build()
+	registry := NewRegistry(base)
+
+	theme, err := registry.LoadTheme(strings.NewReader("id: child\nextends: sepia\ncolors:\n  accent: \"#ff9e64\"\n"), FileFormatYAML)
+	if err != nil {
+		t.Fatalf("LoadTheme() error: %v", err)
+	}
+	if theme.IsDark() {
+		t.Error("IsDark() = true, want the base theme's light mode")
+	}
+}
+
+func TestLoadThemeExtendsUnknown(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	src := "id: child\nname: Child\nextends: missing\n"
+
+	_, err := registry.LoadTheme(strings.NewReader(src), FileFormatYAML)
+	if !errors.Is(err, ErrThemeNotFound) {
+		t.Fatalf("LoadTheme() error = %v, want ErrThemeNotFound", err)
+	}
+	var fileErr *ThemeFileError
+	if errors.As(err, &fileErr) && fileErr.Line != 3 {
+		t.Errorf("Line = %d, want 3", fileErr.Line)
+	}
+}
+
+func TestLoadThemeFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	want := loaderTestTheme()
+
+	for _, ext := range []string{".json", ".yaml", ".yml", ".toml"} {
+		path := filepath.Join(dir, "theme"+ext)
+		if err := WriteThemeFile(path, want); err != nil {
+			t.Fatalf("WriteThemeFile(%s) error: %v", ext, err)
+		}
+		got, err := LoadThemeFile(path)
+		if err != nil {
+			t.Fatalf("LoadThemeFile(%s) error: %v", ext, err)
+		}
+		assertSameColors(t, want, got)
+	}
+}
+
+func TestLoadThemeFileDefaultID(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "ocean_breeze.toml")
+	src := "[colors]\nbackground = \"#0f172a\"\ntext_primary = \"#e2e8f0\"\n"
+	if err := os.WriteFile(path, []byte(src), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	theme, err := NewRegistry(nil).LoadThemeFile(path)
+	if err != nil {
+		t.Fatalf("LoadThemeFile() error: %v", err)
+	}
+	if theme.ID() != "ocean_breeze" {
+		t.Errorf("ID() = %q, want file name %q", theme.ID(), "ocean_breeze")
+	}
+}
+
+func TestLoadThemeFileErrorIncludesPath(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "broken.yaml")
+	if err := os.WriteFile(path, []byte("id: x\ncolors:\n  accent: \"#nothex\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := LoadThemeFile(path)
+	if err == nil {
+		t.Fatal("LoadThemeFile() expected error")
+	}
+	if want := path + ":3:"; !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("error %q should start with %q", err.Error(), want)
+	}
+}
+
+func TestFileFormatFromPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path    string
+		want    FileFormat
+		wantErr bool
+	}{
+		{"theme.json", FileFormatJSON, false},
+		{"theme.YAML", FileFormatYAML, false},
+		{"dir/theme.yml", FileFormatYAML, false},
+		{"theme.toml", FileFormatTOML, false},
+		{"theme.txt", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+			got, err := FileFormatFromPath(tt.path)
+			if tt.wantErr {
+				if !errors.Is(err, ErrUnknownFileFormat) {
+					t.Errorf("FileFormatFromPath(%q) error = %v, want ErrUnknownFileFormat", tt.path, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("FileFormatFromPath(%q) = (%v, %v), want %v", tt.path, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteThemeUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := WriteTheme(&buf, loaderTestTheme(), FileFormat(99)); !errors.Is(err, ErrUnknownFileFormat) {
+		t.Errorf("WriteTheme() error = %v, want ErrUnknownFileFormat", err)
+	}
+	if _, err := LoadTheme(strings.NewReader("{}"), FileFormat(99)); !errors.Is(err, ErrUnknownFileFormat) {
+		t.Errorf("LoadTheme() error = %v, want ErrUnknownFileFormat", err)
+	}
+}
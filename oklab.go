@@ -0,0 +1,77 @@
+package gothememe
+
+import (
+	"math"
+
+	"github.com/tj-smith47/gothememe/internal/colorutil"
+)
+
+// oklab is a color in the OKLab space.
+type oklab struct {
+	l, a, b float64
+}
+
+// newOKLab converts c to OKLab.
+func newOKLab(c Color) oklab {
+	r, g, b := c.RGB()
+	l, la, lb := colorutil.SRGBToOKLab(float64(r)/255, float64(g)/255, float64(b)/255)
+	return oklab{l, la, lb}
+}
+
+// chroma returns the distance from the neutral axis.
+func (c oklab) chroma() float64 {
+	return math.Hypot(c.a, c.b)
+}
+
+// hue returns the hue angle in degrees (0-360).
+func (c oklab) hue() float64 {
+	h := math.Atan2(c.b, c.a) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+// withChroma returns c with its chroma scaled to chroma, keeping the hue.
+func (c oklab) withChroma(chroma float64) oklab {
+	current := c.chroma()
+	if current == 0 {
+		return oklab{c.l, 0, 0}
+	}
+	return oklab{c.l, c.a * chroma / current, c.b * chroma / current}
+}
+
+// withLightness returns c with lightness l, clamped to 0-1.
+func (c oklab) withLightness(l float64) oklab {
+	return oklab{clampUnit(l), c.a, c.b}
+}
+
+// color converts c to an sRGB Color. Colors outside the sRGB gamut keep
+// their lightness and hue and lose chroma until they fit.
+func (c oklab) color() Color {
+	inGamut := func(o oklab) bool {
+		r, g, b := colorutil.OKLabToSRGB(o.l, o.a, o.b)
+		const eps = 1e-4
+		return r >= -eps && r <= 1+eps && g >= -eps && g <= 1+eps && b >= -eps && b <= 1+eps
+	}
+	if !inGamut(c) {
+		lo, hi := 0.0, c.chroma()
+		for range 20 {
+			mid := (lo + hi) / 2
+			if inGamut(c.withChroma(mid)) {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		c = c.withChroma(lo)
+	}
+	r, g, b := colorutil.OKLabToSRGB(c.l, c.a, c.b)
+	return RGB(clampByte(r*255), clampByte(g*255), clampByte(b*255))
+}
+
+// distance returns the squared Euclidean distance between two colors.
+func (c oklab) distance(o oklab) float64 {
+	dl, da, db := c.l-o.l, c.a-o.a, c.b-o.b
+	return dl*dl + da*da + db*db
+}
@@ -5,14 +5,16 @@ import (
 	"strings"
 )
 
-// OutputFormat specifies the output format for theme CSS generation.
+// OutputFormat identifies an export format. Pass it to [Export] to write
+// themes through the exporter registered for it.
 type OutputFormat int
 
 const (
 	// FormatCSS generates standard CSS with custom properties.
 	FormatCSS OutputFormat = iota
 
-	// FormatSCSS generates SCSS variables.
+	// FormatSCSS generates SCSS variables, or a Sass theme map module for
+	// several themes.
 	FormatSCSS
 
 	// FormatJSON generates a JSON representation.
@@ -20,6 +22,92 @@ const (
 
 	// FormatDesignTokens generates DTCG v1 compliant design tokens.
 	FormatDesignTokens
+
+	// FormatTailwind generates a Tailwind CSS v4 stylesheet: an @theme
+	// block followed by the theme CSS.
+	FormatTailwind
+
+	// FormatTailwindConfig generates a Tailwind CSS v3 config preset.
+	FormatTailwindConfig
+
+	// FormatAlacritty generates an Alacritty TOML color configuration.
+	FormatAlacritty
+
+	// FormatKitty generates kitty.conf color settings.
+	FormatKitty
+
+	// FormatWezTerm generates a WezTerm TOML color scheme.
+	FormatWezTerm
+
+	// FormatGhostty generates a Ghostty theme file.
+	FormatGhostty
+
+	// FormatWindowsTerminal generates a Windows Terminal scheme object, or
+	// an array of them for several themes.
+	FormatWindowsTerminal
+
+	// FormatFoot generates foot.ini color sections.
+	FormatFoot
+
+	// FormatXresources generates X resources for xterm and compatible terminals.
+	FormatXresources
+
+	// FormatVSCode generates a VS Code color theme.
+	FormatVSCode
+
+	// FormatNeovim generates a Neovim Lua colorscheme.
+	FormatNeovim
+
+	// FormatTMTheme generates a TextMate .tmTheme property list.
+	FormatTMTheme
+
+	// FormatSublime generates a Sublime Text .sublime-color-scheme file.
+	FormatSublime
+
+	// FormatAndroidColors generates an Android colors.xml resource file.
+	FormatAndroidColors
+
+	// FormatAndroidTheme generates an Android themes.xml resource file with a
+	// Material 3 theme.
+	FormatAndroidTheme
+
+	// FormatCompose generates Kotlin Jetpack Compose colors and color schemes.
+	FormatCompose
+
+	// FormatSwift generates a Swift extension of SwiftUI's Color.
+	FormatSwift
+
+	// FormatGIMP generates a GIMP .gpl palette.
+	FormatGIMP
+
+	// FormatASE generates a binary Adobe Swatch Exchange file.
+	FormatASE
+
+	// FormatSketch generates a Sketch Palettes .sketchpalette file.
+	FormatSketch
+
+	// FormatProcreate generates a Procreate .swatches palette.
+	FormatProcreate
+
+	// FormatFigmaVariables generates a Figma REST API request creating a
+	// variable collection with a mode per theme.
+	FormatFigmaVariables
+
+	// FormatTypeScript generates a TypeScript module with a typed const per
+	// theme and unions of the theme IDs and CSS variable names.
+	FormatTypeScript
+
+	// FormatTypeScriptDeclarations generates the .d.ts type declarations of
+	// the TypeScript module.
+	FormatTypeScriptDeclarations
+
+	// FormatLess generates Less variables, or guard mixins for several
+	// themes.
+	FormatLess
+
+	// FormatStylus generates Stylus variables, or a themes hash for several
+	// themes.
+	FormatStylus
 )
 
 // ColorSpace specifies the color space for output.
@@ -0,0 +1,201 @@
+package gothememe
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// plistDict is an ordered property-list dictionary, used when writing so
+// keys come out in a stable order.
+type plistDict []plistEntry
+
+// plistEntry is a single key/value pair of a plistDict.
+type plistEntry struct {
+	key   string
+	value any
+}
+
+// plistHeader starts every XML property list.
+const plistHeader = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+`
+
+// writePlist renders v as an XML property list. Supported values are
+// plistDict, []any, string, float64, int and bool.
+func writePlist(w io.Writer, v any) error {
+	var sb strings.Builder
+	sb.WriteString(plistHeader)
+	if err := writePlistValue(&sb, v, 0); err != nil {
+		return err
+	}
+	sb.WriteString("</plist>\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// writePlistValue renders a single value at the given indentation depth.
+func writePlistValue(sb *strings.Builder, v any, depth int) error {
+	indent := strings.Repeat("\t", depth)
+	switch val := v.(type) {
+	case plistDict:
+		sb.WriteString(indent + "<dict>\n")
+		for _, e := range val {
+			fmt.Fprintf(sb, "%s\t<key>%s</key>\n", indent, xmlEscape(e.key))
+			if err := writePlistValue(sb, e.value, depth+1); err != nil {
+				return fmt.Errorf("key %q: %w", e.key, err)
+			}
+		}
+		sb.WriteString(indent + "</dict>\n")
+	case []any:
+		sb.WriteString(indent + "<array>\n")
+		for _, item := range val {
+			if err := writePlistValue(sb, item, depth+1); err != nil {
+				return err
+			}
+		}
+		sb.WriteString(indent + "</array>\n")
+	case string:
+		fmt.Fprintf(sb, "%s<string>%s</string>\n", indent, xmlEscape(val))
+	case float64:
+		fmt.Fprintf(sb, "%s<real>%s</real>\n", indent, strconv.FormatFloat(val, 'f', -1, 64))
+	case int:
+		fmt.Fprintf(sb, "%s<integer>%d</integer>\n", indent, val)
+	case bool:
+		if val {
+			sb.WriteString(indent + "<true/>\n")
+		} else {
+			sb.WriteString(indent + "<false/>\n")
+		}
+	default:
+		return fmt.Errorf("unsupported plist value %T", v)
+	}
+	return nil
+}
+
+// xmlEscape escapes text for use in XML character data.
+func xmlEscape(s string) string {
+	var sb strings.Builder
+	if err := xml.EscapeText(&sb, []byte(s)); err != nil {
+		return s
+	}
+	return sb.String()
+}
+
+// decodePlist parses an XML property list. Dictionaries decode to
+// map[string]any, arrays to []any, <real> and <integer> to float64,
+// <string> and <date> to string and <true/>/<false/> to bool.
+func decodePlist(r io.Reader) (any, error) {
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, errors.New("missing <plist> element")
+			}
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local != "plist" {
+			return nil, fmt.Errorf("unexpected <%s> element, want <plist>", start.Name.Local)
+		}
+		return decodePlistValue(dec, nil)
+	}
+}
+
+// decodePlistValue reads the next value. When start is nil the next start
+// element is read first; a nil result with no error means the enclosing
+// element ended.
+func decodePlistValue(dec *xml.Decoder, start *xml.StartElement) (any, error) {
+	for start == nil {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			start = &t
+		case xml.EndElement:
+			return nil, nil
+		}
+	}
+
+	switch start.Name.Local {
+	case "dict":
+		return decodePlistDict(dec)
+	case "array":
+		var items []any
+		for {
+			item, err := decodePlistValue(dec, nil)
+			if err != nil {
+				return nil, err
+			}
+			if item == nil {
+				return items, nil
+			}
+			items = append(items, item)
+		}
+	case "string", "date", "data":
+		var s string
+		if err := dec.DecodeElement(&s, start); err != nil {
+			return nil, err
+		}
+		return s, nil
+	case "real", "integer":
+		var s string
+		if err := dec.DecodeElement(&s, start); err != nil {
+			return nil, err
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid <%s> %q", start.Name.Local, s)
+		}
+		return f, nil
+	case "true", "false":
+		if err := dec.Skip(); err != nil {
+			return nil, err
+		}
+		return start.Name.Local == "true", nil
+	default:
+		return nil, fmt.Errorf("unexpected <%s> element", start.Name.Local)
+	}
+}
+
+// decodePlistDict reads <key>/value pairs up to the closing </dict>.
+func decodePlistDict(dec *xml.Decoder) (map[string]any, error) {
+	dict := make(map[string]any)
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.EndElement:
+			return dict, nil
+		case xml.StartElement:
+			if t.Name.Local != "key" {
+				return nil, fmt.Errorf("unexpected <%s> element in <dict>, want <key>", t.Name.Local)
+			}
+			var key string
+			if err := dec.DecodeElement(&key, &t); err != nil {
+				return nil, err
+			}
+			value, err := decodePlistValue(dec, nil)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: %w", key, err)
+			}
+			if value == nil {
+				return nil, fmt.Errorf("key %q has no value", key)
+			}
+			dict[key] = value
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package gothememe
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPlistRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	in := plistDict{
+		{"name", "A & B <test>"},
+		{"count", 3},
+		{"ratio", 0.25},
+		{"enabled", true},
+		{"disabled", false},
+		{"items", []any{"x", plistDict{{"nested", 1.5}}}},
+		{"empty", []any{}},
+	}
+
+	var sb strings.Builder
+	if err := writePlist(&sb, in); err != nil {
+		t.Fatalf("writePlist() error: %v", err)
+	}
+	if !strings.HasPrefix(sb.String(), "<?xml") || !strings.Contains(sb.String(), "A &amp; B &lt;test&gt;") {
+		t.Errorf("unexpected plist output:\n%s", sb.String())
+	}
+
+	got, err := decodePlist(strings.NewReader(sb.String()))
+	if err != nil {
+		t.Fatalf("decodePlist() error: %v", err)
+	}
+
+	want := map[string]any{
+		"name":     "A & B <test>",
+		"count":    3.0,
+		"ratio":    0.25,
+		"enabled":  true,
+		"disabled": false,
+		"items":    []any{"x", map[string]any{"nested": 1.5}},
+		"empty":    []any(nil),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("decodePlist() = %#v, want %#v", got, want)
+	}
+}
+
+func TestDecodePlistErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"empty", "", "missing <plist>"},
+		{"wrong root", "<html></html>", "want <plist>"},
+		{"bad real", "<plist><real>abc</real></plist>", "invalid <real>"},
+		{"value without key", "<plist><dict><string>x</string></dict></plist>", "want <key>"},
+		{"key without value", "<plist><dict><key>a</key></dict></plist>", `key "a" has no value`},
+		{"unknown element", "<plist><color/></plist>", "unexpected <color>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := decodePlist(strings.NewReader(tt.input))
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWritePlistUnsupported(t *testing.T) {
+	t.Parallel()
+
+	var sb strings.Builder
+	err := writePlist(&sb, plistDict{{"bad", struct{}{}}})
+	if err == nil || !strings.Contains(err.Error(), `key "bad"`) {
+		t.Errorf("writePlist() error = %v, want unsupported value for key bad", err)
+	}
+}
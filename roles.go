@@ -0,0 +1,209 @@
+package gothememe
+
+import (
+	"strings"
+	"unicode"
+)
+
+// colorRole describes a single color slot of a Theme. Each role is addressed
+// by a snake_case key that mirrors its CSS variable name, so "text_primary"
+// corresponds to --theme-text-primary and "success_background" to
+// --theme-success-background.
+type colorRole struct {
+	key string
+	get func(Theme) Color
+	set func(*ThemeBuilder, Color)
+}
+
+// colorRoles lists every color role in the same order as generateVariables.
+// Importers use it to apply colors by name and writers use it to emit every
+// role in a stable order.
+var colorRoles = []colorRole{
+	// Background colors
+	{"background", Theme.Background, func(b *ThemeBuilder, c Color) { b.theme.background = c }},
+	{"background_secondary", Theme.BackgroundSecondary, func(b *ThemeBuilder, c Color) { b.theme.backgroundSecondary = c }},
+	{"surface", Theme.Surface, func(b *ThemeBuilder, c Color) { b.theme.surface = c }},
+	{"surface_secondary", Theme.SurfaceSecondary, func(b *ThemeBuilder, c Color) { b.theme.surfaceSecondary = c }},
+
+	// Text colors
+	{"text_primary", Theme.TextPrimary, func(b *ThemeBuilder, c Color) { b.theme.textPrimary = c }},
+	{"text_secondary", Theme.TextSecondary, func(b *ThemeBuilder, c Color) { b.theme.textSecondary = c }},
+	{"text_muted", Theme.TextMuted, func(b *ThemeBuilder, c Color) { b.theme.textMuted = c }},
+	{"text_inverted", Theme.TextInverted, func(b *ThemeBuilder, c Color) { b.theme.textInverted = c }},
+
+	// Accent/Brand colors
+	{"accent", Theme.Accent, func(b *ThemeBuilder, c Color) { b.theme.accent = c }},
+	{"accent_secondary", Theme.AccentSecondary, func(b *ThemeBuilder, c Color) { b.theme.accentSecondary = c }},
+	{"brand", Theme.Brand, func(b *ThemeBuilder, c Color) { b.theme.brand = c }},
+
+	// Border colors
+	{"border", Theme.Border, func(b *ThemeBuilder, c Color) { b.theme.border = c }},
+	{"border_subtle", Theme.BorderSubtle, func(b *ThemeBuilder, c Color) { b.theme.borderSubtle = c }},
+	{"border_strong", Theme.BorderStrong, func(b *ThemeBuilder, c Color) { b.theme.borderStrong = c }},
+
+	// Semantic colors
+	{"success_background", func(t Theme) Color { return t.Success().Background }, func(b *ThemeBuilder, c Color) { b.theme.success.Background = c }},
+	{"success_border", func(t Theme) Color { return t.Success().Border }, func(b *ThemeBuilder, c Color) { b.theme.success.Border = c }},
+	{"success_text", func(t Theme) Color { return t.Success().Text }, func(b *ThemeBuilder, c Color) { b.theme.success.Text = c }},
+	{"warning_background", func(t Theme) Color { return t.Warning().Background }, func(b *ThemeBuilder, c Color) { b.theme.warning.Background = c }},
+	{"warning_border", func(t Theme) Color { return t.Warning().Border }, func(b *ThemeBuilder, c Color) { b.theme.warning.Border = c }},
+	{"warning_text", func(t Theme) Color { return t.Warning().Text }, func(b *ThemeBuilder, c Color) { b.theme.warning.Text = c }},
+	{"error_background", func(t Theme) Color { return t.Error().Background }, func(b *ThemeBuilder, c Color) { b.theme.errorColor.Background = c }},
+	{"error_border", func(t Theme) Color { return t.Error().Border }, func(b *ThemeBuilder, c Color) { b.theme.errorColor.Border = c }},
+	{"error_text", func(t Theme) Color { return t.Error().Text }, func(b *ThemeBuilder, c Color) { b.theme.errorColor.Text = c }},
+	{"info_background", func(t Theme) Color { return t.Info().Background }, func(b *ThemeBuilder, c Color) { b.theme.info.Background = c }},
+	{"info_border", func(t Theme) Color { return t.Info().Border }, func(b *ThemeBuilder, c Color) { b.theme.info.Border = c }},
+	{"info_text", func(t Theme) Color { return t.Info().Text }, func(b *ThemeBuilder, c Color) { b.theme.info.Text = c }},
+
+	// ANSI colors
+	{"black", Theme.Black, func(b *ThemeBuilder, c Color) { b.theme.black = c }},
+	{"red", Theme.Red, func(b *ThemeBuilder, c Color) { b.theme.red = c }},
+	{"green", Theme.Green, func(b *ThemeBuilder, c Color) { b.theme.green = c }},
+	{"yellow", Theme.Yellow, func(b *ThemeBuilder, c Color) { b.theme.yellow = c }},
+	{"blue", Theme.Blue, func(b *ThemeBuilder, c Color) { b.theme.blue = c }},
+	{"purple", Theme.Purple, func(b *ThemeBuilder, c Color) { b.theme.purple = c }},
+	{"cyan", Theme.Cyan, func(b *ThemeBuilder, c Color) { b.theme.cyan = c }},
+	{"white", Theme.White, func(b *ThemeBuilder, c Color) { b.theme.white = c }},
+	{"bright_black", Theme.BrightBlack, func(b *ThemeBuilder, c Color) { b.theme.brightBlack = c }},
+	{"bright_red", Theme.BrightRed, func(b *ThemeBuilder, c Color) { b.theme.brightRed = c }},
+	{"bright_green", Theme.BrightGreen, func(b *ThemeBuilder, c Color) { b.theme.brightGreen = c }},
+	{"bright_yellow", Theme.BrightYellow, func(b *ThemeBuilder, c Color) { b.theme.brightYellow = c }},
+	{"bright_blue", Theme.BrightBlue, func(b *ThemeBuilder, c Color) { b.theme.brightBlue = c }},
+	{"bright_purple", Theme.BrightPurple, func(b *ThemeBuilder, c Color) { b.theme.brightPurple = c }},
+	{"bright_cyan", Theme.BrightCyan, func(b *ThemeBuilder, c Color) { b.theme.brightCyan = c }},
+	{"bright_white", Theme.BrightWhite, func(b *ThemeBuilder, c Color) { b.theme.brightWhite = c }},
+
+	// Code colors
+	{"code_background", Theme.CodeBackground, func(b *ThemeBuilder, c Color) { b.theme.codeBackground = c }},
+	{"code_text", Theme.CodeText, func(b *ThemeBuilder, c Color) { b.theme.codeText = c }},
+	{"code_comment", Theme.CodeComment, func(b *ThemeBuilder, c Color) { b.theme.codeComment = c }},
+	{"code_keyword", Theme.CodeKeyword, func(b *ThemeBuilder, c Color) { b.theme.codeKeyword = c }},
+	{"code_string", Theme.CodeString, func(b *ThemeBuilder, c Color) { b.theme.codeString = c }},
+	{"code_number", Theme.CodeNumber, func(b *ThemeBuilder, c Color) { b.theme.codeNumber = c }},
+	{"code_function", Theme.CodeFunction, func(b *ThemeBuilder, c Color) { b.theme.codeFunction = c }},
+	{"code_operator", Theme.CodeOperator, func(b *ThemeBuilder, c Color) { b.theme.codeOperator = c }},
+	{"code_punctuation", Theme.CodePunctuation, func(b *ThemeBuilder, c Color) { b.theme.codePunctuation = c }},
+	{"code_variable", Theme.CodeVariable, func(b *ThemeBuilder, c Color) { b.theme.codeVariable = c }},
+	{"code_constant", Theme.CodeConstant, func(b *ThemeBuilder, c Color) { b.theme.codeConstant = c }},
+	{"code_type", Theme.CodeType, func(b *ThemeBuilder, c Color) { b.theme.codeType = c }},
+}
+
+// colorRoleIndex maps normalized role keys to their position in colorRoles.
+var colorRoleIndex = func() map[string]int {
+	index := make(map[string]int, len(colorRoles))
+	for i, r := range colorRoles {
+		index[r.key] = i
+	}
+	return index
+}()
+
+// lookupColorRole finds a role by key. Keys are matched case-insensitively and
+// hyphens are treated as underscores, so "text-primary" and "Text_Primary"
+// both resolve to the "text_primary" role.
+func lookupColorRole(key string) (colorRole, bool) {
+	i, ok := colorRoleIndex[normalizeRoleKey(key)]
+	if !ok {
+		return colorRole{}, false
+	}
+	return colorRoles[i], true
+}
+
+// normalizeRoleKey converts a role name to its canonical snake_case form.
+func normalizeRoleKey(key string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(key)), "-", "_")
+}
+
+// roleAliases maps roles that commonly repeat another role to that role,
+// following the colors [ThemeBuilder] copies when deriving a theme: brand
+// repeats the accent, success_text the ANSI green and code_keyword the ANSI
+// purple. Formats with references write such a role as an alias of its
+// source when a theme gives both the same color.
+var roleAliases = map[string]string{
+	"text_inverted":    "background",
+	"brand":            "accent",
+	"success_text":     "green",
+	"warning_text":     "yellow",
+	"error_text":       "red",
+	"info_text":        "blue",
+	"code_text":        "text_primary",
+	"code_comment":     "text_muted",
+	"code_keyword":     "purple",
+	"code_string":      "green",
+	"code_number":      "yellow",
+	"code_function":    "blue",
+	"code_operator":    "cyan",
+	"code_punctuation": "text_secondary",
+	"code_variable":    "text_primary",
+	"code_constant":    "yellow",
+	"code_type":        "cyan",
+}
+
+// roleAlias returns the role that key repeats in t, when roleAliases lists
+// one and t gives both the same color.
+func roleAlias(t Theme, key string) (string, bool) {
+	source, ok := roleAliases[key]
+	if !ok {
+		return "", false
+	}
+	role, _ := lookupColorRole(key)      //nolint:errcheck // roleAliases holds valid role keys
+	target, _ := lookupColorRole(source) //nolint:errcheck // roleAliases holds valid role keys
+	c := role.get(t)
+	if c.IsEmpty() || c.Hex() != target.get(t).Hex() {
+		return "", false
+	}
+	return source, true
+}
+
+// colorRoleCategory returns the category of a role key, following the
+// grouping of the Theme interface: "Background", "Text", "Accent",
+// "Border", "Semantic", "ANSI" or "Code".
+func colorRoleCategory(key string) string {
+	switch {
+	case strings.HasPrefix(key, "code_"):
+		return "Code"
+	case strings.HasPrefix(key, "background"), strings.HasPrefix(key, "surface"):
+		return "Background"
+	case strings.HasPrefix(key, "text_"):
+		return "Text"
+	case strings.HasPrefix(key, "accent"), key == "brand":
+		return "Accent"
+	case strings.HasPrefix(key, "border"):
+		return "Border"
+	case strings.HasPrefix(key, "success_"), strings.HasPrefix(key, "warning_"),
+		strings.HasPrefix(key, "error_"), strings.HasPrefix(key, "info_"):
+		return "Semantic"
+	default:
+		return "ANSI"
+	}
+}
+
+// pascalCase converts a role key or theme ID to a PascalCase identifier for
+// generated source code, such as "text_primary" to "TextPrimary". Any rune
+// other than a letter or digit separates words, and a leading digit is
+// prefixed with "Theme" so the result is a valid identifier.
+func pascalCase(s string) string {
+	var sb strings.Builder
+	for _, word := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		r := []rune(word)
+		sb.WriteString(string(unicode.ToUpper(r[0])) + string(r[1:]))
+	}
+	name := sb.String()
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "Theme" + name
+	}
+	return name
+}
+
+// ColorRoles returns the keys of every color role a Theme exposes, in the
+// same order used for CSS output. Keys are snake_case versions of the CSS
+// variable names (e.g. "text_primary", "success_background", "code_keyword")
+// and are the names accepted by theme files and importers.
+func ColorRoles() []string {
+	keys := make([]string, len(colorRoles))
+	for i, r := range colorRoles {
+		keys[i] = r.key
+	}
+	return keys
+}
@@ -0,0 +1,157 @@
+package gothememe
+
+import (
+	"testing"
+)
+
+// fullTestScheme is Catppuccin Mocha as a Windows Terminal scheme, which
+// sets every color role.
+const fullTestScheme = `{
+	"name": "Catppuccin Mocha",
+	"cursorColor": "#F5E0DC",
+	"selectionBackground": "#585B70",
+	"background": "#1E1E2E",
+	"foreground": "#CDD6F4",
+	"black": "#45475A",
+	"red": "#F38BA8",
+	"green": "#A6E3A1",
+	"yellow": "#F9E2AF",
+	"blue": "#89B4FA",
+	"purple": "#F5C2E7",
+	"cyan": "#94E2D5",
+	"white": "#BAC2DE",
+	"brightBlack": "#585B70",
+	"brightRed": "#F38BA8",
+	"brightGreen": "#A6E3A1",
+	"brightYellow": "#F9E2AF",
+	"brightBlue": "#89B4FA",
+	"brightPurple": "#F5C2E7",
+	"brightCyan": "#94E2D5",
+	"brightWhite": "#A6ADC8"
+}`
+
+// fullTestTheme returns a theme with every color role set.
+func fullTestTheme(t *testing.T) Theme {
+	t.Helper()
+	theme, err := FromWindowsTerminal([]byte(fullTestScheme))
+	if err != nil {
+		t.Fatalf("FromWindowsTerminal() error: %v", err)
+	}
+	for _, role := range colorRoles {
+		if role.get(theme).IsEmpty() {
+			t.Fatalf("test theme leaves %s empty", role.key)
+		}
+	}
+	return theme
+}
+
+// assertSameColors fails the test if any color role differs between want and got.
+func assertSameColors(t *testing.T, want, got Theme) {
+	t.Helper()
+	for _, role := range colorRoles {
+		if w, g := role.get(want).Hex(), role.get(got).Hex(); w != g {
+			t.Errorf("%s = %q, want %q", role.key, g, w)
+		}
+	}
+}
+
+func TestColorRolesMatchCSSVariables(t *testing.T) {
+	t.Parallel()
+
+	theme := NewThemeBuilder("roles", "Roles").
+		WithBackground(Hex("#282a36")).
+		WithTextPrimary(Hex("#f8f8f2")).
+		Build()
+
+	vars := generateVariables(theme, CSSOptions{})
+	if len(vars) != len(colorRoles) {
+		t.Fatalf("len(colorRoles) = %d, want %d CSS variables", len(colorRoles), len(vars))
+	}
+	for i, v := range vars {
+		if want := normalizeRoleKey(v.name); colorRoles[i].key != want {
+			t.Errorf("colorRoles[%d].key = %q, want %q", i, colorRoles[i].key, want)
+		}
+		got := colorRoles[i].get(theme).Hex()
+		if got == "" {
+			got = "transparent"
+		}
+		if got != v.value {
+			t.Errorf("colorRoles[%d] (%s) getter = %q, want %q", i, colorRoles[i].key, got, v.value)
+		}
+	}
+}
+
+func TestColorRolesSetters(t *testing.T) {
+	t.Parallel()
+
+	for i, role := range colorRoles {
+		b := NewThemeBuilder("set", "Set")
+		c := RGB(uint8(i), 0x42, 0x24)
+		role.set(b, c)
+		if got := role.get(b.theme); got != c {
+			t.Errorf("%s: setter/getter mismatch: got %q, want %q", role.key, got.Hex(), c.Hex())
+		}
+	}
+}
+
+func TestLookupColorRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		want  string
+		ok    bool
+	}{
+		{"background", "background", true},
+		{"text-primary", "text_primary", true},
+		{" Code_Keyword ", "code_keyword", true},
+		{"success-background", "success_background", true},
+		{"nonexistent", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			role, ok := lookupColorRole(tt.input)
+			if ok != tt.ok || role.key != tt.want {
+				t.Errorf("lookupColorRole(%q) = (%q, %v), want (%q, %v)", tt.input, role.key, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestColorRoles(t *testing.T) {
+	t.Parallel()
+
+	keys := ColorRoles()
+	if len(keys) != len(colorRoles) {
+		t.Fatalf("ColorRoles() returned %d keys, want %d", len(keys), len(colorRoles))
+	}
+	keys[0] = "modified"
+	if ColorRoles()[0] != "background" {
+		t.Error("ColorRoles() should return a copy")
+	}
+}
+
+func TestColorRoleCategory(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"background":        "Background",
+		"surface_secondary": "Background",
+		"text_muted":        "Text",
+		"brand":             "Accent",
+		"accent_secondary":  "Accent",
+		"border_strong":     "Border",
+		"error_background":  "Semantic",
+		"info_text":         "Semantic",
+		"bright_white":      "ANSI",
+		"code_background":   "Code",
+		"code_text":         "Code",
+	}
+	for key, want := range tests {
+		if got := colorRoleCategory(key); got != want {
+			t.Errorf("colorRoleCategory(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
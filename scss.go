@@ -0,0 +1,89 @@
+package gothememe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// GenerateSCSSMap generates a Sass module for several themes: a $themes map
+// of each theme's colors keyed by theme ID and variable name, such as
+// map.get($themes, "dracula", "text-primary"), a theme($id) mixin that
+// writes a theme's custom properties, a theme-color($role, $id: null)
+// function and an @each loop that writes a [data-theme] block per theme.
+// theme-color returns a var() reference to the role's custom property, such
+// as var(--theme-accent), or the color itself when given a theme ID, for
+// Sass color functions. Roles a theme leaves empty are transparent, as in
+// its CSS. The output needs Dart Sass for the sass:map module.
+func GenerateSCSSMap(themes []Theme, opts CSSOptions) (string, error) {
+	if opts.Prefix == "" {
+		opts.Prefix = "theme"
+	}
+	themes, err := uniqueThemes(themes)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("// Generated by gothememe. Do not edit.\n\n@use \"sass:map\";\n\n$themes: (\n")
+	for _, t := range themes {
+		if opts.IncludeMetadata {
+			fmt.Fprintf(&sb, "  // %s\n", t.DisplayName())
+		}
+		fmt.Fprintf(&sb, "  %q: (\n", t.ID())
+		for _, v := range generateVariables(t, opts) {
+			fmt.Fprintf(&sb, "    %q: %s,\n", v.name, v.value)
+		}
+		sb.WriteString("  ),\n")
+	}
+	sb.WriteString(");\n")
+
+	fmt.Fprintf(&sb, `
+// Writes the custom properties of the theme $id.
+@mixin theme($id) {
+  @if not map.has-key($themes, $id) {
+    @error "Unknown theme #{$id}.";
+  }
+  @each $role, $color in map.get($themes, $id) {
+    --%[1]s-#{$role}: #{$color};
+  }
+}
+
+// Returns a reference to the custom property of $role, such as
+// var(--%[1]s-accent), or its color in the theme $id when given.
+@function theme-color($role, $id: null) {
+  @if not map.has-key($themes, %[2]q, $role) {
+    @error "Unknown theme color #{$role}.";
+  }
+  @if $id {
+    @if not map.has-key($themes, $id) {
+      @error "Unknown theme #{$id}.";
+    }
+    @return map.get($themes, $id, $role);
+  }
+  @return var(--%[1]s-#{$role});
+}
+
+@each $id, $colors in $themes {
+  [data-theme="#{$id}"] {
+    @include theme($id);
+  }
+}
+`, opts.Prefix, themes[0].ID())
+	return sb.String(), nil
+}
+
+// exportSCSS writes the SCSS variables of a single theme, or the Sass map
+// module of several.
+func exportSCSS(w io.Writer, themes []Theme, opts ExportOptions) error {
+	if len(themes) == 1 {
+		_, err := io.WriteString(w, GenerateSCSS(themes[0], opts.CSS))
+		return err
+	}
+	scss, err := GenerateSCSSMap(themes, opts.CSS)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, scss)
+	return err
+}
@@ -0,0 +1,71 @@
+package gothememe
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGenerateSCSSMap(t *testing.T) {
+	t.Parallel()
+
+	dark, light := figmaTestThemes(t)
+	scss, err := GenerateSCSSMap([]Theme{dark, light}, CSSOptions{Prefix: "ui", IncludeMetadata: true})
+	if err != nil {
+		t.Fatalf("GenerateSCSSMap() error: %v", err)
+	}
+
+	for _, want := range []string{
+		"@use \"sass:map\";\n",
+		"$themes: (\n  // Catppuccin Mocha\n  \"catppuccin_mocha\": (\n    \"background\": #1e1e2e,\n",
+		"    \"success-background\": #a6e3a119,\n",
+		"  // Paper\n  \"paper\": (\n    \"background\": #fdf6e3,\n",
+		"    \"red\": transparent,\n",
+		"@mixin theme($id) {\n",
+		"    --ui-#{$role}: #{$color};\n",
+		"@function theme-color($role, $id: null) {\n",
+		"  @if not map.has-key($themes, \"catppuccin_mocha\", $role) {\n",
+		"    @return map.get($themes, $id, $role);\n",
+		"  @return var(--ui-#{$role});\n",
+		"@each $id, $colors in $themes {\n  [data-theme=\"#{$id}\"] {\n    @include theme($id);\n  }\n}\n",
+	} {
+		if !strings.Contains(scss, want) {
+			t.Errorf("GenerateSCSSMap() missing %q", want)
+		}
+	}
+
+	// Every theme maps every variable once.
+	for _, v := range generateVariables(dark, CSSOptions{}) {
+		if got := strings.Count(scss, "    \""+v.name+"\": "); got != 2 {
+			t.Errorf("%q is mapped by %d themes, want 2", v.name, got)
+		}
+	}
+	if strings.Count(scss, "(") != strings.Count(scss, ")") || strings.Count(scss, "{") != strings.Count(scss, "}") {
+		t.Error("GenerateSCSSMap() output is unbalanced")
+	}
+}
+
+func TestGenerateSCSSMapOptions(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	scss, err := GenerateSCSSMap([]Theme{nil, theme}, CSSOptions{ColorSpace: ColorSpaceRGB})
+	if err != nil {
+		t.Fatalf("GenerateSCSSMap() error: %v", err)
+	}
+	for _, want := range []string{"    \"background\": rgb(30, 30, 46),\n", "  @return var(--theme-#{$role});\n"} {
+		if !strings.Contains(scss, want) {
+			t.Errorf("GenerateSCSSMap() missing %q", want)
+		}
+	}
+	if strings.Contains(scss, "// Catppuccin Mocha") {
+		t.Error("GenerateSCSSMap() wrote metadata without IncludeMetadata")
+	}
+
+	if _, err := GenerateSCSSMap(nil, CSSOptions{}); !errors.Is(err, ErrNoThemes) {
+		t.Errorf("GenerateSCSSMap(nil) error = %v, want ErrNoThemes", err)
+	}
+	if _, err := GenerateSCSSMap([]Theme{theme, theme}, CSSOptions{}); err == nil {
+		t.Error("GenerateSCSSMap() should reject duplicate theme IDs")
+	}
+}
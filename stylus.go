@@ -0,0 +1,109 @@
+package gothememe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// GenerateStylus generates Stylus variables from a theme, such as
+// theme-text-primary.
+func GenerateStylus(t Theme, opts CSSOptions) string {
+	if opts.Prefix == "" {
+		opts.Prefix = "theme"
+	}
+
+	var sb strings.Builder
+
+	// Add metadata comment
+	if opts.IncludeMetadata && !opts.Minify {
+		sb.WriteString(fmt.Sprintf("// Theme: %s (%s)\n", t.DisplayName(), t.ID()))
+		if t.Author() != "" {
+			sb.WriteString(fmt.Sprintf("// Author: %s\n", t.Author()))
+		}
+		sb.WriteString("\n")
+	}
+
+	vars := generateVariables(t, opts)
+	for _, v := range vars {
+		if opts.Minify {
+			sb.WriteString(fmt.Sprintf("%s-%s=%s;", opts.Prefix, v.name, v.value))
+		} else {
+			sb.WriteString(fmt.Sprintf("%s-%s = %s\n", opts.Prefix, v.name, v.value))
+		}
+	}
+
+	return sb.String()
+}
+
+// GenerateStylusMap generates Stylus for several themes: a themes hash of
+// each theme's colors keyed by theme ID and variable name, a theme(id)
+// mixin that writes a theme's custom properties, a theme-color(role, id)
+// function and a loop that writes a [data-theme] block per theme.
+// theme-color returns a var() reference to the role's custom property, such
+// as var(--theme-accent), or the color itself when given a theme ID, for
+// Stylus color functions. Roles a theme leaves empty are transparent, as in
+// its CSS. Stylus is indentation-based, so Minify is ignored.
+func GenerateStylusMap(themes []Theme, opts CSSOptions) (string, error) {
+	if opts.Prefix == "" {
+		opts.Prefix = "theme"
+	}
+	themes, err := uniqueThemes(themes)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("// Generated by gothememe. Do not edit.\n\nthemes = {\n")
+	for _, t := range themes {
+		if opts.IncludeMetadata {
+			fmt.Fprintf(&sb, "  // %s\n", t.DisplayName())
+		}
+		fmt.Fprintf(&sb, "  %q: {\n", t.ID())
+		for _, v := range generateVariables(t, opts) {
+			fmt.Fprintf(&sb, "    %q: %s\n", v.name, v.value)
+		}
+		sb.WriteString("  }\n")
+	}
+	sb.WriteString("}\n")
+
+	fmt.Fprintf(&sb, `
+// Writes the custom properties of the theme id.
+theme(id)
+  unless id in themes
+    error('Unknown theme ' + id)
+  for role, color in themes[id]
+    --%[1]s-{role}: color
+
+// Returns a reference to the custom property of role, such as
+// var(--%[1]s-accent), or its color in the theme id when given.
+theme-color(role, id = null)
+  unless role in themes[%[2]q]
+    error('Unknown theme color ' + role)
+  if id
+    unless id in themes
+      error('Unknown theme ' + id)
+    return themes[id][role]
+  unquote('var(--%[1]s-' + role + ')')
+
+for id, colors in themes
+  [data-theme="{id}"]
+    theme(id)
+`, opts.Prefix, themes[0].ID())
+	return sb.String(), nil
+}
+
+// exportStylus writes the Stylus variables of a single theme, or the
+// themes hash of several.
+func exportStylus(w io.Writer, themes []Theme, opts ExportOptions) error {
+	if len(themes) == 1 {
+		_, err := io.WriteString(w, GenerateStylus(themes[0], opts.CSS))
+		return err
+	}
+	styl, err := GenerateStylusMap(themes, opts.CSS)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, styl)
+	return err
+}
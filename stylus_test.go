@@ -0,0 +1,131 @@
+package gothememe
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGenerateStylus(t *testing.T) {
+	t.Parallel()
+
+	theme := NewThemeBuilder("test", "Test Theme").
+		WithIsDark(true).
+		WithBackground(Hex("#282a36")).
+		WithTextPrimary(Hex("#f8f8f2")).
+		WithAuthor("Jane").
+		Build()
+
+	tests := []struct {
+		name     string
+		opts     CSSOptions
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "defaults",
+			opts:     CSSOptions{},
+			contains: []string{"theme-background = #282a36\n", "theme-text-primary = #f8f8f2\n"},
+			excludes: []string{"// Theme:", ";"},
+		},
+		{
+			name:     "with metadata",
+			opts:     CSSOptions{Prefix: "app", IncludeMetadata: true},
+			contains: []string{"// Theme: Test Theme (test)\n// Author: Jane\n\n", "app-background = #282a36\n"},
+		},
+		{
+			name:     "hsl",
+			opts:     CSSOptions{ColorSpace: ColorSpaceHSL},
+			contains: []string{"theme-background = hsl("},
+		},
+		{
+			name:     "minified",
+			opts:     CSSOptions{Minify: true, IncludeMetadata: true},
+			contains: []string{"theme-background=#282a36;theme-background-secondary="},
+			excludes: []string{"\n", "//"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			styl := GenerateStylus(theme, tt.opts)
+			for _, want := range tt.contains {
+				if !strings.Contains(styl, want) {
+					t.Errorf("GenerateStylus() missing %q\nGot:\n%s", want, styl)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(styl, unwanted) {
+					t.Errorf("GenerateStylus() contains %q\nGot:\n%s", unwanted, styl)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateStylusMap(t *testing.T) {
+	t.Parallel()
+
+	dark, light := figmaTestThemes(t)
+	styl, err := GenerateStylusMap([]Theme{dark, light}, CSSOptions{Prefix: "ui", IncludeMetadata: true})
+	if err != nil {
+		t.Fatalf("GenerateStylusMap() error: %v", err)
+	}
+	for _, want := range []string{
+		"themes = {\n  // Catppuccin Mocha\n  \"catppuccin_mocha\": {\n    \"background\": #1e1e2e\n",
+		"    \"success-background\": #a6e3a119\n",
+		"  // Paper\n  \"paper\": {\n    \"background\": #fdf6e3\n",
+		"    \"red\": transparent\n",
+		"theme(id)\n",
+		"    --ui-{role}: color\n",
+		"theme-color(role, id = null)\n  unless role in themes[\"catppuccin_mocha\"]\n",
+		"    return themes[id][role]\n",
+		"  unquote('var(--ui-' + role + ')')\n",
+		"for id, colors in themes\n  [data-theme=\"{id}\"]\n    theme(id)\n",
+	} {
+		if !strings.Contains(styl, want) {
+			t.Errorf("GenerateStylusMap() missing %q", want)
+		}
+	}
+
+	// Every theme maps every variable once.
+	for _, v := range generateVariables(dark, CSSOptions{}) {
+		if got := strings.Count(styl, "    \""+v.name+"\": "); got != 2 {
+			t.Errorf("%q is mapped by %d themes, want 2", v.name, got)
+		}
+	}
+
+	if _, err := GenerateStylusMap([]Theme{nil}, CSSOptions{}); !errors.Is(err, ErrNoThemes) {
+		t.Errorf("GenerateStylusMap(nil) error = %v, want ErrNoThemes", err)
+	}
+}
+
+func TestExportStylus(t *testing.T) {
+	t.Parallel()
+
+	dark, light := figmaTestThemes(t)
+	if got, err := OutputFormatFromPath("styles/theme.styl"); err != nil || got != FormatStylus {
+		t.Errorf("OutputFormatFromPath(.styl) = %v, %v", got, err)
+	}
+
+	var single strings.Builder
+	if err := Export(&single, FormatStylus, dark); err != nil {
+		t.Fatalf("Export(stylus) error: %v", err)
+	}
+	if single.String() != GenerateStylus(dark, DefaultCSSOptions()) {
+		t.Error("Export(stylus) with one theme should write its variables")
+	}
+
+	var multi strings.Builder
+	if err := Export(&multi, FormatStylus, dark, light); err != nil {
+		t.Fatalf("Export(stylus) error: %v", err)
+	}
+	want, err := GenerateStylusMap([]Theme{dark, light}, DefaultCSSOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if multi.String() != want {
+		t.Error("Export(stylus) with two themes should write the themes hash")
+	}
+}
@@ -0,0 +1,262 @@
+package gothememe
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"unicode/utf16"
+)
+
+// paletteEntry is one named color of a palette file.
+type paletteEntry struct {
+	name     string
+	category string
+	c        Color
+}
+
+// paletteEntries returns an entry for every role t sets, named after the role
+// key, in role order. Formats without an alpha channel get the colors
+// composited over the background.
+func paletteEntries(t Theme, opaque bool) []paletteEntry {
+	var entries []paletteEntry
+	for _, role := range colorRoles {
+		c := role.get(t)
+		if c.IsEmpty() {
+			continue
+		}
+		if opaque {
+			c = c.opaque(t.Background())
+		}
+		entries = append(entries, paletteEntry{role.key, colorRoleCategory(role.key), c})
+	}
+	return entries
+}
+
+// WriteGIMPPalette writes t to w as a GIMP .gpl palette, which GIMP,
+// Inkscape, Krita and Aseprite read. Each entry is named after its role.
+// The format has no alpha channel, so translucent colors are composited
+// over the background.
+func WriteGIMPPalette(w io.Writer, t Theme) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "GIMP Palette\nName: %s\nColumns: 8\n#\n", t.DisplayName())
+	for _, s := range paletteEntries(t, true) {
+		r, g, b := s.c.RGB()
+		fmt.Fprintf(&sb, "%3d %3d %3d\t%s\n", r, g, b, s.name)
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// ASE block types.
+const (
+	aseGroupStart uint16 = 0xC001
+	aseGroupEnd   uint16 = 0xC002
+	aseColorEntry uint16 = 0x0001
+
+	// aseNormalColor is the color type of a process color, as opposed to a
+	// global or spot color.
+	aseNormalColor uint16 = 2
+)
+
+// WriteASE writes t to w as a binary Adobe Swatch Exchange file for
+// Photoshop, Illustrator and InDesign. The swatches are grouped by role
+// category, such as Text and Semantic, and named after their role. The
+// format has no alpha channel, so translucent colors are composited over the
+// background.
+func WriteASE(w io.Writer, t Theme) error {
+	var (
+		blocks []byte
+		count  uint32
+	)
+	block := func(kind uint16, body []byte) {
+		blocks = binary.BigEndian.AppendUint16(blocks, kind)
+		blocks = binary.BigEndian.AppendUint32(blocks, uint32(len(body))) //nolint:gosec // G115: blocks are a few bytes long
+		blocks = append(blocks, body...)
+		count++
+	}
+
+	swatches := paletteEntries(t, true)
+	for i, s := range swatches {
+		if i == 0 || s.category != swatches[i-1].category {
+			if i > 0 {
+				block(aseGroupEnd, nil)
+			}
+			block(aseGroupStart, aseName(s.category))
+		}
+		r, g, b := s.c.RGB()
+		body := append(aseName(s.name), "RGB "...)
+		for _, v := range []uint8{r, g, b} {
+			body = binary.BigEndian.AppendUint32(body, math.Float32bits(float32(v)/255))
+		}
+		block(aseColorEntry, binary.BigEndian.AppendUint16(body, aseNormalColor))
+	}
+	if len(swatches) > 0 {
+		block(aseGroupEnd, nil)
+	}
+
+	out := []byte("ASEF")
+	out = binary.BigEndian.AppendUint16(out, 1) // version 1.0
+	out = binary.BigEndian.AppendUint16(out, 0)
+	out = binary.BigEndian.AppendUint32(out, count)
+	_, err := w.Write(append(out, blocks...))
+	return err
+}
+
+// aseName encodes s as an ASE name: its length in UTF-16 code units,
+// including the terminator, followed by the null-terminated big-endian
+// UTF-16 text.
+func aseName(s string) []byte {
+	units := append(utf16.Encode([]rune(s)), 0)
+	buf := binary.BigEndian.AppendUint16(nil, uint16(len(units))) //nolint:gosec // G115: names are short
+	for _, u := range units {
+		buf = binary.BigEndian.AppendUint16(buf, u)
+	}
+	return buf
+}
+
+// sketchPalette is the shape of a .sketchpalette file of the Sketch Palettes
+// plugin.
+type sketchPalette struct {
+	CompatibleVersion string        `json:"compatibleVersion"`
+	PluginVersion     string        `json:"pluginVersion"`
+	Colors            []sketchColor `json:"colors"`
+}
+
+// sketchColor is one color of a Sketch palette, with components from 0 to 1.
+type sketchColor struct {
+	Name  string  `json:"name"`
+	Red   float64 `json:"red"`
+	Green float64 `json:"green"`
+	Blue  float64 `json:"blue"`
+	Alpha float64 `json:"alpha"`
+}
+
+// WriteSketchPalette writes t to w as a .sketchpalette file, which the
+// Sketch Palettes plugin loads into Sketch's document colors. Each color is
+// named after its role and keeps its alpha.
+func WriteSketchPalette(w io.Writer, t Theme) error {
+	palette := sketchPalette{CompatibleVersion: "2.0", PluginVersion: "2.22"}
+	for _, s := range paletteEntries(t, false) {
+		r, g, b, a := s.c.RGBAComponents()
+		palette.Colors = append(palette.Colors, sketchColor{
+			Name:  s.name,
+			Red:   unitComponent(r),
+			Green: unitComponent(g),
+			Blue:  unitComponent(b),
+			Alpha: unitComponent(a),
+		})
+	}
+
+	data, err := json.MarshalIndent(palette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding Sketch palette: %w", err)
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// unitComponent converts a color byte to the range 0-1, rounded to four
+// decimals.
+func unitComponent(v uint8) float64 {
+	return math.Round(float64(v)/255*10000) / 10000
+}
+
+// procreateMaxSwatches is the number of swatches a Procreate palette holds.
+const procreateMaxSwatches = 30
+
+// procreatePalette is one palette of a Procreate Swatches.json.
+type procreatePalette struct {
+	Name     string            `json:"name"`
+	Swatches []procreateSwatch `json:"swatches"`
+}
+
+// procreateSwatch is one Procreate swatch, an HSB color with components
+// from 0 to 1.
+type procreateSwatch struct {
+	Hue        float64 `json:"hue"`
+	Saturation float64 `json:"saturation"`
+	Brightness float64 `json:"brightness"`
+	Alpha      float64 `json:"alpha"`
+	ColorSpace int     `json:"colorSpace"`
+}
+
+// WriteProcreateSwatches writes t to w as a Procreate .swatches file, a zip
+// archive holding the palette as Swatches.json. Procreate swatches have no
+// names and a palette holds 30 of them, so the palette holds the first 30
+// distinct colors in role order, starting with the backgrounds, text and
+// accents. Translucent colors are composited over the background.
+func WriteProcreateSwatches(w io.Writer, t Theme) error {
+	palette := procreatePalette{Name: t.DisplayName()}
+	seen := map[string]bool{}
+	for _, s := range paletteEntries(t, true) {
+		if seen[s.c.Hex()] || len(palette.Swatches) == procreateMaxSwatches {
+			continue
+		}
+		seen[s.c.Hex()] = true
+		h, sat, v := hsbValues(s.c)
+		palette.Swatches = append(palette.Swatches, procreateSwatch{
+			Hue:        h,
+			Saturation: sat,
+			Brightness: v,
+			Alpha:      1,
+		})
+	}
+
+	data, err := json.Marshal([]procreatePalette{palette})
+	if err != nil {
+		return fmt.Errorf("encoding Procreate swatches: %w", err)
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	// A fixed header keeps the archive free of timestamps, so regenerating
+	// an unchanged theme produces the same file.
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: "Swatches.json", Method: zip.Deflate})
+	if err != nil {
+		return fmt.Errorf("writing Procreate swatches: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		return fmt.Errorf("writing Procreate swatches: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("writing Procreate swatches: %w", err)
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// hsbValues returns the hue, saturation and brightness of c, each from 0
+// to 1, rounded to four decimals.
+func hsbValues(c Color) (h, s, v float64) {
+	r8, g8, b8 := c.RGB()
+	r, g, b := float64(r8)/255, float64(g8)/255, float64(b8)/255
+	maxC, minC := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	delta := maxC - minC
+
+	v = maxC
+	if maxC > 0 {
+		s = delta / maxC
+	}
+	switch {
+	case delta == 0:
+		h = 0
+	case maxC == r:
+		h = math.Mod((g-b)/delta, 6)
+	case maxC == g:
+		h = (b-r)/delta + 2
+	default:
+		h = (r-g)/delta + 4
+	}
+	h /= 6
+	if h < 0 {
+		h++
+	}
+
+	round := func(x float64) float64 { return math.Round(x*10000) / 10000 }
+	return round(h), round(s), round(v)
+}
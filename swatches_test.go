@@ -0,0 +1,275 @@
+package gothememe
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+func TestWriteGIMPPalette(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	var sb strings.Builder
+	if err := WriteGIMPPalette(&sb, theme); err != nil {
+		t.Fatalf("WriteGIMPPalette() error: %v", err)
+	}
+	out := sb.String()
+	for _, want := range []string{
+		"GIMP Palette\nName: Catppuccin Mocha\nColumns: 8\n#\n",
+		" 30  30  46\tbackground\n",
+		"205 214 244\ttext_primary\n",
+		// #a6e3a1 at 10% alpha over #1e1e2e.
+		" 43  49  57\tsuccess_background\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output lacks %q:\n%s", want, out)
+		}
+	}
+	if got := strings.Count(out, "\n") - 4; got != len(colorRoles) {
+		t.Errorf("got %d entries, want %d", got, len(colorRoles))
+	}
+}
+
+// aseBlock is a decoded ASE block.
+type aseBlock struct {
+	kind  uint16
+	name  string
+	model string
+	rgb   [3]float32
+}
+
+// readASE decodes the blocks of an ASE file.
+func readASE(t *testing.T, data []byte) []aseBlock {
+	t.Helper()
+
+	r := bytes.NewReader(data)
+	var header struct {
+		Signature [4]byte
+		Version   [2]uint16
+		Count     uint32
+	}
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		t.Fatalf("reading header: %v", err)
+	}
+	if string(header.Signature[:]) != "ASEF" || header.Version != [2]uint16{1, 0} {
+		t.Fatalf("bad header %+v", header)
+	}
+
+	var blocks []aseBlock
+	for range header.Count {
+		var head struct {
+			Kind   uint16
+			Length uint32
+		}
+		if err := binary.Read(r, binary.BigEndian, &head); err != nil {
+			t.Fatalf("reading block header: %v", err)
+		}
+		body := make([]byte, head.Length)
+		if _, err := io.ReadFull(r, body); err != nil {
+			t.Fatalf("reading block body: %v", err)
+		}
+		b := aseBlock{kind: head.Kind}
+		if len(body) > 0 {
+			n := int(binary.BigEndian.Uint16(body))
+			units := make([]uint16, n)
+			for i := range units {
+				units[i] = binary.BigEndian.Uint16(body[2+2*i:])
+			}
+			if units[n-1] != 0 {
+				t.Fatalf("name %v is not null-terminated", units)
+			}
+			b.name = string(utf16.Decode(units[:n-1]))
+			rest := body[2+2*n:]
+			if head.Kind == aseColorEntry {
+				b.model = string(rest[:4])
+				for i := range b.rgb {
+					b.rgb[i] = math.Float32frombits(binary.BigEndian.Uint32(rest[4+4*i:]))
+				}
+				if kind := binary.BigEndian.Uint16(rest[16:]); kind != aseNormalColor || len(rest) != 18 {
+					t.Fatalf("bad color entry %v", rest)
+				}
+			}
+		}
+		blocks = append(blocks, b)
+	}
+	if r.Len() != 0 {
+		t.Fatalf("%d trailing bytes", r.Len())
+	}
+	return blocks
+}
+
+func TestWriteASE(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	var buf bytes.Buffer
+	if err := WriteASE(&buf, theme); err != nil {
+		t.Fatalf("WriteASE() error: %v", err)
+	}
+	blocks := readASE(t, buf.Bytes())
+
+	var (
+		groups []string
+		open   bool
+		colors int
+	)
+	for _, b := range blocks {
+		switch b.kind {
+		case aseGroupStart:
+			if open {
+				t.Fatalf("group %q starts inside another group", b.name)
+			}
+			groups, open = append(groups, b.name), true
+		case aseGroupEnd:
+			open = false
+		case aseColorEntry:
+			if !open {
+				t.Fatalf("color %q is outside a group", b.name)
+			}
+			colors++
+		}
+	}
+	want := []string{"Background", "Text", "Accent", "Border", "Semantic", "ANSI", "Code"}
+	if strings.Join(groups, ",") != strings.Join(want, ",") {
+		t.Errorf("groups = %v, want %v", groups, want)
+	}
+	if colors != len(colorRoles) {
+		t.Errorf("got %d colors, want %d", colors, len(colorRoles))
+	}
+
+	first := blocks[1]
+	if first.name != "background" || first.model != "RGB " ||
+		first.rgb != [3]float32{30.0 / 255, 30.0 / 255, 46.0 / 255} {
+		t.Errorf("first color = %+v", first)
+	}
+}
+
+func TestWriteSketchPalette(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	var buf bytes.Buffer
+	if err := WriteSketchPalette(&buf, theme); err != nil {
+		t.Fatalf("WriteSketchPalette() error: %v", err)
+	}
+	var palette sketchPalette
+	if err := json.Unmarshal(buf.Bytes(), &palette); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if palette.CompatibleVersion != "2.0" || len(palette.Colors) != len(colorRoles) {
+		t.Fatalf("unexpected palette %+v", palette)
+	}
+	for _, c := range palette.Colors {
+		if c.Name == "success_background" {
+			want := sketchColor{"success_background", 0.651, 0.8902, 0.6314, 0.098}
+			if c != want {
+				t.Errorf("success_background = %+v, want %+v", c, want)
+			}
+		}
+	}
+}
+
+func TestWriteProcreateSwatches(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	var buf bytes.Buffer
+	if err := WriteProcreateSwatches(&buf, theme); err != nil {
+		t.Fatalf("WriteProcreateSwatches() error: %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("output is not a zip archive: %v", err)
+	}
+	if len(zr.File) != 1 || zr.File[0].Name != "Swatches.json" {
+		t.Fatalf("unexpected archive contents %v", zr.File)
+	}
+	f, err := zr.File[0].Open()
+	if err != nil {
+		t.Fatalf("opening Swatches.json: %v", err)
+	}
+	defer f.Close() //nolint:errcheck // closing a read-only zip entry can't lose data
+
+	var palettes []procreatePalette
+	if err := json.NewDecoder(f).Decode(&palettes); err != nil {
+		t.Fatalf("Swatches.json is not valid JSON: %v", err)
+	}
+	if len(palettes) != 1 || palettes[0].Name != "Catppuccin Mocha" {
+		t.Fatalf("unexpected palettes %+v", palettes)
+	}
+	swatches := palettes[0].Swatches
+	if len(swatches) == 0 || len(swatches) > procreateMaxSwatches {
+		t.Fatalf("got %d swatches", len(swatches))
+	}
+	want := procreateSwatch{Hue: 0.6667, Saturation: 0.3478, Brightness: 0.1804, Alpha: 1}
+	if swatches[0] != want {
+		t.Errorf("first swatch = %+v, want %+v", swatches[0], want)
+	}
+	seen := map[procreateSwatch]bool{}
+	for _, s := range swatches {
+		if seen[s] {
+			t.Errorf("duplicate swatch %+v", s)
+		}
+		seen[s] = true
+	}
+
+	// The archive is reproducible.
+	var again bytes.Buffer
+	if err := WriteProcreateSwatches(&again, theme); err != nil {
+		t.Fatalf("WriteProcreateSwatches() error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), again.Bytes()) {
+		t.Error("output differs between runs")
+	}
+}
+
+func TestHSBValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		hex     string
+		h, s, v float64
+	}{
+		{"#000000", 0, 0, 0},
+		{"#ffffff", 0, 0, 1},
+		{"#ff0000", 0, 1, 1},
+		{"#00ff00", 0.3333, 1, 1},
+		{"#0000ff", 0.6667, 1, 1},
+		{"#ff00ff", 0.8333, 1, 1},
+		{"#808080", 0, 0, 0.502},
+	}
+	for _, tt := range tests {
+		h, s, v := hsbValues(Hex(tt.hex))
+		if h != tt.h || s != tt.s || v != tt.v {
+			t.Errorf("hsbValues(%s) = %v, %v, %v, want %v, %v, %v", tt.hex, h, s, v, tt.h, tt.s, tt.v)
+		}
+	}
+}
+
+func TestExportSwatches(t *testing.T) {
+	t.Parallel()
+
+	for path, want := range map[string]OutputFormat{
+		"mocha.gpl":           FormatGIMP,
+		"mocha.ase":           FormatASE,
+		"mocha.sketchpalette": FormatSketch,
+		"mocha.swatches":      FormatProcreate,
+	} {
+		if got, err := OutputFormatFromPath(path); err != nil || got != want {
+			t.Errorf("OutputFormatFromPath(%q) = %s, %v, want %s", path, got, err, want)
+		}
+	}
+
+	theme := fullTestTheme(t)
+	var buf bytes.Buffer
+	if err := Export(&buf, FormatGIMP, theme, theme); err == nil {
+		t.Error("Export(gimp) with two themes should fail")
+	}
+}
@@ -0,0 +1,215 @@
+package gothememe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TailwindVersion selects the Tailwind CSS major version to generate for.
+type TailwindVersion int
+
+const (
+	// TailwindV4 generates an @theme block for Tailwind CSS v4.
+	TailwindV4 TailwindVersion = iota
+
+	// TailwindV3 generates a tailwind.config preset for Tailwind CSS v3.
+	TailwindV3
+)
+
+// TailwindOptions configures Tailwind output.
+type TailwindOptions struct {
+	// Version selects the Tailwind major version (default: TailwindV4).
+	Version TailwindVersion
+
+	// Prefix of the CSS variables the colors reference (default: "theme").
+	// It must match the Prefix the theme CSS was generated with.
+	Prefix string
+
+	// JSON writes the v3 colors as a JSON object for theme.extend instead
+	// of a JavaScript preset. It has no effect for v4.
+	JSON bool
+}
+
+// DefaultTailwindOptions returns sensible default Tailwind options.
+func DefaultTailwindOptions() TailwindOptions {
+	return TailwindOptions{
+		Version: TailwindV4,
+		Prefix:  "theme",
+	}
+}
+
+// tailwindColor is one color name Tailwind utilities accept and the role
+// variable it resolves to.
+type tailwindColor struct {
+	name string
+	role string
+}
+
+// tailwindColors returns the Tailwind colors in one utility namespace:
+// every role under its CSS variable name for the shared colors, and short
+// aliases such as text-muted and border-subtle for the text and border
+// utilities.
+func tailwindColors(namespace string) []tailwindColor {
+	switch namespace {
+	case "textColor":
+		return []tailwindColor{
+			{"primary", "text-primary"},
+			{"secondary", "text-secondary"},
+			{"muted", "text-muted"},
+			{"inverted", "text-inverted"},
+		}
+	case "borderColor":
+		return []tailwindColor{
+			{"DEFAULT", "border"},
+			{"subtle", "border-subtle"},
+			{"strong", "border-strong"},
+		}
+	default:
+		colors := make([]tailwindColor, len(colorRoles))
+		for i, role := range colorRoles {
+			name := strings.ReplaceAll(role.key, "_", "-")
+			colors[i] = tailwindColor{name, name}
+		}
+		return colors
+	}
+}
+
+// tailwindNamespaces pairs the v3 config keys with the v4 @theme variable
+// namespaces that hold the same colors.
+var tailwindNamespaces = []struct {
+	config string
+	theme  string
+}{
+	{"colors", "color"},
+	{"textColor", "text-color"},
+	{"borderColor", "border-color"},
+}
+
+// GenerateTailwind generates Tailwind CSS configuration whose colors
+// reference the theme's CSS custom properties, so utilities such as
+// bg-surface, text-muted, border-subtle and bg-error-background follow the
+// active [data-theme] with no rebuild. The output is the same for every
+// theme; pair it with [GenerateCSS] or [GenerateAllThemesCSS] using the same
+// prefix.
+//
+// For v4 the result is an "@theme inline" block. Inline theme variables make
+// utilities reference --theme-* directly, so a nested [data-theme] element
+// switches colors too. For v3 it is a preset for tailwind.config.js, with
+// color-mix() values so opacity modifiers like bg-surface/50 work.
+func GenerateTailwind(opts TailwindOptions) string {
+	if opts.Prefix == "" {
+		opts.Prefix = "theme"
+	}
+	if opts.Version == TailwindV3 {
+		return generateTailwindConfig(opts)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("@theme inline {\n")
+	for i, ns := range tailwindNamespaces {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		for _, c := range tailwindColors(ns.config) {
+			// v4 has no default border color variable; border-border
+			// covers it.
+			if c.name == "DEFAULT" {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("    --%s-%s: var(--%s-%s);\n", ns.theme, c.name, opts.Prefix, c.role))
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// generateTailwindConfig writes the v3 colors as a JavaScript preset or, with
+// opts.JSON, as a JSON theme.extend object.
+func generateTailwindConfig(opts TailwindOptions) string {
+	value := func(role string) string {
+		return fmt.Sprintf("color-mix(in srgb, var(--%s-%s) calc(<alpha-value> * 100%%), transparent)", opts.Prefix, role)
+	}
+
+	var sb strings.Builder
+	indent := "      "
+	if opts.JSON {
+		indent = ""
+		sb.WriteString("{\n")
+	} else {
+		sb.WriteString("// Tailwind CSS preset generated by gothememe. Add it to tailwind.config.js\n")
+		sb.WriteString("// with presets: [require(\"./gothememe.tailwind.js\")].\n")
+		sb.WriteString("/** @type {import('tailwindcss').Config} */\n")
+		sb.WriteString("module.exports = {\n  theme: {\n    extend: {\n")
+	}
+
+	for i, ns := range tailwindNamespaces {
+		sb.WriteString(fmt.Sprintf("%s  %s: {\n", indent, tailwindKey(ns.config, opts.JSON)))
+		colors := tailwindColors(ns.config)
+		for j, c := range colors {
+			comma := ","
+			if opts.JSON && j == len(colors)-1 {
+				comma = ""
+			}
+			sb.WriteString(fmt.Sprintf("%s    %s: %q%s\n", indent, tailwindKey(c.name, opts.JSON), value(c.role), comma))
+		}
+		comma := ","
+		if opts.JSON && i == len(tailwindNamespaces)-1 {
+			comma = ""
+		}
+		sb.WriteString(fmt.Sprintf("%s  }%s\n", indent, comma))
+	}
+
+	if opts.JSON {
+		sb.WriteString("}\n")
+	} else {
+		sb.WriteString("    },\n  },\n};\n")
+	}
+	return sb.String()
+}
+
+// tailwindKey quotes an object key for JSON, or for JavaScript when it is not
+// a plain identifier.
+func tailwindKey(key string, json bool) string {
+	if json || strings.Contains(key, "-") {
+		return fmt.Sprintf("%q", key)
+	}
+	return key
+}
+
+// tailwindPrefix returns the variable prefix for exported Tailwind output:
+// the Tailwind prefix, or the CSS prefix when it is empty.
+func tailwindPrefix(opts ExportOptions) string {
+	if opts.Tailwind.Prefix != "" {
+		return opts.Tailwind.Prefix
+	}
+	if opts.CSS.Prefix != "" {
+		return opts.CSS.Prefix
+	}
+	return "theme"
+}
+
+// exportTailwind writes a Tailwind v4 stylesheet: the @theme block followed
+// by the CSS custom properties of the themes.
+func exportTailwind(w io.Writer, themes []Theme, opts ExportOptions) error {
+	tw := opts.Tailwind
+	tw.Version = TailwindV4
+	tw.Prefix = tailwindPrefix(opts)
+	opts.CSS.Prefix = tw.Prefix
+
+	if _, err := io.WriteString(w, GenerateTailwind(tw)+"\n"); err != nil {
+		return err
+	}
+	return exportCSS(w, themes, opts)
+}
+
+// exportTailwindConfig writes the Tailwind v3 preset. Its colors don't depend
+// on the themes, whose CSS is written separately with [FormatCSS].
+func exportTailwindConfig(w io.Writer, _ []Theme, opts ExportOptions) error {
+	tw := opts.Tailwind
+	tw.Version = TailwindV3
+	tw.Prefix = tailwindPrefix(opts)
+
+	_, err := io.WriteString(w, GenerateTailwind(tw))
+	return err
+}
@@ -0,0 +1,134 @@
+package gothememe
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestGenerateTailwindV4(t *testing.T) {
+	t.Parallel()
+
+	css := GenerateTailwind(DefaultTailwindOptions())
+	if !strings.HasPrefix(css, "@theme inline {\n") || !strings.HasSuffix(css, "}\n") {
+		t.Errorf("GenerateTailwind() is not an @theme inline block:\n%s", css)
+	}
+	for _, want := range []string{
+		"--color-surface: var(--theme-surface);",
+		"--color-error-background: var(--theme-error-background);",
+		"--color-code-keyword: var(--theme-code-keyword);",
+		"--text-color-muted: var(--theme-text-muted);",
+		"--border-color-subtle: var(--theme-border-subtle);",
+	} {
+		if !strings.Contains(css, want) {
+			t.Errorf("GenerateTailwind() lacks %q", want)
+		}
+	}
+	if strings.Contains(css, "DEFAULT") {
+		t.Error("GenerateTailwind() v4 output contains DEFAULT")
+	}
+	if got, want := strings.Count(css, ": var(--theme-"), len(colorRoles)+6; got != want {
+		t.Errorf("GenerateTailwind() declares %d colors, want %d", got, want)
+	}
+}
+
+func TestGenerateTailwindV3(t *testing.T) {
+	t.Parallel()
+
+	js := GenerateTailwind(TailwindOptions{Version: TailwindV3})
+	for _, want := range []string{
+		"module.exports = {",
+		`surface: "color-mix(in srgb, var(--theme-surface) calc(<alpha-value> * 100%), transparent)",`,
+		`"error-background": "color-mix(in srgb, var(--theme-error-background) calc(<alpha-value> * 100%), transparent)",`,
+		`muted: "color-mix(in srgb, var(--theme-text-muted)`,
+		`DEFAULT: "color-mix(in srgb, var(--theme-border)`,
+	} {
+		if !strings.Contains(js, want) {
+			t.Errorf("GenerateTailwind(v3) lacks %q", want)
+		}
+	}
+
+	raw := GenerateTailwind(TailwindOptions{Version: TailwindV3, Prefix: "app", JSON: true})
+	var extend map[string]map[string]string
+	if err := json.Unmarshal([]byte(raw), &extend); err != nil {
+		t.Fatalf("GenerateTailwind(v3 JSON) is not valid JSON: %v\n%s", err, raw)
+	}
+	if len(extend["colors"]) != len(colorRoles) {
+		t.Errorf("colors has %d entries, want %d", len(extend["colors"]), len(colorRoles))
+	}
+	if got := extend["borderColor"]["subtle"]; !strings.Contains(got, "var(--app-border-subtle)") {
+		t.Errorf("borderColor.subtle = %q, want it to reference --app-border-subtle", got)
+	}
+	if got := extend["textColor"]["muted"]; !strings.Contains(got, "var(--app-text-muted)") {
+		t.Errorf("textColor.muted = %q, want it to reference --app-text-muted", got)
+	}
+}
+
+func TestExportTailwind(t *testing.T) {
+	t.Parallel()
+
+	dark := fullTestTheme(t)
+	light := NewThemeBuilder("paper", "Paper").WithBackground(Hex("#fdf6e3")).Build()
+
+	opts := DefaultExportOptions()
+	opts.CSS.Prefix = "app"
+	var sb strings.Builder
+	if err := ExportWithOptions(&sb, FormatTailwind, opts, dark, light); err != nil {
+		t.Fatalf("Export(tailwind) error: %v", err)
+	}
+	out := sb.String()
+	if !strings.HasPrefix(out, "@theme inline {") {
+		t.Errorf("Export(tailwind) does not start with the @theme block:\n%.200s", out)
+	}
+	if !strings.Contains(out, `[data-theme="catppuccin_mocha"]`) || !strings.Contains(out, `[data-theme="paper"]`) {
+		t.Error("Export(tailwind) lacks the theme blocks")
+	}
+
+	// Every variable the @theme block references is defined by the themes.
+	refs := regexp.MustCompile(`var\((--app-[a-z-]+)\)`).FindAllStringSubmatch(out, -1)
+	if len(refs) == 0 {
+		t.Fatal("Export(tailwind) references no --app-* variables")
+	}
+	for _, ref := range refs {
+		if !strings.Contains(out, ref[1]+":") {
+			t.Errorf("%s is referenced but not defined", ref[1])
+		}
+	}
+
+	sb.Reset()
+	if err := ExportWithOptions(&sb, FormatTailwindConfig, opts, dark); err != nil {
+		t.Fatalf("Export(tailwind-config) error: %v", err)
+	}
+	if !strings.Contains(sb.String(), "var(--app-surface)") || !strings.Contains(sb.String(), "module.exports") {
+		t.Errorf("Export(tailwind-config) = %.200s, want a v3 preset using --app-*", sb.String())
+	}
+}
+
+func TestExportTailwindPrefix(t *testing.T) {
+	t.Parallel()
+
+	dark := fullTestTheme(t)
+	opts := DefaultExportOptions()
+	opts.Tailwind.Prefix = "tw"
+
+	var sb strings.Builder
+	if err := ExportWithOptions(&sb, FormatTailwindConfig, opts, dark); err != nil {
+		t.Fatalf("Export(tailwind-config) error: %v", err)
+	}
+	if !strings.Contains(sb.String(), "var(--tw-surface)") || strings.Contains(sb.String(), "--theme-") {
+		t.Errorf("Export(tailwind-config) = %.200s, want colors using --tw-*", sb.String())
+	}
+
+	// The theme CSS uses the Tailwind prefix too, so the utilities resolve.
+	sb.Reset()
+	if err := ExportWithOptions(&sb, FormatTailwind, opts, dark); err != nil {
+		t.Fatalf("Export(tailwind) error: %v", err)
+	}
+	if !strings.Contains(sb.String(), "var(--tw-surface)") || !strings.Contains(sb.String(), "--tw-surface:") {
+		t.Errorf("Export(tailwind) does not use --tw-* throughout:\n%.200s", sb.String())
+	}
+	if strings.Contains(sb.String(), "--theme-") {
+		t.Error("Export(tailwind) uses the default CSS prefix")
+	}
+}
@@ -0,0 +1,164 @@
+package gothememe
+
+// TerminalFormat identifies a terminal emulator color configuration format.
+type TerminalFormat int
+
+const (
+	// TerminalFormatAuto detects the format from the content when reading.
+	TerminalFormatAuto TerminalFormat = iota
+
+	// TerminalFormatAlacritty is an Alacritty [colors] section, in TOML or
+	// the legacy YAML syntax.
+	TerminalFormatAlacritty
+
+	// TerminalFormatKitty is a kitty.conf color theme.
+	TerminalFormatKitty
+
+	// TerminalFormatWezTerm is a WezTerm color scheme TOML file.
+	TerminalFormatWezTerm
+
+	// TerminalFormatGhostty is a Ghostty theme file.
+	TerminalFormatGhostty
+
+	// TerminalFormatFoot is a foot.ini [colors] section.
+	TerminalFormatFoot
+
+	// TerminalFormatXresources is an X resources color definition.
+	TerminalFormatXresources
+
+	// TerminalFormatWindowsTerminal is a Windows Terminal color scheme
+	// object in JSON.
+	TerminalFormatWindowsTerminal
+)
+
+// String returns the lowercase name of the format.
+func (f TerminalFormat) String() string {
+	switch f {
+	case TerminalFormatAuto:
+		return "auto"
+	case TerminalFormatAlacritty:
+		return "alacritty"
+	case TerminalFormatKitty:
+		return "kitty"
+	case TerminalFormatWezTerm:
+		return "wezterm"
+	case TerminalFormatGhostty:
+		return "ghostty"
+	case TerminalFormatFoot:
+		return "foot"
+	case TerminalFormatXresources:
+		return "xresources"
+	case TerminalFormatWindowsTerminal:
+		return "windows-terminal"
+	default:
+		return "unknown"
+	}
+}
+
+// terminalScheme is the color set shared by terminal emulator formats: the
+// default colors, cursor and selection colors and the 16 ANSI colors in
+// standard order (black, red, green, yellow, blue, magenta, cyan, white,
+// then their bright variants).
+type terminalScheme struct {
+	name          string
+	background    Color
+	foreground    Color
+	cursor        Color
+	cursorText    Color
+	selection     Color
+	selectionText Color
+	bold          Color
+	link          Color
+	ansi          [16]Color
+}
+
+// theme maps the scheme onto a Theme using the same role assignments as the
+// themes generated by themegen. Roles whose source color is missing are left
+// for the builder to derive.
+func (s terminalScheme) theme(id, name string) Theme {
+	a := s.ansi
+	black, red, green, yellow := a[0], a[1], a[2], a[3]
+	blue, purple, cyan, white := a[4], a[5], a[6], a[7]
+	brightBlack, brightYellow, brightCyan := a[8], a[11], a[14]
+
+	semantic := func(c Color) SemanticColor {
+		if c.IsEmpty() {
+			return SemanticColor{}
+		}
+		return SemanticColor{Background: c.WithAlpha(0.1), Border: c.WithAlpha(0.3), Text: c}
+	}
+
+	b := NewThemeBuilder(id, name).
+		WithBackground(s.background).
+		WithBackgroundSecondary(black).
+		WithSurface(s.selection).
+		WithSurfaceSecondary(brightBlack).
+		WithTextPrimary(s.foreground).
+		WithTextSecondary(white).
+		WithTextMuted(brightBlack).
+		WithTextInverted(s.background).
+		WithAccent(blue).
+		WithAccentSecondary(purple).
+		WithBrand(blue).
+		WithBorder(brightBlack).
+		WithBorderSubtle(black).
+		WithBorderStrong(white).
+		WithSuccess(semantic(green)).
+		WithWarning(semantic(yellow)).
+		WithError(semantic(red)).
+		WithInfo(semantic(cyan)).
+		WithCodeBackground(s.background).
+		WithCodeText(s.foreground).
+		WithCodeComment(brightBlack).
+		WithCodeKeyword(purple).
+		WithCodeString(green).
+		WithCodeNumber(yellow).
+		WithCodeFunction(blue).
+		WithCodeOperator(red).
+		WithCodePunctuation(s.foreground).
+		WithCodeVariable(cyan).
+		WithCodeConstant(brightYellow).
+		WithCodeType(brightCyan)
+
+	for i, role := range ansiRoles {
+		if !a[i].IsEmpty() {
+			role.set(b, a[i])
+		}
+	}
+	return b.Build()
+}
+
+// ansiRoles lists the ANSI color roles in terminal palette order.
+var ansiRoles = func() [16]colorRole {
+	keys := [16]string{
+		"black", "red", "green", "yellow", "blue", "purple", "cyan", "white",
+		"bright_black", "bright_red", "bright_green", "bright_yellow",
+		"bright_blue", "bright_purple", "bright_cyan", "bright_white",
+	}
+	var roles [16]colorRole
+	for i, key := range keys {
+		roles[i], _ = lookupColorRole(key) //nolint:errcheck // keys are fixed above
+	}
+	return roles
+}()
+
+// terminalSchemeFromTheme picks the terminal colors for a Theme. The cursor
+// and bold text use the primary text color, the selection uses the surface
+// color and links use the accent.
+func terminalSchemeFromTheme(t Theme) terminalScheme {
+	s := terminalScheme{
+		name:          t.DisplayName(),
+		background:    t.Background(),
+		foreground:    t.TextPrimary(),
+		cursor:        t.TextPrimary(),
+		cursorText:    t.Background(),
+		selection:     t.Surface(),
+		selectionText: t.TextPrimary(),
+		bold:          t.TextPrimary(),
+		link:          t.Accent(),
+	}
+	for i, role := range ansiRoles {
+		s.ansi[i] = role.get(t)
+	}
+	return s
+}
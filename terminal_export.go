@@ -0,0 +1,299 @@
+package gothememe
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteTerminalTheme writes t to w as a color configuration for the given
+// terminal emulator, in the same layout [ParseTerminalTheme] reads. The
+// cursor uses the primary text color and the selection uses the surface
+// color, where the format supports them. Translucent colors are composited
+// over the background, since terminal palettes are opaque.
+//
+// Windows Terminal output is a single scheme object for the "schemes" list
+// of settings.json.
+func WriteTerminalTheme(w io.Writer, t Theme, format TerminalFormat) error {
+	s := terminalSchemeFromTheme(t).opaque()
+
+	var sb strings.Builder
+	switch format {
+	case TerminalFormatAlacritty:
+		writeAlacritty(&sb, s)
+	case TerminalFormatKitty:
+		writeKitty(&sb, s)
+	case TerminalFormatWezTerm:
+		writeWezTerm(&sb, s)
+	case TerminalFormatGhostty:
+		writeGhostty(&sb, s)
+	case TerminalFormatWindowsTerminal:
+		data, err := json.MarshalIndent(windowsTerminalScheme(s), "", "    ")
+		if err != nil {
+			return fmt.Errorf("encoding Windows Terminal scheme: %w", err)
+		}
+		sb.Write(data)
+		sb.WriteString("\n")
+	case TerminalFormatFoot:
+		writeFoot(&sb, s)
+	case TerminalFormatXresources:
+		writeXresources(&sb, s)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownTerminalFormat, format)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// opaque returns the scheme with every color composited over the background.
+func (s terminalScheme) opaque() terminalScheme {
+	bg := s.background
+	for _, c := range []*Color{&s.foreground, &s.cursor, &s.cursorText, &s.selection, &s.selectionText, &s.bold, &s.link} {
+		*c = c.opaque(bg)
+	}
+	for i := range s.ansi {
+		s.ansi[i] = s.ansi[i].opaque(bg)
+	}
+	s.background = bg.opaque(Color{})
+	return s
+}
+
+// terminalLine is one key and color of a terminal configuration.
+type terminalLine struct {
+	key string
+	c   Color
+}
+
+// writeLines writes each non-empty color with format, which receives the key
+// and the hex value.
+func writeLines(sb *strings.Builder, format string, lines []terminalLine) {
+	for _, l := range lines {
+		if !l.c.IsEmpty() {
+			fmt.Fprintf(sb, format, l.key, l.c.Hex())
+		}
+	}
+}
+
+// writeAlacritty writes an Alacritty TOML [colors] configuration.
+func writeAlacritty(sb *strings.Builder, s terminalScheme) {
+	fmt.Fprintf(sb, "# %s\n\n", s.name)
+	sections := []struct {
+		name  string
+		lines []terminalLine
+	}{
+		{"primary", []terminalLine{{"background", s.background}, {"foreground", s.foreground}}},
+		{"cursor", []terminalLine{{"text", s.cursorText}, {"cursor", s.cursor}}},
+		{"selection", []terminalLine{{"text", s.selectionText}, {"background", s.selection}}},
+		{"normal", nil},
+		{"bright", nil},
+	}
+	for i, name := range ansiNames {
+		sections[3].lines = append(sections[3].lines, terminalLine{name, s.ansi[i]})
+		sections[4].lines = append(sections[4].lines, terminalLine{name, s.ansi[i+8]})
+	}
+	for i, sec := range sections {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(sb, "[colors.%s]\n", sec.name)
+		writeLines(sb, "%s = %q\n", sec.lines)
+	}
+}
+
+// writeKitty writes kitty.conf color settings with the "## name:" header
+// kitty's theme kitten reads.
+func writeKitty(sb *strings.Builder, s terminalScheme) {
+	fmt.Fprintf(sb, "## name: %s\n\n", s.name)
+	lines := []terminalLine{
+		{"background", s.background},
+		{"foreground", s.foreground},
+		{"cursor", s.cursor},
+		{"cursor_text_color", s.cursorText},
+		{"selection_background", s.selection},
+		{"selection_foreground", s.selectionText},
+		{"url_color", s.link},
+	}
+	for i, c := range s.ansi {
+		lines = append(lines, terminalLine{"color" + strconv.Itoa(i), c})
+	}
+	writeLines(sb, "%s %s\n", lines)
+}
+
+// writeWezTerm writes a WezTerm color scheme file.
+func writeWezTerm(sb *strings.Builder, s terminalScheme) {
+	sb.WriteString("[colors]\n")
+	writeLines(sb, "%s = %q\n", []terminalLine{
+		{"foreground", s.foreground},
+		{"background", s.background},
+		{"cursor_bg", s.cursor},
+		{"cursor_fg", s.cursorText},
+		{"cursor_border", s.cursor},
+		{"selection_bg", s.selection},
+		{"selection_fg", s.selectionText},
+	})
+	for _, list := range []struct {
+		key    string
+		colors []Color
+	}{
+		{"ansi", s.ansi[:8]},
+		{"brights", s.ansi[8:]},
+	} {
+		quoted := make([]string, len(list.colors))
+		for i, c := range list.colors {
+			quoted[i] = strconv.Quote(c.Hex())
+		}
+		fmt.Fprintf(sb, "%s = [%s]\n", list.key, strings.Join(quoted, ", "))
+	}
+	fmt.Fprintf(sb, "\n[metadata]\nname = %q\n", s.name)
+}
+
+// writeGhostty writes a Ghostty theme file.
+func writeGhostty(sb *strings.Builder, s terminalScheme) {
+	fmt.Fprintf(sb, "# %s\n", s.name)
+	for i, c := range s.ansi {
+		if !c.IsEmpty() {
+			fmt.Fprintf(sb, "palette = %d=%s\n", i, c.Hex())
+		}
+	}
+	writeLines(sb, "%s = %s\n", []terminalLine{
+		{"background", s.background},
+		{"foreground", s.foreground},
+		{"cursor-color", s.cursor},
+		{"cursor-text", s.cursorText},
+		{"selection-background", s.selection},
+		{"selection-foreground", s.selectionText},
+	})
+}
+
+// windowsTerminalScheme returns the scheme as an ordered Windows Terminal
+// scheme object.
+func windowsTerminalScheme(s terminalScheme) json.Marshaler {
+	colors := map[string]Color{
+		"background":          s.background,
+		"foreground":          s.foreground,
+		"cursorColor":         s.cursor,
+		"selectionBackground": s.selection,
+	}
+	for i, key := range windowsTerminalKeys[4:] {
+		colors[key] = s.ansi[i]
+	}
+
+	obj := orderedJSON{{"name", s.name}}
+	for _, key := range windowsTerminalKeys {
+		if c := colors[key]; !c.IsEmpty() {
+			obj = append(obj, orderedJSONField{key, c.Hex()})
+		}
+	}
+	return obj
+}
+
+// orderedJSONField is one key of an orderedJSON object.
+type orderedJSONField struct {
+	key   string
+	value string
+}
+
+// orderedJSON is a JSON object of string values that keeps its key order,
+// which encoding/json does not for maps.
+type orderedJSON []orderedJSONField
+
+// MarshalJSON encodes the fields in order.
+func (o orderedJSON) MarshalJSON() ([]byte, error) {
+	var sb strings.Builder
+	sb.WriteString("{")
+	for i, f := range o {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		key, err := json.Marshal(f.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+		sb.Write(key)
+		sb.WriteString(":")
+		sb.Write(value)
+	}
+	sb.WriteString("}")
+	return []byte(sb.String()), nil
+}
+
+// writeFoot writes the [cursor] and [colors] sections of a foot.ini file.
+// foot writes colors as bare hex without "#".
+func writeFoot(sb *strings.Builder, s terminalScheme) {
+	bare := func(c Color) string { return strings.TrimPrefix(c.Hex(), "#") }
+
+	fmt.Fprintf(sb, "# %s\n\n", s.name)
+	if !s.cursor.IsEmpty() && !s.cursorText.IsEmpty() {
+		fmt.Fprintf(sb, "[cursor]\ncolor=%s %s\n\n", bare(s.cursorText), bare(s.cursor))
+	}
+
+	sb.WriteString("[colors]\n")
+	lines := []terminalLine{
+		{"background", s.background},
+		{"foreground", s.foreground},
+	}
+	for i := range 8 {
+		lines = append(lines, terminalLine{"regular" + strconv.Itoa(i), s.ansi[i]})
+	}
+	for i := range 8 {
+		lines = append(lines, terminalLine{"bright" + strconv.Itoa(i), s.ansi[i+8]})
+	}
+	lines = append(lines,
+		terminalLine{"selection-foreground", s.selectionText},
+		terminalLine{"selection-background", s.selection},
+		terminalLine{"urls", s.link},
+	)
+	for _, l := range lines {
+		if !l.c.IsEmpty() {
+			fmt.Fprintf(sb, "%s=%s\n", l.key, bare(l.c))
+		}
+	}
+}
+
+// writeXresources writes "*.name: #rrggbb" resources. The selection colors
+// use the highlightColor and highlightTextColor resources of xterm and urxvt.
+func writeXresources(sb *strings.Builder, s terminalScheme) {
+	fmt.Fprintf(sb, "! %s\n\n", s.name)
+	lines := []terminalLine{
+		{"foreground", s.foreground},
+		{"background", s.background},
+		{"cursorColor", s.cursor},
+		{"highlightColor", s.selection},
+		{"highlightTextColor", s.selectionText},
+	}
+	for i, c := range s.ansi {
+		lines = append(lines, terminalLine{"color" + strconv.Itoa(i), c})
+	}
+	writeLines(sb, "*.%s: %s\n", lines)
+}
+
+// terminalExporter returns the exporter for a terminal format, which holds a
+// single theme. Windows Terminal also accepts several themes and writes them
+// as a JSON array for the "schemes" list.
+func terminalExporter(format TerminalFormat, extensions ...string) exporterFunc {
+	return exporterFunc{format.String(), extensions, func(w io.Writer, themes []Theme, _ ExportOptions) error {
+		if len(themes) > 1 && format == TerminalFormatWindowsTerminal {
+			schemes := make([]json.Marshaler, len(themes))
+			for i, t := range themes {
+				schemes[i] = windowsTerminalScheme(terminalSchemeFromTheme(t).opaque())
+			}
+			data, err := json.MarshalIndent(schemes, "", "    ")
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(append(data, '\n'))
+			return err
+		}
+		if len(themes) > 1 {
+			return fmt.Errorf("%w: got %d", ErrTooManyThemes, len(themes))
+		}
+		return WriteTerminalTheme(w, themes[0], format)
+	}}
+}
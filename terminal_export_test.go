@@ -0,0 +1,152 @@
+package gothememe
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestWriteTerminalThemeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	want := terminalSchemeFromTheme(theme).opaque()
+
+	tests := []struct {
+		format           TerminalFormat
+		hasSelection     bool
+		hasSelectionText bool
+		hasCursorText    bool
+		hasName          bool
+	}{
+		{TerminalFormatAlacritty, true, true, true, false},
+		{TerminalFormatKitty, true, true, true, false},
+		{TerminalFormatWezTerm, true, true, true, true},
+		{TerminalFormatGhostty, true, true, true, false},
+		{TerminalFormatWindowsTerminal, true, false, false, true},
+		{TerminalFormatFoot, true, true, true, false},
+		{TerminalFormatXresources, true, true, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format.String(), func(t *testing.T) {
+			t.Parallel()
+
+			var sb strings.Builder
+			if err := WriteTerminalTheme(&sb, theme, tt.format); err != nil {
+				t.Fatalf("WriteTerminalTheme() error: %v", err)
+			}
+			out := []byte(sb.String())
+
+			if detected, err := DetectTerminalFormat(out); err != nil || detected != tt.format {
+				t.Errorf("DetectTerminalFormat() = %s, %v, want %s", detected, err, tt.format)
+			}
+			got, err := decodeTerminalScheme(out, tt.format)
+			if err != nil {
+				t.Fatalf("decoding the output: %v\n%s", err, out)
+			}
+
+			type check struct {
+				name      string
+				got, want Color
+			}
+			checks := []check{
+				{"background", got.background, want.background},
+				{"foreground", got.foreground, want.foreground},
+				{"cursor", got.cursor, want.cursor},
+			}
+			if tt.hasCursorText {
+				checks = append(checks, check{"cursor text", got.cursorText, want.cursorText})
+			}
+			if tt.hasSelection {
+				checks = append(checks, check{"selection", got.selection, want.selection})
+			}
+			if tt.hasSelectionText {
+				checks = append(checks, check{"selection text", got.selectionText, want.selectionText})
+			}
+			for i := range want.ansi {
+				checks = append(checks, check{"ANSI " + ansiRoles[i].key, got.ansi[i], want.ansi[i]})
+			}
+			for _, c := range checks {
+				if c.got.Hex() != c.want.Hex() {
+					t.Errorf("%s = %s, want %s", c.name, c.got.Hex(), c.want.Hex())
+				}
+			}
+			if tt.hasName && got.name != theme.DisplayName() {
+				t.Errorf("name = %q, want %q", got.name, theme.DisplayName())
+			}
+		})
+	}
+}
+
+func TestWriteTerminalThemeOpaque(t *testing.T) {
+	t.Parallel()
+
+	theme := NewThemeBuilder("glass", "Glass").
+		WithBackground(Hex("#000000")).
+		WithTextPrimary(Hex("#ffffff")).
+		WithSurface(Hex("#ffffff").WithAlpha(0.5)).
+		Build()
+
+	var sb strings.Builder
+	if err := WriteTerminalTheme(&sb, theme, TerminalFormatKitty); err != nil {
+		t.Fatalf("WriteTerminalTheme() error: %v", err)
+	}
+	if !strings.Contains(sb.String(), "selection_background #7f7f7f\n") {
+		t.Errorf("translucent selection was not composited over the background:\n%s", sb.String())
+	}
+	if !strings.HasPrefix(sb.String(), "## name: Glass\n") {
+		t.Errorf("kitty output lacks the name header:\n%s", sb.String())
+	}
+
+	if err := WriteTerminalTheme(io.Discard, theme, TerminalFormatAuto); !errors.Is(err, ErrUnknownTerminalFormat) {
+		t.Errorf("WriteTerminalTheme(auto) error = %v, want ErrUnknownTerminalFormat", err)
+	}
+}
+
+func TestExportTerminalFormats(t *testing.T) {
+	t.Parallel()
+
+	dark := fullTestTheme(t)
+	light := NewThemeBuilder("paper", "Paper").WithBackground(Hex("#fdf6e3")).Build()
+
+	var sb strings.Builder
+	if err := Export(&sb, FormatWindowsTerminal, dark, light); err != nil {
+		t.Fatalf("Export(windows-terminal) error: %v", err)
+	}
+	var schemes []json.RawMessage
+	if err := json.Unmarshal([]byte(sb.String()), &schemes); err != nil {
+		t.Fatalf("Export(windows-terminal) with two themes is not a JSON array: %v", err)
+	}
+	if len(schemes) != 2 {
+		t.Fatalf("got %d schemes, want 2", len(schemes))
+	}
+	paper, err := FromWindowsTerminal(schemes[1])
+	if err != nil {
+		t.Fatalf("FromWindowsTerminal() error: %v", err)
+	}
+	if paper.ID() != "paper" || paper.Background().Hex() != "#fdf6e3" {
+		t.Errorf("second scheme = %s with background %s, want paper", paper.ID(), paper.Background().Hex())
+	}
+
+	if err := Export(io.Discard, FormatKitty, dark, light); !errors.Is(err, ErrTooManyThemes) {
+		t.Errorf("Export(kitty) with two themes error = %v, want ErrTooManyThemes", err)
+	}
+
+	for _, tt := range []struct {
+		path   string
+		format OutputFormat
+	}{
+		{"mocha.alacritty.toml", FormatAlacritty},
+		{"mocha.ghostty", FormatGhostty},
+		{"mocha.Xresources", FormatXresources},
+	} {
+		if got, err := OutputFormatFromPath(tt.path); err != nil || got != tt.format {
+			t.Errorf("OutputFormatFromPath(%q) = %s, %v, want %s", tt.path, got, err, tt.format)
+		}
+	}
+	if FormatWindowsTerminal.String() != "windows-terminal" {
+		t.Errorf("String() = %q, want windows-terminal", FormatWindowsTerminal.String())
+	}
+}
@@ -0,0 +1,483 @@
+package gothememe
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrUnknownTerminalFormat is returned when a terminal configuration format
+// cannot be detected from its content.
+var ErrUnknownTerminalFormat = errors.New("unknown terminal color format")
+
+// alacrittyYAMLPattern recognizes the legacy YAML Alacritty configuration.
+var alacrittyYAMLPattern = regexp.MustCompile(`(?m)^colors:\s*$`)
+
+// terminalFormatPatterns recognize each format from its content. They are
+// tried in order, so formats with distinctive markers come first.
+var terminalFormatPatterns = []struct {
+	format  TerminalFormat
+	pattern *regexp.Regexp
+}{
+	{TerminalFormatWindowsTerminal, regexp.MustCompile(`^\s*\{`)},
+	{TerminalFormatAlacritty, regexp.MustCompile(`(?m)^\s*\[colors\.(primary|normal|bright|cursor|selection)\]`)},
+	{TerminalFormatWezTerm, regexp.MustCompile(`(?m)^\s*(ansi|brights)\s*=\s*\[`)},
+	{TerminalFormatAlacritty, alacrittyYAMLPattern},
+	{TerminalFormatGhostty, regexp.MustCompile(`(?m)^\s*palette\s*=\s*\d+\s*=`)},
+	{TerminalFormatFoot, regexp.MustCompile(`(?m)^\s*(regular|bright)[0-7]\s*=`)},
+	{TerminalFormatXresources, regexp.MustCompile(`(?m)^[\w.*-]*[*.](foreground|background|color\d+)\s*:`)},
+	{TerminalFormatKitty, regexp.MustCompile(`(?m)^\s*(color\d+|foreground|background)\s+\S`)},
+}
+
+// DetectTerminalFormat guesses the terminal configuration format of data.
+func DetectTerminalFormat(data []byte) (TerminalFormat, error) {
+	for _, p := range terminalFormatPatterns {
+		if p.pattern.Match(data) {
+			return p.format, nil
+		}
+	}
+	return TerminalFormatAuto, ErrUnknownTerminalFormat
+}
+
+// ParseTerminalTheme reads a terminal emulator color configuration and maps
+// it onto a Theme with the same role assignments themegen uses. Pass
+// [TerminalFormatAuto] to detect the format from the content. Only the color
+// settings are read; other settings in the file are ignored.
+func ParseTerminalTheme(r io.Reader, format TerminalFormat) (Theme, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading terminal theme: %w", err)
+	}
+
+	s, err := decodeTerminalScheme(data, format)
+	if err != nil {
+		return nil, err
+	}
+
+	name := s.name
+	if name == "" {
+		name = "imported"
+	}
+	return s.theme(importedThemeID(name, ""), name), nil
+}
+
+// LoadTerminalThemeFile reads the terminal color configuration at path,
+// detecting its format from the content. The theme is named after the
+// scheme name when the format carries one, otherwise after the file. The ID
+// comes from the file name when the scheme name has no ASCII letters or
+// digits.
+func LoadTerminalThemeFile(path string) (Theme, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: loading a caller-specified theme file is the purpose of this function
+	if err != nil {
+		return nil, fmt.Errorf("reading terminal theme: %w", err)
+	}
+
+	s, err := decodeTerminalScheme(data, TerminalFormatAuto)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	file := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	name := s.name
+	if name == "" {
+		name = file
+	}
+	return s.theme(importedThemeID(name, file), name), nil
+}
+
+// decodeTerminalScheme parses data in the given (or detected) format.
+func decodeTerminalScheme(data []byte, format TerminalFormat) (terminalScheme, error) {
+	if format == TerminalFormatAuto {
+		detected, err := DetectTerminalFormat(data)
+		if err != nil {
+			return terminalScheme{}, err
+		}
+		format = detected
+	}
+
+	var (
+		s   terminalScheme
+		err error
+	)
+	switch format {
+	case TerminalFormatAlacritty:
+		s, err = decodeAlacritty(data)
+	case TerminalFormatKitty:
+		s, err = decodeKitty(data)
+	case TerminalFormatWezTerm:
+		s, err = decodeWezTerm(data)
+	case TerminalFormatGhostty:
+		s, err = decodeGhostty(data)
+	case TerminalFormatFoot:
+		s, err = decodeFoot(data)
+	case TerminalFormatXresources:
+		s, err = decodeXresources(data)
+	case TerminalFormatWindowsTerminal:
+		s, err = decodeWindowsTerminal(data)
+	default:
+		return terminalScheme{}, fmt.Errorf("%w: %s", ErrUnknownTerminalFormat, format)
+	}
+	if err != nil {
+		return terminalScheme{}, fmt.Errorf("%s: %w", format, err)
+	}
+	if s.background.IsEmpty() && s.foreground.IsEmpty() {
+		return terminalScheme{}, fmt.Errorf("%s: no background or foreground color", format)
+	}
+	return s, nil
+}
+
+// parseTerminalColor parses a color as terminals write it: "#rrggbb",
+// "0xrrggbb" or a bare "rrggbb", optionally quoted.
+func parseTerminalColor(v string) (Color, error) {
+	v = strings.Trim(strings.TrimSpace(v), `"'`)
+	switch {
+	case strings.HasPrefix(v, "0x") || strings.HasPrefix(v, "0X"):
+		v = "#" + v[2:]
+	case hexPattern.MatchString(v) && !strings.HasPrefix(v, "#"):
+		v = "#" + v
+	}
+	return ParseColor(v)
+}
+
+// terminalKeys assigns color values to scheme fields by key.
+type terminalKeys map[string]*Color
+
+// set parses value into the field for key. Unknown keys are ignored. Invalid
+// values are an error for ANSI and default colors, but skipped for optional
+// colors such as the cursor, which terminals also accept keywords for.
+func (k terminalKeys) set(s *terminalScheme, key, value string) error {
+	dst, ok := k[key]
+	if !ok {
+		return nil
+	}
+	c, err := parseTerminalColor(value)
+	if err != nil {
+		if dst == &s.background || dst == &s.foreground || isANSIField(s, dst) {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		return nil
+	}
+	*dst = c
+	return nil
+}
+
+// isANSIField reports whether dst points into the scheme's ANSI palette.
+func isANSIField(s *terminalScheme, dst *Color) bool {
+	for i := range s.ansi {
+		if dst == &s.ansi[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// ansiNames are the color names Alacritty uses for the 8 base colors.
+var ansiNames = [8]string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// alacrittyColors is the colors section shared by Alacritty's TOML and
+// YAML configurations.
+type alacrittyColors struct {
+	Primary struct {
+		Background string `toml:"background" yaml:"background"`
+		Foreground string `toml:"foreground" yaml:"foreground"`
+	} `toml:"primary" yaml:"primary"`
+	Cursor struct {
+		Text   string `toml:"text" yaml:"text"`
+		Cursor string `toml:"cursor" yaml:"cursor"`
+	} `toml:"cursor" yaml:"cursor"`
+	Selection struct {
+		Text       string `toml:"text" yaml:"text"`
+		Background string `toml:"background" yaml:"background"`
+	} `toml:"selection" yaml:"selection"`
+	Normal map[string]string `toml:"normal" yaml:"normal"`
+	Bright map[string]string `toml:"bright" yaml:"bright"`
+}
+
+// decodeAlacritty reads an Alacritty configuration in TOML or, when it
+// starts with a YAML "colors:" key, the legacy YAML syntax.
+func decodeAlacritty(data []byte) (terminalScheme, error) {
+	var cfg struct {
+		Colors alacrittyColors `toml:"colors" yaml:"colors"`
+	}
+	if alacrittyYAMLPattern.Match(data) {
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return terminalScheme{}, err
+		}
+	} else if _, err := toml.Decode(string(data), &cfg); err != nil {
+		return terminalScheme{}, err
+	}
+
+	var s terminalScheme
+	keys := terminalKeys{
+		"primary.background": &s.background,
+		"primary.foreground": &s.foreground,
+		"cursor.text":        &s.cursorText,
+		"cursor.cursor":      &s.cursor,
+		"selection.text":     &s.selectionText,
+		"selection.bg":       &s.selection,
+	}
+	for i, name := range ansiNames {
+		keys["normal."+name] = &s.ansi[i]
+		keys["bright."+name] = &s.ansi[i+8]
+	}
+
+	c := cfg.Colors
+	values := map[string]string{
+		"primary.background": c.Primary.Background,
+		"primary.foreground": c.Primary.Foreground,
+		"cursor.text":        c.Cursor.Text,
+		"cursor.cursor":      c.Cursor.Cursor,
+		"selection.text":     c.Selection.Text,
+		"selection.bg":       c.Selection.Background,
+	}
+	for name, v := range c.Normal {
+		values["normal."+name] = v
+	}
+	for name, v := range c.Bright {
+		values["bright."+name] = v
+	}
+
+	for key, v := range values {
+		if v == "" {
+			continue
+		}
+		if err := keys.set(&s, key, v); err != nil {
+			return terminalScheme{}, err
+		}
+	}
+	return s, nil
+}
+
+// decodeKitty reads kitty.conf "key value" color settings.
+func decodeKitty(data []byte) (terminalScheme, error) {
+	var s terminalScheme
+	keys := terminalKeys{
+		"background":           &s.background,
+		"foreground":           &s.foreground,
+		"cursor":               &s.cursor,
+		"cursor_text_color":    &s.cursorText,
+		"selection_background": &s.selection,
+		"selection_foreground": &s.selectionText,
+		"url_color":            &s.link,
+	}
+	for i := range s.ansi {
+		keys["color"+strconv.Itoa(i)] = &s.ansi[i]
+	}
+
+	err := scanConfigLines(data, "#", func(line string) error {
+		key, value, _ := strings.Cut(line, " ")
+		return keys.set(&s, key, strings.TrimSpace(value))
+	})
+	return s, err
+}
+
+// decodeWezTerm reads a WezTerm color scheme file.
+func decodeWezTerm(data []byte) (terminalScheme, error) {
+	var cfg struct {
+		Colors struct {
+			Foreground  string   `toml:"foreground"`
+			Background  string   `toml:"background"`
+			CursorBg    string   `toml:"cursor_bg"`
+			CursorFg    string   `toml:"cursor_fg"`
+			SelectionBg string   `toml:"selection_bg"`
+			SelectionFg string   `toml:"selection_fg"`
+			ANSI        []string `toml:"ansi"`
+			Brights     []string `toml:"brights"`
+		} `toml:"colors"`
+		Metadata struct {
+			Name string `toml:"name"`
+		} `toml:"metadata"`
+	}
+	if _, err := toml.Decode(string(data), &cfg); err != nil {
+		return terminalScheme{}, err
+	}
+
+	s := terminalScheme{name: cfg.Metadata.Name}
+	keys := terminalKeys{
+		"foreground":   &s.foreground,
+		"background":   &s.background,
+		"cursor_bg":    &s.cursor,
+		"cursor_fg":    &s.cursorText,
+		"selection_bg": &s.selection,
+		"selection_fg": &s.selectionText,
+	}
+	c := cfg.Colors
+	values := [][2]string{
+		{"foreground", c.Foreground},
+		{"background", c.Background},
+		{"cursor_bg", c.CursorBg},
+		{"cursor_fg", c.CursorFg},
+		{"selection_bg", c.SelectionBg},
+		{"selection_fg", c.SelectionFg},
+	}
+	for i := range 8 {
+		keys[fmt.Sprintf("ansi[%d]", i)] = &s.ansi[i]
+		keys[fmt.Sprintf("brights[%d]", i)] = &s.ansi[i+8]
+	}
+	for i, v := range c.ANSI {
+		values = append(values, [2]string{fmt.Sprintf("ansi[%d]", i), v})
+	}
+	for i, v := range c.Brights {
+		values = append(values, [2]string{fmt.Sprintf("brights[%d]", i), v})
+	}
+
+	for _, kv := range values {
+		if kv[1] == "" {
+			continue
+		}
+		if err := keys.set(&s, kv[0], kv[1]); err != nil {
+			return terminalScheme{}, err
+		}
+	}
+	return s, nil
+}
+
+// decodeGhostty reads a Ghostty theme file of "key = value" lines, where
+// palette entries are written "palette = N=#rrggbb".
+func decodeGhostty(data []byte) (terminalScheme, error) {
+	var s terminalScheme
+	keys := terminalKeys{
+		"background":           &s.background,
+		"foreground":           &s.foreground,
+		"cursor-color":         &s.cursor,
+		"cursor-text":          &s.cursorText,
+		"selection-background": &s.selection,
+		"selection-foreground": &s.selectionText,
+	}
+	for i := range s.ansi {
+		keys["palette "+strconv.Itoa(i)] = &s.ansi[i]
+	}
+
+	err := scanConfigLines(data, "#", func(line string) error {
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if key == "palette" {
+			index, color, ok := strings.Cut(value, "=")
+			if !ok {
+				return fmt.Errorf("palette entry %q: want N=color", value)
+			}
+			key, value = "palette "+strings.TrimSpace(index), color
+		}
+		return keys.set(&s, key, value)
+	})
+	return s, err
+}
+
+// decodeFoot reads the [colors] (or [colors-dark]) and [cursor] sections of
+// a foot.ini file.
+func decodeFoot(data []byte) (terminalScheme, error) {
+	var s terminalScheme
+	keys := terminalKeys{
+		"colors.background":           &s.background,
+		"colors.foreground":           &s.foreground,
+		"colors.selection-background": &s.selection,
+		"colors.selection-foreground": &s.selectionText,
+		"colors.urls":                 &s.link,
+		"cursor.text":                 &s.cursorText,
+		"cursor.cursor":               &s.cursor,
+	}
+	for i := range 8 {
+		keys["colors.regular"+strconv.Itoa(i)] = &s.ansi[i]
+		keys["colors.bright"+strconv.Itoa(i)] = &s.ansi[i+8]
+	}
+
+	section := ""
+	err := scanConfigLines(data, "#", func(line string) error {
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == "colors-dark" {
+				section = "colors"
+			}
+			return nil
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+
+		// [cursor] color = <text color> <cursor color>
+		if section == "cursor" && key == "color" {
+			if fields := strings.Fields(value); len(fields) == 2 {
+				if err := keys.set(&s, "cursor.text", fields[0]); err != nil {
+					return err
+				}
+				return keys.set(&s, "cursor.cursor", fields[1])
+			}
+			return nil
+		}
+		return keys.set(&s, section+"."+key, value)
+	})
+	return s, err
+}
+
+// xresourcesDefine matches cpp-style "#define name value" lines, which
+// base16-xresources and similar templates use for palette variables.
+var xresourcesDefine = regexp.MustCompile(`^#define\s+(\S+)\s+(\S+)`)
+
+// decodeXresources reads "*.color0: #rrggbb" style resources. The resource
+// name is matched on its last component, so "*.foreground",
+// "URxvt*foreground" and "XTerm.vt100.foreground" are all accepted.
+func decodeXresources(data []byte) (terminalScheme, error) {
+	var s terminalScheme
+	keys := terminalKeys{
+		"background":         &s.background,
+		"foreground":         &s.foreground,
+		"cursorcolor":        &s.cursor,
+		"highlightcolor":     &s.selection,
+		"highlighttextcolor": &s.selectionText,
+	}
+	for i := range s.ansi {
+		keys["color"+strconv.Itoa(i)] = &s.ansi[i]
+	}
+
+	defines := map[string]string{}
+	err := scanConfigLines(data, "!", func(line string) error {
+		if m := xresourcesDefine.FindStringSubmatch(line); m != nil {
+			defines[m[1]] = m[2]
+			return nil
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if !ok || strings.HasPrefix(line, "#") {
+			return nil
+		}
+		name = name[strings.LastIndexAny(name, "*.")+1:]
+		value = strings.TrimSpace(value)
+		if v, ok := defines[value]; ok {
+			value = v
+		}
+		return keys.set(&s, strings.ToLower(strings.TrimSpace(name)), value)
+	})
+	return s, err
+}
+
+// scanConfigLines calls fn with each non-empty line of data, trimmed and with
+// full-line comments starting with comment removed.
+func scanConfigLines(data []byte, comment string, fn func(line string) error) error {
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, comment) {
+			continue
+		}
+		if err := fn(line); err != nil {
+			return fmt.Errorf("line %d: %w", lineNo, err)
+		}
+	}
+	return sc.Err()
+}
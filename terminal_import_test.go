@@ -0,0 +1,376 @@
+package gothememe
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Each sample describes the same scheme: background #1e1e2e, foreground
+// #cdd6f4, red #f38ba8, bright white #a6adc8 and selection #585b70.
+var terminalSamples = map[TerminalFormat]string{
+	TerminalFormatAlacritty: `
+[colors.primary]
+background = "#1e1e2e"
+foreground = "#cdd6f4"
+
+[colors.cursor]
+text = "#1e1e2e"
+cursor = "CellForeground"
+
+[colors.selection]
+text = "#cdd6f4"
+background = "#585b70"
+
+[colors.normal]
+black = "#45475a"
+red = "#f38ba8"
+green = "#a6e3a1"
+yellow = "#f9e2af"
+blue = "#89b4fa"
+magenta = "#f5c2e7"
+cyan = "#94e2d5"
+white = "#bac2de"
+
+[colors.bright]
+black = "#585b70"
+red = "#f38ba8"
+green = "#a6e3a1"
+yellow = "#f9e2af"
+blue = "#89b4fa"
+magenta = "#f5c2e7"
+cyan = "#94e2d5"
+white = "#a6adc8"
+`,
+	TerminalFormatKitty: `
+# vim:ft=kitty
+foreground              #cdd6f4
+background              #1e1e2e
+selection_foreground    #1e1e2e
+selection_background    #585b70
+cursor                  #f5e0dc
+url_color               #f5e0dc
+color0 #45475a
+color8 #585b70
+color1 #f38ba8
+color9 #f38ba8
+color2 #a6e3a1
+color10 #a6e3a1
+color3 #f9e2af
+color11 #f9e2af
+color4 #89b4fa
+color12 #89b4fa
+color5 #f5c2e7
+color13 #f5c2e7
+color6 #94e2d5
+color14 #94e2d5
+color7 #bac2de
+color15 #a6adc8
+`,
+	TerminalFormatWezTerm: `
+[colors]
+ansi = ["#45475a", "#f38ba8", "#a6e3a1", "#f9e2af", "#89b4fa", "#f5c2e7", "#94e2d5", "#bac2de"]
+brights = ["#585b70", "#f38ba8", "#a6e3a1", "#f9e2af", "#89b4fa", "#f5c2e7", "#94e2d5", "#a6adc8"]
+background = "#1e1e2e"
+foreground = "#cdd6f4"
+cursor_bg = "#f5e0dc"
+selection_bg = "#585b70"
+
+[metadata]
+name = "Catppuccin Mocha"
+`,
+	TerminalFormatGhostty: `
+palette = 0=#45475a
+palette = 1=#f38ba8
+palette = 2=#a6e3a1
+palette = 3=#f9e2af
+palette = 4=#89b4fa
+palette = 5=#f5c2e7
+palette = 6=#94e2d5
+palette = 7=#bac2de
+palette = 8=#585b70
+palette = 9=#f38ba8
+palette = 10=#a6e3a1
+palette = 11=#f9e2af
+palette = 12=#89b4fa
+palette = 13=#f5c2e7
+palette = 14=#94e2d5
+palette = 15=#a6adc8
+background = 1e1e2e
+foreground = cdd6f4
+cursor-color = f5e0dc
+selection-background = 585b70
+selection-foreground = cdd6f4
+`,
+	TerminalFormatFoot: `
+[main]
+font=monospace:size=10
+
+[cursor]
+color=1e1e2e f5e0dc
+
+[colors]
+foreground=cdd6f4
+background=1e1e2e
+regular0=45475a
+regular1=f38ba8
+regular2=a6e3a1
+regular3=f9e2af
+regular4=89b4fa
+regular5=f5c2e7
+regular6=94e2d5
+regular7=bac2de
+bright0=585b70
+bright1=f38ba8
+bright2=a6e3a1
+bright3=f9e2af
+bright4=89b4fa
+bright5=f5c2e7
+bright6=94e2d5
+bright7=a6adc8
+selection-background=585b70
+`,
+	TerminalFormatXresources: `
+! Catppuccin Mocha
+#define bg #1e1e2e
+#define sel #585b70
+*.foreground: #cdd6f4
+*.background: bg
+*.cursorColor: #f5e0dc
+*.color0: #45475a
+*.color8: sel
+*.color1: #f38ba8
+*.color9: #f38ba8
+*.color2: #a6e3a1
+*.color10: #a6e3a1
+*.color3: #f9e2af
+URxvt*color11: #f9e2af
+*.color4: #89b4fa
+*.color12: #89b4fa
+*.color5: #f5c2e7
+*.color13: #f5c2e7
+*.color6: #94e2d5
+*.color14: #94e2d5
+*.color7: #bac2de
+XTerm.vt100.color15: #a6adc8
+`,
+	TerminalFormatWindowsTerminal: `{
+    // Catppuccin Mocha
+    "name": "Catppuccin Mocha",
+    "cursorColor": "#F5E0DC",
+    "selectionBackground": "#585B70",
+    "background": "#1E1E2E",
+    "foreground": "#CDD6F4",
+    "black": "#45475A",
+    "red": "#F38BA8",
+    "green": "#A6E3A1",
+    "yellow": "#F9E2AF",
+    "blue": "#89B4FA",
+    "purple": "#F5C2E7",
+    "cyan": "#94E2D5",
+    "white": "#BAC2DE",
+    "brightBlack": "#585B70",
+    "brightRed": "#F38BA8",
+    "brightGreen": "#A6E3A1",
+    "brightYellow": "#F9E2AF",
+    "brightBlue": "#89B4FA",
+    "brightPurple": "#F5C2E7",
+    "brightCyan": "#94E2D5",
+    "brightWhite": "#A6ADC8",
+}`,
+}
+
+func TestDetectTerminalFormat(t *testing.T) {
+	t.Parallel()
+
+	for want, sample := range terminalSamples {
+		t.Run(want.String(), func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DetectTerminalFormat([]byte(sample))
+			if err != nil {
+				t.Fatalf("DetectTerminalFormat() error: %v", err)
+			}
+			if got != want {
+				t.Errorf("DetectTerminalFormat() = %s, want %s", got, want)
+			}
+		})
+	}
+
+	t.Run("alacritty yaml", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := DetectTerminalFormat([]byte("colors:\n  primary:\n    background: '0x1e1e2e'\n"))
+		if err != nil || got != TerminalFormatAlacritty {
+			t.Errorf("DetectTerminalFormat() = %s, %v, want alacritty", got, err)
+		}
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := DetectTerminalFormat([]byte("just some text"))
+		if !errors.Is(err, ErrUnknownTerminalFormat) {
+			t.Errorf("DetectTerminalFormat() error = %v, want ErrUnknownTerminalFormat", err)
+		}
+	})
+}
+
+func TestParseTerminalTheme(t *testing.T) {
+	t.Parallel()
+
+	for format, sample := range terminalSamples {
+		t.Run(format.String(), func(t *testing.T) {
+			t.Parallel()
+
+			for _, f := range []TerminalFormat{TerminalFormatAuto, format} {
+				theme, err := ParseTerminalTheme(strings.NewReader(sample), f)
+				if err != nil {
+					t.Fatalf("ParseTerminalTheme(%s) error: %v", f, err)
+				}
+
+				checks := []struct {
+					name string
+					got  Color
+					want string
+				}{
+					{"background", theme.Background(), "#1e1e2e"},
+					{"foreground", theme.TextPrimary(), "#cdd6f4"},
+					{"red", theme.Red(), "#f38ba8"},
+					{"bright black", theme.BrightBlack(), "#585b70"},
+					{"bright white", theme.BrightWhite(), "#a6adc8"},
+					{"accent from blue", theme.Accent(), "#89b4fa"},
+					{"keyword from magenta", theme.CodeKeyword(), "#f5c2e7"},
+				}
+				for _, c := range checks {
+					if c.got.Hex() != c.want {
+						t.Errorf("%s = %s, want %s", c.name, c.got.Hex(), c.want)
+					}
+				}
+				if format != TerminalFormatXresources && theme.Surface().Hex() != "#585b70" {
+					t.Errorf("surface = %s, want selection #585b70", theme.Surface().Hex())
+				}
+				if !theme.IsDark() {
+					t.Error("IsDark() = false, want true")
+				}
+			}
+		})
+	}
+}
+
+func TestParseTerminalThemeAlacrittyYAML(t *testing.T) {
+	t.Parallel()
+
+	input := `colors:
+  primary:
+    background: '0x282a36'
+    foreground: 0xf8f8f2
+  normal:
+    red: '#ff5555'
+    blue: '#bd93f9'
+`
+	theme, err := ParseTerminalTheme(strings.NewReader(input), TerminalFormatAuto)
+	if err != nil {
+		t.Fatalf("ParseTerminalTheme() error: %v", err)
+	}
+	if theme.Background().Hex() != "#282a36" || theme.TextPrimary().Hex() != "#f8f8f2" {
+		t.Errorf("primary colors = %s/%s", theme.Background().Hex(), theme.TextPrimary().Hex())
+	}
+	if theme.Red().Hex() != "#ff5555" || theme.Accent().Hex() != "#bd93f9" {
+		t.Errorf("normal colors = %s/%s", theme.Red().Hex(), theme.Accent().Hex())
+	}
+}
+
+func TestParseTerminalThemeNames(t *testing.T) {
+	t.Parallel()
+
+	theme, err := ParseTerminalTheme(strings.NewReader(terminalSamples[TerminalFormatWezTerm]), TerminalFormatAuto)
+	if err != nil {
+		t.Fatalf("ParseTerminalTheme() error: %v", err)
+	}
+	if theme.ID() != "catppuccin_mocha" || theme.DisplayName() != "Catppuccin Mocha" {
+		t.Errorf("identity = %q/%q, want name from metadata", theme.ID(), theme.DisplayName())
+	}
+
+	theme, err = ParseTerminalTheme(strings.NewReader(terminalSamples[TerminalFormatKitty]), TerminalFormatAuto)
+	if err != nil {
+		t.Fatalf("ParseTerminalTheme() error: %v", err)
+	}
+	if theme.ID() != "imported" {
+		t.Errorf("ID() = %q, want imported", theme.ID())
+	}
+
+	path := filepath.Join(t.TempDir(), "Mocha Night.conf")
+	writeTestFile(t, path, terminalSamples[TerminalFormatKitty])
+	theme, err = LoadTerminalThemeFile(path)
+	if err != nil {
+		t.Fatalf("LoadTerminalThemeFile() error: %v", err)
+	}
+	if theme.ID() != "mocha_night" || theme.DisplayName() != "Mocha Night" {
+		t.Errorf("identity = %q/%q, want name from file", theme.ID(), theme.DisplayName())
+	}
+
+	// Names without ASCII letters or digits take the ID from the file name,
+	// then fall back to "imported".
+	cjk := strings.Replace(terminalSamples[TerminalFormatWezTerm], "Catppuccin Mocha", "夜空", 1)
+	theme, err = ParseTerminalTheme(strings.NewReader(cjk), TerminalFormatAuto)
+	if err != nil {
+		t.Fatalf("ParseTerminalTheme() error: %v", err)
+	}
+	if theme.ID() != "imported" || theme.DisplayName() != "夜空" {
+		t.Errorf("identity = %q/%q, want imported/夜空", theme.ID(), theme.DisplayName())
+	}
+
+	dir := t.TempDir()
+	tests := []struct {
+		file, src, want string
+	}{
+		{"night-sky.toml", cjk, "night_sky"},
+		{"夜空.toml", cjk, "imported"},
+		{"夜空.conf", terminalSamples[TerminalFormatKitty], "imported"},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(dir, tt.file)
+		writeTestFile(t, path, tt.src)
+		theme, err := LoadTerminalThemeFile(path)
+		if err != nil {
+			t.Fatalf("LoadTerminalThemeFile(%s) error: %v", tt.file, err)
+		}
+		if theme.ID() != tt.want {
+			t.Errorf("LoadTerminalThemeFile(%s) ID() = %q, want %q", tt.file, theme.ID(), tt.want)
+		}
+	}
+}
+
+func TestParseTerminalThemeErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		format  TerminalFormat
+		wantErr string
+	}{
+		{"undetectable", "hello", TerminalFormatAuto, "unknown terminal color format"},
+		{"invalid format", "x", TerminalFormat(99), "unknown terminal color format"},
+		{"bad ansi color", "color1 notacolor\nbackground #000000", TerminalFormatKitty, "line 1: color1"},
+		{"bad toml", "[colors\nansi = [", TerminalFormatWezTerm, "wezterm"},
+		{"bad palette entry", "palette = 3\nbackground = 000000", TerminalFormatGhostty, "want N=color"},
+		{"no colors", "color1 #ff0000", TerminalFormatKitty, "no background or foreground"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseTerminalTheme(strings.NewReader(tt.input), tt.format)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package gothememe
+
+import "testing"
+
+func testTerminalScheme() terminalScheme {
+	return terminalScheme{
+		background: Hex("#1e1e2e"),
+		foreground: Hex("#cdd6f4"),
+		selection:  Hex("#585b70"),
+		ansi: [16]Color{
+			Hex("#45475a"), Hex("#f38ba8"), Hex("#a6e3a1"), Hex("#f9e2af"),
+			Hex("#89b4fa"), Hex("#f5c2e7"), Hex("#94e2d5"), Hex("#bac2de"),
+			Hex("#585b70"), Hex("#f37799"), Hex("#89d88b"), Hex("#ebd391"),
+			Hex("#74a8fc"), Hex("#f2aede"), Hex("#6bd7ca"), Hex("#a6adc8"),
+		},
+	}
+}
+
+func TestTerminalSchemeTheme(t *testing.T) {
+	t.Parallel()
+
+	s := testTerminalScheme()
+	theme := s.theme("mocha", "Mocha")
+
+	// The role assignments must match the themes generated by themegen.
+	tests := []struct {
+		name string
+		got  Color
+		want Color
+	}{
+		{"background", theme.Background(), s.background},
+		{"background secondary", theme.BackgroundSecondary(), s.ansi[0]},
+		{"surface", theme.Surface(), s.selection},
+		{"surface secondary", theme.SurfaceSecondary(), s.ansi[8]},
+		{"text primary", theme.TextPrimary(), s.foreground},
+		{"text secondary", theme.TextSecondary(), s.ansi[7]},
+		{"text muted", theme.TextMuted(), s.ansi[8]},
+		{"text inverted", theme.TextInverted(), s.background},
+		{"accent", theme.Accent(), s.ansi[4]},
+		{"accent secondary", theme.AccentSecondary(), s.ansi[5]},
+		{"brand", theme.Brand(), s.ansi[4]},
+		{"border", theme.Border(), s.ansi[8]},
+		{"border subtle", theme.BorderSubtle(), s.ansi[0]},
+		{"border strong", theme.BorderStrong(), s.ansi[7]},
+		{"success", theme.Success().Text, s.ansi[2]},
+		{"warning", theme.Warning().Text, s.ansi[3]},
+		{"error", theme.Error().Text, s.ansi[1]},
+		{"info", theme.Info().Text, s.ansi[6]},
+		{"error background", theme.Error().Background, s.ansi[1].WithAlpha(0.1)},
+		{"error border", theme.Error().Border, s.ansi[1].WithAlpha(0.3)},
+		{"bright white", theme.BrightWhite(), s.ansi[15]},
+		{"code comment", theme.CodeComment(), s.ansi[8]},
+		{"code keyword", theme.CodeKeyword(), s.ansi[5]},
+		{"code string", theme.CodeString(), s.ansi[2]},
+		{"code number", theme.CodeNumber(), s.ansi[3]},
+		{"code function", theme.CodeFunction(), s.ansi[4]},
+		{"code operator", theme.CodeOperator(), s.ansi[1]},
+		{"code punctuation", theme.CodePunctuation(), s.foreground},
+		{"code variable", theme.CodeVariable(), s.ansi[6]},
+		{"code constant", theme.CodeConstant(), s.ansi[11]},
+		{"code type", theme.CodeType(), s.ansi[14]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if tt.got.Hex() != tt.want.Hex() {
+				t.Errorf("got %s, want %s", tt.got.Hex(), tt.want.Hex())
+			}
+		})
+	}
+
+	if !theme.IsDark() {
+		t.Error("IsDark() = false, want detection from dark background")
+	}
+}
+
+func TestTerminalSchemeThemeMissingColors(t *testing.T) {
+	t.Parallel()
+
+	s := terminalScheme{background: Hex("#ffffff"), foreground: Hex("#000000")}
+	theme := s.theme("sparse", "Sparse")
+
+	if theme.IsDark() {
+		t.Error("IsDark() = true, want false")
+	}
+	if theme.Surface().IsEmpty() || theme.Error().Text.IsEmpty() || theme.CodeBackground().IsEmpty() {
+		t.Error("missing roles should be derived by the builder")
+	}
+}
+
+func TestTerminalSchemeFromTheme(t *testing.T) {
+	t.Parallel()
+
+	theme := testTerminalScheme().theme("mocha", "Mocha")
+	s := terminalSchemeFromTheme(theme)
+
+	if s.name != "Mocha" {
+		t.Errorf("name = %q, want Mocha", s.name)
+	}
+	if s.background != theme.Background() || s.foreground != theme.TextPrimary() {
+		t.Error("background/foreground not taken from the theme")
+	}
+	if s.selection != theme.Surface() || s.cursor != theme.TextPrimary() || s.link != theme.Accent() {
+		t.Error("selection, cursor or link not taken from the theme")
+	}
+	for i, role := range ansiRoles {
+		if s.ansi[i] != role.get(theme) {
+			t.Errorf("ansi[%d] = %s, want %s", i, s.ansi[i].Hex(), role.get(theme).Hex())
+		}
+	}
+}
+
+func TestTerminalFormatString(t *testing.T) {
+	t.Parallel()
+
+	want := map[TerminalFormat]string{
+		TerminalFormatAuto:            "auto",
+		TerminalFormatAlacritty:       "alacritty",
+		TerminalFormatKitty:           "kitty",
+		TerminalFormatWezTerm:         "wezterm",
+		TerminalFormatGhostty:         "ghostty",
+		TerminalFormatFoot:            "foot",
+		TerminalFormatXresources:      "xresources",
+		TerminalFormatWindowsTerminal: "windows-terminal",
+		TerminalFormat(99):            "unknown",
+	}
+	for f, name := range want {
+		if got := f.String(); got != name {
+			t.Errorf("TerminalFormat(%d).String() = %q, want %q", int(f), got, name)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package gothememe
+
+import (
+	"crypto/sha1" //nolint:gosec // G505: the digest only derives a stable UUID, not a security boundary
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// textMateGlobal is one global editor color, under its .tmTheme key and its
+// .sublime-color-scheme key. An empty key leaves the color out of that
+// format.
+type textMateGlobal struct {
+	tmTheme string
+	sublime string
+	c       Color
+}
+
+// textMateGlobals returns the global editor colors of t, taken from the UI
+// roles.
+func textMateGlobals(t Theme) []textMateGlobal {
+	success, warning, errs := t.Success(), t.Warning(), t.Error()
+	return []textMateGlobal{
+		{"background", "background", t.Background()},
+		{"foreground", "foreground", t.TextPrimary()},
+		{"caret", "caret", t.TextPrimary()},
+		{"selection", "selection", t.Surface()},
+		{"lineHighlight", "line_highlight", t.BackgroundSecondary()},
+		{"invisibles", "", t.BorderSubtle()},
+		{"guide", "guide", t.BorderSubtle()},
+		{"activeGuide", "active_guide", t.BorderStrong()},
+		{"gutter", "gutter", t.Background()},
+		{"gutterForeground", "gutter_foreground", t.TextMuted()},
+		{"findHighlight", "find_highlight", t.Accent()},
+		{"findHighlightForeground", "find_highlight_foreground", t.TextInverted()},
+		{"", "accent", t.Accent()},
+		{"", "misspelling", errs.Text},
+		{"", "line_diff_added", success.Text},
+		{"", "line_diff_modified", warning.Text},
+		{"", "line_diff_deleted", errs.Text},
+	}
+}
+
+// textMateRule is one scope rule of an exported color scheme.
+type textMateRule struct {
+	name  string
+	scope string
+	c     Color
+}
+
+// textMateRules returns the scope rules of t: the code roles under the
+// scopes [ParseVSCodeTheme] reads, followed by diff markup and invalid code
+// from the semantic colors. Rules without a color are left out.
+func textMateRules(t Theme) []textMateRule {
+	var rules []textMateRule
+	for _, m := range vscodeScopes {
+		role, _ := lookupColorRole(m.role) //nolint:errcheck // vscodeScopes holds valid role keys
+		rules = append(rules, textMateRule{m.name, strings.Join(m.scopes, ", "), role.get(t)})
+	}
+	rules = append(rules,
+		textMateRule{"Inserted", "markup.inserted", t.Success().Text},
+		textMateRule{"Changed", "markup.changed", t.Warning().Text},
+		textMateRule{"Deleted", "markup.deleted", t.Error().Text},
+		textMateRule{"Invalid", "invalid", t.Error().Text},
+	)
+	return slices.DeleteFunc(rules, func(r textMateRule) bool { return r.c.IsEmpty() })
+}
+
+// WriteTMTheme writes t to w as a TextMate .tmTheme property list, the
+// format read by TextMate, Sublime Text, bat and syntect, Zed's theme
+// importer and many static-site highlighters. The global settings come from
+// the UI roles and the scope rules from the code roles.
+func WriteTMTheme(w io.Writer, t Theme) error {
+	globals := plistDict{}
+	for _, g := range textMateGlobals(t) {
+		if g.tmTheme != "" && !g.c.IsEmpty() {
+			globals = append(globals, plistEntry{g.tmTheme, g.c.Hex()})
+		}
+	}
+
+	settings := []any{plistDict{{"settings", globals}}}
+	for _, r := range textMateRules(t) {
+		settings = append(settings, plistDict{
+			{"name", r.name},
+			{"scope", r.scope},
+			{"settings", plistDict{{"foreground", r.c.Hex()}}},
+		})
+	}
+
+	class := "theme.light."
+	if t.IsDark() {
+		class = "theme.dark."
+	}
+
+	dict := plistDict{{"name", t.DisplayName()}}
+	if author := t.Author(); author != "" {
+		dict = append(dict, plistEntry{"author", author})
+	}
+	dict = append(dict,
+		plistEntry{"uuid", textMateUUID(t.ID())},
+		plistEntry{"colorSpaceName", "sRGB"},
+		plistEntry{"semanticClass", class + t.ID()},
+		plistEntry{"settings", settings},
+	)
+	return writePlist(w, dict)
+}
+
+// textMateUUID derives a stable UUID for a theme ID, so regenerating a theme
+// doesn't make TextMate treat it as a new one.
+func textMateUUID(id string) string {
+	sum := sha1.Sum([]byte("gothememe:" + id)) //nolint:gosec // G401: the digest only derives a stable UUID
+	sum[6] = sum[6]&0x0f | 0x50
+	sum[8] = sum[8]&0x3f | 0x80
+	return fmt.Sprintf("%X-%X-%X-%X-%X", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+}
+
+// sublimeColorScheme is the shape of a .sublime-color-scheme file.
+type sublimeColorScheme struct {
+	Name    string              `json:"name"`
+	Author  string              `json:"author,omitempty"`
+	Globals orderedJSON         `json:"globals"`
+	Rules   []sublimeSchemeRule `json:"rules"`
+}
+
+// sublimeSchemeRule is one entry of a color scheme's rules.
+type sublimeSchemeRule struct {
+	Name       string `json:"name"`
+	Scope      string `json:"scope"`
+	Foreground string `json:"foreground"`
+}
+
+// WriteSublimeColorScheme writes t to w as a Sublime Text
+// .sublime-color-scheme file, with the same global colors and scope rules as
+// [WriteTMTheme] plus Sublime's accent, misspelling and line diff colors.
+func WriteSublimeColorScheme(w io.Writer, t Theme) error {
+	scheme := sublimeColorScheme{
+		Name:   t.DisplayName(),
+		Author: t.Author(),
+	}
+	for _, g := range textMateGlobals(t) {
+		if g.sublime != "" && !g.c.IsEmpty() {
+			scheme.Globals = append(scheme.Globals, orderedJSONField{g.sublime, g.c.Hex()})
+		}
+	}
+	for _, r := range textMateRules(t) {
+		scheme.Rules = append(scheme.Rules, sublimeSchemeRule{r.name, r.scope, r.c.Hex()})
+	}
+
+	data, err := json.MarshalIndent(scheme, "", "    ")
+	if err != nil {
+		return fmt.Errorf("encoding Sublime color scheme: %w", err)
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
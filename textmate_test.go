@@ -0,0 +1,159 @@
+package gothememe
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestWriteTMTheme(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	var sb strings.Builder
+	if err := WriteTMTheme(&sb, theme); err != nil {
+		t.Fatalf("WriteTMTheme() error: %v", err)
+	}
+
+	decoded, err := decodePlist(strings.NewReader(sb.String()))
+	if err != nil {
+		t.Fatalf("output is not a valid plist: %v\n%s", err, sb.String())
+	}
+	dict, ok := decoded.(map[string]any)
+	if !ok {
+		t.Fatalf("plist root is %T, want a dict", decoded)
+	}
+	if dict["name"] != theme.DisplayName() {
+		t.Errorf("name = %v, want %q", dict["name"], theme.DisplayName())
+	}
+	uuid, _ := dict["uuid"].(string) //nolint:errcheck // a missing uuid fails the pattern below
+	if !regexp.MustCompile(`^[0-9A-F]{8}-[0-9A-F]{4}-5[0-9A-F]{3}-[89AB][0-9A-F]{3}-[0-9A-F]{12}$`).MatchString(uuid) {
+		t.Errorf("uuid = %q, want a version 5 UUID", uuid)
+	}
+	if uuid != textMateUUID(theme.ID()) || uuid == textMateUUID("other") {
+		t.Error("uuid is not stable per theme ID")
+	}
+
+	settings, ok := dict["settings"].([]any)
+	if !ok || len(settings) < 2 {
+		t.Fatalf("settings = %v, want globals and rules", dict["settings"])
+	}
+	wantGlobals := map[string]any{"settings": map[string]any{
+		"background":              "#1e1e2e",
+		"foreground":              "#cdd6f4",
+		"caret":                   "#cdd6f4",
+		"selection":               theme.Surface().Hex(),
+		"lineHighlight":           theme.BackgroundSecondary().Hex(),
+		"invisibles":              theme.BorderSubtle().Hex(),
+		"guide":                   theme.BorderSubtle().Hex(),
+		"activeGuide":             theme.BorderStrong().Hex(),
+		"gutter":                  "#1e1e2e",
+		"gutterForeground":        theme.TextMuted().Hex(),
+		"findHighlight":           theme.Accent().Hex(),
+		"findHighlightForeground": theme.TextInverted().Hex(),
+	}}
+	if !reflect.DeepEqual(settings[0], wantGlobals) {
+		t.Errorf("global settings = %#v, want %#v", settings[0], wantGlobals)
+	}
+	wantString := map[string]any{
+		"name":     "Strings",
+		"scope":    "string.quoted, string",
+		"settings": map[string]any{"foreground": theme.CodeString().Hex()},
+	}
+	if !slices.ContainsFunc(settings[1:], func(v any) bool { return reflect.DeepEqual(v, wantString) }) {
+		t.Errorf("settings lack the string rule %#v", wantString)
+	}
+	wantDeleted := map[string]any{
+		"name":     "Deleted",
+		"scope":    "markup.deleted",
+		"settings": map[string]any{"foreground": theme.Error().Text.Hex()},
+	}
+	if !slices.ContainsFunc(settings[1:], func(v any) bool { return reflect.DeepEqual(v, wantDeleted) }) {
+		t.Errorf("settings lack the markup.deleted rule %#v", wantDeleted)
+	}
+}
+
+func TestWriteSublimeColorScheme(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	var sb strings.Builder
+	if err := WriteSublimeColorScheme(&sb, theme); err != nil {
+		t.Fatalf("WriteSublimeColorScheme() error: %v", err)
+	}
+
+	var scheme struct {
+		Name    string            `json:"name"`
+		Globals map[string]string `json:"globals"`
+		Rules   []struct {
+			Scope      string `json:"scope"`
+			Foreground string `json:"foreground"`
+		} `json:"rules"`
+	}
+	if err := json.Unmarshal([]byte(sb.String()), &scheme); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, sb.String())
+	}
+	if scheme.Name != theme.DisplayName() {
+		t.Errorf("name = %q, want %q", scheme.Name, theme.DisplayName())
+	}
+	wantGlobals := map[string]string{
+		"background":      "#1e1e2e",
+		"caret":           "#cdd6f4",
+		"selection":       theme.Surface().Hex(),
+		"line_highlight":  theme.BackgroundSecondary().Hex(),
+		"accent":          theme.Accent().Hex(),
+		"line_diff_added": theme.Success().Text.Hex(),
+	}
+	for key, want := range wantGlobals {
+		if scheme.Globals[key] != want {
+			t.Errorf("global %s = %q, want %s", key, scheme.Globals[key], want)
+		}
+	}
+	if _, ok := scheme.Globals["lineHighlight"]; ok {
+		t.Error("globals use the .tmTheme key lineHighlight")
+	}
+	if len(scheme.Rules) == 0 || scheme.Rules[0].Scope != "comment.line, comment" || scheme.Rules[0].Foreground != theme.CodeComment().Hex() {
+		t.Errorf("first rule = %+v, want the comment rule", scheme.Rules)
+	}
+}
+
+func TestExportTextMateFormats(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	tests := []struct {
+		format OutputFormat
+		path   string
+		write  func(io.Writer, Theme) error
+	}{
+		{FormatTMTheme, "Acme.tmTheme", WriteTMTheme},
+		{FormatSublime, "Acme.sublime-color-scheme", WriteSublimeColorScheme},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format.String(), func(t *testing.T) {
+			t.Parallel()
+
+			if format, err := OutputFormatFromPath(tt.path); err != nil || format != tt.format {
+				t.Errorf("OutputFormatFromPath(%q) = %s, %v", tt.path, format, err)
+			}
+			var want, got strings.Builder
+			if err := tt.write(&want, theme); err != nil {
+				t.Fatalf("write error: %v", err)
+			}
+			if err := Export(&got, tt.format, theme); err != nil {
+				t.Fatalf("Export() error: %v", err)
+			}
+			if got.String() != want.String() {
+				t.Error("Export() output differs from the writer")
+			}
+			if err := Export(io.Discard, tt.format, theme, theme); !errors.Is(err, ErrTooManyThemes) {
+				t.Errorf("Export() with two themes error = %v, want ErrTooManyThemes", err)
+			}
+		})
+	}
+}
@@ -43,32 +43,32 @@ func (t *themeN0x96f) BorderStrong() gothememe.Color { return gothememe.Hex("#fc
 // Semantic colors
 func (t *themeN0x96f) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b3e03a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b3e03a").WithAlpha(0.3),
+		Background: gothememe.Hex("#b3e03a19"),
+		Border:     gothememe.Hex("#b3e03a4c"),
 		Text:       gothememe.Hex("#b3e03a"),
 	}
 }
 
 func (t *themeN0x96f) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc739").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc739").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc73919"),
+		Border:     gothememe.Hex("#ffc7394c"),
 		Text:       gothememe.Hex("#ffc739"),
 	}
 }
 
 func (t *themeN0x96f) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff666d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff666d").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff666d19"),
+		Border:     gothememe.Hex("#ff666d4c"),
 		Text:       gothememe.Hex("#ff666d"),
 	}
 }
 
 func (t *themeN0x96f) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9deaf6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9deaf6").WithAlpha(0.3),
+		Background: gothememe.Hex("#9deaf619"),
+		Border:     gothememe.Hex("#9deaf64c"),
 		Text:       gothememe.Hex("#9deaf6"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeN12BitRainbow) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeN12BitRainbow) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#40d080").WithAlpha(0.1),
-		Border:     gothememe.Hex("#40d080").WithAlpha(0.3),
+		Background: gothememe.Hex("#40d08019"),
+		Border:     gothememe.Hex("#40d0804c"),
 		Text:       gothememe.Hex("#40d080"),
 	}
 }
 
 func (t *themeN12BitRainbow) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e09040").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e09040").WithAlpha(0.3),
+		Background: gothememe.Hex("#e0904019"),
+		Border:     gothememe.Hex("#e090404c"),
 		Text:       gothememe.Hex("#e09040"),
 	}
 }
 
 func (t *themeN12BitRainbow) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a03050").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a03050").WithAlpha(0.3),
+		Background: gothememe.Hex("#a0305019"),
+		Border:     gothememe.Hex("#a030504c"),
 		Text:       gothememe.Hex("#a03050"),
 	}
 }
 
 func (t *themeN12BitRainbow) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0090c0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0090c0").WithAlpha(0.3),
+		Background: gothememe.Hex("#0090c019"),
+		Border:     gothememe.Hex("#0090c04c"),
 		Text:       gothememe.Hex("#0090c0"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeN3024Day) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeN3024Day) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#01a252").WithAlpha(0.1),
-		Border:     gothememe.Hex("#01a252").WithAlpha(0.3),
+		Background: gothememe.Hex("#01a25219"),
+		Border:     gothememe.Hex("#01a2524c"),
 		Text:       gothememe.Hex("#01a252"),
 	}
 }
 
 func (t *themeN3024Day) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#caba00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#caba00").WithAlpha(0.3),
+		Background: gothememe.Hex("#caba0019"),
+		Border:     gothememe.Hex("#caba004c"),
 		Text:       gothememe.Hex("#caba00"),
 	}
 }
 
 func (t *themeN3024Day) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#db2d20").WithAlpha(0.1),
-		Border:     gothememe.Hex("#db2d20").WithAlpha(0.3),
+		Background: gothememe.Hex("#db2d2019"),
+		Border:     gothememe.Hex("#db2d204c"),
 		Text:       gothememe.Hex("#db2d20"),
 	}
 }
 
 func (t *themeN3024Day) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8fbece").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8fbece").WithAlpha(0.3),
+		Background: gothememe.Hex("#8fbece19"),
+		Border:     gothememe.Hex("#8fbece4c"),
 		Text:       gothememe.Hex("#8fbece"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeN3024Night) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeN3024Night) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#01a252").WithAlpha(0.1),
-		Border:     gothememe.Hex("#01a252").WithAlpha(0.3),
+		Background: gothememe.Hex("#01a25219"),
+		Border:     gothememe.Hex("#01a2524c"),
 		Text:       gothememe.Hex("#01a252"),
 	}
 }
 
 func (t *themeN3024Night) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fded02").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fded02").WithAlpha(0.3),
+		Background: gothememe.Hex("#fded0219"),
+		Border:     gothememe.Hex("#fded024c"),
 		Text:       gothememe.Hex("#fded02"),
 	}
 }
 
 func (t *themeN3024Night) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#db2d20").WithAlpha(0.1),
-		Border:     gothememe.Hex("#db2d20").WithAlpha(0.3),
+		Background: gothememe.Hex("#db2d2019"),
+		Border:     gothememe.Hex("#db2d204c"),
 		Text:       gothememe.Hex("#db2d20"),
 	}
 }
 
 func (t *themeN3024Night) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b5e4f4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b5e4f4").WithAlpha(0.3),
+		Background: gothememe.Hex("#b5e4f419"),
+		Border:     gothememe.Hex("#b5e4f44c"),
 		Text:       gothememe.Hex("#b5e4f4"),
 	}
 }
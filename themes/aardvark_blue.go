@@ -45,32 +45,32 @@ func (t *themeAardvarkBlue) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeAardvarkBlue) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4b8c0f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4b8c0f").WithAlpha(0.3),
+		Background: gothememe.Hex("#4b8c0f19"),
+		Border:     gothememe.Hex("#4b8c0f4c"),
 		Text:       gothememe.Hex("#4b8c0f"),
 	}
 }
 
 func (t *themeAardvarkBlue) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dbba00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dbba00").WithAlpha(0.3),
+		Background: gothememe.Hex("#dbba0019"),
+		Border:     gothememe.Hex("#dbba004c"),
 		Text:       gothememe.Hex("#dbba00"),
 	}
 }
 
 func (t *themeAardvarkBlue) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aa342e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aa342e").WithAlpha(0.3),
+		Background: gothememe.Hex("#aa342e19"),
+		Border:     gothememe.Hex("#aa342e4c"),
 		Text:       gothememe.Hex("#aa342e"),
 	}
 }
 
 func (t *themeAardvarkBlue) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#008eb0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#008eb0").WithAlpha(0.3),
+		Background: gothememe.Hex("#008eb019"),
+		Border:     gothememe.Hex("#008eb04c"),
 		Text:       gothememe.Hex("#008eb0"),
 	}
 }
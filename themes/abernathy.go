@@ -43,32 +43,32 @@ func (t *themeAbernathy) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeAbernathy) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00cd00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00cd00").WithAlpha(0.3),
+		Background: gothememe.Hex("#00cd0019"),
+		Border:     gothememe.Hex("#00cd004c"),
 		Text:       gothememe.Hex("#00cd00"),
 	}
 }
 
 func (t *themeAbernathy) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cdcd00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cdcd00").WithAlpha(0.3),
+		Background: gothememe.Hex("#cdcd0019"),
+		Border:     gothememe.Hex("#cdcd004c"),
 		Text:       gothememe.Hex("#cdcd00"),
 	}
 }
 
 func (t *themeAbernathy) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cd0000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cd0000").WithAlpha(0.3),
+		Background: gothememe.Hex("#cd000019"),
+		Border:     gothememe.Hex("#cd00004c"),
 		Text:       gothememe.Hex("#cd0000"),
 	}
 }
 
 func (t *themeAbernathy) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00cdcd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00cdcd").WithAlpha(0.3),
+		Background: gothememe.Hex("#00cdcd19"),
+		Border:     gothememe.Hex("#00cdcd4c"),
 		Text:       gothememe.Hex("#00cdcd"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeAdventure) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeAdventure) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5da602").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5da602").WithAlpha(0.3),
+		Background: gothememe.Hex("#5da60219"),
+		Border:     gothememe.Hex("#5da6024c"),
 		Text:       gothememe.Hex("#5da602"),
 	}
 }
 
 func (t *themeAdventure) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#eebb6e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#eebb6e").WithAlpha(0.3),
+		Background: gothememe.Hex("#eebb6e19"),
+		Border:     gothememe.Hex("#eebb6e4c"),
 		Text:       gothememe.Hex("#eebb6e"),
 	}
 }
 
 func (t *themeAdventure) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d84a33").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d84a33").WithAlpha(0.3),
+		Background: gothememe.Hex("#d84a3319"),
+		Border:     gothememe.Hex("#d84a334c"),
 		Text:       gothememe.Hex("#d84a33"),
 	}
 }
 
 func (t *themeAdventure) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bdcfe5").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bdcfe5").WithAlpha(0.3),
+		Background: gothememe.Hex("#bdcfe519"),
+		Border:     gothememe.Hex("#bdcfe54c"),
 		Text:       gothememe.Hex("#bdcfe5"),
 	}
 }
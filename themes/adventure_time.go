@@ -45,32 +45,32 @@ func (t *themeAdventureTime) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeAdventureTime) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4ab118").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4ab118").WithAlpha(0.3),
+		Background: gothememe.Hex("#4ab11819"),
+		Border:     gothememe.Hex("#4ab1184c"),
 		Text:       gothememe.Hex("#4ab118"),
 	}
 }
 
 func (t *themeAdventureTime) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e7741e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e7741e").WithAlpha(0.3),
+		Background: gothememe.Hex("#e7741e19"),
+		Border:     gothememe.Hex("#e7741e4c"),
 		Text:       gothememe.Hex("#e7741e"),
 	}
 }
 
 func (t *themeAdventureTime) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bd0013").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bd0013").WithAlpha(0.3),
+		Background: gothememe.Hex("#bd001319"),
+		Border:     gothememe.Hex("#bd00134c"),
 		Text:       gothememe.Hex("#bd0013"),
 	}
 }
 
 func (t *themeAdventureTime) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#70a598").WithAlpha(0.1),
-		Border:     gothememe.Hex("#70a598").WithAlpha(0.3),
+		Background: gothememe.Hex("#70a59819"),
+		Border:     gothememe.Hex("#70a5984c"),
 		Text:       gothememe.Hex("#70a598"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeAdwaita) BorderStrong() gothememe.Color { return gothememe.Hex("#c
 // Semantic colors
 func (t *themeAdwaita) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2ec27e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2ec27e").WithAlpha(0.3),
+		Background: gothememe.Hex("#2ec27e19"),
+		Border:     gothememe.Hex("#2ec27e4c"),
 		Text:       gothememe.Hex("#2ec27e"),
 	}
 }
 
 func (t *themeAdwaita) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e8b504").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e8b504").WithAlpha(0.3),
+		Background: gothememe.Hex("#e8b50419"),
+		Border:     gothememe.Hex("#e8b5044c"),
 		Text:       gothememe.Hex("#e8b504"),
 	}
 }
 
 func (t *themeAdwaita) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c01c28").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c01c28").WithAlpha(0.3),
+		Background: gothememe.Hex("#c01c2819"),
+		Border:     gothememe.Hex("#c01c284c"),
 		Text:       gothememe.Hex("#c01c28"),
 	}
 }
 
 func (t *themeAdwaita) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0ab9dc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0ab9dc").WithAlpha(0.3),
+		Background: gothememe.Hex("#0ab9dc19"),
+		Border:     gothememe.Hex("#0ab9dc4c"),
 		Text:       gothememe.Hex("#0ab9dc"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeAdwaitaDark) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeAdwaitaDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2ec27e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2ec27e").WithAlpha(0.3),
+		Background: gothememe.Hex("#2ec27e19"),
+		Border:     gothememe.Hex("#2ec27e4c"),
 		Text:       gothememe.Hex("#2ec27e"),
 	}
 }
 
 func (t *themeAdwaitaDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f5c211").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f5c211").WithAlpha(0.3),
+		Background: gothememe.Hex("#f5c21119"),
+		Border:     gothememe.Hex("#f5c2114c"),
 		Text:       gothememe.Hex("#f5c211"),
 	}
 }
 
 func (t *themeAdwaitaDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c01c28").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c01c28").WithAlpha(0.3),
+		Background: gothememe.Hex("#c01c2819"),
+		Border:     gothememe.Hex("#c01c284c"),
 		Text:       gothememe.Hex("#c01c28"),
 	}
 }
 
 func (t *themeAdwaitaDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0ab9dc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0ab9dc").WithAlpha(0.3),
+		Background: gothememe.Hex("#0ab9dc19"),
+		Border:     gothememe.Hex("#0ab9dc4c"),
 		Text:       gothememe.Hex("#0ab9dc"),
 	}
 }
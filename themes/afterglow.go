@@ -43,32 +43,32 @@ func (t *themeAfterglow) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeAfterglow) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7e8e50").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7e8e50").WithAlpha(0.3),
+		Background: gothememe.Hex("#7e8e5019"),
+		Border:     gothememe.Hex("#7e8e504c"),
 		Text:       gothememe.Hex("#7e8e50"),
 	}
 }
 
 func (t *themeAfterglow) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e5b567").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e5b567").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5b56719"),
+		Border:     gothememe.Hex("#e5b5674c"),
 		Text:       gothememe.Hex("#e5b567"),
 	}
 }
 
 func (t *themeAfterglow) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ac4142").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ac4142").WithAlpha(0.3),
+		Background: gothememe.Hex("#ac414219"),
+		Border:     gothememe.Hex("#ac41424c"),
 		Text:       gothememe.Hex("#ac4142"),
 	}
 }
 
 func (t *themeAfterglow) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7dd6cf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7dd6cf").WithAlpha(0.3),
+		Background: gothememe.Hex("#7dd6cf19"),
+		Border:     gothememe.Hex("#7dd6cf4c"),
 		Text:       gothememe.Hex("#7dd6cf"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeAlabaster) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeAlabaster) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#448c27").WithAlpha(0.1),
-		Border:     gothememe.Hex("#448c27").WithAlpha(0.3),
+		Background: gothememe.Hex("#448c2719"),
+		Border:     gothememe.Hex("#448c274c"),
 		Text:       gothememe.Hex("#448c27"),
 	}
 }
 
 func (t *themeAlabaster) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cb9000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cb9000").WithAlpha(0.3),
+		Background: gothememe.Hex("#cb900019"),
+		Border:     gothememe.Hex("#cb90004c"),
 		Text:       gothememe.Hex("#cb9000"),
 	}
 }
 
 func (t *themeAlabaster) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aa3731").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aa3731").WithAlpha(0.3),
+		Background: gothememe.Hex("#aa373119"),
+		Border:     gothememe.Hex("#aa37314c"),
 		Text:       gothememe.Hex("#aa3731"),
 	}
 }
 
 func (t *themeAlabaster) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0083b2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0083b2").WithAlpha(0.3),
+		Background: gothememe.Hex("#0083b219"),
+		Border:     gothememe.Hex("#0083b24c"),
 		Text:       gothememe.Hex("#0083b2"),
 	}
 }
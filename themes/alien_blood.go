@@ -43,32 +43,32 @@ func (t *themeAlienBlood) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeAlienBlood) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2f7e25").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2f7e25").WithAlpha(0.3),
+		Background: gothememe.Hex("#2f7e2519"),
+		Border:     gothememe.Hex("#2f7e254c"),
 		Text:       gothememe.Hex("#2f7e25"),
 	}
 }
 
 func (t *themeAlienBlood) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#717f24").WithAlpha(0.1),
-		Border:     gothememe.Hex("#717f24").WithAlpha(0.3),
+		Background: gothememe.Hex("#717f2419"),
+		Border:     gothememe.Hex("#717f244c"),
 		Text:       gothememe.Hex("#717f24"),
 	}
 }
 
 func (t *themeAlienBlood) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7f2b27").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7f2b27").WithAlpha(0.3),
+		Background: gothememe.Hex("#7f2b2719"),
+		Border:     gothememe.Hex("#7f2b274c"),
 		Text:       gothememe.Hex("#7f2b27"),
 	}
 }
 
 func (t *themeAlienBlood) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#327f77").WithAlpha(0.1),
-		Border:     gothememe.Hex("#327f77").WithAlpha(0.3),
+		Background: gothememe.Hex("#327f7719"),
+		Border:     gothememe.Hex("#327f774c"),
 		Text:       gothememe.Hex("#327f77"),
 	}
 }
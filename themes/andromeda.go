@@ -43,32 +43,32 @@ func (t *themeAndromeda) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeAndromeda) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#05bc79").WithAlpha(0.1),
-		Border:     gothememe.Hex("#05bc79").WithAlpha(0.3),
+		Background: gothememe.Hex("#05bc7919"),
+		Border:     gothememe.Hex("#05bc794c"),
 		Text:       gothememe.Hex("#05bc79"),
 	}
 }
 
 func (t *themeAndromeda) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e5e512").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e5e512").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5e51219"),
+		Border:     gothememe.Hex("#e5e5124c"),
 		Text:       gothememe.Hex("#e5e512"),
 	}
 }
 
 func (t *themeAndromeda) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cd3131").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cd3131").WithAlpha(0.3),
+		Background: gothememe.Hex("#cd313119"),
+		Border:     gothememe.Hex("#cd31314c"),
 		Text:       gothememe.Hex("#cd3131"),
 	}
 }
 
 func (t *themeAndromeda) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0fa8cd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0fa8cd").WithAlpha(0.3),
+		Background: gothememe.Hex("#0fa8cd19"),
+		Border:     gothememe.Hex("#0fa8cd4c"),
 		Text:       gothememe.Hex("#0fa8cd"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeAppleClassic) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeAppleClassic) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00c200").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00c200").WithAlpha(0.3),
+		Background: gothememe.Hex("#00c20019"),
+		Border:     gothememe.Hex("#00c2004c"),
 		Text:       gothememe.Hex("#00c200"),
 	}
 }
 
 func (t *themeAppleClassic) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c7c400").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c7c400").WithAlpha(0.3),
+		Background: gothememe.Hex("#c7c40019"),
+		Border:     gothememe.Hex("#c7c4004c"),
 		Text:       gothememe.Hex("#c7c400"),
 	}
 }
 
 func (t *themeAppleClassic) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c91b00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c91b00").WithAlpha(0.3),
+		Background: gothememe.Hex("#c91b0019"),
+		Border:     gothememe.Hex("#c91b004c"),
 		Text:       gothememe.Hex("#c91b00"),
 	}
 }
 
 func (t *themeAppleClassic) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00c5c7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00c5c7").WithAlpha(0.3),
+		Background: gothememe.Hex("#00c5c719"),
+		Border:     gothememe.Hex("#00c5c74c"),
 		Text:       gothememe.Hex("#00c5c7"),
 	}
 }
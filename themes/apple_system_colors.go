@@ -47,32 +47,32 @@ func (t *themeAppleSystemColors) BorderStrong() gothememe.Color { return gotheme
 // Semantic colors
 func (t *themeAppleSystemColors) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#26a439").WithAlpha(0.1),
-		Border:     gothememe.Hex("#26a439").WithAlpha(0.3),
+		Background: gothememe.Hex("#26a43919"),
+		Border:     gothememe.Hex("#26a4394c"),
 		Text:       gothememe.Hex("#26a439"),
 	}
 }
 
 func (t *themeAppleSystemColors) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cdac08").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cdac08").WithAlpha(0.3),
+		Background: gothememe.Hex("#cdac0819"),
+		Border:     gothememe.Hex("#cdac084c"),
 		Text:       gothememe.Hex("#cdac08"),
 	}
 }
 
 func (t *themeAppleSystemColors) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc372e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc372e").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc372e19"),
+		Border:     gothememe.Hex("#cc372e4c"),
 		Text:       gothememe.Hex("#cc372e"),
 	}
 }
 
 func (t *themeAppleSystemColors) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#479ec2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#479ec2").WithAlpha(0.3),
+		Background: gothememe.Hex("#479ec219"),
+		Border:     gothememe.Hex("#479ec24c"),
 		Text:       gothememe.Hex("#479ec2"),
 	}
 }
@@ -55,32 +55,32 @@ func (t *themeAppleSystemColorsLight) BorderStrong() gothememe.Color { return go
 // Semantic colors
 func (t *themeAppleSystemColorsLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#26a439").WithAlpha(0.1),
-		Border:     gothememe.Hex("#26a439").WithAlpha(0.3),
+		Background: gothememe.Hex("#26a43919"),
+		Border:     gothememe.Hex("#26a4394c"),
 		Text:       gothememe.Hex("#26a439"),
 	}
 }
 
 func (t *themeAppleSystemColorsLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cdac08").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cdac08").WithAlpha(0.3),
+		Background: gothememe.Hex("#cdac0819"),
+		Border:     gothememe.Hex("#cdac084c"),
 		Text:       gothememe.Hex("#cdac08"),
 	}
 }
 
 func (t *themeAppleSystemColorsLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc372e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc372e").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc372e19"),
+		Border:     gothememe.Hex("#cc372e4c"),
 		Text:       gothememe.Hex("#cc372e"),
 	}
 }
 
 func (t *themeAppleSystemColorsLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#479ec2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#479ec2").WithAlpha(0.3),
+		Background: gothememe.Hex("#479ec219"),
+		Border:     gothememe.Hex("#479ec24c"),
 		Text:       gothememe.Hex("#479ec2"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeArcoiris) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeArcoiris) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#12c258").WithAlpha(0.1),
-		Border:     gothememe.Hex("#12c258").WithAlpha(0.3),
+		Background: gothememe.Hex("#12c25819"),
+		Border:     gothememe.Hex("#12c2584c"),
 		Text:       gothememe.Hex("#12c258"),
 	}
 }
 
 func (t *themeArcoiris) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc656").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc656").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc65619"),
+		Border:     gothememe.Hex("#ffc6564c"),
 		Text:       gothememe.Hex("#ffc656"),
 	}
 }
 
 func (t *themeArcoiris) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#da2700").WithAlpha(0.1),
-		Border:     gothememe.Hex("#da2700").WithAlpha(0.3),
+		Background: gothememe.Hex("#da270019"),
+		Border:     gothememe.Hex("#da27004c"),
 		Text:       gothememe.Hex("#da2700"),
 	}
 }
 
 func (t *themeArcoiris) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#63fad5").WithAlpha(0.1),
-		Border:     gothememe.Hex("#63fad5").WithAlpha(0.3),
+		Background: gothememe.Hex("#63fad519"),
+		Border:     gothememe.Hex("#63fad54c"),
 		Text:       gothememe.Hex("#63fad5"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeArdoise) BorderStrong() gothememe.Color { return gothememe.Hex("#f
 // Semantic colors
 func (t *themeArdoise) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#588b35").WithAlpha(0.1),
-		Border:     gothememe.Hex("#588b35").WithAlpha(0.3),
+		Background: gothememe.Hex("#588b3519"),
+		Border:     gothememe.Hex("#588b354c"),
 		Text:       gothememe.Hex("#588b35"),
 	}
 }
 
 func (t *themeArdoise) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fca93a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fca93a").WithAlpha(0.3),
+		Background: gothememe.Hex("#fca93a19"),
+		Border:     gothememe.Hex("#fca93a4c"),
 		Text:       gothememe.Hex("#fca93a"),
 	}
 }
 
 func (t *themeArdoise) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d3322d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d3322d").WithAlpha(0.3),
+		Background: gothememe.Hex("#d3322d19"),
+		Border:     gothememe.Hex("#d3322d4c"),
 		Text:       gothememe.Hex("#d3322d"),
 	}
 }
 
 func (t *themeArdoise) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#64e1b8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#64e1b8").WithAlpha(0.3),
+		Background: gothememe.Hex("#64e1b819"),
+		Border:     gothememe.Hex("#64e1b84c"),
 		Text:       gothememe.Hex("#64e1b8"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeArgonaut) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeArgonaut) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8ce10b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8ce10b").WithAlpha(0.3),
+		Background: gothememe.Hex("#8ce10b19"),
+		Border:     gothememe.Hex("#8ce10b4c"),
 		Text:       gothememe.Hex("#8ce10b"),
 	}
 }
 
 func (t *themeArgonaut) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffb900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffb900").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffb90019"),
+		Border:     gothememe.Hex("#ffb9004c"),
 		Text:       gothememe.Hex("#ffb900"),
 	}
 }
 
 func (t *themeArgonaut) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff000f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff000f").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff000f19"),
+		Border:     gothememe.Hex("#ff000f4c"),
 		Text:       gothememe.Hex("#ff000f"),
 	}
 }
 
 func (t *themeArgonaut) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00d8eb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00d8eb").WithAlpha(0.3),
+		Background: gothememe.Hex("#00d8eb19"),
+		Border:     gothememe.Hex("#00d8eb4c"),
 		Text:       gothememe.Hex("#00d8eb"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeArthur) BorderStrong() gothememe.Color { return gothememe.Hex("#bb
 // Semantic colors
 func (t *themeArthur) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#86af80").WithAlpha(0.1),
-		Border:     gothememe.Hex("#86af80").WithAlpha(0.3),
+		Background: gothememe.Hex("#86af8019"),
+		Border:     gothememe.Hex("#86af804c"),
 		Text:       gothememe.Hex("#86af80"),
 	}
 }
 
 func (t *themeArthur) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e8ae5b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e8ae5b").WithAlpha(0.3),
+		Background: gothememe.Hex("#e8ae5b19"),
+		Border:     gothememe.Hex("#e8ae5b4c"),
 		Text:       gothememe.Hex("#e8ae5b"),
 	}
 }
 
 func (t *themeArthur) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cd5c5c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cd5c5c").WithAlpha(0.3),
+		Background: gothememe.Hex("#cd5c5c19"),
+		Border:     gothememe.Hex("#cd5c5c4c"),
 		Text:       gothememe.Hex("#cd5c5c"),
 	}
 }
 
 func (t *themeArthur) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b0c4de").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b0c4de").WithAlpha(0.3),
+		Background: gothememe.Hex("#b0c4de19"),
+		Border:     gothememe.Hex("#b0c4de4c"),
 		Text:       gothememe.Hex("#b0c4de"),
 	}
 }
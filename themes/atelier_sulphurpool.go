@@ -47,32 +47,32 @@ func (t *themeAtelierSulphurpool) BorderStrong() gothememe.Color { return gothem
 // Semantic colors
 func (t *themeAtelierSulphurpool) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ac9739").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ac9739").WithAlpha(0.3),
+		Background: gothememe.Hex("#ac973919"),
+		Border:     gothememe.Hex("#ac97394c"),
 		Text:       gothememe.Hex("#ac9739"),
 	}
 }
 
 func (t *themeAtelierSulphurpool) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c08b30").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c08b30").WithAlpha(0.3),
+		Background: gothememe.Hex("#c08b3019"),
+		Border:     gothememe.Hex("#c08b304c"),
 		Text:       gothememe.Hex("#c08b30"),
 	}
 }
 
 func (t *themeAtelierSulphurpool) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c94922").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c94922").WithAlpha(0.3),
+		Background: gothememe.Hex("#c9492219"),
+		Border:     gothememe.Hex("#c949224c"),
 		Text:       gothememe.Hex("#c94922"),
 	}
 }
 
 func (t *themeAtelierSulphurpool) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#22a2c9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#22a2c9").WithAlpha(0.3),
+		Background: gothememe.Hex("#22a2c919"),
+		Border:     gothememe.Hex("#22a2c94c"),
 		Text:       gothememe.Hex("#22a2c9"),
 	}
 }
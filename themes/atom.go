@@ -43,32 +43,32 @@ func (t *themeAtom) BorderStrong() gothememe.Color { return gothememe.Hex("#e0e0
 // Semantic colors
 func (t *themeAtom) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#87c38a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#87c38a").WithAlpha(0.3),
+		Background: gothememe.Hex("#87c38a19"),
+		Border:     gothememe.Hex("#87c38a4c"),
 		Text:       gothememe.Hex("#87c38a"),
 	}
 }
 
 func (t *themeAtom) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffd7b1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffd7b1").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffd7b119"),
+		Border:     gothememe.Hex("#ffd7b14c"),
 		Text:       gothememe.Hex("#ffd7b1"),
 	}
 }
 
 func (t *themeAtom) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fd5ff1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fd5ff1").WithAlpha(0.3),
+		Background: gothememe.Hex("#fd5ff119"),
+		Border:     gothememe.Hex("#fd5ff14c"),
 		Text:       gothememe.Hex("#fd5ff1"),
 	}
 }
 
 func (t *themeAtom) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#85befd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#85befd").WithAlpha(0.3),
+		Background: gothememe.Hex("#85befd19"),
+		Border:     gothememe.Hex("#85befd4c"),
 		Text:       gothememe.Hex("#85befd"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeAtomOneDark) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeAtomOneDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98c379").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98c379").WithAlpha(0.3),
+		Background: gothememe.Hex("#98c37919"),
+		Border:     gothememe.Hex("#98c3794c"),
 		Text:       gothememe.Hex("#98c379"),
 	}
 }
 
 func (t *themeAtomOneDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e5c07b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e5c07b").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5c07b19"),
+		Border:     gothememe.Hex("#e5c07b4c"),
 		Text:       gothememe.Hex("#e5c07b"),
 	}
 }
 
 func (t *themeAtomOneDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e06c75").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e06c75").WithAlpha(0.3),
+		Background: gothememe.Hex("#e06c7519"),
+		Border:     gothememe.Hex("#e06c754c"),
 		Text:       gothememe.Hex("#e06c75"),
 	}
 }
 
 func (t *themeAtomOneDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#56b6c2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#56b6c2").WithAlpha(0.3),
+		Background: gothememe.Hex("#56b6c219"),
+		Border:     gothememe.Hex("#56b6c24c"),
 		Text:       gothememe.Hex("#56b6c2"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeAtomOneLight) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeAtomOneLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3f953a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3f953a").WithAlpha(0.3),
+		Background: gothememe.Hex("#3f953a19"),
+		Border:     gothememe.Hex("#3f953a4c"),
 		Text:       gothememe.Hex("#3f953a"),
 	}
 }
 
 func (t *themeAtomOneLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d2b67c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d2b67c").WithAlpha(0.3),
+		Background: gothememe.Hex("#d2b67c19"),
+		Border:     gothememe.Hex("#d2b67c4c"),
 		Text:       gothememe.Hex("#d2b67c"),
 	}
 }
 
 func (t *themeAtomOneLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#de3e35").WithAlpha(0.1),
-		Border:     gothememe.Hex("#de3e35").WithAlpha(0.3),
+		Background: gothememe.Hex("#de3e3519"),
+		Border:     gothememe.Hex("#de3e354c"),
 		Text:       gothememe.Hex("#de3e35"),
 	}
 }
 
 func (t *themeAtomOneLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3f953a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3f953a").WithAlpha(0.3),
+		Background: gothememe.Hex("#3f953a19"),
+		Border:     gothememe.Hex("#3f953a4c"),
 		Text:       gothememe.Hex("#3f953a"),
 	}
 }
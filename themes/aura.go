@@ -43,32 +43,32 @@ func (t *themeAura) BorderStrong() gothememe.Color { return gothememe.Hex("#edec
 // Semantic colors
 func (t *themeAura) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#61ffca").WithAlpha(0.1),
-		Border:     gothememe.Hex("#61ffca").WithAlpha(0.3),
+		Background: gothememe.Hex("#61ffca19"),
+		Border:     gothememe.Hex("#61ffca4c"),
 		Text:       gothememe.Hex("#61ffca"),
 	}
 }
 
 func (t *themeAura) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffca85").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffca85").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffca8519"),
+		Border:     gothememe.Hex("#ffca854c"),
 		Text:       gothememe.Hex("#ffca85"),
 	}
 }
 
 func (t *themeAura) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff6767").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff6767").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff676719"),
+		Border:     gothememe.Hex("#ff67674c"),
 		Text:       gothememe.Hex("#ff6767"),
 	}
 }
 
 func (t *themeAura) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#61ffca").WithAlpha(0.1),
-		Border:     gothememe.Hex("#61ffca").WithAlpha(0.3),
+		Background: gothememe.Hex("#61ffca19"),
+		Border:     gothememe.Hex("#61ffca4c"),
 		Text:       gothememe.Hex("#61ffca"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeAurora) BorderStrong() gothememe.Color { return gothememe.Hex("#c7
 // Semantic colors
 func (t *themeAurora) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8fd46d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8fd46d").WithAlpha(0.3),
+		Background: gothememe.Hex("#8fd46d19"),
+		Border:     gothememe.Hex("#8fd46d4c"),
 		Text:       gothememe.Hex("#8fd46d"),
 	}
 }
 
 func (t *themeAurora) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffe66d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffe66d").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffe66d19"),
+		Border:     gothememe.Hex("#ffe66d4c"),
 		Text:       gothememe.Hex("#ffe66d"),
 	}
 }
 
 func (t *themeAurora) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f0266f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f0266f").WithAlpha(0.3),
+		Background: gothememe.Hex("#f0266f19"),
+		Border:     gothememe.Hex("#f0266f4c"),
 		Text:       gothememe.Hex("#f0266f"),
 	}
 }
 
 func (t *themeAurora) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#03d6b8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#03d6b8").WithAlpha(0.3),
+		Background: gothememe.Hex("#03d6b819"),
+		Border:     gothememe.Hex("#03d6b84c"),
 		Text:       gothememe.Hex("#03d6b8"),
 	}
 }
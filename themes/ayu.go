@@ -43,32 +43,32 @@ func (t *themeAyu) BorderStrong() gothememe.Color { return gothememe.Hex("#c7c7c
 // Semantic colors
 func (t *themeAyu) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7fd962").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7fd962").WithAlpha(0.3),
+		Background: gothememe.Hex("#7fd96219"),
+		Border:     gothememe.Hex("#7fd9624c"),
 		Text:       gothememe.Hex("#7fd962"),
 	}
 }
 
 func (t *themeAyu) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f9af4f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f9af4f").WithAlpha(0.3),
+		Background: gothememe.Hex("#f9af4f19"),
+		Border:     gothememe.Hex("#f9af4f4c"),
 		Text:       gothememe.Hex("#f9af4f"),
 	}
 }
 
 func (t *themeAyu) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ea6c73").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ea6c73").WithAlpha(0.3),
+		Background: gothememe.Hex("#ea6c7319"),
+		Border:     gothememe.Hex("#ea6c734c"),
 		Text:       gothememe.Hex("#ea6c73"),
 	}
 }
 
 func (t *themeAyu) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#90e1c6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#90e1c6").WithAlpha(0.3),
+		Background: gothememe.Hex("#90e1c619"),
+		Border:     gothememe.Hex("#90e1c64c"),
 		Text:       gothememe.Hex("#90e1c6"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeAyuLight) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeAyuLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6cbf43").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6cbf43").WithAlpha(0.3),
+		Background: gothememe.Hex("#6cbf4319"),
+		Border:     gothememe.Hex("#6cbf434c"),
 		Text:       gothememe.Hex("#6cbf43"),
 	}
 }
 
 func (t *themeAyuLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#eca944").WithAlpha(0.1),
-		Border:     gothememe.Hex("#eca944").WithAlpha(0.3),
+		Background: gothememe.Hex("#eca94419"),
+		Border:     gothememe.Hex("#eca9444c"),
 		Text:       gothememe.Hex("#eca944"),
 	}
 }
 
 func (t *themeAyuLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ea6c6d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ea6c6d").WithAlpha(0.3),
+		Background: gothememe.Hex("#ea6c6d19"),
+		Border:     gothememe.Hex("#ea6c6d4c"),
 		Text:       gothememe.Hex("#ea6c6d"),
 	}
 }
 
 func (t *themeAyuLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#46ba94").WithAlpha(0.1),
-		Border:     gothememe.Hex("#46ba94").WithAlpha(0.3),
+		Background: gothememe.Hex("#46ba9419"),
+		Border:     gothememe.Hex("#46ba944c"),
 		Text:       gothememe.Hex("#46ba94"),
 	}
 }
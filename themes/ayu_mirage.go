@@ -43,32 +43,32 @@ func (t *themeAyuMirage) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeAyuMirage) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#87d96c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#87d96c").WithAlpha(0.3),
+		Background: gothememe.Hex("#87d96c19"),
+		Border:     gothememe.Hex("#87d96c4c"),
 		Text:       gothememe.Hex("#87d96c"),
 	}
 }
 
 func (t *themeAyuMirage) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#facc6e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#facc6e").WithAlpha(0.3),
+		Background: gothememe.Hex("#facc6e19"),
+		Border:     gothememe.Hex("#facc6e4c"),
 		Text:       gothememe.Hex("#facc6e"),
 	}
 }
 
 func (t *themeAyuMirage) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ed8274").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ed8274").WithAlpha(0.3),
+		Background: gothememe.Hex("#ed827419"),
+		Border:     gothememe.Hex("#ed82744c"),
 		Text:       gothememe.Hex("#ed8274"),
 	}
 }
 
 func (t *themeAyuMirage) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#90e1c6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#90e1c6").WithAlpha(0.3),
+		Background: gothememe.Hex("#90e1c619"),
+		Border:     gothememe.Hex("#90e1c64c"),
 		Text:       gothememe.Hex("#90e1c6"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeBananaBlueberry) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeBananaBlueberry) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00bd9c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00bd9c").WithAlpha(0.3),
+		Background: gothememe.Hex("#00bd9c19"),
+		Border:     gothememe.Hex("#00bd9c4c"),
 		Text:       gothememe.Hex("#00bd9c"),
 	}
 }
 
 func (t *themeBananaBlueberry) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e6c62f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e6c62f").WithAlpha(0.3),
+		Background: gothememe.Hex("#e6c62f19"),
+		Border:     gothememe.Hex("#e6c62f4c"),
 		Text:       gothememe.Hex("#e6c62f"),
 	}
 }
 
 func (t *themeBananaBlueberry) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff6b7f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff6b7f").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff6b7f19"),
+		Border:     gothememe.Hex("#ff6b7f4c"),
 		Text:       gothememe.Hex("#ff6b7f"),
 	}
 }
 
 func (t *themeBananaBlueberry) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#56b6c2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#56b6c2").WithAlpha(0.3),
+		Background: gothememe.Hex("#56b6c219"),
+		Border:     gothememe.Hex("#56b6c24c"),
 		Text:       gothememe.Hex("#56b6c2"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeBatman) BorderStrong() gothememe.Color { return gothememe.Hex("#c6
 // Semantic colors
 func (t *themeBatman) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c8be46").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c8be46").WithAlpha(0.3),
+		Background: gothememe.Hex("#c8be4619"),
+		Border:     gothememe.Hex("#c8be464c"),
 		Text:       gothememe.Hex("#c8be46"),
 	}
 }
 
 func (t *themeBatman) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f4fd22").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f4fd22").WithAlpha(0.3),
+		Background: gothememe.Hex("#f4fd2219"),
+		Border:     gothememe.Hex("#f4fd224c"),
 		Text:       gothememe.Hex("#f4fd22"),
 	}
 }
 
 func (t *themeBatman) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e6dc44").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e6dc44").WithAlpha(0.3),
+		Background: gothememe.Hex("#e6dc4419"),
+		Border:     gothememe.Hex("#e6dc444c"),
 		Text:       gothememe.Hex("#e6dc44"),
 	}
 }
 
 func (t *themeBatman) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#62605f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#62605f").WithAlpha(0.3),
+		Background: gothememe.Hex("#62605f19"),
+		Border:     gothememe.Hex("#62605f4c"),
 		Text:       gothememe.Hex("#62605f"),
 	}
 }
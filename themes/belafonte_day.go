@@ -18,7 +18,7 @@ func (t *themeBelafonteDay) License() string     { return "MIT" }
 func (t *themeBelafonteDay) Source() string {
 	return "https://github.com/mbadolato/iTerm2-Color-Schemes"
 }
-func (t *themeBelafonteDay) IsDark() bool { return true }
+func (t *themeBelafonteDay) IsDark() bool { return false }
 
 // Background colors
 func (t *themeBelafonteDay) Background() gothememe.Color          { return gothememe.Hex("#d5ccba") }
@@ -45,32 +45,32 @@ func (t *themeBelafonteDay) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeBelafonteDay) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#858162").WithAlpha(0.1),
-		Border:     gothememe.Hex("#858162").WithAlpha(0.3),
+		Background: gothememe.Hex("#85816219"),
+		Border:     gothememe.Hex("#8581624c"),
 		Text:       gothememe.Hex("#858162"),
 	}
 }
 
 func (t *themeBelafonteDay) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d08b30").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d08b30").WithAlpha(0.3),
+		Background: gothememe.Hex("#d08b3019"),
+		Border:     gothememe.Hex("#d08b304c"),
 		Text:       gothememe.Hex("#d08b30"),
 	}
 }
 
 func (t *themeBelafonteDay) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#be100e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#be100e").WithAlpha(0.3),
+		Background: gothememe.Hex("#be100e19"),
+		Border:     gothememe.Hex("#be100e4c"),
 		Text:       gothememe.Hex("#be100e"),
 	}
 }
 
 func (t *themeBelafonteDay) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#989a9c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#989a9c").WithAlpha(0.3),
+		Background: gothememe.Hex("#989a9c19"),
+		Border:     gothememe.Hex("#989a9c4c"),
 		Text:       gothememe.Hex("#989a9c"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeBelafonteNight) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeBelafonteNight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#858162").WithAlpha(0.1),
-		Border:     gothememe.Hex("#858162").WithAlpha(0.3),
+		Background: gothememe.Hex("#85816219"),
+		Border:     gothememe.Hex("#8581624c"),
 		Text:       gothememe.Hex("#858162"),
 	}
 }
 
 func (t *themeBelafonteNight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#eaa549").WithAlpha(0.1),
-		Border:     gothememe.Hex("#eaa549").WithAlpha(0.3),
+		Background: gothememe.Hex("#eaa54919"),
+		Border:     gothememe.Hex("#eaa5494c"),
 		Text:       gothememe.Hex("#eaa549"),
 	}
 }
 
 func (t *themeBelafonteNight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#be100e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#be100e").WithAlpha(0.3),
+		Background: gothememe.Hex("#be100e19"),
+		Border:     gothememe.Hex("#be100e4c"),
 		Text:       gothememe.Hex("#be100e"),
 	}
 }
 
 func (t *themeBelafonteNight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#989a9c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#989a9c").WithAlpha(0.3),
+		Background: gothememe.Hex("#989a9c19"),
+		Border:     gothememe.Hex("#989a9c4c"),
 		Text:       gothememe.Hex("#989a9c"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeBirdsOfParadise) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeBirdsOfParadise) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6ba18a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6ba18a").WithAlpha(0.3),
+		Background: gothememe.Hex("#6ba18a19"),
+		Border:     gothememe.Hex("#6ba18a4c"),
 		Text:       gothememe.Hex("#6ba18a"),
 	}
 }
 
 func (t *themeBirdsOfParadise) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e99d2a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e99d2a").WithAlpha(0.3),
+		Background: gothememe.Hex("#e99d2a19"),
+		Border:     gothememe.Hex("#e99d2a4c"),
 		Text:       gothememe.Hex("#e99d2a"),
 	}
 }
 
 func (t *themeBirdsOfParadise) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#be2d26").WithAlpha(0.1),
-		Border:     gothememe.Hex("#be2d26").WithAlpha(0.3),
+		Background: gothememe.Hex("#be2d2619"),
+		Border:     gothememe.Hex("#be2d264c"),
 		Text:       gothememe.Hex("#be2d26"),
 	}
 }
 
 func (t *themeBirdsOfParadise) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#74a6ad").WithAlpha(0.1),
-		Border:     gothememe.Hex("#74a6ad").WithAlpha(0.3),
+		Background: gothememe.Hex("#74a6ad19"),
+		Border:     gothememe.Hex("#74a6ad4c"),
 		Text:       gothememe.Hex("#74a6ad"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeBlackMetal) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeBlackMetal) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dd9999").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dd9999").WithAlpha(0.3),
+		Background: gothememe.Hex("#dd999919"),
+		Border:     gothememe.Hex("#dd99994c"),
 		Text:       gothememe.Hex("#dd9999"),
 	}
 }
 
 func (t *themeBlackMetal) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a06666").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a06666").WithAlpha(0.3),
+		Background: gothememe.Hex("#a0666619"),
+		Border:     gothememe.Hex("#a066664c"),
 		Text:       gothememe.Hex("#a06666"),
 	}
 }
 
 func (t *themeBlackMetal) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#486e6f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#486e6f").WithAlpha(0.3),
+		Background: gothememe.Hex("#486e6f19"),
+		Border:     gothememe.Hex("#486e6f4c"),
 		Text:       gothememe.Hex("#486e6f"),
 	}
 }
 
 func (t *themeBlackMetal) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aaaaaa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aaaaaa").WithAlpha(0.3),
+		Background: gothememe.Hex("#aaaaaa19"),
+		Border:     gothememe.Hex("#aaaaaa4c"),
 		Text:       gothememe.Hex("#aaaaaa"),
 	}
 }
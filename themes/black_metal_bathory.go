@@ -47,32 +47,32 @@ func (t *themeBlackMetalBathory) BorderStrong() gothememe.Color { return gotheme
 // Semantic colors
 func (t *themeBlackMetalBathory) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fbcb97").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fbcb97").WithAlpha(0.3),
+		Background: gothememe.Hex("#fbcb9719"),
+		Border:     gothememe.Hex("#fbcb974c"),
 		Text:       gothememe.Hex("#fbcb97"),
 	}
 }
 
 func (t *themeBlackMetalBathory) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e78a53").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e78a53").WithAlpha(0.3),
+		Background: gothememe.Hex("#e78a5319"),
+		Border:     gothememe.Hex("#e78a534c"),
 		Text:       gothememe.Hex("#e78a53"),
 	}
 }
 
 func (t *themeBlackMetalBathory) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5f8787").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5f8787").WithAlpha(0.3),
+		Background: gothememe.Hex("#5f878719"),
+		Border:     gothememe.Hex("#5f87874c"),
 		Text:       gothememe.Hex("#5f8787"),
 	}
 }
 
 func (t *themeBlackMetalBathory) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aaaaaa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aaaaaa").WithAlpha(0.3),
+		Background: gothememe.Hex("#aaaaaa19"),
+		Border:     gothememe.Hex("#aaaaaa4c"),
 		Text:       gothememe.Hex("#aaaaaa"),
 	}
 }
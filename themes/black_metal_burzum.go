@@ -47,32 +47,32 @@ func (t *themeBlackMetalBurzum) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeBlackMetalBurzum) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ddeecc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ddeecc").WithAlpha(0.3),
+		Background: gothememe.Hex("#ddeecc19"),
+		Border:     gothememe.Hex("#ddeecc4c"),
 		Text:       gothememe.Hex("#ddeecc"),
 	}
 }
 
 func (t *themeBlackMetalBurzum) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#99bbaa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#99bbaa").WithAlpha(0.3),
+		Background: gothememe.Hex("#99bbaa19"),
+		Border:     gothememe.Hex("#99bbaa4c"),
 		Text:       gothememe.Hex("#99bbaa"),
 	}
 }
 
 func (t *themeBlackMetalBurzum) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5f8787").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5f8787").WithAlpha(0.3),
+		Background: gothememe.Hex("#5f878719"),
+		Border:     gothememe.Hex("#5f87874c"),
 		Text:       gothememe.Hex("#5f8787"),
 	}
 }
 
 func (t *themeBlackMetalBurzum) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aaaaaa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aaaaaa").WithAlpha(0.3),
+		Background: gothememe.Hex("#aaaaaa19"),
+		Border:     gothememe.Hex("#aaaaaa4c"),
 		Text:       gothememe.Hex("#aaaaaa"),
 	}
 }
@@ -53,32 +53,32 @@ func (t *themeBlackMetalDarkFuneral) BorderStrong() gothememe.Color { return got
 // Semantic colors
 func (t *themeBlackMetalDarkFuneral) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d0dfee").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d0dfee").WithAlpha(0.3),
+		Background: gothememe.Hex("#d0dfee19"),
+		Border:     gothememe.Hex("#d0dfee4c"),
 		Text:       gothememe.Hex("#d0dfee"),
 	}
 }
 
 func (t *themeBlackMetalDarkFuneral) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5f81a5").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5f81a5").WithAlpha(0.3),
+		Background: gothememe.Hex("#5f81a519"),
+		Border:     gothememe.Hex("#5f81a54c"),
 		Text:       gothememe.Hex("#5f81a5"),
 	}
 }
 
 func (t *themeBlackMetalDarkFuneral) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5f8787").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5f8787").WithAlpha(0.3),
+		Background: gothememe.Hex("#5f878719"),
+		Border:     gothememe.Hex("#5f87874c"),
 		Text:       gothememe.Hex("#5f8787"),
 	}
 }
 
 func (t *themeBlackMetalDarkFuneral) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aaaaaa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aaaaaa").WithAlpha(0.3),
+		Background: gothememe.Hex("#aaaaaa19"),
+		Border:     gothememe.Hex("#aaaaaa4c"),
 		Text:       gothememe.Hex("#aaaaaa"),
 	}
 }
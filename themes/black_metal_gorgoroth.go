@@ -49,32 +49,32 @@ func (t *themeBlackMetalGorgoroth) BorderStrong() gothememe.Color { return gothe
 // Semantic colors
 func (t *themeBlackMetalGorgoroth) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9b8d7f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9b8d7f").WithAlpha(0.3),
+		Background: gothememe.Hex("#9b8d7f19"),
+		Border:     gothememe.Hex("#9b8d7f4c"),
 		Text:       gothememe.Hex("#9b8d7f"),
 	}
 }
 
 func (t *themeBlackMetalGorgoroth) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8c7f70").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8c7f70").WithAlpha(0.3),
+		Background: gothememe.Hex("#8c7f7019"),
+		Border:     gothememe.Hex("#8c7f704c"),
 		Text:       gothememe.Hex("#8c7f70"),
 	}
 }
 
 func (t *themeBlackMetalGorgoroth) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5f8787").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5f8787").WithAlpha(0.3),
+		Background: gothememe.Hex("#5f878719"),
+		Border:     gothememe.Hex("#5f87874c"),
 		Text:       gothememe.Hex("#5f8787"),
 	}
 }
 
 func (t *themeBlackMetalGorgoroth) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aaaaaa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aaaaaa").WithAlpha(0.3),
+		Background: gothememe.Hex("#aaaaaa19"),
+		Border:     gothememe.Hex("#aaaaaa4c"),
 		Text:       gothememe.Hex("#aaaaaa"),
 	}
 }
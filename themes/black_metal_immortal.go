@@ -47,32 +47,32 @@ func (t *themeBlackMetalImmortal) BorderStrong() gothememe.Color { return gothem
 // Semantic colors
 func (t *themeBlackMetalImmortal) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7799bb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7799bb").WithAlpha(0.3),
+		Background: gothememe.Hex("#7799bb19"),
+		Border:     gothememe.Hex("#7799bb4c"),
 		Text:       gothememe.Hex("#7799bb"),
 	}
 }
 
 func (t *themeBlackMetalImmortal) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#556677").WithAlpha(0.1),
-		Border:     gothememe.Hex("#556677").WithAlpha(0.3),
+		Background: gothememe.Hex("#55667719"),
+		Border:     gothememe.Hex("#5566774c"),
 		Text:       gothememe.Hex("#556677"),
 	}
 }
 
 func (t *themeBlackMetalImmortal) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5f8787").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5f8787").WithAlpha(0.3),
+		Background: gothememe.Hex("#5f878719"),
+		Border:     gothememe.Hex("#5f87874c"),
 		Text:       gothememe.Hex("#5f8787"),
 	}
 }
 
 func (t *themeBlackMetalImmortal) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aaaaaa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aaaaaa").WithAlpha(0.3),
+		Background: gothememe.Hex("#aaaaaa19"),
+		Border:     gothememe.Hex("#aaaaaa4c"),
 		Text:       gothememe.Hex("#aaaaaa"),
 	}
 }
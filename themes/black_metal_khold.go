@@ -45,32 +45,32 @@ func (t *themeBlackMetalKhold) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeBlackMetalKhold) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#eceee3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#eceee3").WithAlpha(0.3),
+		Background: gothememe.Hex("#eceee319"),
+		Border:     gothememe.Hex("#eceee34c"),
 		Text:       gothememe.Hex("#eceee3"),
 	}
 }
 
 func (t *themeBlackMetalKhold) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#974b46").WithAlpha(0.1),
-		Border:     gothememe.Hex("#974b46").WithAlpha(0.3),
+		Background: gothememe.Hex("#974b4619"),
+		Border:     gothememe.Hex("#974b464c"),
 		Text:       gothememe.Hex("#974b46"),
 	}
 }
 
 func (t *themeBlackMetalKhold) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5f8787").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5f8787").WithAlpha(0.3),
+		Background: gothememe.Hex("#5f878719"),
+		Border:     gothememe.Hex("#5f87874c"),
 		Text:       gothememe.Hex("#5f8787"),
 	}
 }
 
 func (t *themeBlackMetalKhold) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aaaaaa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aaaaaa").WithAlpha(0.3),
+		Background: gothememe.Hex("#aaaaaa19"),
+		Border:     gothememe.Hex("#aaaaaa4c"),
 		Text:       gothememe.Hex("#aaaaaa"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeBlackMetalMarduk) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeBlackMetalMarduk) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a5aaa7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a5aaa7").WithAlpha(0.3),
+		Background: gothememe.Hex("#a5aaa719"),
+		Border:     gothememe.Hex("#a5aaa74c"),
 		Text:       gothememe.Hex("#a5aaa7"),
 	}
 }
 
 func (t *themeBlackMetalMarduk) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#626b67").WithAlpha(0.1),
-		Border:     gothememe.Hex("#626b67").WithAlpha(0.3),
+		Background: gothememe.Hex("#626b6719"),
+		Border:     gothememe.Hex("#626b674c"),
 		Text:       gothememe.Hex("#626b67"),
 	}
 }
 
 func (t *themeBlackMetalMarduk) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5f8787").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5f8787").WithAlpha(0.3),
+		Background: gothememe.Hex("#5f878719"),
+		Border:     gothememe.Hex("#5f87874c"),
 		Text:       gothememe.Hex("#5f8787"),
 	}
 }
 
 func (t *themeBlackMetalMarduk) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aaaaaa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aaaaaa").WithAlpha(0.3),
+		Background: gothememe.Hex("#aaaaaa19"),
+		Border:     gothememe.Hex("#aaaaaa4c"),
 		Text:       gothememe.Hex("#aaaaaa"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeBlackMetalMayhem) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeBlackMetalMayhem) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f3ecd4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f3ecd4").WithAlpha(0.3),
+		Background: gothememe.Hex("#f3ecd419"),
+		Border:     gothememe.Hex("#f3ecd44c"),
 		Text:       gothememe.Hex("#f3ecd4"),
 	}
 }
 
 func (t *themeBlackMetalMayhem) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#eecc6c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#eecc6c").WithAlpha(0.3),
+		Background: gothememe.Hex("#eecc6c19"),
+		Border:     gothememe.Hex("#eecc6c4c"),
 		Text:       gothememe.Hex("#eecc6c"),
 	}
 }
 
 func (t *themeBlackMetalMayhem) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5f8787").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5f8787").WithAlpha(0.3),
+		Background: gothememe.Hex("#5f878719"),
+		Border:     gothememe.Hex("#5f87874c"),
 		Text:       gothememe.Hex("#5f8787"),
 	}
 }
 
 func (t *themeBlackMetalMayhem) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aaaaaa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aaaaaa").WithAlpha(0.3),
+		Background: gothememe.Hex("#aaaaaa19"),
+		Border:     gothememe.Hex("#aaaaaa4c"),
 		Text:       gothememe.Hex("#aaaaaa"),
 	}
 }
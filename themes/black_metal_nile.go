@@ -45,32 +45,32 @@ func (t *themeBlackMetalNile) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeBlackMetalNile) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aa9988").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aa9988").WithAlpha(0.3),
+		Background: gothememe.Hex("#aa998819"),
+		Border:     gothememe.Hex("#aa99884c"),
 		Text:       gothememe.Hex("#aa9988"),
 	}
 }
 
 func (t *themeBlackMetalNile) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#777755").WithAlpha(0.1),
-		Border:     gothememe.Hex("#777755").WithAlpha(0.3),
+		Background: gothememe.Hex("#77775519"),
+		Border:     gothememe.Hex("#7777554c"),
 		Text:       gothememe.Hex("#777755"),
 	}
 }
 
 func (t *themeBlackMetalNile) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5f8787").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5f8787").WithAlpha(0.3),
+		Background: gothememe.Hex("#5f878719"),
+		Border:     gothememe.Hex("#5f87874c"),
 		Text:       gothememe.Hex("#5f8787"),
 	}
 }
 
 func (t *themeBlackMetalNile) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aaaaaa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aaaaaa").WithAlpha(0.3),
+		Background: gothememe.Hex("#aaaaaa19"),
+		Border:     gothememe.Hex("#aaaaaa4c"),
 		Text:       gothememe.Hex("#aaaaaa"),
 	}
 }
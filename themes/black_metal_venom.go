@@ -45,32 +45,32 @@ func (t *themeBlackMetalVenom) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeBlackMetalVenom) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f8f7f2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f8f7f2").WithAlpha(0.3),
+		Background: gothememe.Hex("#f8f7f219"),
+		Border:     gothememe.Hex("#f8f7f24c"),
 		Text:       gothememe.Hex("#f8f7f2"),
 	}
 }
 
 func (t *themeBlackMetalVenom) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#79241f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#79241f").WithAlpha(0.3),
+		Background: gothememe.Hex("#79241f19"),
+		Border:     gothememe.Hex("#79241f4c"),
 		Text:       gothememe.Hex("#79241f"),
 	}
 }
 
 func (t *themeBlackMetalVenom) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5f8787").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5f8787").WithAlpha(0.3),
+		Background: gothememe.Hex("#5f878719"),
+		Border:     gothememe.Hex("#5f87874c"),
 		Text:       gothememe.Hex("#5f8787"),
 	}
 }
 
 func (t *themeBlackMetalVenom) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aaaaaa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aaaaaa").WithAlpha(0.3),
+		Background: gothememe.Hex("#aaaaaa19"),
+		Border:     gothememe.Hex("#aaaaaa4c"),
 		Text:       gothememe.Hex("#aaaaaa"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeBlazer) BorderStrong() gothememe.Color { return gothememe.Hex("#d9
 // Semantic colors
 func (t *themeBlazer) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7ab87a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7ab87a").WithAlpha(0.3),
+		Background: gothememe.Hex("#7ab87a19"),
+		Border:     gothememe.Hex("#7ab87a4c"),
 		Text:       gothememe.Hex("#7ab87a"),
 	}
 }
 
 func (t *themeBlazer) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b8b87a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b8b87a").WithAlpha(0.3),
+		Background: gothememe.Hex("#b8b87a19"),
+		Border:     gothememe.Hex("#b8b87a4c"),
 		Text:       gothememe.Hex("#b8b87a"),
 	}
 }
 
 func (t *themeBlazer) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b87a7a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b87a7a").WithAlpha(0.3),
+		Background: gothememe.Hex("#b87a7a19"),
+		Border:     gothememe.Hex("#b87a7a4c"),
 		Text:       gothememe.Hex("#b87a7a"),
 	}
 }
 
 func (t *themeBlazer) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7ab8b8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7ab8b8").WithAlpha(0.3),
+		Background: gothememe.Hex("#7ab8b819"),
+		Border:     gothememe.Hex("#7ab8b84c"),
 		Text:       gothememe.Hex("#7ab8b8"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeBlueBerryPie) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeBlueBerryPie) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5cb1b3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5cb1b3").WithAlpha(0.3),
+		Background: gothememe.Hex("#5cb1b319"),
+		Border:     gothememe.Hex("#5cb1b34c"),
 		Text:       gothememe.Hex("#5cb1b3"),
 	}
 }
 
 func (t *themeBlueBerryPie) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#eab9a8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#eab9a8").WithAlpha(0.3),
+		Background: gothememe.Hex("#eab9a819"),
+		Border:     gothememe.Hex("#eab9a84c"),
 		Text:       gothememe.Hex("#eab9a8"),
 	}
 }
 
 func (t *themeBlueBerryPie) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#99246e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#99246e").WithAlpha(0.3),
+		Background: gothememe.Hex("#99246e19"),
+		Border:     gothememe.Hex("#99246e4c"),
 		Text:       gothememe.Hex("#99246e"),
 	}
 }
 
 func (t *themeBlueBerryPie) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7e83cc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7e83cc").WithAlpha(0.3),
+		Background: gothememe.Hex("#7e83cc19"),
+		Border:     gothememe.Hex("#7e83cc4c"),
 		Text:       gothememe.Hex("#7e83cc"),
 	}
 }
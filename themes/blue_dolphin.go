@@ -45,32 +45,32 @@ func (t *themeBlueDolphin) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeBlueDolphin) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b4e88d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b4e88d").WithAlpha(0.3),
+		Background: gothememe.Hex("#b4e88d19"),
+		Border:     gothememe.Hex("#b4e88d4c"),
 		Text:       gothememe.Hex("#b4e88d"),
 	}
 }
 
 func (t *themeBlueDolphin) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f4d69f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f4d69f").WithAlpha(0.3),
+		Background: gothememe.Hex("#f4d69f19"),
+		Border:     gothememe.Hex("#f4d69f4c"),
 		Text:       gothememe.Hex("#f4d69f"),
 	}
 }
 
 func (t *themeBlueDolphin) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff8288").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff8288").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff828819"),
+		Border:     gothememe.Hex("#ff82884c"),
 		Text:       gothememe.Hex("#ff8288"),
 	}
 }
 
 func (t *themeBlueDolphin) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#89ebff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#89ebff").WithAlpha(0.3),
+		Background: gothememe.Hex("#89ebff19"),
+		Border:     gothememe.Hex("#89ebff4c"),
 		Text:       gothememe.Hex("#89ebff"),
 	}
 }
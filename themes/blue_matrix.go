@@ -43,32 +43,32 @@ func (t *themeBlueMatrix) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeBlueMatrix) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00ff9c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00ff9c").WithAlpha(0.3),
+		Background: gothememe.Hex("#00ff9c19"),
+		Border:     gothememe.Hex("#00ff9c4c"),
 		Text:       gothememe.Hex("#00ff9c"),
 	}
 }
 
 func (t *themeBlueMatrix) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fffc58").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fffc58").WithAlpha(0.3),
+		Background: gothememe.Hex("#fffc5819"),
+		Border:     gothememe.Hex("#fffc584c"),
 		Text:       gothememe.Hex("#fffc58"),
 	}
 }
 
 func (t *themeBlueMatrix) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff5680").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff5680").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff568019"),
+		Border:     gothememe.Hex("#ff56804c"),
 		Text:       gothememe.Hex("#ff5680"),
 	}
 }
 
 func (t *themeBlueMatrix) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#76c1ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#76c1ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#76c1ff19"),
+		Border:     gothememe.Hex("#76c1ff4c"),
 		Text:       gothememe.Hex("#76c1ff"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeBlulocoDark) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeBlulocoDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#25a45c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#25a45c").WithAlpha(0.3),
+		Background: gothememe.Hex("#25a45c19"),
+		Border:     gothememe.Hex("#25a45c4c"),
 		Text:       gothememe.Hex("#25a45c"),
 	}
 }
 
 func (t *themeBlulocoDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff936a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff936a").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff936a19"),
+		Border:     gothememe.Hex("#ff936a4c"),
 		Text:       gothememe.Hex("#ff936a"),
 	}
 }
 
 func (t *themeBlulocoDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fc2f52").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fc2f52").WithAlpha(0.3),
+		Background: gothememe.Hex("#fc2f5219"),
+		Border:     gothememe.Hex("#fc2f524c"),
 		Text:       gothememe.Hex("#fc2f52"),
 	}
 }
 
 func (t *themeBlulocoDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4483aa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4483aa").WithAlpha(0.3),
+		Background: gothememe.Hex("#4483aa19"),
+		Border:     gothememe.Hex("#4483aa4c"),
 		Text:       gothememe.Hex("#4483aa"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeBlulocoLight) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeBlulocoLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#23974a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#23974a").WithAlpha(0.3),
+		Background: gothememe.Hex("#23974a19"),
+		Border:     gothememe.Hex("#23974a4c"),
 		Text:       gothememe.Hex("#23974a"),
 	}
 }
 
 func (t *themeBlulocoLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#df631c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#df631c").WithAlpha(0.3),
+		Background: gothememe.Hex("#df631c19"),
+		Border:     gothememe.Hex("#df631c4c"),
 		Text:       gothememe.Hex("#df631c"),
 	}
 }
 
 func (t *themeBlulocoLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d52753").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d52753").WithAlpha(0.3),
+		Background: gothememe.Hex("#d5275319"),
+		Border:     gothememe.Hex("#d527534c"),
 		Text:       gothememe.Hex("#d52753"),
 	}
 }
 
 func (t *themeBlulocoLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#27618d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#27618d").WithAlpha(0.3),
+		Background: gothememe.Hex("#27618d19"),
+		Border:     gothememe.Hex("#27618d4c"),
 		Text:       gothememe.Hex("#27618d"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeBorland) BorderStrong() gothememe.Color { return gothememe.Hex("#e
 // Semantic colors
 func (t *themeBorland) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a8ff60").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a8ff60").WithAlpha(0.3),
+		Background: gothememe.Hex("#a8ff6019"),
+		Border:     gothememe.Hex("#a8ff604c"),
 		Text:       gothememe.Hex("#a8ff60"),
 	}
 }
 
 func (t *themeBorland) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffffb6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffffb6").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffffb619"),
+		Border:     gothememe.Hex("#ffffb64c"),
 		Text:       gothememe.Hex("#ffffb6"),
 	}
 }
 
 func (t *themeBorland) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff6c60").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff6c60").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff6c6019"),
+		Border:     gothememe.Hex("#ff6c604c"),
 		Text:       gothememe.Hex("#ff6c60"),
 	}
 }
 
 func (t *themeBorland) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c6c5fe").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c6c5fe").WithAlpha(0.3),
+		Background: gothememe.Hex("#c6c5fe19"),
+		Border:     gothememe.Hex("#c6c5fe4c"),
 		Text:       gothememe.Hex("#c6c5fe"),
 	}
 }
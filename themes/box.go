@@ -43,32 +43,32 @@ func (t *themeBox) BorderStrong() gothememe.Color { return gothememe.Hex("#ddddd
 // Semantic colors
 func (t *themeBox) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#19cb00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#19cb00").WithAlpha(0.3),
+		Background: gothememe.Hex("#19cb0019"),
+		Border:     gothememe.Hex("#19cb004c"),
 		Text:       gothememe.Hex("#19cb00"),
 	}
 }
 
 func (t *themeBox) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cecb00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cecb00").WithAlpha(0.3),
+		Background: gothememe.Hex("#cecb0019"),
+		Border:     gothememe.Hex("#cecb004c"),
 		Text:       gothememe.Hex("#cecb00"),
 	}
 }
 
 func (t *themeBox) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc0403").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc0403").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc040319"),
+		Border:     gothememe.Hex("#cc04034c"),
 		Text:       gothememe.Hex("#cc0403"),
 	}
 }
 
 func (t *themeBox) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0dcdcd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0dcdcd").WithAlpha(0.3),
+		Background: gothememe.Hex("#0dcdcd19"),
+		Border:     gothememe.Hex("#0dcdcd4c"),
 		Text:       gothememe.Hex("#0dcdcd"),
 	}
 }
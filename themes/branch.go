@@ -43,32 +43,32 @@ func (t *themeBranch) BorderStrong() gothememe.Color { return gothememe.Hex("#cf
 // Semantic colors
 func (t *themeBranch) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#96a65e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#96a65e").WithAlpha(0.3),
+		Background: gothememe.Hex("#96a65e19"),
+		Border:     gothememe.Hex("#96a65e4c"),
 		Text:       gothememe.Hex("#96a65e"),
 	}
 }
 
 func (t *themeBranch) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d29b5a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d29b5a").WithAlpha(0.3),
+		Background: gothememe.Hex("#d29b5a19"),
+		Border:     gothememe.Hex("#d29b5a4c"),
 		Text:       gothememe.Hex("#d29b5a"),
 	}
 }
 
 func (t *themeBranch) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c2562d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c2562d").WithAlpha(0.3),
+		Background: gothememe.Hex("#c2562d19"),
+		Border:     gothememe.Hex("#c2562d4c"),
 		Text:       gothememe.Hex("#c2562d"),
 	}
 }
 
 func (t *themeBranch) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#639a90").WithAlpha(0.1),
-		Border:     gothememe.Hex("#639a90").WithAlpha(0.3),
+		Background: gothememe.Hex("#639a9019"),
+		Border:     gothememe.Hex("#639a904c"),
 		Text:       gothememe.Hex("#639a90"),
 	}
 }
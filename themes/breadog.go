@@ -43,32 +43,32 @@ func (t *themeBreadog) BorderStrong() gothememe.Color { return gothememe.Hex("#b
 // Semantic colors
 func (t *themeBreadog) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#007232").WithAlpha(0.1),
-		Border:     gothememe.Hex("#007232").WithAlpha(0.3),
+		Background: gothememe.Hex("#00723219"),
+		Border:     gothememe.Hex("#0072324c"),
 		Text:       gothememe.Hex("#007232"),
 	}
 }
 
 func (t *themeBreadog) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8b4c00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8b4c00").WithAlpha(0.3),
+		Background: gothememe.Hex("#8b4c0019"),
+		Border:     gothememe.Hex("#8b4c004c"),
 		Text:       gothememe.Hex("#8b4c00"),
 	}
 }
 
 func (t *themeBreadog) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b10b00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b10b00").WithAlpha(0.3),
+		Background: gothememe.Hex("#b10b0019"),
+		Border:     gothememe.Hex("#b10b004c"),
 		Text:       gothememe.Hex("#b10b00"),
 	}
 }
 
 func (t *themeBreadog) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#006a78").WithAlpha(0.1),
-		Border:     gothememe.Hex("#006a78").WithAlpha(0.3),
+		Background: gothememe.Hex("#006a7819"),
+		Border:     gothememe.Hex("#006a784c"),
 		Text:       gothememe.Hex("#006a78"),
 	}
 }
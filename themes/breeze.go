@@ -43,32 +43,32 @@ func (t *themeBreeze) BorderStrong() gothememe.Color { return gothememe.Hex("#ef
 // Semantic colors
 func (t *themeBreeze) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#11d116").WithAlpha(0.1),
-		Border:     gothememe.Hex("#11d116").WithAlpha(0.3),
+		Background: gothememe.Hex("#11d11619"),
+		Border:     gothememe.Hex("#11d1164c"),
 		Text:       gothememe.Hex("#11d116"),
 	}
 }
 
 func (t *themeBreeze) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f67400").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f67400").WithAlpha(0.3),
+		Background: gothememe.Hex("#f6740019"),
+		Border:     gothememe.Hex("#f674004c"),
 		Text:       gothememe.Hex("#f67400"),
 	}
 }
 
 func (t *themeBreeze) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ed1515").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ed1515").WithAlpha(0.3),
+		Background: gothememe.Hex("#ed151519"),
+		Border:     gothememe.Hex("#ed15154c"),
 		Text:       gothememe.Hex("#ed1515"),
 	}
 }
 
 func (t *themeBreeze) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1abc9c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1abc9c").WithAlpha(0.3),
+		Background: gothememe.Hex("#1abc9c19"),
+		Border:     gothememe.Hex("#1abc9c4c"),
 		Text:       gothememe.Hex("#1abc9c"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeBrightLights) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeBrightLights) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b7e876").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b7e876").WithAlpha(0.3),
+		Background: gothememe.Hex("#b7e87619"),
+		Border:     gothememe.Hex("#b7e8764c"),
 		Text:       gothememe.Hex("#b7e876"),
 	}
 }
 
 func (t *themeBrightLights) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc251").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc251").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc25119"),
+		Border:     gothememe.Hex("#ffc2514c"),
 		Text:       gothememe.Hex("#ffc251"),
 	}
 }
 
 func (t *themeBrightLights) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff355b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff355b").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff355b19"),
+		Border:     gothememe.Hex("#ff355b4c"),
 		Text:       gothememe.Hex("#ff355b"),
 	}
 }
 
 func (t *themeBrightLights) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6cbfb5").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6cbfb5").WithAlpha(0.3),
+		Background: gothememe.Hex("#6cbfb519"),
+		Border:     gothememe.Hex("#6cbfb54c"),
 		Text:       gothememe.Hex("#6cbfb5"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeBroadcast) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeBroadcast) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#519f50").WithAlpha(0.1),
-		Border:     gothememe.Hex("#519f50").WithAlpha(0.3),
+		Background: gothememe.Hex("#519f5019"),
+		Border:     gothememe.Hex("#519f504c"),
 		Text:       gothememe.Hex("#519f50"),
 	}
 }
 
 func (t *themeBroadcast) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffd24a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffd24a").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffd24a19"),
+		Border:     gothememe.Hex("#ffd24a4c"),
 		Text:       gothememe.Hex("#ffd24a"),
 	}
 }
 
 func (t *themeBroadcast) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#da4939").WithAlpha(0.1),
-		Border:     gothememe.Hex("#da4939").WithAlpha(0.3),
+		Background: gothememe.Hex("#da493919"),
+		Border:     gothememe.Hex("#da49394c"),
 		Text:       gothememe.Hex("#da4939"),
 	}
 }
 
 func (t *themeBroadcast) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6e9cbe").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6e9cbe").WithAlpha(0.3),
+		Background: gothememe.Hex("#6e9cbe19"),
+		Border:     gothememe.Hex("#6e9cbe4c"),
 		Text:       gothememe.Hex("#6e9cbe"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeBrogrammer) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeBrogrammer) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2dc55e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2dc55e").WithAlpha(0.3),
+		Background: gothememe.Hex("#2dc55e19"),
+		Border:     gothememe.Hex("#2dc55e4c"),
 		Text:       gothememe.Hex("#2dc55e"),
 	}
 }
 
 func (t *themeBrogrammer) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ecba0f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ecba0f").WithAlpha(0.3),
+		Background: gothememe.Hex("#ecba0f19"),
+		Border:     gothememe.Hex("#ecba0f4c"),
 		Text:       gothememe.Hex("#ecba0f"),
 	}
 }
 
 func (t *themeBrogrammer) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f81118").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f81118").WithAlpha(0.3),
+		Background: gothememe.Hex("#f8111819"),
+		Border:     gothememe.Hex("#f811184c"),
 		Text:       gothememe.Hex("#f81118"),
 	}
 }
 
 func (t *themeBrogrammer) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1081d6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1081d6").WithAlpha(0.3),
+		Background: gothememe.Hex("#1081d619"),
+		Border:     gothememe.Hex("#1081d64c"),
 		Text:       gothememe.Hex("#1081d6"),
 	}
 }
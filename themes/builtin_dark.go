@@ -45,32 +45,32 @@ func (t *themeBuiltinDark) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeBuiltinDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00bb00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00bb00").WithAlpha(0.3),
+		Background: gothememe.Hex("#00bb0019"),
+		Border:     gothememe.Hex("#00bb004c"),
 		Text:       gothememe.Hex("#00bb00"),
 	}
 }
 
 func (t *themeBuiltinDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bbbb00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bbbb00").WithAlpha(0.3),
+		Background: gothememe.Hex("#bbbb0019"),
+		Border:     gothememe.Hex("#bbbb004c"),
 		Text:       gothememe.Hex("#bbbb00"),
 	}
 }
 
 func (t *themeBuiltinDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bb0000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bb0000").WithAlpha(0.3),
+		Background: gothememe.Hex("#bb000019"),
+		Border:     gothememe.Hex("#bb00004c"),
 		Text:       gothememe.Hex("#bb0000"),
 	}
 }
 
 func (t *themeBuiltinDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00bbbb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00bbbb").WithAlpha(0.3),
+		Background: gothememe.Hex("#00bbbb19"),
+		Border:     gothememe.Hex("#00bbbb4c"),
 		Text:       gothememe.Hex("#00bbbb"),
 	}
 }
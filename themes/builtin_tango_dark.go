@@ -47,32 +47,32 @@ func (t *themeBuiltinTangoDark) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeBuiltinTangoDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4e9a06").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4e9a06").WithAlpha(0.3),
+		Background: gothememe.Hex("#4e9a0619"),
+		Border:     gothememe.Hex("#4e9a064c"),
 		Text:       gothememe.Hex("#4e9a06"),
 	}
 }
 
 func (t *themeBuiltinTangoDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c4a000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c4a000").WithAlpha(0.3),
+		Background: gothememe.Hex("#c4a00019"),
+		Border:     gothememe.Hex("#c4a0004c"),
 		Text:       gothememe.Hex("#c4a000"),
 	}
 }
 
 func (t *themeBuiltinTangoDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc0000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc0000").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc000019"),
+		Border:     gothememe.Hex("#cc00004c"),
 		Text:       gothememe.Hex("#cc0000"),
 	}
 }
 
 func (t *themeBuiltinTangoDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#06989a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#06989a").WithAlpha(0.3),
+		Background: gothememe.Hex("#06989a19"),
+		Border:     gothememe.Hex("#06989a4c"),
 		Text:       gothememe.Hex("#06989a"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeC64) BorderStrong() gothememe.Color { return gothememe.Hex("#fffff
 // Semantic colors
 func (t *themeC64) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#55a049").WithAlpha(0.1),
-		Border:     gothememe.Hex("#55a049").WithAlpha(0.3),
+		Background: gothememe.Hex("#55a04919"),
+		Border:     gothememe.Hex("#55a0494c"),
 		Text:       gothememe.Hex("#55a049"),
 	}
 }
 
 func (t *themeC64) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bfce72").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bfce72").WithAlpha(0.3),
+		Background: gothememe.Hex("#bfce7219"),
+		Border:     gothememe.Hex("#bfce724c"),
 		Text:       gothememe.Hex("#bfce72"),
 	}
 }
 
 func (t *themeC64) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a2524c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a2524c").WithAlpha(0.3),
+		Background: gothememe.Hex("#a2524c19"),
+		Border:     gothememe.Hex("#a2524c4c"),
 		Text:       gothememe.Hex("#a2524c"),
 	}
 }
 
 func (t *themeC64) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#67b6bd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#67b6bd").WithAlpha(0.3),
+		Background: gothememe.Hex("#67b6bd19"),
+		Border:     gothememe.Hex("#67b6bd4c"),
 		Text:       gothememe.Hex("#67b6bd"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeCalamity) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeCalamity) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a5f69c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a5f69c").WithAlpha(0.3),
+		Background: gothememe.Hex("#a5f69c19"),
+		Border:     gothememe.Hex("#a5f69c4c"),
 		Text:       gothememe.Hex("#a5f69c"),
 	}
 }
 
 func (t *themeCalamity) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e9d7a5").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e9d7a5").WithAlpha(0.3),
+		Background: gothememe.Hex("#e9d7a519"),
+		Border:     gothememe.Hex("#e9d7a54c"),
 		Text:       gothememe.Hex("#e9d7a5"),
 	}
 }
 
 func (t *themeCalamity) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fc644d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fc644d").WithAlpha(0.3),
+		Background: gothememe.Hex("#fc644d19"),
+		Border:     gothememe.Hex("#fc644d4c"),
 		Text:       gothememe.Hex("#fc644d"),
 	}
 }
 
 func (t *themeCalamity) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#74d3de").WithAlpha(0.1),
-		Border:     gothememe.Hex("#74d3de").WithAlpha(0.3),
+		Background: gothememe.Hex("#74d3de19"),
+		Border:     gothememe.Hex("#74d3de4c"),
 		Text:       gothememe.Hex("#74d3de"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeCarbonfox) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeCarbonfox) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#25be6a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#25be6a").WithAlpha(0.3),
+		Background: gothememe.Hex("#25be6a19"),
+		Border:     gothememe.Hex("#25be6a4c"),
 		Text:       gothememe.Hex("#25be6a"),
 	}
 }
 
 func (t *themeCarbonfox) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#08bdba").WithAlpha(0.1),
-		Border:     gothememe.Hex("#08bdba").WithAlpha(0.3),
+		Background: gothememe.Hex("#08bdba19"),
+		Border:     gothememe.Hex("#08bdba4c"),
 		Text:       gothememe.Hex("#08bdba"),
 	}
 }
 
 func (t *themeCarbonfox) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ee5396").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ee5396").WithAlpha(0.3),
+		Background: gothememe.Hex("#ee539619"),
+		Border:     gothememe.Hex("#ee53964c"),
 		Text:       gothememe.Hex("#ee5396"),
 	}
 }
 
 func (t *themeCarbonfox) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#33b1ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#33b1ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#33b1ff19"),
+		Border:     gothememe.Hex("#33b1ff4c"),
 		Text:       gothememe.Hex("#33b1ff"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeCatppuccinFrappe) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeCatppuccinFrappe) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a6d189").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a6d189").WithAlpha(0.3),
+		Background: gothememe.Hex("#a6d18919"),
+		Border:     gothememe.Hex("#a6d1894c"),
 		Text:       gothememe.Hex("#a6d189"),
 	}
 }
 
 func (t *themeCatppuccinFrappe) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e5c890").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e5c890").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5c89019"),
+		Border:     gothememe.Hex("#e5c8904c"),
 		Text:       gothememe.Hex("#e5c890"),
 	}
 }
 
 func (t *themeCatppuccinFrappe) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e78284").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e78284").WithAlpha(0.3),
+		Background: gothememe.Hex("#e7828419"),
+		Border:     gothememe.Hex("#e782844c"),
 		Text:       gothememe.Hex("#e78284"),
 	}
 }
 
 func (t *themeCatppuccinFrappe) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#81c8be").WithAlpha(0.1),
-		Border:     gothememe.Hex("#81c8be").WithAlpha(0.3),
+		Background: gothememe.Hex("#81c8be19"),
+		Border:     gothememe.Hex("#81c8be4c"),
 		Text:       gothememe.Hex("#81c8be"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeCatppuccinLatte) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeCatppuccinLatte) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#40a02b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#40a02b").WithAlpha(0.3),
+		Background: gothememe.Hex("#40a02b19"),
+		Border:     gothememe.Hex("#40a02b4c"),
 		Text:       gothememe.Hex("#40a02b"),
 	}
 }
 
 func (t *themeCatppuccinLatte) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#df8e1d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#df8e1d").WithAlpha(0.3),
+		Background: gothememe.Hex("#df8e1d19"),
+		Border:     gothememe.Hex("#df8e1d4c"),
 		Text:       gothememe.Hex("#df8e1d"),
 	}
 }
 
 func (t *themeCatppuccinLatte) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d20f39").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d20f39").WithAlpha(0.3),
+		Background: gothememe.Hex("#d20f3919"),
+		Border:     gothememe.Hex("#d20f394c"),
 		Text:       gothememe.Hex("#d20f39"),
 	}
 }
 
 func (t *themeCatppuccinLatte) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#179299").WithAlpha(0.1),
-		Border:     gothememe.Hex("#179299").WithAlpha(0.3),
+		Background: gothememe.Hex("#17929919"),
+		Border:     gothememe.Hex("#1792994c"),
 		Text:       gothememe.Hex("#179299"),
 	}
 }
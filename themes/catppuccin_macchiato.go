@@ -49,32 +49,32 @@ func (t *themeCatppuccinMacchiato) BorderStrong() gothememe.Color { return gothe
 // Semantic colors
 func (t *themeCatppuccinMacchiato) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a6da95").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a6da95").WithAlpha(0.3),
+		Background: gothememe.Hex("#a6da9519"),
+		Border:     gothememe.Hex("#a6da954c"),
 		Text:       gothememe.Hex("#a6da95"),
 	}
 }
 
 func (t *themeCatppuccinMacchiato) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#eed49f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#eed49f").WithAlpha(0.3),
+		Background: gothememe.Hex("#eed49f19"),
+		Border:     gothememe.Hex("#eed49f4c"),
 		Text:       gothememe.Hex("#eed49f"),
 	}
 }
 
 func (t *themeCatppuccinMacchiato) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ed8796").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ed8796").WithAlpha(0.3),
+		Background: gothememe.Hex("#ed879619"),
+		Border:     gothememe.Hex("#ed87964c"),
 		Text:       gothememe.Hex("#ed8796"),
 	}
 }
 
 func (t *themeCatppuccinMacchiato) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8bd5ca").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8bd5ca").WithAlpha(0.3),
+		Background: gothememe.Hex("#8bd5ca19"),
+		Border:     gothememe.Hex("#8bd5ca4c"),
 		Text:       gothememe.Hex("#8bd5ca"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeCatppuccinMocha) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeCatppuccinMocha) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a6e3a1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a6e3a1").WithAlpha(0.3),
+		Background: gothememe.Hex("#a6e3a119"),
+		Border:     gothememe.Hex("#a6e3a14c"),
 		Text:       gothememe.Hex("#a6e3a1"),
 	}
 }
 
 func (t *themeCatppuccinMocha) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f9e2af").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f9e2af").WithAlpha(0.3),
+		Background: gothememe.Hex("#f9e2af19"),
+		Border:     gothememe.Hex("#f9e2af4c"),
 		Text:       gothememe.Hex("#f9e2af"),
 	}
 }
 
 func (t *themeCatppuccinMocha) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f38ba8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f38ba8").WithAlpha(0.3),
+		Background: gothememe.Hex("#f38ba819"),
+		Border:     gothememe.Hex("#f38ba84c"),
 		Text:       gothememe.Hex("#f38ba8"),
 	}
 }
 
 func (t *themeCatppuccinMocha) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#94e2d5").WithAlpha(0.1),
-		Border:     gothememe.Hex("#94e2d5").WithAlpha(0.3),
+		Background: gothememe.Hex("#94e2d519"),
+		Border:     gothememe.Hex("#94e2d54c"),
 		Text:       gothememe.Hex("#94e2d5"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeCga) BorderStrong() gothememe.Color { return gothememe.Hex("#aaaaa
 // Semantic colors
 func (t *themeCga) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00aa00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00aa00").WithAlpha(0.3),
+		Background: gothememe.Hex("#00aa0019"),
+		Border:     gothememe.Hex("#00aa004c"),
 		Text:       gothememe.Hex("#00aa00"),
 	}
 }
 
 func (t *themeCga) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aa5500").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aa5500").WithAlpha(0.3),
+		Background: gothememe.Hex("#aa550019"),
+		Border:     gothememe.Hex("#aa55004c"),
 		Text:       gothememe.Hex("#aa5500"),
 	}
 }
 
 func (t *themeCga) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aa0000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aa0000").WithAlpha(0.3),
+		Background: gothememe.Hex("#aa000019"),
+		Border:     gothememe.Hex("#aa00004c"),
 		Text:       gothememe.Hex("#aa0000"),
 	}
 }
 
 func (t *themeCga) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00aaaa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00aaaa").WithAlpha(0.3),
+		Background: gothememe.Hex("#00aaaa19"),
+		Border:     gothememe.Hex("#00aaaa4c"),
 		Text:       gothememe.Hex("#00aaaa"),
 	}
 }
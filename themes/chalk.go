@@ -43,32 +43,32 @@ func (t *themeChalk) BorderStrong() gothememe.Color { return gothememe.Hex("#d2d
 // Semantic colors
 func (t *themeChalk) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#789b6a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#789b6a").WithAlpha(0.3),
+		Background: gothememe.Hex("#789b6a19"),
+		Border:     gothememe.Hex("#789b6a4c"),
 		Text:       gothememe.Hex("#789b6a"),
 	}
 }
 
 func (t *themeChalk) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b9ac4a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b9ac4a").WithAlpha(0.3),
+		Background: gothememe.Hex("#b9ac4a19"),
+		Border:     gothememe.Hex("#b9ac4a4c"),
 		Text:       gothememe.Hex("#b9ac4a"),
 	}
 }
 
 func (t *themeChalk) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b23a52").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b23a52").WithAlpha(0.3),
+		Background: gothememe.Hex("#b23a5219"),
+		Border:     gothememe.Hex("#b23a524c"),
 		Text:       gothememe.Hex("#b23a52"),
 	}
 }
 
 func (t *themeChalk) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#44a799").WithAlpha(0.1),
-		Border:     gothememe.Hex("#44a799").WithAlpha(0.3),
+		Background: gothememe.Hex("#44a79919"),
+		Border:     gothememe.Hex("#44a7994c"),
 		Text:       gothememe.Hex("#44a799"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeChalkboard) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeChalkboard) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#72c373").WithAlpha(0.1),
-		Border:     gothememe.Hex("#72c373").WithAlpha(0.3),
+		Background: gothememe.Hex("#72c37319"),
+		Border:     gothememe.Hex("#72c3734c"),
 		Text:       gothememe.Hex("#72c373"),
 	}
 }
 
 func (t *themeChalkboard) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c2c372").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c2c372").WithAlpha(0.3),
+		Background: gothememe.Hex("#c2c37219"),
+		Border:     gothememe.Hex("#c2c3724c"),
 		Text:       gothememe.Hex("#c2c372"),
 	}
 }
 
 func (t *themeChalkboard) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c37372").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c37372").WithAlpha(0.3),
+		Background: gothememe.Hex("#c3737219"),
+		Border:     gothememe.Hex("#c373724c"),
 		Text:       gothememe.Hex("#c37372"),
 	}
 }
 
 func (t *themeChalkboard) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#72c2c3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#72c2c3").WithAlpha(0.3),
+		Background: gothememe.Hex("#72c2c319"),
+		Border:     gothememe.Hex("#72c2c34c"),
 		Text:       gothememe.Hex("#72c2c3"),
 	}
 }
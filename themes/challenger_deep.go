@@ -45,32 +45,32 @@ func (t *themeChallengerDeep) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeChallengerDeep) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#62d196").WithAlpha(0.1),
-		Border:     gothememe.Hex("#62d196").WithAlpha(0.3),
+		Background: gothememe.Hex("#62d19619"),
+		Border:     gothememe.Hex("#62d1964c"),
 		Text:       gothememe.Hex("#62d196"),
 	}
 }
 
 func (t *themeChallengerDeep) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffb378").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffb378").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffb37819"),
+		Border:     gothememe.Hex("#ffb3784c"),
 		Text:       gothememe.Hex("#ffb378"),
 	}
 }
 
 func (t *themeChallengerDeep) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff5458").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff5458").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff545819"),
+		Border:     gothememe.Hex("#ff54584c"),
 		Text:       gothememe.Hex("#ff5458"),
 	}
 }
 
 func (t *themeChallengerDeep) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#63f2f1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#63f2f1").WithAlpha(0.3),
+		Background: gothememe.Hex("#63f2f119"),
+		Border:     gothememe.Hex("#63f2f14c"),
 		Text:       gothememe.Hex("#63f2f1"),
 	}
 }
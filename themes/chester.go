@@ -43,32 +43,32 @@ func (t *themeChester) BorderStrong() gothememe.Color { return gothememe.Hex("#e
 // Semantic colors
 func (t *themeChester) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#16c98d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#16c98d").WithAlpha(0.3),
+		Background: gothememe.Hex("#16c98d19"),
+		Border:     gothememe.Hex("#16c98d4c"),
 		Text:       gothememe.Hex("#16c98d"),
 	}
 }
 
 func (t *themeChester) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc83f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc83f").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc83f19"),
+		Border:     gothememe.Hex("#ffc83f4c"),
 		Text:       gothememe.Hex("#ffc83f"),
 	}
 }
 
 func (t *themeChester) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fa5e5b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fa5e5b").WithAlpha(0.3),
+		Background: gothememe.Hex("#fa5e5b19"),
+		Border:     gothememe.Hex("#fa5e5b4c"),
 		Text:       gothememe.Hex("#fa5e5b"),
 	}
 }
 
 func (t *themeChester) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#28ddde").WithAlpha(0.1),
-		Border:     gothememe.Hex("#28ddde").WithAlpha(0.3),
+		Background: gothememe.Hex("#28ddde19"),
+		Border:     gothememe.Hex("#28ddde4c"),
 		Text:       gothememe.Hex("#28ddde"),
 	}
 }
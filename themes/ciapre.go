@@ -43,32 +43,32 @@ func (t *themeCiapre) BorderStrong() gothememe.Color { return gothememe.Hex("#ae
 // Semantic colors
 func (t *themeCiapre) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#48513b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#48513b").WithAlpha(0.3),
+		Background: gothememe.Hex("#48513b19"),
+		Border:     gothememe.Hex("#48513b4c"),
 		Text:       gothememe.Hex("#48513b"),
 	}
 }
 
 func (t *themeCiapre) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc8b3f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc8b3f").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc8b3f19"),
+		Border:     gothememe.Hex("#cc8b3f4c"),
 		Text:       gothememe.Hex("#cc8b3f"),
 	}
 }
 
 func (t *themeCiapre) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8e0d16").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8e0d16").WithAlpha(0.3),
+		Background: gothememe.Hex("#8e0d1619"),
+		Border:     gothememe.Hex("#8e0d164c"),
 		Text:       gothememe.Hex("#8e0d16"),
 	}
 }
 
 func (t *themeCiapre) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5c4f4b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5c4f4b").WithAlpha(0.3),
+		Background: gothememe.Hex("#5c4f4b19"),
+		Border:     gothememe.Hex("#5c4f4b4c"),
 		Text:       gothememe.Hex("#5c4f4b"),
 	}
 }
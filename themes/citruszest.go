@@ -43,32 +43,32 @@ func (t *themeCitruszest) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeCitruszest) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00cc7a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00cc7a").WithAlpha(0.3),
+		Background: gothememe.Hex("#00cc7a19"),
+		Border:     gothememe.Hex("#00cc7a4c"),
 		Text:       gothememe.Hex("#00cc7a"),
 	}
 }
 
 func (t *themeCitruszest) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffd400").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffd400").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffd40019"),
+		Border:     gothememe.Hex("#ffd4004c"),
 		Text:       gothememe.Hex("#ffd400"),
 	}
 }
 
 func (t *themeCitruszest) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff5454").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff5454").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff545419"),
+		Border:     gothememe.Hex("#ff54544c"),
 		Text:       gothememe.Hex("#ff5454"),
 	}
 }
 
 func (t *themeCitruszest) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#48d1cc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#48d1cc").WithAlpha(0.3),
+		Background: gothememe.Hex("#48d1cc19"),
+		Border:     gothememe.Hex("#48d1cc4c"),
 		Text:       gothememe.Hex("#48d1cc"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeClrs) BorderStrong() gothememe.Color { return gothememe.Hex("#b3b3
 // Semantic colors
 func (t *themeClrs) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#328a5d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#328a5d").WithAlpha(0.3),
+		Background: gothememe.Hex("#328a5d19"),
+		Border:     gothememe.Hex("#328a5d4c"),
 		Text:       gothememe.Hex("#328a5d"),
 	}
 }
 
 func (t *themeClrs) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fa701d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fa701d").WithAlpha(0.3),
+		Background: gothememe.Hex("#fa701d19"),
+		Border:     gothememe.Hex("#fa701d4c"),
 		Text:       gothememe.Hex("#fa701d"),
 	}
 }
 
 func (t *themeClrs) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f8282a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f8282a").WithAlpha(0.3),
+		Background: gothememe.Hex("#f8282a19"),
+		Border:     gothememe.Hex("#f8282a4c"),
 		Text:       gothememe.Hex("#f8282a"),
 	}
 }
 
 func (t *themeClrs) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#33c3c1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#33c3c1").WithAlpha(0.3),
+		Background: gothememe.Hex("#33c3c119"),
+		Border:     gothememe.Hex("#33c3c14c"),
 		Text:       gothememe.Hex("#33c3c1"),
 	}
 }
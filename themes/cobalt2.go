@@ -43,32 +43,32 @@ func (t *themeCobalt2) BorderStrong() gothememe.Color { return gothememe.Hex("#b
 // Semantic colors
 func (t *themeCobalt2) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#38de21").WithAlpha(0.1),
-		Border:     gothememe.Hex("#38de21").WithAlpha(0.3),
+		Background: gothememe.Hex("#38de2119"),
+		Border:     gothememe.Hex("#38de214c"),
 		Text:       gothememe.Hex("#38de21"),
 	}
 }
 
 func (t *themeCobalt2) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffe50a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffe50a").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffe50a19"),
+		Border:     gothememe.Hex("#ffe50a4c"),
 		Text:       gothememe.Hex("#ffe50a"),
 	}
 }
 
 func (t *themeCobalt2) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff0000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff0000").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff000019"),
+		Border:     gothememe.Hex("#ff00004c"),
 		Text:       gothememe.Hex("#ff0000"),
 	}
 }
 
 func (t *themeCobalt2) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00bbbb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00bbbb").WithAlpha(0.3),
+		Background: gothememe.Hex("#00bbbb19"),
+		Border:     gothememe.Hex("#00bbbb4c"),
 		Text:       gothememe.Hex("#00bbbb"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeCobaltNeon) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeCobaltNeon) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3ba5ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3ba5ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#3ba5ff19"),
+		Border:     gothememe.Hex("#3ba5ff4c"),
 		Text:       gothememe.Hex("#3ba5ff"),
 	}
 }
 
 func (t *themeCobaltNeon) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e9e75c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e9e75c").WithAlpha(0.3),
+		Background: gothememe.Hex("#e9e75c19"),
+		Border:     gothememe.Hex("#e9e75c4c"),
 		Text:       gothememe.Hex("#e9e75c"),
 	}
 }
 
 func (t *themeCobaltNeon) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff2320").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff2320").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff232019"),
+		Border:     gothememe.Hex("#ff23204c"),
 		Text:       gothememe.Hex("#ff2320"),
 	}
 }
 
 func (t *themeCobaltNeon) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8ff586").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8ff586").WithAlpha(0.3),
+		Background: gothememe.Hex("#8ff58619"),
+		Border:     gothememe.Hex("#8ff5864c"),
 		Text:       gothememe.Hex("#8ff586"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeCobaltNext) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeCobaltNext) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8cc98f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8cc98f").WithAlpha(0.3),
+		Background: gothememe.Hex("#8cc98f19"),
+		Border:     gothememe.Hex("#8cc98f4c"),
 		Text:       gothememe.Hex("#8cc98f"),
 	}
 }
 
 func (t *themeCobaltNext) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc64c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc64c").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc64c19"),
+		Border:     gothememe.Hex("#ffc64c4c"),
 		Text:       gothememe.Hex("#ffc64c"),
 	}
 }
 
 func (t *themeCobaltNext) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff527b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff527b").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff527b19"),
+		Border:     gothememe.Hex("#ff527b4c"),
 		Text:       gothememe.Hex("#ff527b"),
 	}
 }
 
 func (t *themeCobaltNext) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#37b5b4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#37b5b4").WithAlpha(0.3),
+		Background: gothememe.Hex("#37b5b419"),
+		Border:     gothememe.Hex("#37b5b44c"),
 		Text:       gothememe.Hex("#37b5b4"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeCobaltNextDark) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeCobaltNextDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8cc98f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8cc98f").WithAlpha(0.3),
+		Background: gothememe.Hex("#8cc98f19"),
+		Border:     gothememe.Hex("#8cc98f4c"),
 		Text:       gothememe.Hex("#8cc98f"),
 	}
 }
 
 func (t *themeCobaltNextDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc64c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc64c").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc64c19"),
+		Border:     gothememe.Hex("#ffc64c4c"),
 		Text:       gothememe.Hex("#ffc64c"),
 	}
 }
 
 func (t *themeCobaltNextDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f94967").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f94967").WithAlpha(0.3),
+		Background: gothememe.Hex("#f9496719"),
+		Border:     gothememe.Hex("#f949674c"),
 		Text:       gothememe.Hex("#f94967"),
 	}
 }
 
 func (t *themeCobaltNextDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#37b5b4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#37b5b4").WithAlpha(0.3),
+		Background: gothememe.Hex("#37b5b419"),
+		Border:     gothememe.Hex("#37b5b44c"),
 		Text:       gothememe.Hex("#37b5b4"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeCobaltNextMinimal) BorderStrong() gothememe.Color { return gotheme
 // Semantic colors
 func (t *themeCobaltNextMinimal) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8cc98f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8cc98f").WithAlpha(0.3),
+		Background: gothememe.Hex("#8cc98f19"),
+		Border:     gothememe.Hex("#8cc98f4c"),
 		Text:       gothememe.Hex("#8cc98f"),
 	}
 }
 
 func (t *themeCobaltNextMinimal) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc64c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc64c").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc64c19"),
+		Border:     gothememe.Hex("#ffc64c4c"),
 		Text:       gothememe.Hex("#ffc64c"),
 	}
 }
 
 func (t *themeCobaltNextMinimal) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff657a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff657a").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff657a19"),
+		Border:     gothememe.Hex("#ff657a4c"),
 		Text:       gothememe.Hex("#ff657a"),
 	}
 }
 
 func (t *themeCobaltNextMinimal) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#37b5b4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#37b5b4").WithAlpha(0.3),
+		Background: gothememe.Hex("#37b5b419"),
+		Border:     gothememe.Hex("#37b5b44c"),
 		Text:       gothememe.Hex("#37b5b4"),
 	}
 }
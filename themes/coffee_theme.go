@@ -45,32 +45,32 @@ func (t *themeCoffeeTheme) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeCoffeeTheme) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00c200").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00c200").WithAlpha(0.3),
+		Background: gothememe.Hex("#00c20019"),
+		Border:     gothememe.Hex("#00c2004c"),
 		Text:       gothememe.Hex("#00c200"),
 	}
 }
 
 func (t *themeCoffeeTheme) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#adaa00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#adaa00").WithAlpha(0.3),
+		Background: gothememe.Hex("#adaa0019"),
+		Border:     gothememe.Hex("#adaa004c"),
 		Text:       gothememe.Hex("#adaa00"),
 	}
 }
 
 func (t *themeCoffeeTheme) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c91b00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c91b00").WithAlpha(0.3),
+		Background: gothememe.Hex("#c91b0019"),
+		Border:     gothememe.Hex("#c91b004c"),
 		Text:       gothememe.Hex("#c91b00"),
 	}
 }
 
 func (t *themeCoffeeTheme) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00b8ba").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00b8ba").WithAlpha(0.3),
+		Background: gothememe.Hex("#00b8ba19"),
+		Border:     gothememe.Hex("#00b8ba4c"),
 		Text:       gothememe.Hex("#00b8ba"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeCrayonPonyFish) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeCrayonPonyFish) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#579524").WithAlpha(0.1),
-		Border:     gothememe.Hex("#579524").WithAlpha(0.3),
+		Background: gothememe.Hex("#57952419"),
+		Border:     gothememe.Hex("#5795244c"),
 		Text:       gothememe.Hex("#579524"),
 	}
 }
 
 func (t *themeCrayonPonyFish) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ab311b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ab311b").WithAlpha(0.3),
+		Background: gothememe.Hex("#ab311b19"),
+		Border:     gothememe.Hex("#ab311b4c"),
 		Text:       gothememe.Hex("#ab311b"),
 	}
 }
 
 func (t *themeCrayonPonyFish) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#91002b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#91002b").WithAlpha(0.3),
+		Background: gothememe.Hex("#91002b19"),
+		Border:     gothememe.Hex("#91002b4c"),
 		Text:       gothememe.Hex("#91002b"),
 	}
 }
 
 func (t *themeCrayonPonyFish) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e8a866").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e8a866").WithAlpha(0.3),
+		Background: gothememe.Hex("#e8a86619"),
+		Border:     gothememe.Hex("#e8a8664c"),
 		Text:       gothememe.Hex("#e8a866"),
 	}
 }
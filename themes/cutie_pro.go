@@ -43,32 +43,32 @@ func (t *themeCutiePro) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeCutiePro) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bec975").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bec975").WithAlpha(0.3),
+		Background: gothememe.Hex("#bec97519"),
+		Border:     gothememe.Hex("#bec9754c"),
 		Text:       gothememe.Hex("#bec975"),
 	}
 }
 
 func (t *themeCutiePro) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f58669").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f58669").WithAlpha(0.3),
+		Background: gothememe.Hex("#f5866919"),
+		Border:     gothememe.Hex("#f586694c"),
 		Text:       gothememe.Hex("#f58669"),
 	}
 }
 
 func (t *themeCutiePro) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f56e7f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f56e7f").WithAlpha(0.3),
+		Background: gothememe.Hex("#f56e7f19"),
+		Border:     gothememe.Hex("#f56e7f4c"),
 		Text:       gothememe.Hex("#f56e7f"),
 	}
 }
 
 func (t *themeCutiePro) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#37cb8a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#37cb8a").WithAlpha(0.3),
+		Background: gothememe.Hex("#37cb8a19"),
+		Border:     gothememe.Hex("#37cb8a4c"),
 		Text:       gothememe.Hex("#37cb8a"),
 	}
 }
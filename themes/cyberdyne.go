@@ -43,32 +43,32 @@ func (t *themeCyberdyne) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeCyberdyne) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00c172").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00c172").WithAlpha(0.3),
+		Background: gothememe.Hex("#00c17219"),
+		Border:     gothememe.Hex("#00c1724c"),
 		Text:       gothememe.Hex("#00c172"),
 	}
 }
 
 func (t *themeCyberdyne) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d2a700").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d2a700").WithAlpha(0.3),
+		Background: gothememe.Hex("#d2a70019"),
+		Border:     gothememe.Hex("#d2a7004c"),
 		Text:       gothememe.Hex("#d2a700"),
 	}
 }
 
 func (t *themeCyberdyne) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff8373").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff8373").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff837319"),
+		Border:     gothememe.Hex("#ff83734c"),
 		Text:       gothememe.Hex("#ff8373"),
 	}
 }
 
 func (t *themeCyberdyne) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6bffdd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6bffdd").WithAlpha(0.3),
+		Background: gothememe.Hex("#6bffdd19"),
+		Border:     gothememe.Hex("#6bffdd4c"),
 		Text:       gothememe.Hex("#6bffdd"),
 	}
 }
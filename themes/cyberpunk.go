@@ -43,32 +43,32 @@ func (t *themeCyberpunk) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeCyberpunk) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00fbac").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00fbac").WithAlpha(0.3),
+		Background: gothememe.Hex("#00fbac19"),
+		Border:     gothememe.Hex("#00fbac4c"),
 		Text:       gothememe.Hex("#00fbac"),
 	}
 }
 
 func (t *themeCyberpunk) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fffa6a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fffa6a").WithAlpha(0.3),
+		Background: gothememe.Hex("#fffa6a19"),
+		Border:     gothememe.Hex("#fffa6a4c"),
 		Text:       gothememe.Hex("#fffa6a"),
 	}
 }
 
 func (t *themeCyberpunk) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff7092").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff7092").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff709219"),
+		Border:     gothememe.Hex("#ff70924c"),
 		Text:       gothememe.Hex("#ff7092"),
 	}
 }
 
 func (t *themeCyberpunk) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#86cbfe").WithAlpha(0.1),
-		Border:     gothememe.Hex("#86cbfe").WithAlpha(0.3),
+		Background: gothememe.Hex("#86cbfe19"),
+		Border:     gothememe.Hex("#86cbfe4c"),
 		Text:       gothememe.Hex("#86cbfe"),
 	}
 }
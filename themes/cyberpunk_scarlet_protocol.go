@@ -63,32 +63,32 @@ func (t *themeCyberpunkScarletProtocol) BorderStrong() gothememe.Color {
 // Semantic colors
 func (t *themeCyberpunkScarletProtocol) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#01dc84").WithAlpha(0.1),
-		Border:     gothememe.Hex("#01dc84").WithAlpha(0.3),
+		Background: gothememe.Hex("#01dc8419"),
+		Border:     gothememe.Hex("#01dc844c"),
 		Text:       gothememe.Hex("#01dc84"),
 	}
 }
 
 func (t *themeCyberpunkScarletProtocol) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#faf945").WithAlpha(0.1),
-		Border:     gothememe.Hex("#faf945").WithAlpha(0.3),
+		Background: gothememe.Hex("#faf94519"),
+		Border:     gothememe.Hex("#faf9454c"),
 		Text:       gothememe.Hex("#faf945"),
 	}
 }
 
 func (t *themeCyberpunkScarletProtocol) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff0051").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff0051").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff005119"),
+		Border:     gothememe.Hex("#ff00514c"),
 		Text:       gothememe.Hex("#ff0051"),
 	}
 }
 
 func (t *themeCyberpunkScarletProtocol) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00c5c7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00c5c7").WithAlpha(0.3),
+		Background: gothememe.Hex("#00c5c719"),
+		Border:     gothememe.Hex("#00c5c74c"),
 		Text:       gothememe.Hex("#00c5c7"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeDark) BorderStrong() gothememe.Color { return gothememe.Hex("#e5e5
 // Semantic colors
 func (t *themeDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0dbc79").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0dbc79").WithAlpha(0.3),
+		Background: gothememe.Hex("#0dbc7919"),
+		Border:     gothememe.Hex("#0dbc794c"),
 		Text:       gothememe.Hex("#0dbc79"),
 	}
 }
 
 func (t *themeDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e5e510").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e5e510").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5e51019"),
+		Border:     gothememe.Hex("#e5e5104c"),
 		Text:       gothememe.Hex("#e5e510"),
 	}
 }
 
 func (t *themeDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cd3131").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cd3131").WithAlpha(0.3),
+		Background: gothememe.Hex("#cd313119"),
+		Border:     gothememe.Hex("#cd31314c"),
 		Text:       gothememe.Hex("#cd3131"),
 	}
 }
 
 func (t *themeDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#11a8cd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#11a8cd").WithAlpha(0.3),
+		Background: gothememe.Hex("#11a8cd19"),
+		Border:     gothememe.Hex("#11a8cd4c"),
 		Text:       gothememe.Hex("#11a8cd"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeDarkModern) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeDarkModern) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2ea043").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2ea043").WithAlpha(0.3),
+		Background: gothememe.Hex("#2ea04319"),
+		Border:     gothememe.Hex("#2ea0434c"),
 		Text:       gothememe.Hex("#2ea043"),
 	}
 }
 
 func (t *themeDarkModern) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9e6a03").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9e6a03").WithAlpha(0.3),
+		Background: gothememe.Hex("#9e6a0319"),
+		Border:     gothememe.Hex("#9e6a034c"),
 		Text:       gothememe.Hex("#9e6a03"),
 	}
 }
 
 func (t *themeDarkModern) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f74949").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f74949").WithAlpha(0.3),
+		Background: gothememe.Hex("#f7494919"),
+		Border:     gothememe.Hex("#f749494c"),
 		Text:       gothememe.Hex("#f74949"),
 	}
 }
 
 func (t *themeDarkModern) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1db4d6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1db4d6").WithAlpha(0.3),
+		Background: gothememe.Hex("#1db4d619"),
+		Border:     gothememe.Hex("#1db4d64c"),
 		Text:       gothememe.Hex("#1db4d6"),
 	}
 }
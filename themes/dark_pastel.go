@@ -43,32 +43,32 @@ func (t *themeDarkPastel) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeDarkPastel) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#55ff55").WithAlpha(0.1),
-		Border:     gothememe.Hex("#55ff55").WithAlpha(0.3),
+		Background: gothememe.Hex("#55ff5519"),
+		Border:     gothememe.Hex("#55ff554c"),
 		Text:       gothememe.Hex("#55ff55"),
 	}
 }
 
 func (t *themeDarkPastel) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffff55").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffff55").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffff5519"),
+		Border:     gothememe.Hex("#ffff554c"),
 		Text:       gothememe.Hex("#ffff55"),
 	}
 }
 
 func (t *themeDarkPastel) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff5555").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff5555").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff555519"),
+		Border:     gothememe.Hex("#ff55554c"),
 		Text:       gothememe.Hex("#ff5555"),
 	}
 }
 
 func (t *themeDarkPastel) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#55ffff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#55ffff").WithAlpha(0.3),
+		Background: gothememe.Hex("#55ffff19"),
+		Border:     gothememe.Hex("#55ffff4c"),
 		Text:       gothememe.Hex("#55ffff"),
 	}
 }
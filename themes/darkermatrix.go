@@ -45,32 +45,32 @@ func (t *themeDarkermatrix) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeDarkermatrix) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6fa64c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6fa64c").WithAlpha(0.3),
+		Background: gothememe.Hex("#6fa64c19"),
+		Border:     gothememe.Hex("#6fa64c4c"),
 		Text:       gothememe.Hex("#6fa64c"),
 	}
 }
 
 func (t *themeDarkermatrix) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#595900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#595900").WithAlpha(0.3),
+		Background: gothememe.Hex("#59590019"),
+		Border:     gothememe.Hex("#5959004c"),
 		Text:       gothememe.Hex("#595900"),
 	}
 }
 
 func (t *themeDarkermatrix) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1a4831").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1a4831").WithAlpha(0.3),
+		Background: gothememe.Hex("#1a483119"),
+		Border:     gothememe.Hex("#1a48314c"),
 		Text:       gothememe.Hex("#1a4831"),
 	}
 }
 
 func (t *themeDarkermatrix) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#125459").WithAlpha(0.1),
-		Border:     gothememe.Hex("#125459").WithAlpha(0.3),
+		Background: gothememe.Hex("#12545919"),
+		Border:     gothememe.Hex("#1254594c"),
 		Text:       gothememe.Hex("#125459"),
 	}
 }
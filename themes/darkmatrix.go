@@ -43,32 +43,32 @@ func (t *themeDarkmatrix) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeDarkmatrix) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6fa64c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6fa64c").WithAlpha(0.3),
+		Background: gothememe.Hex("#6fa64c19"),
+		Border:     gothememe.Hex("#6fa64c4c"),
 		Text:       gothememe.Hex("#6fa64c"),
 	}
 }
 
 func (t *themeDarkmatrix) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7e8000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7e8000").WithAlpha(0.3),
+		Background: gothememe.Hex("#7e800019"),
+		Border:     gothememe.Hex("#7e80004c"),
 		Text:       gothememe.Hex("#7e8000"),
 	}
 }
 
 func (t *themeDarkmatrix) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#006536").WithAlpha(0.1),
-		Border:     gothememe.Hex("#006536").WithAlpha(0.3),
+		Background: gothememe.Hex("#00653619"),
+		Border:     gothememe.Hex("#0065364c"),
 		Text:       gothememe.Hex("#006536"),
 	}
 }
 
 func (t *themeDarkmatrix) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#114d53").WithAlpha(0.1),
-		Border:     gothememe.Hex("#114d53").WithAlpha(0.3),
+		Background: gothememe.Hex("#114d5319"),
+		Border:     gothememe.Hex("#114d534c"),
 		Text:       gothememe.Hex("#114d53"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeDarkside) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeDarkside) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#68c256").WithAlpha(0.1),
-		Border:     gothememe.Hex("#68c256").WithAlpha(0.3),
+		Background: gothememe.Hex("#68c25619"),
+		Border:     gothememe.Hex("#68c2564c"),
 		Text:       gothememe.Hex("#68c256"),
 	}
 }
 
 func (t *themeDarkside) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f2d42c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f2d42c").WithAlpha(0.3),
+		Background: gothememe.Hex("#f2d42c19"),
+		Border:     gothememe.Hex("#f2d42c4c"),
 		Text:       gothememe.Hex("#f2d42c"),
 	}
 }
 
 func (t *themeDarkside) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e8341c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e8341c").WithAlpha(0.3),
+		Background: gothememe.Hex("#e8341c19"),
+		Border:     gothememe.Hex("#e8341c4c"),
 		Text:       gothememe.Hex("#e8341c"),
 	}
 }
 
 func (t *themeDarkside) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1c98e8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1c98e8").WithAlpha(0.3),
+		Background: gothememe.Hex("#1c98e819"),
+		Border:     gothememe.Hex("#1c98e84c"),
 		Text:       gothememe.Hex("#1c98e8"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeDawnfox) BorderStrong() gothememe.Color { return gothememe.Hex("#b
 // Semantic colors
 func (t *themeDawnfox) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#618774").WithAlpha(0.1),
-		Border:     gothememe.Hex("#618774").WithAlpha(0.3),
+		Background: gothememe.Hex("#61877419"),
+		Border:     gothememe.Hex("#6187744c"),
 		Text:       gothememe.Hex("#618774"),
 	}
 }
 
 func (t *themeDawnfox) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ea9d34").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ea9d34").WithAlpha(0.3),
+		Background: gothememe.Hex("#ea9d3419"),
+		Border:     gothememe.Hex("#ea9d344c"),
 		Text:       gothememe.Hex("#ea9d34"),
 	}
 }
 
 func (t *themeDawnfox) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b4637a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b4637a").WithAlpha(0.3),
+		Background: gothememe.Hex("#b4637a19"),
+		Border:     gothememe.Hex("#b4637a4c"),
 		Text:       gothememe.Hex("#b4637a"),
 	}
 }
 
 func (t *themeDawnfox) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#56949f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#56949f").WithAlpha(0.3),
+		Background: gothememe.Hex("#56949f19"),
+		Border:     gothememe.Hex("#56949f4c"),
 		Text:       gothememe.Hex("#56949f"),
 	}
 }
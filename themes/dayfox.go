@@ -43,32 +43,32 @@ func (t *themeDayfox) BorderStrong() gothememe.Color { return gothememe.Hex("#bf
 // Semantic colors
 func (t *themeDayfox) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#396847").WithAlpha(0.1),
-		Border:     gothememe.Hex("#396847").WithAlpha(0.3),
+		Background: gothememe.Hex("#39684719"),
+		Border:     gothememe.Hex("#3968474c"),
 		Text:       gothememe.Hex("#396847"),
 	}
 }
 
 func (t *themeDayfox) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ac5402").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ac5402").WithAlpha(0.3),
+		Background: gothememe.Hex("#ac540219"),
+		Border:     gothememe.Hex("#ac54024c"),
 		Text:       gothememe.Hex("#ac5402"),
 	}
 }
 
 func (t *themeDayfox) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a5222f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a5222f").WithAlpha(0.3),
+		Background: gothememe.Hex("#a5222f19"),
+		Border:     gothememe.Hex("#a5222f4c"),
 		Text:       gothememe.Hex("#a5222f"),
 	}
 }
 
 func (t *themeDayfox) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#287980").WithAlpha(0.1),
-		Border:     gothememe.Hex("#287980").WithAlpha(0.3),
+		Background: gothememe.Hex("#28798019"),
+		Border:     gothememe.Hex("#2879804c"),
 		Text:       gothememe.Hex("#287980"),
 	}
 }
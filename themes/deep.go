@@ -43,32 +43,32 @@ func (t *themeDeep) BorderStrong() gothememe.Color { return gothememe.Hex("#e0e0
 // Semantic colors
 func (t *themeDeep) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1cd915").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1cd915").WithAlpha(0.3),
+		Background: gothememe.Hex("#1cd91519"),
+		Border:     gothememe.Hex("#1cd9154c"),
 		Text:       gothememe.Hex("#1cd915"),
 	}
 }
 
 func (t *themeDeep) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d9bd26").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d9bd26").WithAlpha(0.3),
+		Background: gothememe.Hex("#d9bd2619"),
+		Border:     gothememe.Hex("#d9bd264c"),
 		Text:       gothememe.Hex("#d9bd26"),
 	}
 }
 
 func (t *themeDeep) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d70005").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d70005").WithAlpha(0.3),
+		Background: gothememe.Hex("#d7000519"),
+		Border:     gothememe.Hex("#d700054c"),
 		Text:       gothememe.Hex("#d70005"),
 	}
 }
 
 func (t *themeDeep) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#50d2da").WithAlpha(0.1),
-		Border:     gothememe.Hex("#50d2da").WithAlpha(0.3),
+		Background: gothememe.Hex("#50d2da19"),
+		Border:     gothememe.Hex("#50d2da4c"),
 		Text:       gothememe.Hex("#50d2da"),
 	}
 }
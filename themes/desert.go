@@ -43,32 +43,32 @@ func (t *themeDesert) BorderStrong() gothememe.Color { return gothememe.Hex("#f5
 // Semantic colors
 func (t *themeDesert) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98fb98").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98fb98").WithAlpha(0.3),
+		Background: gothememe.Hex("#98fb9819"),
+		Border:     gothememe.Hex("#98fb984c"),
 		Text:       gothememe.Hex("#98fb98"),
 	}
 }
 
 func (t *themeDesert) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f0e68c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f0e68c").WithAlpha(0.3),
+		Background: gothememe.Hex("#f0e68c19"),
+		Border:     gothememe.Hex("#f0e68c4c"),
 		Text:       gothememe.Hex("#f0e68c"),
 	}
 }
 
 func (t *themeDesert) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff2b2b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff2b2b").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff2b2b19"),
+		Border:     gothememe.Hex("#ff2b2b4c"),
 		Text:       gothememe.Hex("#ff2b2b"),
 	}
 }
 
 func (t *themeDesert) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffa0a0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffa0a0").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffa0a019"),
+		Border:     gothememe.Hex("#ffa0a04c"),
 		Text:       gothememe.Hex("#ffa0a0"),
 	}
 }
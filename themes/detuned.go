@@ -43,32 +43,32 @@ func (t *themeDetuned) BorderStrong() gothememe.Color { return gothememe.Hex("#c
 // Semantic colors
 func (t *themeDetuned) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a6e32d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a6e32d").WithAlpha(0.3),
+		Background: gothememe.Hex("#a6e32d19"),
+		Border:     gothememe.Hex("#a6e32d4c"),
 		Text:       gothememe.Hex("#a6e32d"),
 	}
 }
 
 func (t *themeDetuned) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e6da73").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e6da73").WithAlpha(0.3),
+		Background: gothememe.Hex("#e6da7319"),
+		Border:     gothememe.Hex("#e6da734c"),
 		Text:       gothememe.Hex("#e6da73"),
 	}
 }
 
 func (t *themeDetuned) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fe4386").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fe4386").WithAlpha(0.3),
+		Background: gothememe.Hex("#fe438619"),
+		Border:     gothememe.Hex("#fe43864c"),
 		Text:       gothememe.Hex("#fe4386"),
 	}
 }
 
 func (t *themeDetuned) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#50b7d9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#50b7d9").WithAlpha(0.3),
+		Background: gothememe.Hex("#50b7d919"),
+		Border:     gothememe.Hex("#50b7d94c"),
 		Text:       gothememe.Hex("#50b7d9"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeDimidium) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeDimidium) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#60b442").WithAlpha(0.1),
-		Border:     gothememe.Hex("#60b442").WithAlpha(0.3),
+		Background: gothememe.Hex("#60b44219"),
+		Border:     gothememe.Hex("#60b4424c"),
 		Text:       gothememe.Hex("#60b442"),
 	}
 }
 
 func (t *themeDimidium) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#db9c11").WithAlpha(0.1),
-		Border:     gothememe.Hex("#db9c11").WithAlpha(0.3),
+		Background: gothememe.Hex("#db9c1119"),
+		Border:     gothememe.Hex("#db9c114c"),
 		Text:       gothememe.Hex("#db9c11"),
 	}
 }
 
 func (t *themeDimidium) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cf494c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cf494c").WithAlpha(0.3),
+		Background: gothememe.Hex("#cf494c19"),
+		Border:     gothememe.Hex("#cf494c4c"),
 		Text:       gothememe.Hex("#cf494c"),
 	}
 }
 
 func (t *themeDimidium) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1db6bb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1db6bb").WithAlpha(0.3),
+		Background: gothememe.Hex("#1db6bb19"),
+		Border:     gothememe.Hex("#1db6bb4c"),
 		Text:       gothememe.Hex("#1db6bb"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeDimmedMonokai) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeDimmedMonokai) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#879a3b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#879a3b").WithAlpha(0.3),
+		Background: gothememe.Hex("#879a3b19"),
+		Border:     gothememe.Hex("#879a3b4c"),
 		Text:       gothememe.Hex("#879a3b"),
 	}
 }
 
 func (t *themeDimmedMonokai) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c5a635").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c5a635").WithAlpha(0.3),
+		Background: gothememe.Hex("#c5a63519"),
+		Border:     gothememe.Hex("#c5a6354c"),
 		Text:       gothememe.Hex("#c5a635"),
 	}
 }
 
 func (t *themeDimmedMonokai) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#be3f48").WithAlpha(0.1),
-		Border:     gothememe.Hex("#be3f48").WithAlpha(0.3),
+		Background: gothememe.Hex("#be3f4819"),
+		Border:     gothememe.Hex("#be3f484c"),
 		Text:       gothememe.Hex("#be3f48"),
 	}
 }
 
 func (t *themeDimmedMonokai) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#578fa4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#578fa4").WithAlpha(0.3),
+		Background: gothememe.Hex("#578fa419"),
+		Border:     gothememe.Hex("#578fa44c"),
 		Text:       gothememe.Hex("#578fa4"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeDjango) BorderStrong() gothememe.Color { return gothememe.Hex("#ff
 // Semantic colors
 func (t *themeDjango) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#41a83e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#41a83e").WithAlpha(0.3),
+		Background: gothememe.Hex("#41a83e19"),
+		Border:     gothememe.Hex("#41a83e4c"),
 		Text:       gothememe.Hex("#41a83e"),
 	}
 }
 
 func (t *themeDjango) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffe862").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffe862").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffe86219"),
+		Border:     gothememe.Hex("#ffe8624c"),
 		Text:       gothememe.Hex("#ffe862"),
 	}
 }
 
 func (t *themeDjango) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fd6209").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fd6209").WithAlpha(0.3),
+		Background: gothememe.Hex("#fd620919"),
+		Border:     gothememe.Hex("#fd62094c"),
 		Text:       gothememe.Hex("#fd6209"),
 	}
 }
 
 func (t *themeDjango) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9df39f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9df39f").WithAlpha(0.3),
+		Background: gothememe.Hex("#9df39f19"),
+		Border:     gothememe.Hex("#9df39f4c"),
 		Text:       gothememe.Hex("#9df39f"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeDoomOne) BorderStrong() gothememe.Color { return gothememe.Hex("#b
 // Semantic colors
 func (t *themeDoomOne) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98be65").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98be65").WithAlpha(0.3),
+		Background: gothememe.Hex("#98be6519"),
+		Border:     gothememe.Hex("#98be654c"),
 		Text:       gothememe.Hex("#98be65"),
 	}
 }
 
 func (t *themeDoomOne) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ecbe7b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ecbe7b").WithAlpha(0.3),
+		Background: gothememe.Hex("#ecbe7b19"),
+		Border:     gothememe.Hex("#ecbe7b4c"),
 		Text:       gothememe.Hex("#ecbe7b"),
 	}
 }
 
 func (t *themeDoomOne) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff6c6b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff6c6b").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff6c6b19"),
+		Border:     gothememe.Hex("#ff6c6b4c"),
 		Text:       gothememe.Hex("#ff6c6b"),
 	}
 }
 
 func (t *themeDoomOne) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#51afef").WithAlpha(0.1),
-		Border:     gothememe.Hex("#51afef").WithAlpha(0.3),
+		Background: gothememe.Hex("#51afef19"),
+		Border:     gothememe.Hex("#51afef4c"),
 		Text:       gothememe.Hex("#51afef"),
 	}
 }
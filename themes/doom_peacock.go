@@ -45,32 +45,32 @@ func (t *themeDoomPeacock) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeDoomPeacock) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#26a6a6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#26a6a6").WithAlpha(0.3),
+		Background: gothememe.Hex("#26a6a619"),
+		Border:     gothememe.Hex("#26a6a64c"),
 		Text:       gothememe.Hex("#26a6a6"),
 	}
 }
 
 func (t *themeDoomPeacock) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bcd42a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bcd42a").WithAlpha(0.3),
+		Background: gothememe.Hex("#bcd42a19"),
+		Border:     gothememe.Hex("#bcd42a4c"),
 		Text:       gothememe.Hex("#bcd42a"),
 	}
 }
 
 func (t *themeDoomPeacock) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cb4b16").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cb4b16").WithAlpha(0.3),
+		Background: gothememe.Hex("#cb4b1619"),
+		Border:     gothememe.Hex("#cb4b164c"),
 		Text:       gothememe.Hex("#cb4b16"),
 	}
 }
 
 func (t *themeDoomPeacock) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5699af").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5699af").WithAlpha(0.3),
+		Background: gothememe.Hex("#5699af19"),
+		Border:     gothememe.Hex("#5699af4c"),
 		Text:       gothememe.Hex("#5699af"),
 	}
 }
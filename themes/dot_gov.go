@@ -43,32 +43,32 @@ func (t *themeDotGov) BorderStrong() gothememe.Color { return gothememe.Hex("#ff
 // Semantic colors
 func (t *themeDotGov) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3d9751").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3d9751").WithAlpha(0.3),
+		Background: gothememe.Hex("#3d975119"),
+		Border:     gothememe.Hex("#3d97514c"),
 		Text:       gothememe.Hex("#3d9751"),
 	}
 }
 
 func (t *themeDotGov) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f6bb34").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f6bb34").WithAlpha(0.3),
+		Background: gothememe.Hex("#f6bb3419"),
+		Border:     gothememe.Hex("#f6bb344c"),
 		Text:       gothememe.Hex("#f6bb34"),
 	}
 }
 
 func (t *themeDotGov) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bf091d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bf091d").WithAlpha(0.3),
+		Background: gothememe.Hex("#bf091d19"),
+		Border:     gothememe.Hex("#bf091d4c"),
 		Text:       gothememe.Hex("#bf091d"),
 	}
 }
 
 func (t *themeDotGov) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8bd2ed").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8bd2ed").WithAlpha(0.3),
+		Background: gothememe.Hex("#8bd2ed19"),
+		Border:     gothememe.Hex("#8bd2ed4c"),
 		Text:       gothememe.Hex("#8bd2ed"),
 	}
 }
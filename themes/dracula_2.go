@@ -43,32 +43,32 @@ func (t *themeDracula2) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeDracula2) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#50fa7b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#50fa7b").WithAlpha(0.3),
+		Background: gothememe.Hex("#50fa7b19"),
+		Border:     gothememe.Hex("#50fa7b4c"),
 		Text:       gothememe.Hex("#50fa7b"),
 	}
 }
 
 func (t *themeDracula2) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f1fa8c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f1fa8c").WithAlpha(0.3),
+		Background: gothememe.Hex("#f1fa8c19"),
+		Border:     gothememe.Hex("#f1fa8c4c"),
 		Text:       gothememe.Hex("#f1fa8c"),
 	}
 }
 
 func (t *themeDracula2) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff5555").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff5555").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff555519"),
+		Border:     gothememe.Hex("#ff55554c"),
 		Text:       gothememe.Hex("#ff5555"),
 	}
 }
 
 func (t *themeDracula2) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8be9fd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8be9fd").WithAlpha(0.3),
+		Background: gothememe.Hex("#8be9fd19"),
+		Border:     gothememe.Hex("#8be9fd4c"),
 		Text:       gothememe.Hex("#8be9fd"),
 	}
 }
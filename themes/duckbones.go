@@ -43,32 +43,32 @@ func (t *themeDuckbones) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeDuckbones) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5dcd97").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5dcd97").WithAlpha(0.3),
+		Background: gothememe.Hex("#5dcd9719"),
+		Border:     gothememe.Hex("#5dcd974c"),
 		Text:       gothememe.Hex("#5dcd97"),
 	}
 }
 
 func (t *themeDuckbones) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e39500").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e39500").WithAlpha(0.3),
+		Background: gothememe.Hex("#e3950019"),
+		Border:     gothememe.Hex("#e395004c"),
 		Text:       gothememe.Hex("#e39500"),
 	}
 }
 
 func (t *themeDuckbones) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e03600").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e03600").WithAlpha(0.3),
+		Background: gothememe.Hex("#e0360019"),
+		Border:     gothememe.Hex("#e036004c"),
 		Text:       gothememe.Hex("#e03600"),
 	}
 }
 
 func (t *themeDuckbones) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00a3cb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00a3cb").WithAlpha(0.3),
+		Background: gothememe.Hex("#00a3cb19"),
+		Border:     gothememe.Hex("#00a3cb4c"),
 		Text:       gothememe.Hex("#00a3cb"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeDuotoneDark) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeDuotoneDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2dcd73").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2dcd73").WithAlpha(0.3),
+		Background: gothememe.Hex("#2dcd7319"),
+		Border:     gothememe.Hex("#2dcd734c"),
 		Text:       gothememe.Hex("#2dcd73"),
 	}
 }
 
 func (t *themeDuotoneDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d9b76e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d9b76e").WithAlpha(0.3),
+		Background: gothememe.Hex("#d9b76e19"),
+		Border:     gothememe.Hex("#d9b76e4c"),
 		Text:       gothememe.Hex("#d9b76e"),
 	}
 }
 
 func (t *themeDuotoneDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d9393e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d9393e").WithAlpha(0.3),
+		Background: gothememe.Hex("#d9393e19"),
+		Border:     gothememe.Hex("#d9393e4c"),
 		Text:       gothememe.Hex("#d9393e"),
 	}
 }
 
 func (t *themeDuotoneDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2488ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2488ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#2488ff19"),
+		Border:     gothememe.Hex("#2488ff4c"),
 		Text:       gothememe.Hex("#2488ff"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeDuskfox) BorderStrong() gothememe.Color { return gothememe.Hex("#e
 // Semantic colors
 func (t *themeDuskfox) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a3be8c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a3be8c").WithAlpha(0.3),
+		Background: gothememe.Hex("#a3be8c19"),
+		Border:     gothememe.Hex("#a3be8c4c"),
 		Text:       gothememe.Hex("#a3be8c"),
 	}
 }
 
 func (t *themeDuskfox) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f6c177").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f6c177").WithAlpha(0.3),
+		Background: gothememe.Hex("#f6c17719"),
+		Border:     gothememe.Hex("#f6c1774c"),
 		Text:       gothememe.Hex("#f6c177"),
 	}
 }
 
 func (t *themeDuskfox) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#eb6f92").WithAlpha(0.1),
-		Border:     gothememe.Hex("#eb6f92").WithAlpha(0.3),
+		Background: gothememe.Hex("#eb6f9219"),
+		Border:     gothememe.Hex("#eb6f924c"),
 		Text:       gothememe.Hex("#eb6f92"),
 	}
 }
 
 func (t *themeDuskfox) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9ccfd8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9ccfd8").WithAlpha(0.3),
+		Background: gothememe.Hex("#9ccfd819"),
+		Border:     gothememe.Hex("#9ccfd84c"),
 		Text:       gothememe.Hex("#9ccfd8"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeEarthsong) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeEarthsong) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#85c54c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#85c54c").WithAlpha(0.3),
+		Background: gothememe.Hex("#85c54c19"),
+		Border:     gothememe.Hex("#85c54c4c"),
 		Text:       gothememe.Hex("#85c54c"),
 	}
 }
 
 func (t *themeEarthsong) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f5ae2e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f5ae2e").WithAlpha(0.3),
+		Background: gothememe.Hex("#f5ae2e19"),
+		Border:     gothememe.Hex("#f5ae2e4c"),
 		Text:       gothememe.Hex("#f5ae2e"),
 	}
 }
 
 func (t *themeEarthsong) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c94234").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c94234").WithAlpha(0.3),
+		Background: gothememe.Hex("#c9423419"),
+		Border:     gothememe.Hex("#c942344c"),
 		Text:       gothememe.Hex("#c94234"),
 	}
 }
 
 func (t *themeEarthsong) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#509552").WithAlpha(0.1),
-		Border:     gothememe.Hex("#509552").WithAlpha(0.3),
+		Background: gothememe.Hex("#50955219"),
+		Border:     gothememe.Hex("#5095524c"),
 		Text:       gothememe.Hex("#509552"),
 	}
 }
@@ -49,32 +49,32 @@ func (t *themeElectronHighlighter) BorderStrong() gothememe.Color { return gothe
 // Semantic colors
 func (t *themeElectronHighlighter) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00ffc3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00ffc3").WithAlpha(0.3),
+		Background: gothememe.Hex("#00ffc319"),
+		Border:     gothememe.Hex("#00ffc34c"),
 		Text:       gothememe.Hex("#00ffc3"),
 	}
 }
 
 func (t *themeElectronHighlighter) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffd7a9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffd7a9").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffd7a919"),
+		Border:     gothememe.Hex("#ffd7a94c"),
 		Text:       gothememe.Hex("#ffd7a9"),
 	}
 }
 
 func (t *themeElectronHighlighter) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff6c8d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff6c8d").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff6c8d19"),
+		Border:     gothememe.Hex("#ff6c8d4c"),
 		Text:       gothememe.Hex("#ff6c8d"),
 	}
 }
 
 func (t *themeElectronHighlighter) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00fdff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00fdff").WithAlpha(0.3),
+		Background: gothememe.Hex("#00fdff19"),
+		Border:     gothememe.Hex("#00fdff4c"),
 		Text:       gothememe.Hex("#00fdff"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeElegant) BorderStrong() gothememe.Color { return gothememe.Hex("#f
 // Semantic colors
 func (t *themeElegant) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#85cc95").WithAlpha(0.1),
-		Border:     gothememe.Hex("#85cc95").WithAlpha(0.3),
+		Background: gothememe.Hex("#85cc9519"),
+		Border:     gothememe.Hex("#85cc954c"),
 		Text:       gothememe.Hex("#85cc95"),
 	}
 }
 
 func (t *themeElegant) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffcb8b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffcb8b").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffcb8b19"),
+		Border:     gothememe.Hex("#ffcb8b4c"),
 		Text:       gothememe.Hex("#ffcb8b"),
 	}
 }
 
 func (t *themeElegant) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff0257").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff0257").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff025719"),
+		Border:     gothememe.Hex("#ff02574c"),
 		Text:       gothememe.Hex("#ff0257"),
 	}
 }
 
 func (t *themeElegant) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#78ccf0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#78ccf0").WithAlpha(0.3),
+		Background: gothememe.Hex("#78ccf019"),
+		Border:     gothememe.Hex("#78ccf04c"),
 		Text:       gothememe.Hex("#78ccf0"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeElemental) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeElemental) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#479a43").WithAlpha(0.1),
-		Border:     gothememe.Hex("#479a43").WithAlpha(0.3),
+		Background: gothememe.Hex("#479a4319"),
+		Border:     gothememe.Hex("#479a434c"),
 		Text:       gothememe.Hex("#479a43"),
 	}
 }
 
 func (t *themeElemental) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7f7111").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7f7111").WithAlpha(0.3),
+		Background: gothememe.Hex("#7f711119"),
+		Border:     gothememe.Hex("#7f71114c"),
 		Text:       gothememe.Hex("#7f7111"),
 	}
 }
 
 func (t *themeElemental) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98290f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98290f").WithAlpha(0.3),
+		Background: gothememe.Hex("#98290f19"),
+		Border:     gothememe.Hex("#98290f4c"),
 		Text:       gothememe.Hex("#98290f"),
 	}
 }
 
 func (t *themeElemental) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#387f58").WithAlpha(0.1),
-		Border:     gothememe.Hex("#387f58").WithAlpha(0.3),
+		Background: gothememe.Hex("#387f5819"),
+		Border:     gothememe.Hex("#387f584c"),
 		Text:       gothememe.Hex("#387f58"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeElementary) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeElementary) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5aa513").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5aa513").WithAlpha(0.3),
+		Background: gothememe.Hex("#5aa51319"),
+		Border:     gothememe.Hex("#5aa5134c"),
 		Text:       gothememe.Hex("#5aa513"),
 	}
 }
 
 func (t *themeElementary) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fdb40c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fdb40c").WithAlpha(0.3),
+		Background: gothememe.Hex("#fdb40c19"),
+		Border:     gothememe.Hex("#fdb40c4c"),
 		Text:       gothememe.Hex("#fdb40c"),
 	}
 }
 
 func (t *themeElementary) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d71c15").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d71c15").WithAlpha(0.3),
+		Background: gothememe.Hex("#d71c1519"),
+		Border:     gothememe.Hex("#d71c154c"),
 		Text:       gothememe.Hex("#d71c15"),
 	}
 }
 
 func (t *themeElementary) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2595e1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2595e1").WithAlpha(0.3),
+		Background: gothememe.Hex("#2595e119"),
+		Border:     gothememe.Hex("#2595e14c"),
 		Text:       gothememe.Hex("#2595e1"),
 	}
 }
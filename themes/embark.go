@@ -43,32 +43,32 @@ func (t *themeEmbark) BorderStrong() gothememe.Color { return gothememe.Hex("#f8
 // Semantic colors
 func (t *themeEmbark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a1efd3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a1efd3").WithAlpha(0.3),
+		Background: gothememe.Hex("#a1efd319"),
+		Border:     gothememe.Hex("#a1efd34c"),
 		Text:       gothememe.Hex("#a1efd3"),
 	}
 }
 
 func (t *themeEmbark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffe9aa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffe9aa").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffe9aa19"),
+		Border:     gothememe.Hex("#ffe9aa4c"),
 		Text:       gothememe.Hex("#ffe9aa"),
 	}
 }
 
 func (t *themeEmbark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f0719b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f0719b").WithAlpha(0.3),
+		Background: gothememe.Hex("#f0719b19"),
+		Border:     gothememe.Hex("#f0719b4c"),
 		Text:       gothememe.Hex("#f0719b"),
 	}
 }
 
 func (t *themeEmbark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#87dfeb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#87dfeb").WithAlpha(0.3),
+		Background: gothememe.Hex("#87dfeb19"),
+		Border:     gothememe.Hex("#87dfeb4c"),
 		Text:       gothememe.Hex("#87dfeb"),
 	}
 }
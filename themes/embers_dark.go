@@ -43,32 +43,32 @@ func (t *themeEmbersDark) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeEmbersDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#57826d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#57826d").WithAlpha(0.3),
+		Background: gothememe.Hex("#57826d19"),
+		Border:     gothememe.Hex("#57826d4c"),
 		Text:       gothememe.Hex("#57826d"),
 	}
 }
 
 func (t *themeEmbersDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6d8257").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6d8257").WithAlpha(0.3),
+		Background: gothememe.Hex("#6d825719"),
+		Border:     gothememe.Hex("#6d82574c"),
 		Text:       gothememe.Hex("#6d8257"),
 	}
 }
 
 func (t *themeEmbersDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#826d57").WithAlpha(0.1),
-		Border:     gothememe.Hex("#826d57").WithAlpha(0.3),
+		Background: gothememe.Hex("#826d5719"),
+		Border:     gothememe.Hex("#826d574c"),
 		Text:       gothememe.Hex("#826d57"),
 	}
 }
 
 func (t *themeEmbersDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#576d82").WithAlpha(0.1),
-		Border:     gothememe.Hex("#576d82").WithAlpha(0.3),
+		Background: gothememe.Hex("#576d8219"),
+		Border:     gothememe.Hex("#576d824c"),
 		Text:       gothememe.Hex("#576d82"),
 	}
 }
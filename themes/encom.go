@@ -43,32 +43,32 @@ func (t *themeEncom) BorderStrong() gothememe.Color { return gothememe.Hex("#bbb
 // Semantic colors
 func (t *themeEncom) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#008b00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#008b00").WithAlpha(0.3),
+		Background: gothememe.Hex("#008b0019"),
+		Border:     gothememe.Hex("#008b004c"),
 		Text:       gothememe.Hex("#008b00"),
 	}
 }
 
 func (t *themeEncom) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffd000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffd000").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffd00019"),
+		Border:     gothememe.Hex("#ffd0004c"),
 		Text:       gothememe.Hex("#ffd000"),
 	}
 }
 
 func (t *themeEncom) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9f0000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9f0000").WithAlpha(0.3),
+		Background: gothememe.Hex("#9f000019"),
+		Border:     gothememe.Hex("#9f00004c"),
 		Text:       gothememe.Hex("#9f0000"),
 	}
 }
 
 func (t *themeEncom) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#008b8b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#008b8b").WithAlpha(0.3),
+		Background: gothememe.Hex("#008b8b19"),
+		Border:     gothememe.Hex("#008b8b4c"),
 		Text:       gothememe.Hex("#008b8b"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeEspresso) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeEspresso) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a5c261").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a5c261").WithAlpha(0.3),
+		Background: gothememe.Hex("#a5c26119"),
+		Border:     gothememe.Hex("#a5c2614c"),
 		Text:       gothememe.Hex("#a5c261"),
 	}
 }
 
 func (t *themeEspresso) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc66d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc66d").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc66d19"),
+		Border:     gothememe.Hex("#ffc66d4c"),
 		Text:       gothememe.Hex("#ffc66d"),
 	}
 }
 
 func (t *themeEspresso) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d25252").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d25252").WithAlpha(0.3),
+		Background: gothememe.Hex("#d2525219"),
+		Border:     gothememe.Hex("#d252524c"),
 		Text:       gothememe.Hex("#d25252"),
 	}
 }
 
 func (t *themeEspresso) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bed6ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bed6ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#bed6ff19"),
+		Border:     gothememe.Hex("#bed6ff4c"),
 		Text:       gothememe.Hex("#bed6ff"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeEspressoLibre) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeEspressoLibre) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1a921c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1a921c").WithAlpha(0.3),
+		Background: gothememe.Hex("#1a921c19"),
+		Border:     gothememe.Hex("#1a921c4c"),
 		Text:       gothememe.Hex("#1a921c"),
 	}
 }
 
 func (t *themeEspressoLibre) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f0e53a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f0e53a").WithAlpha(0.3),
+		Background: gothememe.Hex("#f0e53a19"),
+		Border:     gothememe.Hex("#f0e53a4c"),
 		Text:       gothememe.Hex("#f0e53a"),
 	}
 }
 
 func (t *themeEspressoLibre) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc0000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc0000").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc000019"),
+		Border:     gothememe.Hex("#cc00004c"),
 		Text:       gothememe.Hex("#cc0000"),
 	}
 }
 
 func (t *themeEspressoLibre) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#06989a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#06989a").WithAlpha(0.3),
+		Background: gothememe.Hex("#06989a19"),
+		Border:     gothememe.Hex("#06989a4c"),
 		Text:       gothememe.Hex("#06989a"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeEverblush) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeEverblush) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8ccf7e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8ccf7e").WithAlpha(0.3),
+		Background: gothememe.Hex("#8ccf7e19"),
+		Border:     gothememe.Hex("#8ccf7e4c"),
 		Text:       gothememe.Hex("#8ccf7e"),
 	}
 }
 
 func (t *themeEverblush) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e5c76b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e5c76b").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5c76b19"),
+		Border:     gothememe.Hex("#e5c76b4c"),
 		Text:       gothememe.Hex("#e5c76b"),
 	}
 }
 
 func (t *themeEverblush) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e57474").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e57474").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5747419"),
+		Border:     gothememe.Hex("#e574744c"),
 		Text:       gothememe.Hex("#e57474"),
 	}
 }
 
 func (t *themeEverblush) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6cbfbf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6cbfbf").WithAlpha(0.3),
+		Background: gothememe.Hex("#6cbfbf19"),
+		Border:     gothememe.Hex("#6cbfbf4c"),
 		Text:       gothememe.Hex("#6cbfbf"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeEverforestDarkHard) BorderStrong() gothememe.Color { return gothem
 // Semantic colors
 func (t *themeEverforestDarkHard) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a7c080").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a7c080").WithAlpha(0.3),
+		Background: gothememe.Hex("#a7c08019"),
+		Border:     gothememe.Hex("#a7c0804c"),
 		Text:       gothememe.Hex("#a7c080"),
 	}
 }
 
 func (t *themeEverforestDarkHard) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dbbc7f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dbbc7f").WithAlpha(0.3),
+		Background: gothememe.Hex("#dbbc7f19"),
+		Border:     gothememe.Hex("#dbbc7f4c"),
 		Text:       gothememe.Hex("#dbbc7f"),
 	}
 }
 
 func (t *themeEverforestDarkHard) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e67e80").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e67e80").WithAlpha(0.3),
+		Background: gothememe.Hex("#e67e8019"),
+		Border:     gothememe.Hex("#e67e804c"),
 		Text:       gothememe.Hex("#e67e80"),
 	}
 }
 
 func (t *themeEverforestDarkHard) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#83c092").WithAlpha(0.1),
-		Border:     gothememe.Hex("#83c092").WithAlpha(0.3),
+		Background: gothememe.Hex("#83c09219"),
+		Border:     gothememe.Hex("#83c0924c"),
 		Text:       gothememe.Hex("#83c092"),
 	}
 }
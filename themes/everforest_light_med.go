@@ -47,32 +47,32 @@ func (t *themeEverforestLightMed) BorderStrong() gothememe.Color { return gothem
 // Semantic colors
 func (t *themeEverforestLightMed) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9ab373").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9ab373").WithAlpha(0.3),
+		Background: gothememe.Hex("#9ab37319"),
+		Border:     gothememe.Hex("#9ab3734c"),
 		Text:       gothememe.Hex("#9ab373"),
 	}
 }
 
 func (t *themeEverforestLightMed) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c1a266").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c1a266").WithAlpha(0.3),
+		Background: gothememe.Hex("#c1a26619"),
+		Border:     gothememe.Hex("#c1a2664c"),
 		Text:       gothememe.Hex("#c1a266"),
 	}
 }
 
 func (t *themeEverforestLightMed) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e67e80").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e67e80").WithAlpha(0.3),
+		Background: gothememe.Hex("#e67e8019"),
+		Border:     gothememe.Hex("#e67e804c"),
 		Text:       gothememe.Hex("#e67e80"),
 	}
 }
 
 func (t *themeEverforestLightMed) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#83c092").WithAlpha(0.1),
-		Border:     gothememe.Hex("#83c092").WithAlpha(0.3),
+		Background: gothememe.Hex("#83c09219"),
+		Border:     gothememe.Hex("#83c0924c"),
 		Text:       gothememe.Hex("#83c092"),
 	}
 }
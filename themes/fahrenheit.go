@@ -43,32 +43,32 @@ func (t *themeFahrenheit) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeFahrenheit) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9e744d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9e744d").WithAlpha(0.3),
+		Background: gothememe.Hex("#9e744d19"),
+		Border:     gothememe.Hex("#9e744d4c"),
 		Text:       gothememe.Hex("#9e744d"),
 	}
 }
 
 func (t *themeFahrenheit) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fecf75").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fecf75").WithAlpha(0.3),
+		Background: gothememe.Hex("#fecf7519"),
+		Border:     gothememe.Hex("#fecf754c"),
 		Text:       gothememe.Hex("#fecf75"),
 	}
 }
 
 func (t *themeFahrenheit) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cda074").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cda074").WithAlpha(0.3),
+		Background: gothememe.Hex("#cda07419"),
+		Border:     gothememe.Hex("#cda0744c"),
 		Text:       gothememe.Hex("#cda074"),
 	}
 }
 
 func (t *themeFahrenheit) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#979797").WithAlpha(0.1),
-		Border:     gothememe.Hex("#979797").WithAlpha(0.3),
+		Background: gothememe.Hex("#97979719"),
+		Border:     gothememe.Hex("#9797974c"),
 		Text:       gothememe.Hex("#979797"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeFairyfloss) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeFairyfloss) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c2ffdf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c2ffdf").WithAlpha(0.3),
+		Background: gothememe.Hex("#c2ffdf19"),
+		Border:     gothememe.Hex("#c2ffdf4c"),
 		Text:       gothememe.Hex("#c2ffdf"),
 	}
 }
 
 func (t *themeFairyfloss) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e6c000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e6c000").WithAlpha(0.3),
+		Background: gothememe.Hex("#e6c00019"),
+		Border:     gothememe.Hex("#e6c0004c"),
 		Text:       gothememe.Hex("#e6c000"),
 	}
 }
 
 func (t *themeFairyfloss) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f92672").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f92672").WithAlpha(0.3),
+		Background: gothememe.Hex("#f9267219"),
+		Border:     gothememe.Hex("#f926724c"),
 		Text:       gothememe.Hex("#f92672"),
 	}
 }
 
 func (t *themeFairyfloss) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c5a3ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c5a3ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#c5a3ff19"),
+		Border:     gothememe.Hex("#c5a3ff4c"),
 		Text:       gothememe.Hex("#c5a3ff"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeFarmhouseDark) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeFarmhouseDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#549d00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#549d00").WithAlpha(0.3),
+		Background: gothememe.Hex("#549d0019"),
+		Border:     gothememe.Hex("#549d004c"),
 		Text:       gothememe.Hex("#549d00"),
 	}
 }
 
 func (t *themeFarmhouseDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c87300").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c87300").WithAlpha(0.3),
+		Background: gothememe.Hex("#c8730019"),
+		Border:     gothememe.Hex("#c873004c"),
 		Text:       gothememe.Hex("#c87300"),
 	}
 }
 
 func (t *themeFarmhouseDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ba0004").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ba0004").WithAlpha(0.3),
+		Background: gothememe.Hex("#ba000419"),
+		Border:     gothememe.Hex("#ba00044c"),
 		Text:       gothememe.Hex("#ba0004"),
 	}
 }
 
 func (t *themeFarmhouseDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1fb65c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1fb65c").WithAlpha(0.3),
+		Background: gothememe.Hex("#1fb65c19"),
+		Border:     gothememe.Hex("#1fb65c4c"),
 		Text:       gothememe.Hex("#1fb65c"),
 	}
 }
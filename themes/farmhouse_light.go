@@ -45,32 +45,32 @@ func (t *themeFarmhouseLight) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeFarmhouseLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3a7d00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3a7d00").WithAlpha(0.3),
+		Background: gothememe.Hex("#3a7d0019"),
+		Border:     gothememe.Hex("#3a7d004c"),
 		Text:       gothememe.Hex("#3a7d00"),
 	}
 }
 
 func (t *themeFarmhouseLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a95600").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a95600").WithAlpha(0.3),
+		Background: gothememe.Hex("#a9560019"),
+		Border:     gothememe.Hex("#a956004c"),
 		Text:       gothememe.Hex("#a95600"),
 	}
 }
 
 func (t *themeFarmhouseLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8d0003").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8d0003").WithAlpha(0.3),
+		Background: gothememe.Hex("#8d000319"),
+		Border:     gothememe.Hex("#8d00034c"),
 		Text:       gothememe.Hex("#8d0003"),
 	}
 }
 
 func (t *themeFarmhouseLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#229256").WithAlpha(0.1),
-		Border:     gothememe.Hex("#229256").WithAlpha(0.3),
+		Background: gothememe.Hex("#22925619"),
+		Border:     gothememe.Hex("#2292564c"),
 		Text:       gothememe.Hex("#229256"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeFideloper) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeFideloper) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#edb8ac").WithAlpha(0.1),
-		Border:     gothememe.Hex("#edb8ac").WithAlpha(0.3),
+		Background: gothememe.Hex("#edb8ac19"),
+		Border:     gothememe.Hex("#edb8ac4c"),
 		Text:       gothememe.Hex("#edb8ac"),
 	}
 }
 
 func (t *themeFideloper) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b7ab9b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b7ab9b").WithAlpha(0.3),
+		Background: gothememe.Hex("#b7ab9b19"),
+		Border:     gothememe.Hex("#b7ab9b4c"),
 		Text:       gothememe.Hex("#b7ab9b"),
 	}
 }
 
 func (t *themeFideloper) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cb1e2d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cb1e2d").WithAlpha(0.3),
+		Background: gothememe.Hex("#cb1e2d19"),
+		Border:     gothememe.Hex("#cb1e2d4c"),
 		Text:       gothememe.Hex("#cb1e2d"),
 	}
 }
 
 func (t *themeFideloper) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#309186").WithAlpha(0.1),
-		Border:     gothememe.Hex("#309186").WithAlpha(0.3),
+		Background: gothememe.Hex("#30918619"),
+		Border:     gothememe.Hex("#3091864c"),
 		Text:       gothememe.Hex("#309186"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeFireflyTraditional) BorderStrong() gothememe.Color { return gothem
 // Semantic colors
 func (t *themeFireflyTraditional) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#33bc26").WithAlpha(0.1),
-		Border:     gothememe.Hex("#33bc26").WithAlpha(0.3),
+		Background: gothememe.Hex("#33bc2619"),
+		Border:     gothememe.Hex("#33bc264c"),
 		Text:       gothememe.Hex("#33bc26"),
 	}
 }
 
 func (t *themeFireflyTraditional) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#afad24").WithAlpha(0.1),
-		Border:     gothememe.Hex("#afad24").WithAlpha(0.3),
+		Background: gothememe.Hex("#afad2419"),
+		Border:     gothememe.Hex("#afad244c"),
 		Text:       gothememe.Hex("#afad24"),
 	}
 }
 
 func (t *themeFireflyTraditional) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c23720").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c23720").WithAlpha(0.3),
+		Background: gothememe.Hex("#c2372019"),
+		Border:     gothememe.Hex("#c237204c"),
 		Text:       gothememe.Hex("#c23720"),
 	}
 }
 
 func (t *themeFireflyTraditional) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#33bbc7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#33bbc7").WithAlpha(0.3),
+		Background: gothememe.Hex("#33bbc719"),
+		Border:     gothememe.Hex("#33bbc74c"),
 		Text:       gothememe.Hex("#33bbc7"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeFirefoxDev) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeFirefoxDev) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5eb83c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5eb83c").WithAlpha(0.3),
+		Background: gothememe.Hex("#5eb83c19"),
+		Border:     gothememe.Hex("#5eb83c4c"),
 		Text:       gothememe.Hex("#5eb83c"),
 	}
 }
 
 func (t *themeFirefoxDev) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a57706").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a57706").WithAlpha(0.3),
+		Background: gothememe.Hex("#a5770619"),
+		Border:     gothememe.Hex("#a577064c"),
 		Text:       gothememe.Hex("#a57706"),
 	}
 }
 
 func (t *themeFirefoxDev) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e63853").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e63853").WithAlpha(0.3),
+		Background: gothememe.Hex("#e6385319"),
+		Border:     gothememe.Hex("#e638534c"),
 		Text:       gothememe.Hex("#e63853"),
 	}
 }
 
 func (t *themeFirefoxDev) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4b73a2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4b73a2").WithAlpha(0.3),
+		Background: gothememe.Hex("#4b73a219"),
+		Border:     gothememe.Hex("#4b73a24c"),
 		Text:       gothememe.Hex("#4b73a2"),
 	}
 }
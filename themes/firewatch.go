@@ -43,32 +43,32 @@ func (t *themeFirewatch) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeFirewatch) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5ab977").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5ab977").WithAlpha(0.3),
+		Background: gothememe.Hex("#5ab97719"),
+		Border:     gothememe.Hex("#5ab9774c"),
 		Text:       gothememe.Hex("#5ab977"),
 	}
 }
 
 func (t *themeFirewatch) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dfb563").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dfb563").WithAlpha(0.3),
+		Background: gothememe.Hex("#dfb56319"),
+		Border:     gothememe.Hex("#dfb5634c"),
 		Text:       gothememe.Hex("#dfb563"),
 	}
 }
 
 func (t *themeFirewatch) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d95360").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d95360").WithAlpha(0.3),
+		Background: gothememe.Hex("#d9536019"),
+		Border:     gothememe.Hex("#d953604c"),
 		Text:       gothememe.Hex("#d95360"),
 	}
 }
 
 func (t *themeFirewatch) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#44a8b6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#44a8b6").WithAlpha(0.3),
+		Background: gothememe.Hex("#44a8b619"),
+		Border:     gothememe.Hex("#44a8b64c"),
 		Text:       gothememe.Hex("#44a8b6"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeFishTank) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeFishTank) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#acf157").WithAlpha(0.1),
-		Border:     gothememe.Hex("#acf157").WithAlpha(0.3),
+		Background: gothememe.Hex("#acf15719"),
+		Border:     gothememe.Hex("#acf1574c"),
 		Text:       gothememe.Hex("#acf157"),
 	}
 }
 
 func (t *themeFishTank) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fecd5e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fecd5e").WithAlpha(0.3),
+		Background: gothememe.Hex("#fecd5e19"),
+		Border:     gothememe.Hex("#fecd5e4c"),
 		Text:       gothememe.Hex("#fecd5e"),
 	}
 }
 
 func (t *themeFishTank) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c6004a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c6004a").WithAlpha(0.3),
+		Background: gothememe.Hex("#c6004a19"),
+		Border:     gothememe.Hex("#c6004a4c"),
 		Text:       gothememe.Hex("#c6004a"),
 	}
 }
 
 func (t *themeFishTank) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#968763").WithAlpha(0.1),
-		Border:     gothememe.Hex("#968763").WithAlpha(0.3),
+		Background: gothememe.Hex("#96876319"),
+		Border:     gothememe.Hex("#9687634c"),
 		Text:       gothememe.Hex("#968763"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeFlat) BorderStrong() gothememe.Color { return gothememe.Hex("#b0b6
 // Semantic colors
 func (t *themeFlat) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#32a548").WithAlpha(0.1),
-		Border:     gothememe.Hex("#32a548").WithAlpha(0.3),
+		Background: gothememe.Hex("#32a54819"),
+		Border:     gothememe.Hex("#32a5484c"),
 		Text:       gothememe.Hex("#32a548"),
 	}
 }
 
 func (t *themeFlat) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e58d11").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e58d11").WithAlpha(0.3),
+		Background: gothememe.Hex("#e58d1119"),
+		Border:     gothememe.Hex("#e58d114c"),
 		Text:       gothememe.Hex("#e58d11"),
 	}
 }
 
 func (t *themeFlat) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a82320").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a82320").WithAlpha(0.3),
+		Background: gothememe.Hex("#a8232019"),
+		Border:     gothememe.Hex("#a823204c"),
 		Text:       gothememe.Hex("#a82320"),
 	}
 }
 
 func (t *themeFlat) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2c9370").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2c9370").WithAlpha(0.3),
+		Background: gothememe.Hex("#2c937019"),
+		Border:     gothememe.Hex("#2c93704c"),
 		Text:       gothememe.Hex("#2c9370"),
 	}
 }
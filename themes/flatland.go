@@ -43,32 +43,32 @@ func (t *themeFlatland) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeFlatland) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9fd364").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9fd364").WithAlpha(0.3),
+		Background: gothememe.Hex("#9fd36419"),
+		Border:     gothememe.Hex("#9fd3644c"),
 		Text:       gothememe.Hex("#9fd364"),
 	}
 }
 
 func (t *themeFlatland) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f4ef6d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f4ef6d").WithAlpha(0.3),
+		Background: gothememe.Hex("#f4ef6d19"),
+		Border:     gothememe.Hex("#f4ef6d4c"),
 		Text:       gothememe.Hex("#f4ef6d"),
 	}
 }
 
 func (t *themeFlatland) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f18339").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f18339").WithAlpha(0.3),
+		Background: gothememe.Hex("#f1833919"),
+		Border:     gothememe.Hex("#f183394c"),
 		Text:       gothememe.Hex("#f18339"),
 	}
 }
 
 func (t *themeFlatland) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d63865").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d63865").WithAlpha(0.3),
+		Background: gothememe.Hex("#d6386519"),
+		Border:     gothememe.Hex("#d638654c"),
 		Text:       gothememe.Hex("#d63865"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeFlexokiDark) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeFlexokiDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#879a39").WithAlpha(0.1),
-		Border:     gothememe.Hex("#879a39").WithAlpha(0.3),
+		Background: gothememe.Hex("#879a3919"),
+		Border:     gothememe.Hex("#879a394c"),
 		Text:       gothememe.Hex("#879a39"),
 	}
 }
 
 func (t *themeFlexokiDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d0a215").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d0a215").WithAlpha(0.3),
+		Background: gothememe.Hex("#d0a21519"),
+		Border:     gothememe.Hex("#d0a2154c"),
 		Text:       gothememe.Hex("#d0a215"),
 	}
 }
 
 func (t *themeFlexokiDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d14d41").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d14d41").WithAlpha(0.3),
+		Background: gothememe.Hex("#d14d4119"),
+		Border:     gothememe.Hex("#d14d414c"),
 		Text:       gothememe.Hex("#d14d41"),
 	}
 }
 
 func (t *themeFlexokiDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3aa99f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3aa99f").WithAlpha(0.3),
+		Background: gothememe.Hex("#3aa99f19"),
+		Border:     gothememe.Hex("#3aa99f4c"),
 		Text:       gothememe.Hex("#3aa99f"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeFlexokiLight) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeFlexokiLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#66800b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#66800b").WithAlpha(0.3),
+		Background: gothememe.Hex("#66800b19"),
+		Border:     gothememe.Hex("#66800b4c"),
 		Text:       gothememe.Hex("#66800b"),
 	}
 }
 
 func (t *themeFlexokiLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ad8301").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ad8301").WithAlpha(0.3),
+		Background: gothememe.Hex("#ad830119"),
+		Border:     gothememe.Hex("#ad83014c"),
 		Text:       gothememe.Hex("#ad8301"),
 	}
 }
 
 func (t *themeFlexokiLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#af3029").WithAlpha(0.1),
-		Border:     gothememe.Hex("#af3029").WithAlpha(0.3),
+		Background: gothememe.Hex("#af302919"),
+		Border:     gothememe.Hex("#af30294c"),
 		Text:       gothememe.Hex("#af3029"),
 	}
 }
 
 func (t *themeFlexokiLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#24837b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#24837b").WithAlpha(0.3),
+		Background: gothememe.Hex("#24837b19"),
+		Border:     gothememe.Hex("#24837b4c"),
 		Text:       gothememe.Hex("#24837b"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeFloraverse) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeFloraverse) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5d731a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5d731a").WithAlpha(0.3),
+		Background: gothememe.Hex("#5d731a19"),
+		Border:     gothememe.Hex("#5d731a4c"),
 		Text:       gothememe.Hex("#5d731a"),
 	}
 }
 
 func (t *themeFloraverse) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cd751c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cd751c").WithAlpha(0.3),
+		Background: gothememe.Hex("#cd751c19"),
+		Border:     gothememe.Hex("#cd751c4c"),
 		Text:       gothememe.Hex("#cd751c"),
 	}
 }
 
 func (t *themeFloraverse) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7e1a46").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7e1a46").WithAlpha(0.3),
+		Background: gothememe.Hex("#7e1a4619"),
+		Border:     gothememe.Hex("#7e1a464c"),
 		Text:       gothememe.Hex("#7e1a46"),
 	}
 }
 
 func (t *themeFloraverse) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#42a38c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#42a38c").WithAlpha(0.3),
+		Background: gothememe.Hex("#42a38c19"),
+		Border:     gothememe.Hex("#42a38c4c"),
 		Text:       gothememe.Hex("#42a38c"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeForestBlue) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeForestBlue) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#92d3a2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#92d3a2").WithAlpha(0.3),
+		Background: gothememe.Hex("#92d3a219"),
+		Border:     gothememe.Hex("#92d3a24c"),
 		Text:       gothememe.Hex("#92d3a2"),
 	}
 }
 
 func (t *themeForestBlue) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1a8e63").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1a8e63").WithAlpha(0.3),
+		Background: gothememe.Hex("#1a8e6319"),
+		Border:     gothememe.Hex("#1a8e634c"),
 		Text:       gothememe.Hex("#1a8e63"),
 	}
 }
 
 func (t *themeForestBlue) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f8818e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f8818e").WithAlpha(0.3),
+		Background: gothememe.Hex("#f8818e19"),
+		Border:     gothememe.Hex("#f8818e4c"),
 		Text:       gothememe.Hex("#f8818e"),
 	}
 }
 
 func (t *themeForestBlue) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#31658c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#31658c").WithAlpha(0.3),
+		Background: gothememe.Hex("#31658c19"),
+		Border:     gothememe.Hex("#31658c4c"),
 		Text:       gothememe.Hex("#31658c"),
 	}
 }
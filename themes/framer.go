@@ -43,32 +43,32 @@ func (t *themeFramer) BorderStrong() gothememe.Color { return gothememe.Hex("#cc
 // Semantic colors
 func (t *themeFramer) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98ec65").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98ec65").WithAlpha(0.3),
+		Background: gothememe.Hex("#98ec6519"),
+		Border:     gothememe.Hex("#98ec654c"),
 		Text:       gothememe.Hex("#98ec65"),
 	}
 }
 
 func (t *themeFramer) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffcc33").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffcc33").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffcc3319"),
+		Border:     gothememe.Hex("#ffcc334c"),
 		Text:       gothememe.Hex("#ffcc33"),
 	}
 }
 
 func (t *themeFramer) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff5555").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff5555").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff555519"),
+		Border:     gothememe.Hex("#ff55554c"),
 		Text:       gothememe.Hex("#ff5555"),
 	}
 }
 
 func (t *themeFramer) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#88ddff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#88ddff").WithAlpha(0.3),
+		Background: gothememe.Hex("#88ddff19"),
+		Border:     gothememe.Hex("#88ddff4c"),
 		Text:       gothememe.Hex("#88ddff"),
 	}
 }
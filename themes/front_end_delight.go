@@ -45,32 +45,32 @@ func (t *themeFrontEndDelight) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeFrontEndDelight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#565747").WithAlpha(0.1),
-		Border:     gothememe.Hex("#565747").WithAlpha(0.3),
+		Background: gothememe.Hex("#56574719"),
+		Border:     gothememe.Hex("#5657474c"),
 		Text:       gothememe.Hex("#565747"),
 	}
 }
 
 func (t *themeFrontEndDelight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fa771d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fa771d").WithAlpha(0.3),
+		Background: gothememe.Hex("#fa771d19"),
+		Border:     gothememe.Hex("#fa771d4c"),
 		Text:       gothememe.Hex("#fa771d"),
 	}
 }
 
 func (t *themeFrontEndDelight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f8511b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f8511b").WithAlpha(0.3),
+		Background: gothememe.Hex("#f8511b19"),
+		Border:     gothememe.Hex("#f8511b4c"),
 		Text:       gothememe.Hex("#f8511b"),
 	}
 }
 
 func (t *themeFrontEndDelight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3ca1a6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3ca1a6").WithAlpha(0.3),
+		Background: gothememe.Hex("#3ca1a619"),
+		Border:     gothememe.Hex("#3ca1a64c"),
 		Text:       gothememe.Hex("#3ca1a6"),
 	}
 }
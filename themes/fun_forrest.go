@@ -43,32 +43,32 @@ func (t *themeFunForrest) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeFunForrest) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#919c00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#919c00").WithAlpha(0.3),
+		Background: gothememe.Hex("#919c0019"),
+		Border:     gothememe.Hex("#919c004c"),
 		Text:       gothememe.Hex("#919c00"),
 	}
 }
 
 func (t *themeFunForrest) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#be8a13").WithAlpha(0.1),
-		Border:     gothememe.Hex("#be8a13").WithAlpha(0.3),
+		Background: gothememe.Hex("#be8a1319"),
+		Border:     gothememe.Hex("#be8a134c"),
 		Text:       gothememe.Hex("#be8a13"),
 	}
 }
 
 func (t *themeFunForrest) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d6262b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d6262b").WithAlpha(0.3),
+		Background: gothememe.Hex("#d6262b19"),
+		Border:     gothememe.Hex("#d6262b4c"),
 		Text:       gothememe.Hex("#d6262b"),
 	}
 }
 
 func (t *themeFunForrest) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#da8213").WithAlpha(0.1),
-		Border:     gothememe.Hex("#da8213").WithAlpha(0.3),
+		Background: gothememe.Hex("#da821319"),
+		Border:     gothememe.Hex("#da82134c"),
 		Text:       gothememe.Hex("#da8213"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeGalaxy) BorderStrong() gothememe.Color { return gothememe.Hex("#bb
 // Semantic colors
 func (t *themeGalaxy) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#21b089").WithAlpha(0.1),
-		Border:     gothememe.Hex("#21b089").WithAlpha(0.3),
+		Background: gothememe.Hex("#21b08919"),
+		Border:     gothememe.Hex("#21b0894c"),
 		Text:       gothememe.Hex("#21b089"),
 	}
 }
 
 func (t *themeGalaxy) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fef02a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fef02a").WithAlpha(0.3),
+		Background: gothememe.Hex("#fef02a19"),
+		Border:     gothememe.Hex("#fef02a4c"),
 		Text:       gothememe.Hex("#fef02a"),
 	}
 }
 
 func (t *themeGalaxy) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f9555f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f9555f").WithAlpha(0.3),
+		Background: gothememe.Hex("#f9555f19"),
+		Border:     gothememe.Hex("#f9555f4c"),
 		Text:       gothememe.Hex("#f9555f"),
 	}
 }
 
 func (t *themeGalaxy) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1f9ee7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1f9ee7").WithAlpha(0.3),
+		Background: gothememe.Hex("#1f9ee719"),
+		Border:     gothememe.Hex("#1f9ee74c"),
 		Text:       gothememe.Hex("#1f9ee7"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeGalizur) BorderStrong() gothememe.Color { return gothememe.Hex("#8
 // Semantic colors
 func (t *themeGalizur) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#33aa11").WithAlpha(0.1),
-		Border:     gothememe.Hex("#33aa11").WithAlpha(0.3),
+		Background: gothememe.Hex("#33aa1119"),
+		Border:     gothememe.Hex("#33aa114c"),
 		Text:       gothememe.Hex("#33aa11"),
 	}
 }
 
 func (t *themeGalizur) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ccaa22").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ccaa22").WithAlpha(0.3),
+		Background: gothememe.Hex("#ccaa2219"),
+		Border:     gothememe.Hex("#ccaa224c"),
 		Text:       gothememe.Hex("#ccaa22"),
 	}
 }
 
 func (t *themeGalizur) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aa1122").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aa1122").WithAlpha(0.3),
+		Background: gothememe.Hex("#aa112219"),
+		Border:     gothememe.Hex("#aa11224c"),
 		Text:       gothememe.Hex("#aa1122"),
 	}
 }
 
 func (t *themeGalizur) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#22bbdd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#22bbdd").WithAlpha(0.3),
+		Background: gothememe.Hex("#22bbdd19"),
+		Border:     gothememe.Hex("#22bbdd4c"),
 		Text:       gothememe.Hex("#22bbdd"),
 	}
 }
@@ -61,32 +61,32 @@ func (t *themeGhosttyDefaultStyleDark) BorderStrong() gothememe.Color {
 // Semantic colors
 func (t *themeGhosttyDefaultStyleDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b6bd68").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b6bd68").WithAlpha(0.3),
+		Background: gothememe.Hex("#b6bd6819"),
+		Border:     gothememe.Hex("#b6bd684c"),
 		Text:       gothememe.Hex("#b6bd68"),
 	}
 }
 
 func (t *themeGhosttyDefaultStyleDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f0c674").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f0c674").WithAlpha(0.3),
+		Background: gothememe.Hex("#f0c67419"),
+		Border:     gothememe.Hex("#f0c6744c"),
 		Text:       gothememe.Hex("#f0c674"),
 	}
 }
 
 func (t *themeGhosttyDefaultStyleDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc6566").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc6566").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc656619"),
+		Border:     gothememe.Hex("#cc65664c"),
 		Text:       gothememe.Hex("#cc6566"),
 	}
 }
 
 func (t *themeGhosttyDefaultStyleDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8abeb7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8abeb7").WithAlpha(0.3),
+		Background: gothememe.Hex("#8abeb719"),
+		Border:     gothememe.Hex("#8abeb74c"),
 		Text:       gothememe.Hex("#8abeb7"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeGithub) BorderStrong() gothememe.Color { return gothememe.Hex("#b2
 // Semantic colors
 func (t *themeGithub) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#07962a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#07962a").WithAlpha(0.3),
+		Background: gothememe.Hex("#07962a19"),
+		Border:     gothememe.Hex("#07962a4c"),
 		Text:       gothememe.Hex("#07962a"),
 	}
 }
 
 func (t *themeGithub) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c5bb94").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c5bb94").WithAlpha(0.3),
+		Background: gothememe.Hex("#c5bb9419"),
+		Border:     gothememe.Hex("#c5bb944c"),
 		Text:       gothememe.Hex("#c5bb94"),
 	}
 }
 
 func (t *themeGithub) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#970b16").WithAlpha(0.1),
-		Border:     gothememe.Hex("#970b16").WithAlpha(0.3),
+		Background: gothememe.Hex("#970b1619"),
+		Border:     gothememe.Hex("#970b164c"),
 		Text:       gothememe.Hex("#970b16"),
 	}
 }
 
 func (t *themeGithub) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7cc4df").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7cc4df").WithAlpha(0.3),
+		Background: gothememe.Hex("#7cc4df19"),
+		Border:     gothememe.Hex("#7cc4df4c"),
 		Text:       gothememe.Hex("#7cc4df"),
 	}
 }
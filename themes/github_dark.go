@@ -43,32 +43,32 @@ func (t *themeGithubDark) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeGithubDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#56d364").WithAlpha(0.1),
-		Border:     gothememe.Hex("#56d364").WithAlpha(0.3),
+		Background: gothememe.Hex("#56d36419"),
+		Border:     gothememe.Hex("#56d3644c"),
 		Text:       gothememe.Hex("#56d364"),
 	}
 }
 
 func (t *themeGithubDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e3b341").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e3b341").WithAlpha(0.3),
+		Background: gothememe.Hex("#e3b34119"),
+		Border:     gothememe.Hex("#e3b3414c"),
 		Text:       gothememe.Hex("#e3b341"),
 	}
 }
 
 func (t *themeGithubDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f78166").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f78166").WithAlpha(0.3),
+		Background: gothememe.Hex("#f7816619"),
+		Border:     gothememe.Hex("#f781664c"),
 		Text:       gothememe.Hex("#f78166"),
 	}
 }
 
 func (t *themeGithubDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2b7489").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2b7489").WithAlpha(0.3),
+		Background: gothememe.Hex("#2b748919"),
+		Border:     gothememe.Hex("#2b74894c"),
 		Text:       gothememe.Hex("#2b7489"),
 	}
 }
@@ -51,32 +51,32 @@ func (t *themeGithubDarkColorblind) BorderStrong() gothememe.Color { return goth
 // Semantic colors
 func (t *themeGithubDarkColorblind) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#58a6ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#58a6ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#58a6ff19"),
+		Border:     gothememe.Hex("#58a6ff4c"),
 		Text:       gothememe.Hex("#58a6ff"),
 	}
 }
 
 func (t *themeGithubDarkColorblind) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d29922").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d29922").WithAlpha(0.3),
+		Background: gothememe.Hex("#d2992219"),
+		Border:     gothememe.Hex("#d299224c"),
 		Text:       gothememe.Hex("#d29922"),
 	}
 }
 
 func (t *themeGithubDarkColorblind) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ec8e2c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ec8e2c").WithAlpha(0.3),
+		Background: gothememe.Hex("#ec8e2c19"),
+		Border:     gothememe.Hex("#ec8e2c4c"),
 		Text:       gothememe.Hex("#ec8e2c"),
 	}
 }
 
 func (t *themeGithubDarkColorblind) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#39c5cf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#39c5cf").WithAlpha(0.3),
+		Background: gothememe.Hex("#39c5cf19"),
+		Border:     gothememe.Hex("#39c5cf4c"),
 		Text:       gothememe.Hex("#39c5cf"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeGithubDarkDefault) BorderStrong() gothememe.Color { return gotheme
 // Semantic colors
 func (t *themeGithubDarkDefault) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3fb950").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3fb950").WithAlpha(0.3),
+		Background: gothememe.Hex("#3fb95019"),
+		Border:     gothememe.Hex("#3fb9504c"),
 		Text:       gothememe.Hex("#3fb950"),
 	}
 }
 
 func (t *themeGithubDarkDefault) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d29922").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d29922").WithAlpha(0.3),
+		Background: gothememe.Hex("#d2992219"),
+		Border:     gothememe.Hex("#d299224c"),
 		Text:       gothememe.Hex("#d29922"),
 	}
 }
 
 func (t *themeGithubDarkDefault) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff7b72").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff7b72").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff7b7219"),
+		Border:     gothememe.Hex("#ff7b724c"),
 		Text:       gothememe.Hex("#ff7b72"),
 	}
 }
 
 func (t *themeGithubDarkDefault) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#39c5cf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#39c5cf").WithAlpha(0.3),
+		Background: gothememe.Hex("#39c5cf19"),
+		Border:     gothememe.Hex("#39c5cf4c"),
 		Text:       gothememe.Hex("#39c5cf"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeGithubDarkDimmed) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeGithubDarkDimmed) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#57ab5a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#57ab5a").WithAlpha(0.3),
+		Background: gothememe.Hex("#57ab5a19"),
+		Border:     gothememe.Hex("#57ab5a4c"),
 		Text:       gothememe.Hex("#57ab5a"),
 	}
 }
 
 func (t *themeGithubDarkDimmed) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c69026").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c69026").WithAlpha(0.3),
+		Background: gothememe.Hex("#c6902619"),
+		Border:     gothememe.Hex("#c690264c"),
 		Text:       gothememe.Hex("#c69026"),
 	}
 }
 
 func (t *themeGithubDarkDimmed) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f47067").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f47067").WithAlpha(0.3),
+		Background: gothememe.Hex("#f4706719"),
+		Border:     gothememe.Hex("#f470674c"),
 		Text:       gothememe.Hex("#f47067"),
 	}
 }
 
 func (t *themeGithubDarkDimmed) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#39c5cf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#39c5cf").WithAlpha(0.3),
+		Background: gothememe.Hex("#39c5cf19"),
+		Border:     gothememe.Hex("#39c5cf4c"),
 		Text:       gothememe.Hex("#39c5cf"),
 	}
 }
@@ -55,32 +55,32 @@ func (t *themeGithubDarkHighContrast) BorderStrong() gothememe.Color { return go
 // Semantic colors
 func (t *themeGithubDarkHighContrast) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#26cd4d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#26cd4d").WithAlpha(0.3),
+		Background: gothememe.Hex("#26cd4d19"),
+		Border:     gothememe.Hex("#26cd4d4c"),
 		Text:       gothememe.Hex("#26cd4d"),
 	}
 }
 
 func (t *themeGithubDarkHighContrast) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f0b72f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f0b72f").WithAlpha(0.3),
+		Background: gothememe.Hex("#f0b72f19"),
+		Border:     gothememe.Hex("#f0b72f4c"),
 		Text:       gothememe.Hex("#f0b72f"),
 	}
 }
 
 func (t *themeGithubDarkHighContrast) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff9492").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff9492").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff949219"),
+		Border:     gothememe.Hex("#ff94924c"),
 		Text:       gothememe.Hex("#ff9492"),
 	}
 }
 
 func (t *themeGithubDarkHighContrast) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#39c5cf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#39c5cf").WithAlpha(0.3),
+		Background: gothememe.Hex("#39c5cf19"),
+		Border:     gothememe.Hex("#39c5cf4c"),
 		Text:       gothememe.Hex("#39c5cf"),
 	}
 }
@@ -53,32 +53,32 @@ func (t *themeGithubLightColorblind) BorderStrong() gothememe.Color { return got
 // Semantic colors
 func (t *themeGithubLightColorblind) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0550ae").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0550ae").WithAlpha(0.3),
+		Background: gothememe.Hex("#0550ae19"),
+		Border:     gothememe.Hex("#0550ae4c"),
 		Text:       gothememe.Hex("#0550ae"),
 	}
 }
 
 func (t *themeGithubLightColorblind) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4d2d00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4d2d00").WithAlpha(0.3),
+		Background: gothememe.Hex("#4d2d0019"),
+		Border:     gothememe.Hex("#4d2d004c"),
 		Text:       gothememe.Hex("#4d2d00"),
 	}
 }
 
 func (t *themeGithubLightColorblind) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b35900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b35900").WithAlpha(0.3),
+		Background: gothememe.Hex("#b3590019"),
+		Border:     gothememe.Hex("#b359004c"),
 		Text:       gothememe.Hex("#b35900"),
 	}
 }
 
 func (t *themeGithubLightColorblind) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1b7c83").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1b7c83").WithAlpha(0.3),
+		Background: gothememe.Hex("#1b7c8319"),
+		Border:     gothememe.Hex("#1b7c834c"),
 		Text:       gothememe.Hex("#1b7c83"),
 	}
 }
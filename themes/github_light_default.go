@@ -47,32 +47,32 @@ func (t *themeGithubLightDefault) BorderStrong() gothememe.Color { return gothem
 // Semantic colors
 func (t *themeGithubLightDefault) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#116329").WithAlpha(0.1),
-		Border:     gothememe.Hex("#116329").WithAlpha(0.3),
+		Background: gothememe.Hex("#11632919"),
+		Border:     gothememe.Hex("#1163294c"),
 		Text:       gothememe.Hex("#116329"),
 	}
 }
 
 func (t *themeGithubLightDefault) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4d2d00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4d2d00").WithAlpha(0.3),
+		Background: gothememe.Hex("#4d2d0019"),
+		Border:     gothememe.Hex("#4d2d004c"),
 		Text:       gothememe.Hex("#4d2d00"),
 	}
 }
 
 func (t *themeGithubLightDefault) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cf222e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cf222e").WithAlpha(0.3),
+		Background: gothememe.Hex("#cf222e19"),
+		Border:     gothememe.Hex("#cf222e4c"),
 		Text:       gothememe.Hex("#cf222e"),
 	}
 }
 
 func (t *themeGithubLightDefault) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1b7c83").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1b7c83").WithAlpha(0.3),
+		Background: gothememe.Hex("#1b7c8319"),
+		Border:     gothememe.Hex("#1b7c834c"),
 		Text:       gothememe.Hex("#1b7c83"),
 	}
 }
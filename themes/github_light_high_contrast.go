@@ -61,32 +61,32 @@ func (t *themeGithubLightHighContrast) BorderStrong() gothememe.Color {
 // Semantic colors
 func (t *themeGithubLightHighContrast) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#024c1a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#024c1a").WithAlpha(0.3),
+		Background: gothememe.Hex("#024c1a19"),
+		Border:     gothememe.Hex("#024c1a4c"),
 		Text:       gothememe.Hex("#024c1a"),
 	}
 }
 
 func (t *themeGithubLightHighContrast) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3f2200").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3f2200").WithAlpha(0.3),
+		Background: gothememe.Hex("#3f220019"),
+		Border:     gothememe.Hex("#3f22004c"),
 		Text:       gothememe.Hex("#3f2200"),
 	}
 }
 
 func (t *themeGithubLightHighContrast) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a0111f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a0111f").WithAlpha(0.3),
+		Background: gothememe.Hex("#a0111f19"),
+		Border:     gothememe.Hex("#a0111f4c"),
 		Text:       gothememe.Hex("#a0111f"),
 	}
 }
 
 func (t *themeGithubLightHighContrast) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1b7c83").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1b7c83").WithAlpha(0.3),
+		Background: gothememe.Hex("#1b7c8319"),
+		Border:     gothememe.Hex("#1b7c834c"),
 		Text:       gothememe.Hex("#1b7c83"),
 	}
 }
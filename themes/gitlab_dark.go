@@ -43,32 +43,32 @@ func (t *themeGitlabDark) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeGitlabDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#52b87a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#52b87a").WithAlpha(0.3),
+		Background: gothememe.Hex("#52b87a19"),
+		Border:     gothememe.Hex("#52b87a4c"),
 		Text:       gothememe.Hex("#52b87a"),
 	}
 }
 
 func (t *themeGitlabDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d99530").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d99530").WithAlpha(0.3),
+		Background: gothememe.Hex("#d9953019"),
+		Border:     gothememe.Hex("#d995304c"),
 		Text:       gothememe.Hex("#d99530"),
 	}
 }
 
 func (t *themeGitlabDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f57f6c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f57f6c").WithAlpha(0.3),
+		Background: gothememe.Hex("#f57f6c19"),
+		Border:     gothememe.Hex("#f57f6c4c"),
 		Text:       gothememe.Hex("#f57f6c"),
 	}
 }
 
 func (t *themeGitlabDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#32c5d2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#32c5d2").WithAlpha(0.3),
+		Background: gothememe.Hex("#32c5d219"),
+		Border:     gothememe.Hex("#32c5d24c"),
 		Text:       gothememe.Hex("#32c5d2"),
 	}
 }
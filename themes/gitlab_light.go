@@ -45,32 +45,32 @@ func (t *themeGitlabLight) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeGitlabLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0a7f3d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0a7f3d").WithAlpha(0.3),
+		Background: gothememe.Hex("#0a7f3d19"),
+		Border:     gothememe.Hex("#0a7f3d4c"),
 		Text:       gothememe.Hex("#0a7f3d"),
 	}
 }
 
 func (t *themeGitlabLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#af551d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#af551d").WithAlpha(0.3),
+		Background: gothememe.Hex("#af551d19"),
+		Border:     gothememe.Hex("#af551d4c"),
 		Text:       gothememe.Hex("#af551d"),
 	}
 }
 
 func (t *themeGitlabLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a31700").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a31700").WithAlpha(0.3),
+		Background: gothememe.Hex("#a3170019"),
+		Border:     gothememe.Hex("#a317004c"),
 		Text:       gothememe.Hex("#a31700"),
 	}
 }
 
 func (t *themeGitlabLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00798a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00798a").WithAlpha(0.3),
+		Background: gothememe.Hex("#00798a19"),
+		Border:     gothememe.Hex("#00798a4c"),
 		Text:       gothememe.Hex("#00798a"),
 	}
 }
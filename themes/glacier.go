@@ -43,32 +43,32 @@ func (t *themeGlacier) BorderStrong() gothememe.Color { return gothememe.Hex("#f
 // Semantic colors
 func (t *themeGlacier) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#35a770").WithAlpha(0.1),
-		Border:     gothememe.Hex("#35a770").WithAlpha(0.3),
+		Background: gothememe.Hex("#35a77019"),
+		Border:     gothememe.Hex("#35a7704c"),
 		Text:       gothememe.Hex("#35a770"),
 	}
 }
 
 func (t *themeGlacier) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fb9435").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fb9435").WithAlpha(0.3),
+		Background: gothememe.Hex("#fb943519"),
+		Border:     gothememe.Hex("#fb94354c"),
 		Text:       gothememe.Hex("#fb9435"),
 	}
 }
 
 func (t *themeGlacier) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bd0f2f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bd0f2f").WithAlpha(0.3),
+		Background: gothememe.Hex("#bd0f2f19"),
+		Border:     gothememe.Hex("#bd0f2f4c"),
 		Text:       gothememe.Hex("#bd0f2f"),
 	}
 }
 
 func (t *themeGlacier) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#778397").WithAlpha(0.1),
-		Border:     gothememe.Hex("#778397").WithAlpha(0.3),
+		Background: gothememe.Hex("#77839719"),
+		Border:     gothememe.Hex("#7783974c"),
 		Text:       gothememe.Hex("#778397"),
 	}
 }
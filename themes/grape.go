@@ -43,32 +43,32 @@ func (t *themeGrape) BorderStrong() gothememe.Color { return gothememe.Hex("#9e9
 // Semantic colors
 func (t *themeGrape) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1fa91b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1fa91b").WithAlpha(0.3),
+		Background: gothememe.Hex("#1fa91b19"),
+		Border:     gothememe.Hex("#1fa91b4c"),
 		Text:       gothememe.Hex("#1fa91b"),
 	}
 }
 
 func (t *themeGrape) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8ddc20").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8ddc20").WithAlpha(0.3),
+		Background: gothememe.Hex("#8ddc2019"),
+		Border:     gothememe.Hex("#8ddc204c"),
 		Text:       gothememe.Hex("#8ddc20"),
 	}
 }
 
 func (t *themeGrape) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ed2261").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ed2261").WithAlpha(0.3),
+		Background: gothememe.Hex("#ed226119"),
+		Border:     gothememe.Hex("#ed22614c"),
 		Text:       gothememe.Hex("#ed2261"),
 	}
 }
 
 func (t *themeGrape) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3bdeed").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3bdeed").WithAlpha(0.3),
+		Background: gothememe.Hex("#3bdeed19"),
+		Border:     gothememe.Hex("#3bdeed4c"),
 		Text:       gothememe.Hex("#3bdeed"),
 	}
 }
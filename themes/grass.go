@@ -43,32 +43,32 @@ func (t *themeGrass) BorderStrong() gothememe.Color { return gothememe.Hex("#bbb
 // Semantic colors
 func (t *themeGrass) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00bb00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00bb00").WithAlpha(0.3),
+		Background: gothememe.Hex("#00bb0019"),
+		Border:     gothememe.Hex("#00bb004c"),
 		Text:       gothememe.Hex("#00bb00"),
 	}
 }
 
 func (t *themeGrass) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e7b000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e7b000").WithAlpha(0.3),
+		Background: gothememe.Hex("#e7b00019"),
+		Border:     gothememe.Hex("#e7b0004c"),
 		Text:       gothememe.Hex("#e7b000"),
 	}
 }
 
 func (t *themeGrass) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff5959").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff5959").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff595919"),
+		Border:     gothememe.Hex("#ff59594c"),
 		Text:       gothememe.Hex("#ff5959"),
 	}
 }
 
 func (t *themeGrass) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00bbbb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00bbbb").WithAlpha(0.3),
+		Background: gothememe.Hex("#00bbbb19"),
+		Border:     gothememe.Hex("#00bbbb4c"),
 		Text:       gothememe.Hex("#00bbbb"),
 	}
 }
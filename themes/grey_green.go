@@ -43,32 +43,32 @@ func (t *themeGreyGreen) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeGreyGreen) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#74ff00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#74ff00").WithAlpha(0.3),
+		Background: gothememe.Hex("#74ff0019"),
+		Border:     gothememe.Hex("#74ff004c"),
 		Text:       gothememe.Hex("#74ff00"),
 	}
 }
 
 func (t *themeGreyGreen) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f1ff01").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f1ff01").WithAlpha(0.3),
+		Background: gothememe.Hex("#f1ff0119"),
+		Border:     gothememe.Hex("#f1ff014c"),
 		Text:       gothememe.Hex("#f1ff01"),
 	}
 }
 
 func (t *themeGreyGreen) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fe1414").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fe1414").WithAlpha(0.3),
+		Background: gothememe.Hex("#fe141419"),
+		Border:     gothememe.Hex("#fe14144c"),
 		Text:       gothememe.Hex("#fe1414"),
 	}
 }
 
 func (t *themeGreyGreen) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00ffbc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00ffbc").WithAlpha(0.3),
+		Background: gothememe.Hex("#00ffbc19"),
+		Border:     gothememe.Hex("#00ffbc4c"),
 		Text:       gothememe.Hex("#00ffbc"),
 	}
 }
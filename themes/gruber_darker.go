@@ -45,32 +45,32 @@ func (t *themeGruberDarker) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeGruberDarker) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#42dc00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#42dc00").WithAlpha(0.3),
+		Background: gothememe.Hex("#42dc0019"),
+		Border:     gothememe.Hex("#42dc004c"),
 		Text:       gothememe.Hex("#42dc00"),
 	}
 }
 
 func (t *themeGruberDarker) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffdb00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffdb00").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffdb0019"),
+		Border:     gothememe.Hex("#ffdb004c"),
 		Text:       gothememe.Hex("#ffdb00"),
 	}
 }
 
 func (t *themeGruberDarker) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff0a36").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff0a36").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff0a3619"),
+		Border:     gothememe.Hex("#ff0a364c"),
 		Text:       gothememe.Hex("#ff0a36"),
 	}
 }
 
 func (t *themeGruberDarker) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#90aa9e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#90aa9e").WithAlpha(0.3),
+		Background: gothememe.Hex("#90aa9e19"),
+		Border:     gothememe.Hex("#90aa9e4c"),
 		Text:       gothememe.Hex("#90aa9e"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeGruvboxDarkHard) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeGruvboxDarkHard) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98971a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98971a").WithAlpha(0.3),
+		Background: gothememe.Hex("#98971a19"),
+		Border:     gothememe.Hex("#98971a4c"),
 		Text:       gothememe.Hex("#98971a"),
 	}
 }
 
 func (t *themeGruvboxDarkHard) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d79921").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d79921").WithAlpha(0.3),
+		Background: gothememe.Hex("#d7992119"),
+		Border:     gothememe.Hex("#d799214c"),
 		Text:       gothememe.Hex("#d79921"),
 	}
 }
 
 func (t *themeGruvboxDarkHard) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc241d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc241d").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc241d19"),
+		Border:     gothememe.Hex("#cc241d4c"),
 		Text:       gothememe.Hex("#cc241d"),
 	}
 }
 
 func (t *themeGruvboxDarkHard) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#689d6a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#689d6a").WithAlpha(0.3),
+		Background: gothememe.Hex("#689d6a19"),
+		Border:     gothememe.Hex("#689d6a4c"),
 		Text:       gothememe.Hex("#689d6a"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeGruvboxMaterial) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeGruvboxMaterial) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c1d041").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c1d041").WithAlpha(0.3),
+		Background: gothememe.Hex("#c1d04119"),
+		Border:     gothememe.Hex("#c1d0414c"),
 		Text:       gothememe.Hex("#c1d041"),
 	}
 }
 
 func (t *themeGruvboxMaterial) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#eecf75").WithAlpha(0.1),
-		Border:     gothememe.Hex("#eecf75").WithAlpha(0.3),
+		Background: gothememe.Hex("#eecf7519"),
+		Border:     gothememe.Hex("#eecf754c"),
 		Text:       gothememe.Hex("#eecf75"),
 	}
 }
 
 func (t *themeGruvboxMaterial) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ea6926").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ea6926").WithAlpha(0.3),
+		Background: gothememe.Hex("#ea692619"),
+		Border:     gothememe.Hex("#ea69264c"),
 		Text:       gothememe.Hex("#ea6926"),
 	}
 }
 
 func (t *themeGruvboxMaterial) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fe9d6e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fe9d6e").WithAlpha(0.3),
+		Background: gothememe.Hex("#fe9d6e19"),
+		Border:     gothememe.Hex("#fe9d6e4c"),
 		Text:       gothememe.Hex("#fe9d6e"),
 	}
 }
@@ -49,32 +49,32 @@ func (t *themeGruvboxMaterialDark) BorderStrong() gothememe.Color { return gothe
 // Semantic colors
 func (t *themeGruvboxMaterialDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a9b665").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a9b665").WithAlpha(0.3),
+		Background: gothememe.Hex("#a9b66519"),
+		Border:     gothememe.Hex("#a9b6654c"),
 		Text:       gothememe.Hex("#a9b665"),
 	}
 }
 
 func (t *themeGruvboxMaterialDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d8a657").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d8a657").WithAlpha(0.3),
+		Background: gothememe.Hex("#d8a65719"),
+		Border:     gothememe.Hex("#d8a6574c"),
 		Text:       gothememe.Hex("#d8a657"),
 	}
 }
 
 func (t *themeGruvboxMaterialDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ea6962").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ea6962").WithAlpha(0.3),
+		Background: gothememe.Hex("#ea696219"),
+		Border:     gothememe.Hex("#ea69624c"),
 		Text:       gothememe.Hex("#ea6962"),
 	}
 }
 
 func (t *themeGruvboxMaterialDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#89b482").WithAlpha(0.1),
-		Border:     gothememe.Hex("#89b482").WithAlpha(0.3),
+		Background: gothememe.Hex("#89b48219"),
+		Border:     gothememe.Hex("#89b4824c"),
 		Text:       gothememe.Hex("#89b482"),
 	}
 }
@@ -51,32 +51,32 @@ func (t *themeGruvboxMaterialLight) BorderStrong() gothememe.Color { return goth
 // Semantic colors
 func (t *themeGruvboxMaterialLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6c782e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6c782e").WithAlpha(0.3),
+		Background: gothememe.Hex("#6c782e19"),
+		Border:     gothememe.Hex("#6c782e4c"),
 		Text:       gothememe.Hex("#6c782e"),
 	}
 }
 
 func (t *themeGruvboxMaterialLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b47109").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b47109").WithAlpha(0.3),
+		Background: gothememe.Hex("#b4710919"),
+		Border:     gothememe.Hex("#b471094c"),
 		Text:       gothememe.Hex("#b47109"),
 	}
 }
 
 func (t *themeGruvboxMaterialLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c14a4a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c14a4a").WithAlpha(0.3),
+		Background: gothememe.Hex("#c14a4a19"),
+		Border:     gothememe.Hex("#c14a4a4c"),
 		Text:       gothememe.Hex("#c14a4a"),
 	}
 }
 
 func (t *themeGruvboxMaterialLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4c7a5d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4c7a5d").WithAlpha(0.3),
+		Background: gothememe.Hex("#4c7a5d19"),
+		Border:     gothememe.Hex("#4c7a5d4c"),
 		Text:       gothememe.Hex("#4c7a5d"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeGuezwhoz) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeGuezwhoz) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7ad694").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7ad694").WithAlpha(0.3),
+		Background: gothememe.Hex("#7ad69419"),
+		Border:     gothememe.Hex("#7ad6944c"),
 		Text:       gothememe.Hex("#7ad694"),
 	}
 }
 
 func (t *themeGuezwhoz) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b7d074").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b7d074").WithAlpha(0.3),
+		Background: gothememe.Hex("#b7d07419"),
+		Border:     gothememe.Hex("#b7d0744c"),
 		Text:       gothememe.Hex("#b7d074"),
 	}
 }
 
 func (t *themeGuezwhoz) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e85181").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e85181").WithAlpha(0.3),
+		Background: gothememe.Hex("#e8518119"),
+		Border:     gothememe.Hex("#e851814c"),
 		Text:       gothememe.Hex("#e85181"),
 	}
 }
 
 func (t *themeGuezwhoz) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#58d6ce").WithAlpha(0.1),
-		Border:     gothememe.Hex("#58d6ce").WithAlpha(0.3),
+		Background: gothememe.Hex("#58d6ce19"),
+		Border:     gothememe.Hex("#58d6ce4c"),
 		Text:       gothememe.Hex("#58d6ce"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeHacktober) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeHacktober) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#587744").WithAlpha(0.1),
-		Border:     gothememe.Hex("#587744").WithAlpha(0.3),
+		Background: gothememe.Hex("#58774419"),
+		Border:     gothememe.Hex("#5877444c"),
 		Text:       gothememe.Hex("#587744"),
 	}
 }
 
 func (t *themeHacktober) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d08949").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d08949").WithAlpha(0.3),
+		Background: gothememe.Hex("#d0894919"),
+		Border:     gothememe.Hex("#d089494c"),
 		Text:       gothememe.Hex("#d08949"),
 	}
 }
 
 func (t *themeHacktober) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b34538").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b34538").WithAlpha(0.3),
+		Background: gothememe.Hex("#b3453819"),
+		Border:     gothememe.Hex("#b345384c"),
 		Text:       gothememe.Hex("#b34538"),
 	}
 }
 
 func (t *themeHacktober) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ac9166").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ac9166").WithAlpha(0.3),
+		Background: gothememe.Hex("#ac916619"),
+		Border:     gothememe.Hex("#ac91664c"),
 		Text:       gothememe.Hex("#ac9166"),
 	}
 }
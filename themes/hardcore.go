@@ -43,32 +43,32 @@ func (t *themeHardcore) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeHardcore) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a6e22e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a6e22e").WithAlpha(0.3),
+		Background: gothememe.Hex("#a6e22e19"),
+		Border:     gothememe.Hex("#a6e22e4c"),
 		Text:       gothememe.Hex("#a6e22e"),
 	}
 }
 
 func (t *themeHardcore) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fd971f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fd971f").WithAlpha(0.3),
+		Background: gothememe.Hex("#fd971f19"),
+		Border:     gothememe.Hex("#fd971f4c"),
 		Text:       gothememe.Hex("#fd971f"),
 	}
 }
 
 func (t *themeHardcore) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f92672").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f92672").WithAlpha(0.3),
+		Background: gothememe.Hex("#f9267219"),
+		Border:     gothememe.Hex("#f926724c"),
 		Text:       gothememe.Hex("#f92672"),
 	}
 }
 
 func (t *themeHardcore) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5e7175").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5e7175").WithAlpha(0.3),
+		Background: gothememe.Hex("#5e717519"),
+		Border:     gothememe.Hex("#5e71754c"),
 		Text:       gothememe.Hex("#5e7175"),
 	}
 }
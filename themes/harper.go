@@ -43,32 +43,32 @@ func (t *themeHarper) BorderStrong() gothememe.Color { return gothememe.Hex("#a8
 // Semantic colors
 func (t *themeHarper) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7fb5e1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7fb5e1").WithAlpha(0.3),
+		Background: gothememe.Hex("#7fb5e119"),
+		Border:     gothememe.Hex("#7fb5e14c"),
 		Text:       gothememe.Hex("#7fb5e1"),
 	}
 }
 
 func (t *themeHarper) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d6da25").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d6da25").WithAlpha(0.3),
+		Background: gothememe.Hex("#d6da2519"),
+		Border:     gothememe.Hex("#d6da254c"),
 		Text:       gothememe.Hex("#d6da25"),
 	}
 }
 
 func (t *themeHarper) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f8b63f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f8b63f").WithAlpha(0.3),
+		Background: gothememe.Hex("#f8b63f19"),
+		Border:     gothememe.Hex("#f8b63f4c"),
 		Text:       gothememe.Hex("#f8b63f"),
 	}
 }
 
 func (t *themeHarper) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f5bfd7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f5bfd7").WithAlpha(0.3),
+		Background: gothememe.Hex("#f5bfd719"),
+		Border:     gothememe.Hex("#f5bfd74c"),
 		Text:       gothememe.Hex("#f5bfd7"),
 	}
 }
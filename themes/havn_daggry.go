@@ -43,32 +43,32 @@ func (t *themeHavnDaggry) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeHavnDaggry) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#577159").WithAlpha(0.1),
-		Border:     gothememe.Hex("#577159").WithAlpha(0.3),
+		Background: gothememe.Hex("#57715919"),
+		Border:     gothememe.Hex("#5771594c"),
 		Text:       gothememe.Hex("#577159"),
 	}
 }
 
 func (t *themeHavnDaggry) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#be6b00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#be6b00").WithAlpha(0.3),
+		Background: gothememe.Hex("#be6b0019"),
+		Border:     gothememe.Hex("#be6b004c"),
 		Text:       gothememe.Hex("#be6b00"),
 	}
 }
 
 func (t *themeHavnDaggry) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#985248").WithAlpha(0.1),
-		Border:     gothememe.Hex("#985248").WithAlpha(0.3),
+		Background: gothememe.Hex("#98524819"),
+		Border:     gothememe.Hex("#9852484c"),
 		Text:       gothememe.Hex("#985248"),
 	}
 }
 
 func (t *themeHavnDaggry) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#925780").WithAlpha(0.1),
-		Border:     gothememe.Hex("#925780").WithAlpha(0.3),
+		Background: gothememe.Hex("#92578019"),
+		Border:     gothememe.Hex("#9257804c"),
 		Text:       gothememe.Hex("#925780"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeHavnSkumring) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeHavnSkumring) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6ead7b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6ead7b").WithAlpha(0.3),
+		Background: gothememe.Hex("#6ead7b19"),
+		Border:     gothememe.Hex("#6ead7b4c"),
 		Text:       gothememe.Hex("#6ead7b"),
 	}
 }
 
 func (t *themeHavnSkumring) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f8b330").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f8b330").WithAlpha(0.3),
+		Background: gothememe.Hex("#f8b33019"),
+		Border:     gothememe.Hex("#f8b3304c"),
 		Text:       gothememe.Hex("#f8b330"),
 	}
 }
 
 func (t *themeHavnSkumring) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ea563e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ea563e").WithAlpha(0.3),
+		Background: gothememe.Hex("#ea563e19"),
+		Border:     gothememe.Hex("#ea563e4c"),
 		Text:       gothememe.Hex("#ea563e"),
 	}
 }
 
 func (t *themeHavnSkumring) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d588c1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d588c1").WithAlpha(0.3),
+		Background: gothememe.Hex("#d588c119"),
+		Border:     gothememe.Hex("#d588c14c"),
 		Text:       gothememe.Hex("#d588c1"),
 	}
 }
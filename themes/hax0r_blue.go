@@ -43,32 +43,32 @@ func (t *themeHax0rBlue) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeHax0rBlue) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#10b6ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#10b6ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#10b6ff19"),
+		Border:     gothememe.Hex("#10b6ff4c"),
 		Text:       gothememe.Hex("#10b6ff"),
 	}
 }
 
 func (t *themeHax0rBlue) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#10b6ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#10b6ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#10b6ff19"),
+		Border:     gothememe.Hex("#10b6ff4c"),
 		Text:       gothememe.Hex("#10b6ff"),
 	}
 }
 
 func (t *themeHax0rBlue) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#10b6ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#10b6ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#10b6ff19"),
+		Border:     gothememe.Hex("#10b6ff4c"),
 		Text:       gothememe.Hex("#10b6ff"),
 	}
 }
 
 func (t *themeHax0rBlue) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#10b6ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#10b6ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#10b6ff19"),
+		Border:     gothememe.Hex("#10b6ff4c"),
 		Text:       gothememe.Hex("#10b6ff"),
 	}
 }
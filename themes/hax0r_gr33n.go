@@ -43,32 +43,32 @@ func (t *themeHax0rGr33n) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeHax0rGr33n) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#15d00d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#15d00d").WithAlpha(0.3),
+		Background: gothememe.Hex("#15d00d19"),
+		Border:     gothememe.Hex("#15d00d4c"),
 		Text:       gothememe.Hex("#15d00d"),
 	}
 }
 
 func (t *themeHax0rGr33n) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#15d00d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#15d00d").WithAlpha(0.3),
+		Background: gothememe.Hex("#15d00d19"),
+		Border:     gothememe.Hex("#15d00d4c"),
 		Text:       gothememe.Hex("#15d00d"),
 	}
 }
 
 func (t *themeHax0rGr33n) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#15d00d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#15d00d").WithAlpha(0.3),
+		Background: gothememe.Hex("#15d00d19"),
+		Border:     gothememe.Hex("#15d00d4c"),
 		Text:       gothememe.Hex("#15d00d"),
 	}
 }
 
 func (t *themeHax0rGr33n) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#15d00d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#15d00d").WithAlpha(0.3),
+		Background: gothememe.Hex("#15d00d19"),
+		Border:     gothememe.Hex("#15d00d4c"),
 		Text:       gothememe.Hex("#15d00d"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeHax0rR3d) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeHax0rR3d) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b00d0d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b00d0d").WithAlpha(0.3),
+		Background: gothememe.Hex("#b00d0d19"),
+		Border:     gothememe.Hex("#b00d0d4c"),
 		Text:       gothememe.Hex("#b00d0d"),
 	}
 }
 
 func (t *themeHax0rR3d) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b00d0d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b00d0d").WithAlpha(0.3),
+		Background: gothememe.Hex("#b00d0d19"),
+		Border:     gothememe.Hex("#b00d0d4c"),
 		Text:       gothememe.Hex("#b00d0d"),
 	}
 }
 
 func (t *themeHax0rR3d) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b00d0d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b00d0d").WithAlpha(0.3),
+		Background: gothememe.Hex("#b00d0d19"),
+		Border:     gothememe.Hex("#b00d0d4c"),
 		Text:       gothememe.Hex("#b00d0d"),
 	}
 }
 
 func (t *themeHax0rR3d) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b00d0d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b00d0d").WithAlpha(0.3),
+		Background: gothememe.Hex("#b00d0d19"),
+		Border:     gothememe.Hex("#b00d0d4c"),
 		Text:       gothememe.Hex("#b00d0d"),
 	}
 }
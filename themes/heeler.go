@@ -43,32 +43,32 @@ func (t *themeHeeler) BorderStrong() gothememe.Color { return gothememe.Hex("#ff
 // Semantic colors
 func (t *themeHeeler) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bdd100").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bdd100").WithAlpha(0.3),
+		Background: gothememe.Hex("#bdd10019"),
+		Border:     gothememe.Hex("#bdd1004c"),
 		Text:       gothememe.Hex("#bdd100"),
 	}
 }
 
 func (t *themeHeeler) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f4ce65").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f4ce65").WithAlpha(0.3),
+		Background: gothememe.Hex("#f4ce6519"),
+		Border:     gothememe.Hex("#f4ce654c"),
 		Text:       gothememe.Hex("#f4ce65"),
 	}
 }
 
 func (t *themeHeeler) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e44c2e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e44c2e").WithAlpha(0.3),
+		Background: gothememe.Hex("#e44c2e19"),
+		Border:     gothememe.Hex("#e44c2e4c"),
 		Text:       gothememe.Hex("#e44c2e"),
 	}
 }
 
 func (t *themeHeeler) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff9763").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff9763").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff976319"),
+		Border:     gothememe.Hex("#ff97634c"),
 		Text:       gothememe.Hex("#ff9763"),
 	}
 }
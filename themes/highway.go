@@ -43,32 +43,32 @@ func (t *themeHighway) BorderStrong() gothememe.Color { return gothememe.Hex("#e
 // Semantic colors
 func (t *themeHighway) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#138034").WithAlpha(0.1),
-		Border:     gothememe.Hex("#138034").WithAlpha(0.3),
+		Background: gothememe.Hex("#13803419"),
+		Border:     gothememe.Hex("#1380344c"),
 		Text:       gothememe.Hex("#138034"),
 	}
 }
 
 func (t *themeHighway) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffcb3e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffcb3e").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffcb3e19"),
+		Border:     gothememe.Hex("#ffcb3e4c"),
 		Text:       gothememe.Hex("#ffcb3e"),
 	}
 }
 
 func (t *themeHighway) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d00e18").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d00e18").WithAlpha(0.3),
+		Background: gothememe.Hex("#d00e1819"),
+		Border:     gothememe.Hex("#d00e184c"),
 		Text:       gothememe.Hex("#d00e18"),
 	}
 }
 
 func (t *themeHighway) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#455271").WithAlpha(0.1),
-		Border:     gothememe.Hex("#455271").WithAlpha(0.3),
+		Background: gothememe.Hex("#45527119"),
+		Border:     gothememe.Hex("#4552714c"),
 		Text:       gothememe.Hex("#455271"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeHipsterGreen) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeHipsterGreen) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00a600").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00a600").WithAlpha(0.3),
+		Background: gothememe.Hex("#00a60019"),
+		Border:     gothememe.Hex("#00a6004c"),
 		Text:       gothememe.Hex("#00a600"),
 	}
 }
 
 func (t *themeHipsterGreen) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bfbf00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bfbf00").WithAlpha(0.3),
+		Background: gothememe.Hex("#bfbf0019"),
+		Border:     gothememe.Hex("#bfbf004c"),
 		Text:       gothememe.Hex("#bfbf00"),
 	}
 }
 
 func (t *themeHipsterGreen) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b6214a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b6214a").WithAlpha(0.3),
+		Background: gothememe.Hex("#b6214a19"),
+		Border:     gothememe.Hex("#b6214a4c"),
 		Text:       gothememe.Hex("#b6214a"),
 	}
 }
 
 func (t *themeHipsterGreen) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00a6b2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00a6b2").WithAlpha(0.3),
+		Background: gothememe.Hex("#00a6b219"),
+		Border:     gothememe.Hex("#00a6b24c"),
 		Text:       gothememe.Hex("#00a6b2"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeHivacruz) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeHivacruz) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ac9739").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ac9739").WithAlpha(0.3),
+		Background: gothememe.Hex("#ac973919"),
+		Border:     gothememe.Hex("#ac97394c"),
 		Text:       gothememe.Hex("#ac9739"),
 	}
 }
 
 func (t *themeHivacruz) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c08b30").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c08b30").WithAlpha(0.3),
+		Background: gothememe.Hex("#c08b3019"),
+		Border:     gothememe.Hex("#c08b304c"),
 		Text:       gothememe.Hex("#c08b30"),
 	}
 }
 
 func (t *themeHivacruz) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c94922").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c94922").WithAlpha(0.3),
+		Background: gothememe.Hex("#c9492219"),
+		Border:     gothememe.Hex("#c949224c"),
 		Text:       gothememe.Hex("#c94922"),
 	}
 }
 
 func (t *themeHivacruz) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#22a2c9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#22a2c9").WithAlpha(0.3),
+		Background: gothememe.Hex("#22a2c919"),
+		Border:     gothememe.Hex("#22a2c94c"),
 		Text:       gothememe.Hex("#22a2c9"),
 	}
 }
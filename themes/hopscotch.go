@@ -43,32 +43,32 @@ func (t *themeHopscotch) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeHopscotch) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8fc13e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8fc13e").WithAlpha(0.3),
+		Background: gothememe.Hex("#8fc13e19"),
+		Border:     gothememe.Hex("#8fc13e4c"),
 		Text:       gothememe.Hex("#8fc13e"),
 	}
 }
 
 func (t *themeHopscotch) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fdcc59").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fdcc59").WithAlpha(0.3),
+		Background: gothememe.Hex("#fdcc5919"),
+		Border:     gothememe.Hex("#fdcc594c"),
 		Text:       gothememe.Hex("#fdcc59"),
 	}
 }
 
 func (t *themeHopscotch) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dd464c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dd464c").WithAlpha(0.3),
+		Background: gothememe.Hex("#dd464c19"),
+		Border:     gothememe.Hex("#dd464c4c"),
 		Text:       gothememe.Hex("#dd464c"),
 	}
 }
 
 func (t *themeHopscotch) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#149b93").WithAlpha(0.1),
-		Border:     gothememe.Hex("#149b93").WithAlpha(0.3),
+		Background: gothememe.Hex("#149b9319"),
+		Border:     gothememe.Hex("#149b934c"),
 		Text:       gothememe.Hex("#149b93"),
 	}
 }
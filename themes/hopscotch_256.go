@@ -45,32 +45,32 @@ func (t *themeHopscotch256) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeHopscotch256) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8fc13e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8fc13e").WithAlpha(0.3),
+		Background: gothememe.Hex("#8fc13e19"),
+		Border:     gothememe.Hex("#8fc13e4c"),
 		Text:       gothememe.Hex("#8fc13e"),
 	}
 }
 
 func (t *themeHopscotch256) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fdcc59").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fdcc59").WithAlpha(0.3),
+		Background: gothememe.Hex("#fdcc5919"),
+		Border:     gothememe.Hex("#fdcc594c"),
 		Text:       gothememe.Hex("#fdcc59"),
 	}
 }
 
 func (t *themeHopscotch256) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dd464c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dd464c").WithAlpha(0.3),
+		Background: gothememe.Hex("#dd464c19"),
+		Border:     gothememe.Hex("#dd464c4c"),
 		Text:       gothememe.Hex("#dd464c"),
 	}
 }
 
 func (t *themeHopscotch256) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#149b93").WithAlpha(0.1),
-		Border:     gothememe.Hex("#149b93").WithAlpha(0.3),
+		Background: gothememe.Hex("#149b9319"),
+		Border:     gothememe.Hex("#149b934c"),
 		Text:       gothememe.Hex("#149b93"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeHorizon) BorderStrong() gothememe.Color { return gothememe.Hex("#e
 // Semantic colors
 func (t *themeHorizon) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#29d398").WithAlpha(0.1),
-		Border:     gothememe.Hex("#29d398").WithAlpha(0.3),
+		Background: gothememe.Hex("#29d39819"),
+		Border:     gothememe.Hex("#29d3984c"),
 		Text:       gothememe.Hex("#29d398"),
 	}
 }
 
 func (t *themeHorizon) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fab795").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fab795").WithAlpha(0.3),
+		Background: gothememe.Hex("#fab79519"),
+		Border:     gothememe.Hex("#fab7954c"),
 		Text:       gothememe.Hex("#fab795"),
 	}
 }
 
 func (t *themeHorizon) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e95678").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e95678").WithAlpha(0.3),
+		Background: gothememe.Hex("#e9567819"),
+		Border:     gothememe.Hex("#e956784c"),
 		Text:       gothememe.Hex("#e95678"),
 	}
 }
 
 func (t *themeHorizon) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#59e1e3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#59e1e3").WithAlpha(0.3),
+		Background: gothememe.Hex("#59e1e319"),
+		Border:     gothememe.Hex("#59e1e34c"),
 		Text:       gothememe.Hex("#59e1e3"),
 	}
 }
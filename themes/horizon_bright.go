@@ -45,32 +45,32 @@ func (t *themeHorizonBright) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeHorizonBright) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00ca86").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00ca86").WithAlpha(0.3),
+		Background: gothememe.Hex("#00ca8619"),
+		Border:     gothememe.Hex("#00ca864c"),
 		Text:       gothememe.Hex("#00ca86"),
 	}
 }
 
 func (t *themeHorizonBright) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f2a682").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f2a682").WithAlpha(0.3),
+		Background: gothememe.Hex("#f2a68219"),
+		Border:     gothememe.Hex("#f2a6824c"),
 		Text:       gothememe.Hex("#f2a682"),
 	}
 }
 
 func (t *themeHorizonBright) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fc4777").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fc4777").WithAlpha(0.3),
+		Background: gothememe.Hex("#fc477719"),
+		Border:     gothememe.Hex("#fc47774c"),
 		Text:       gothememe.Hex("#fc4777"),
 	}
 }
 
 func (t *themeHorizonBright) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00cdcb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00cdcb").WithAlpha(0.3),
+		Background: gothememe.Hex("#00cdcb19"),
+		Border:     gothememe.Hex("#00cdcb4c"),
 		Text:       gothememe.Hex("#00cdcb"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeHotDogStand) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeHotDogStand) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffff54").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffff54").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffff5419"),
+		Border:     gothememe.Hex("#ffff544c"),
 		Text:       gothememe.Hex("#ffff54"),
 	}
 }
 
 func (t *themeHotDogStand) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffff54").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffff54").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffff5419"),
+		Border:     gothememe.Hex("#ffff544c"),
 		Text:       gothememe.Hex("#ffff54"),
 	}
 }
 
 func (t *themeHotDogStand) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffff54").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffff54").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffff5419"),
+		Border:     gothememe.Hex("#ffff544c"),
 		Text:       gothememe.Hex("#ffff54"),
 	}
 }
 
 func (t *themeHotDogStand) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffffff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffffff").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffffff19"),
+		Border:     gothememe.Hex("#ffffff4c"),
 		Text:       gothememe.Hex("#ffffff"),
 	}
 }
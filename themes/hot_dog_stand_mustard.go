@@ -47,32 +47,32 @@ func (t *themeHotDogStandMustard) BorderStrong() gothememe.Color { return gothem
 // Semantic colors
 func (t *themeHotDogStandMustard) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ea3323").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ea3323").WithAlpha(0.3),
+		Background: gothememe.Hex("#ea332319"),
+		Border:     gothememe.Hex("#ea33234c"),
 		Text:       gothememe.Hex("#ea3323"),
 	}
 }
 
 func (t *themeHotDogStandMustard) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ea3323").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ea3323").WithAlpha(0.3),
+		Background: gothememe.Hex("#ea332319"),
+		Border:     gothememe.Hex("#ea33234c"),
 		Text:       gothememe.Hex("#ea3323"),
 	}
 }
 
 func (t *themeHotDogStandMustard) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ea3323").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ea3323").WithAlpha(0.3),
+		Background: gothememe.Hex("#ea332319"),
+		Border:     gothememe.Hex("#ea33234c"),
 		Text:       gothememe.Hex("#ea3323"),
 	}
 }
 
 func (t *themeHotDogStandMustard) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#000000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#000000").WithAlpha(0.3),
+		Background: gothememe.Hex("#00000019"),
+		Border:     gothememe.Hex("#0000004c"),
 		Text:       gothememe.Hex("#000000"),
 	}
 }
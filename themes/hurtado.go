@@ -43,32 +43,32 @@ func (t *themeHurtado) BorderStrong() gothememe.Color { return gothememe.Hex("#c
 // Semantic colors
 func (t *themeHurtado) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a5e055").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a5e055").WithAlpha(0.3),
+		Background: gothememe.Hex("#a5e05519"),
+		Border:     gothememe.Hex("#a5e0554c"),
 		Text:       gothememe.Hex("#a5e055"),
 	}
 }
 
 func (t *themeHurtado) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fbe74a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fbe74a").WithAlpha(0.3),
+		Background: gothememe.Hex("#fbe74a19"),
+		Border:     gothememe.Hex("#fbe74a4c"),
 		Text:       gothememe.Hex("#fbe74a"),
 	}
 }
 
 func (t *themeHurtado) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff1b00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff1b00").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff1b0019"),
+		Border:     gothememe.Hex("#ff1b004c"),
 		Text:       gothememe.Hex("#ff1b00"),
 	}
 }
 
 func (t *themeHurtado) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#86e9fe").WithAlpha(0.1),
-		Border:     gothememe.Hex("#86e9fe").WithAlpha(0.3),
+		Background: gothememe.Hex("#86e9fe19"),
+		Border:     gothememe.Hex("#86e9fe4c"),
 		Text:       gothememe.Hex("#86e9fe"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeHybrid) BorderStrong() gothememe.Color { return gothememe.Hex("#b5
 // Semantic colors
 func (t *themeHybrid) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b3bf5a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b3bf5a").WithAlpha(0.3),
+		Background: gothememe.Hex("#b3bf5a19"),
+		Border:     gothememe.Hex("#b3bf5a4c"),
 		Text:       gothememe.Hex("#b3bf5a"),
 	}
 }
 
 func (t *themeHybrid) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e4b55e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e4b55e").WithAlpha(0.3),
+		Background: gothememe.Hex("#e4b55e19"),
+		Border:     gothememe.Hex("#e4b55e4c"),
 		Text:       gothememe.Hex("#e4b55e"),
 	}
 }
 
 func (t *themeHybrid) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b84d51").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b84d51").WithAlpha(0.3),
+		Background: gothememe.Hex("#b84d5119"),
+		Border:     gothememe.Hex("#b84d514c"),
 		Text:       gothememe.Hex("#b84d51"),
 	}
 }
 
 func (t *themeHybrid) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7fbfb4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7fbfb4").WithAlpha(0.3),
+		Background: gothememe.Hex("#7fbfb419"),
+		Border:     gothememe.Hex("#7fbfb44c"),
 		Text:       gothememe.Hex("#7fbfb4"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeIbm5153Cga) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeIbm5153Cga) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1bd01b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1bd01b").WithAlpha(0.3),
+		Background: gothememe.Hex("#1bd01b19"),
+		Border:     gothememe.Hex("#1bd01b4c"),
 		Text:       gothememe.Hex("#1bd01b"),
 	}
 }
 
 func (t *themeIbm5153Cga) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d08c1b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d08c1b").WithAlpha(0.3),
+		Background: gothememe.Hex("#d08c1b19"),
+		Border:     gothememe.Hex("#d08c1b4c"),
 		Text:       gothememe.Hex("#d08c1b"),
 	}
 }
 
 func (t *themeIbm5153Cga) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d03333").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d03333").WithAlpha(0.3),
+		Background: gothememe.Hex("#d0333319"),
+		Border:     gothememe.Hex("#d033334c"),
 		Text:       gothememe.Hex("#d03333"),
 	}
 }
 
 func (t *themeIbm5153Cga) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1bd0d0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1bd0d0").WithAlpha(0.3),
+		Background: gothememe.Hex("#1bd0d019"),
+		Border:     gothememe.Hex("#1bd0d04c"),
 		Text:       gothememe.Hex("#1bd0d0"),
 	}
 }
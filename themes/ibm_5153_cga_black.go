@@ -45,32 +45,32 @@ func (t *themeIbm5153CgaBlack) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeIbm5153CgaBlack) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00c400").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00c400").WithAlpha(0.3),
+		Background: gothememe.Hex("#00c40019"),
+		Border:     gothememe.Hex("#00c4004c"),
 		Text:       gothememe.Hex("#00c400"),
 	}
 }
 
 func (t *themeIbm5153CgaBlack) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c47e00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c47e00").WithAlpha(0.3),
+		Background: gothememe.Hex("#c47e0019"),
+		Border:     gothememe.Hex("#c47e004c"),
 		Text:       gothememe.Hex("#c47e00"),
 	}
 }
 
 func (t *themeIbm5153CgaBlack) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c40000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c40000").WithAlpha(0.3),
+		Background: gothememe.Hex("#c4000019"),
+		Border:     gothememe.Hex("#c400004c"),
 		Text:       gothememe.Hex("#c40000"),
 	}
 }
 
 func (t *themeIbm5153CgaBlack) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00c4c4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00c4c4").WithAlpha(0.3),
+		Background: gothememe.Hex("#00c4c419"),
+		Border:     gothememe.Hex("#00c4c44c"),
 		Text:       gothememe.Hex("#00c4c4"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeIcGreenPpl) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeIcGreenPpl) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#41a638").WithAlpha(0.1),
-		Border:     gothememe.Hex("#41a638").WithAlpha(0.3),
+		Background: gothememe.Hex("#41a63819"),
+		Border:     gothememe.Hex("#41a6384c"),
 		Text:       gothememe.Hex("#41a638"),
 	}
 }
 
 func (t *themeIcGreenPpl) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#76a831").WithAlpha(0.1),
-		Border:     gothememe.Hex("#76a831").WithAlpha(0.3),
+		Background: gothememe.Hex("#76a83119"),
+		Border:     gothememe.Hex("#76a8314c"),
 		Text:       gothememe.Hex("#76a831"),
 	}
 }
 
 func (t *themeIcGreenPpl) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff2736").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff2736").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff273619"),
+		Border:     gothememe.Hex("#ff27364c"),
 		Text:       gothememe.Hex("#ff2736"),
 	}
 }
 
 func (t *themeIcGreenPpl) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3ca078").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3ca078").WithAlpha(0.3),
+		Background: gothememe.Hex("#3ca07819"),
+		Border:     gothememe.Hex("#3ca0784c"),
 		Text:       gothememe.Hex("#3ca078"),
 	}
 }
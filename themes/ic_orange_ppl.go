@@ -45,32 +45,32 @@ func (t *themeIcOrangePpl) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeIcOrangePpl) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a4a900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a4a900").WithAlpha(0.3),
+		Background: gothememe.Hex("#a4a90019"),
+		Border:     gothememe.Hex("#a4a9004c"),
 		Text:       gothememe.Hex("#a4a900"),
 	}
 }
 
 func (t *themeIcOrangePpl) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#caaf00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#caaf00").WithAlpha(0.3),
+		Background: gothememe.Hex("#caaf0019"),
+		Border:     gothememe.Hex("#caaf004c"),
 		Text:       gothememe.Hex("#caaf00"),
 	}
 }
 
 func (t *themeIcOrangePpl) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c13900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c13900").WithAlpha(0.3),
+		Background: gothememe.Hex("#c1390019"),
+		Border:     gothememe.Hex("#c139004c"),
 		Text:       gothememe.Hex("#c13900"),
 	}
 }
 
 func (t *themeIcOrangePpl) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f79500").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f79500").WithAlpha(0.3),
+		Background: gothememe.Hex("#f7950019"),
+		Border:     gothememe.Hex("#f795004c"),
 		Text:       gothememe.Hex("#f79500"),
 	}
 }
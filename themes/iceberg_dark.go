@@ -45,32 +45,32 @@ func (t *themeIcebergDark) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeIcebergDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b4be82").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b4be82").WithAlpha(0.3),
+		Background: gothememe.Hex("#b4be8219"),
+		Border:     gothememe.Hex("#b4be824c"),
 		Text:       gothememe.Hex("#b4be82"),
 	}
 }
 
 func (t *themeIcebergDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e2a478").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e2a478").WithAlpha(0.3),
+		Background: gothememe.Hex("#e2a47819"),
+		Border:     gothememe.Hex("#e2a4784c"),
 		Text:       gothememe.Hex("#e2a478"),
 	}
 }
 
 func (t *themeIcebergDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e27878").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e27878").WithAlpha(0.3),
+		Background: gothememe.Hex("#e2787819"),
+		Border:     gothememe.Hex("#e278784c"),
 		Text:       gothememe.Hex("#e27878"),
 	}
 }
 
 func (t *themeIcebergDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#89b8c2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#89b8c2").WithAlpha(0.3),
+		Background: gothememe.Hex("#89b8c219"),
+		Border:     gothememe.Hex("#89b8c24c"),
 		Text:       gothememe.Hex("#89b8c2"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeIcebergLight) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeIcebergLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#668e3d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#668e3d").WithAlpha(0.3),
+		Background: gothememe.Hex("#668e3d19"),
+		Border:     gothememe.Hex("#668e3d4c"),
 		Text:       gothememe.Hex("#668e3d"),
 	}
 }
 
 func (t *themeIcebergLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c57339").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c57339").WithAlpha(0.3),
+		Background: gothememe.Hex("#c5733919"),
+		Border:     gothememe.Hex("#c573394c"),
 		Text:       gothememe.Hex("#c57339"),
 	}
 }
 
 func (t *themeIcebergLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc517a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc517a").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc517a19"),
+		Border:     gothememe.Hex("#cc517a4c"),
 		Text:       gothememe.Hex("#cc517a"),
 	}
 }
 
 func (t *themeIcebergLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3f83a6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3f83a6").WithAlpha(0.3),
+		Background: gothememe.Hex("#3f83a619"),
+		Border:     gothememe.Hex("#3f83a64c"),
 		Text:       gothememe.Hex("#3f83a6"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeIdea) BorderStrong() gothememe.Color { return gothememe.Hex("#4b4b
 // Semantic colors
 func (t *themeIdea) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98b61c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98b61c").WithAlpha(0.3),
+		Background: gothememe.Hex("#98b61c19"),
+		Border:     gothememe.Hex("#98b61c4c"),
 		Text:       gothememe.Hex("#98b61c"),
 	}
 }
 
 func (t *themeIdea) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ccb444").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ccb444").WithAlpha(0.3),
+		Background: gothememe.Hex("#ccb44419"),
+		Border:     gothememe.Hex("#ccb4444c"),
 		Text:       gothememe.Hex("#ccb444"),
 	}
 }
 
 func (t *themeIdea) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fc5256").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fc5256").WithAlpha(0.3),
+		Background: gothememe.Hex("#fc525619"),
+		Border:     gothememe.Hex("#fc52564c"),
 		Text:       gothememe.Hex("#fc5256"),
 	}
 }
 
 func (t *themeIdea) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#248887").WithAlpha(0.1),
-		Border:     gothememe.Hex("#248887").WithAlpha(0.3),
+		Background: gothememe.Hex("#24888719"),
+		Border:     gothememe.Hex("#2488874c"),
 		Text:       gothememe.Hex("#248887"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeIdleToes) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeIdleToes) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7fe173").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7fe173").WithAlpha(0.3),
+		Background: gothememe.Hex("#7fe17319"),
+		Border:     gothememe.Hex("#7fe1734c"),
 		Text:       gothememe.Hex("#7fe173"),
 	}
 }
 
 func (t *themeIdleToes) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc66d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc66d").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc66d19"),
+		Border:     gothememe.Hex("#ffc66d4c"),
 		Text:       gothememe.Hex("#ffc66d"),
 	}
 }
 
 func (t *themeIdleToes) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d25252").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d25252").WithAlpha(0.3),
+		Background: gothememe.Hex("#d2525219"),
+		Border:     gothememe.Hex("#d252524c"),
 		Text:       gothememe.Hex("#d25252"),
 	}
 }
 
 func (t *themeIdleToes) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bed6ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bed6ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#bed6ff19"),
+		Border:     gothememe.Hex("#bed6ff4c"),
 		Text:       gothememe.Hex("#bed6ff"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeIrBlack) BorderStrong() gothememe.Color { return gothememe.Hex("#e
 // Semantic colors
 func (t *themeIrBlack) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a8ff60").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a8ff60").WithAlpha(0.3),
+		Background: gothememe.Hex("#a8ff6019"),
+		Border:     gothememe.Hex("#a8ff604c"),
 		Text:       gothememe.Hex("#a8ff60"),
 	}
 }
 
 func (t *themeIrBlack) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fffeb7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fffeb7").WithAlpha(0.3),
+		Background: gothememe.Hex("#fffeb719"),
+		Border:     gothememe.Hex("#fffeb74c"),
 		Text:       gothememe.Hex("#fffeb7"),
 	}
 }
 
 func (t *themeIrBlack) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fa6c60").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fa6c60").WithAlpha(0.3),
+		Background: gothememe.Hex("#fa6c6019"),
+		Border:     gothememe.Hex("#fa6c604c"),
 		Text:       gothememe.Hex("#fa6c60"),
 	}
 }
 
 func (t *themeIrBlack) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c6c5fe").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c6c5fe").WithAlpha(0.3),
+		Background: gothememe.Hex("#c6c5fe19"),
+		Border:     gothememe.Hex("#c6c5fe4c"),
 		Text:       gothememe.Hex("#c6c5fe"),
 	}
 }
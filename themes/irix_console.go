@@ -45,32 +45,32 @@ func (t *themeIrixConsole) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeIrixConsole) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#37a327").WithAlpha(0.1),
-		Border:     gothememe.Hex("#37a327").WithAlpha(0.3),
+		Background: gothememe.Hex("#37a32719"),
+		Border:     gothememe.Hex("#37a3274c"),
 		Text:       gothememe.Hex("#37a327"),
 	}
 }
 
 func (t *themeIrixConsole) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c29d28").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c29d28").WithAlpha(0.3),
+		Background: gothememe.Hex("#c29d2819"),
+		Border:     gothememe.Hex("#c29d284c"),
 		Text:       gothememe.Hex("#c29d28"),
 	}
 }
 
 func (t *themeIrixConsole) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d42426").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d42426").WithAlpha(0.3),
+		Background: gothememe.Hex("#d4242619"),
+		Border:     gothememe.Hex("#d424264c"),
 		Text:       gothememe.Hex("#d42426"),
 	}
 }
 
 func (t *themeIrixConsole) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4497df").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4497df").WithAlpha(0.3),
+		Background: gothememe.Hex("#4497df19"),
+		Border:     gothememe.Hex("#4497df4c"),
 		Text:       gothememe.Hex("#4497df"),
 	}
 }
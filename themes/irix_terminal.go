@@ -45,32 +45,32 @@ func (t *themeIrixTerminal) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeIrixTerminal) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#57ff3d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#57ff3d").WithAlpha(0.3),
+		Background: gothememe.Hex("#57ff3d19"),
+		Border:     gothememe.Hex("#57ff3d4c"),
 		Text:       gothememe.Hex("#57ff3d"),
 	}
 }
 
 func (t *themeIrixTerminal) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffff44").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffff44").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffff4419"),
+		Border:     gothememe.Hex("#ffff444c"),
 		Text:       gothememe.Hex("#ffff44"),
 	}
 }
 
 func (t *themeIrixTerminal) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff2b1e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff2b1e").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff2b1e19"),
+		Border:     gothememe.Hex("#ff2b1e4c"),
 		Text:       gothememe.Hex("#ff2b1e"),
 	}
 }
 
 func (t *themeIrixTerminal) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#56ffff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#56ffff").WithAlpha(0.3),
+		Background: gothememe.Hex("#56ffff19"),
+		Border:     gothememe.Hex("#56ffff4c"),
 		Text:       gothememe.Hex("#56ffff"),
 	}
 }
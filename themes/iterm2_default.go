@@ -45,32 +45,32 @@ func (t *themeIterm2Default) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeIterm2Default) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00c200").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00c200").WithAlpha(0.3),
+		Background: gothememe.Hex("#00c20019"),
+		Border:     gothememe.Hex("#00c2004c"),
 		Text:       gothememe.Hex("#00c200"),
 	}
 }
 
 func (t *themeIterm2Default) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c7c400").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c7c400").WithAlpha(0.3),
+		Background: gothememe.Hex("#c7c40019"),
+		Border:     gothememe.Hex("#c7c4004c"),
 		Text:       gothememe.Hex("#c7c400"),
 	}
 }
 
 func (t *themeIterm2Default) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c91b00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c91b00").WithAlpha(0.3),
+		Background: gothememe.Hex("#c91b0019"),
+		Border:     gothememe.Hex("#c91b004c"),
 		Text:       gothememe.Hex("#c91b00"),
 	}
 }
 
 func (t *themeIterm2Default) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00c5c7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00c5c7").WithAlpha(0.3),
+		Background: gothememe.Hex("#00c5c719"),
+		Border:     gothememe.Hex("#00c5c74c"),
 		Text:       gothememe.Hex("#00c5c7"),
 	}
 }
@@ -69,32 +69,32 @@ func (t *themeIterm2PastelDarkBackground) BorderStrong() gothememe.Color {
 // Semantic colors
 func (t *themeIterm2PastelDarkBackground) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b4fb73").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b4fb73").WithAlpha(0.3),
+		Background: gothememe.Hex("#b4fb7319"),
+		Border:     gothememe.Hex("#b4fb734c"),
 		Text:       gothememe.Hex("#b4fb73"),
 	}
 }
 
 func (t *themeIterm2PastelDarkBackground) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fffdc3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fffdc3").WithAlpha(0.3),
+		Background: gothememe.Hex("#fffdc319"),
+		Border:     gothememe.Hex("#fffdc34c"),
 		Text:       gothememe.Hex("#fffdc3"),
 	}
 }
 
 func (t *themeIterm2PastelDarkBackground) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff8373").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff8373").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff837319"),
+		Border:     gothememe.Hex("#ff83734c"),
 		Text:       gothememe.Hex("#ff8373"),
 	}
 }
 
 func (t *themeIterm2PastelDarkBackground) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d1d1fe").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d1d1fe").WithAlpha(0.3),
+		Background: gothememe.Hex("#d1d1fe19"),
+		Border:     gothememe.Hex("#d1d1fe4c"),
 		Text:       gothememe.Hex("#d1d1fe"),
 	}
 }
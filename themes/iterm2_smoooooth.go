@@ -45,32 +45,32 @@ func (t *themeIterm2Smoooooth) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeIterm2Smoooooth) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00c200").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00c200").WithAlpha(0.3),
+		Background: gothememe.Hex("#00c20019"),
+		Border:     gothememe.Hex("#00c2004c"),
 		Text:       gothememe.Hex("#00c200"),
 	}
 }
 
 func (t *themeIterm2Smoooooth) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c7c400").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c7c400").WithAlpha(0.3),
+		Background: gothememe.Hex("#c7c40019"),
+		Border:     gothememe.Hex("#c7c4004c"),
 		Text:       gothememe.Hex("#c7c400"),
 	}
 }
 
 func (t *themeIterm2Smoooooth) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b43c2a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b43c2a").WithAlpha(0.3),
+		Background: gothememe.Hex("#b43c2a19"),
+		Border:     gothememe.Hex("#b43c2a4c"),
 		Text:       gothememe.Hex("#b43c2a"),
 	}
 }
 
 func (t *themeIterm2Smoooooth) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00c5c7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00c5c7").WithAlpha(0.3),
+		Background: gothememe.Hex("#00c5c719"),
+		Border:     gothememe.Hex("#00c5c74c"),
 		Text:       gothememe.Hex("#00c5c7"),
 	}
 }
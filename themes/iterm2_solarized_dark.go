@@ -49,32 +49,32 @@ func (t *themeIterm2SolarizedDark) BorderStrong() gothememe.Color { return gothe
 // Semantic colors
 func (t *themeIterm2SolarizedDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#859900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#859900").WithAlpha(0.3),
+		Background: gothememe.Hex("#85990019"),
+		Border:     gothememe.Hex("#8599004c"),
 		Text:       gothememe.Hex("#859900"),
 	}
 }
 
 func (t *themeIterm2SolarizedDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b58900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b58900").WithAlpha(0.3),
+		Background: gothememe.Hex("#b5890019"),
+		Border:     gothememe.Hex("#b589004c"),
 		Text:       gothememe.Hex("#b58900"),
 	}
 }
 
 func (t *themeIterm2SolarizedDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dc322f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dc322f").WithAlpha(0.3),
+		Background: gothememe.Hex("#dc322f19"),
+		Border:     gothememe.Hex("#dc322f4c"),
 		Text:       gothememe.Hex("#dc322f"),
 	}
 }
 
 func (t *themeIterm2SolarizedDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2aa198").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2aa198").WithAlpha(0.3),
+		Background: gothememe.Hex("#2aa19819"),
+		Border:     gothememe.Hex("#2aa1984c"),
 		Text:       gothememe.Hex("#2aa198"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeIterm2TangoDark) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeIterm2TangoDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5ea702").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5ea702").WithAlpha(0.3),
+		Background: gothememe.Hex("#5ea70219"),
+		Border:     gothememe.Hex("#5ea7024c"),
 		Text:       gothememe.Hex("#5ea702"),
 	}
 }
 
 func (t *themeIterm2TangoDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cfae00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cfae00").WithAlpha(0.3),
+		Background: gothememe.Hex("#cfae0019"),
+		Border:     gothememe.Hex("#cfae004c"),
 		Text:       gothememe.Hex("#cfae00"),
 	}
 }
 
 func (t *themeIterm2TangoDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d81e00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d81e00").WithAlpha(0.3),
+		Background: gothememe.Hex("#d81e0019"),
+		Border:     gothememe.Hex("#d81e004c"),
 		Text:       gothememe.Hex("#d81e00"),
 	}
 }
 
 func (t *themeIterm2TangoDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00a7aa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00a7aa").WithAlpha(0.3),
+		Background: gothememe.Hex("#00a7aa19"),
+		Border:     gothememe.Hex("#00a7aa4c"),
 		Text:       gothememe.Hex("#00a7aa"),
 	}
 }
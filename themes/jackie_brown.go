@@ -45,32 +45,32 @@ func (t *themeJackieBrown) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeJackieBrown) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2baf2b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2baf2b").WithAlpha(0.3),
+		Background: gothememe.Hex("#2baf2b19"),
+		Border:     gothememe.Hex("#2baf2b4c"),
 		Text:       gothememe.Hex("#2baf2b"),
 	}
 }
 
 func (t *themeJackieBrown) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bebf00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bebf00").WithAlpha(0.3),
+		Background: gothememe.Hex("#bebf0019"),
+		Border:     gothememe.Hex("#bebf004c"),
 		Text:       gothememe.Hex("#bebf00"),
 	}
 }
 
 func (t *themeJackieBrown) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ef5734").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ef5734").WithAlpha(0.3),
+		Background: gothememe.Hex("#ef573419"),
+		Border:     gothememe.Hex("#ef57344c"),
 		Text:       gothememe.Hex("#ef5734"),
 	}
 }
 
 func (t *themeJackieBrown) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00acee").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00acee").WithAlpha(0.3),
+		Background: gothememe.Hex("#00acee19"),
+		Border:     gothememe.Hex("#00acee4c"),
 		Text:       gothememe.Hex("#00acee"),
 	}
 }
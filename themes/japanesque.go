@@ -43,32 +43,32 @@ func (t *themeJapanesque) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeJapanesque) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7bb75b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7bb75b").WithAlpha(0.3),
+		Background: gothememe.Hex("#7bb75b19"),
+		Border:     gothememe.Hex("#7bb75b4c"),
 		Text:       gothememe.Hex("#7bb75b"),
 	}
 }
 
 func (t *themeJapanesque) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e9b32a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e9b32a").WithAlpha(0.3),
+		Background: gothememe.Hex("#e9b32a19"),
+		Border:     gothememe.Hex("#e9b32a4c"),
 		Text:       gothememe.Hex("#e9b32a"),
 	}
 }
 
 func (t *themeJapanesque) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cf3f61").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cf3f61").WithAlpha(0.3),
+		Background: gothememe.Hex("#cf3f6119"),
+		Border:     gothememe.Hex("#cf3f614c"),
 		Text:       gothememe.Hex("#cf3f61"),
 	}
 }
 
 func (t *themeJapanesque) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#389aad").WithAlpha(0.1),
-		Border:     gothememe.Hex("#389aad").WithAlpha(0.3),
+		Background: gothememe.Hex("#389aad19"),
+		Border:     gothememe.Hex("#389aad4c"),
 		Text:       gothememe.Hex("#389aad"),
 	}
 }
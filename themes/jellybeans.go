@@ -43,32 +43,32 @@ func (t *themeJellybeans) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeJellybeans) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#94b979").WithAlpha(0.1),
-		Border:     gothememe.Hex("#94b979").WithAlpha(0.3),
+		Background: gothememe.Hex("#94b97919"),
+		Border:     gothememe.Hex("#94b9794c"),
 		Text:       gothememe.Hex("#94b979"),
 	}
 }
 
 func (t *themeJellybeans) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffba7b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffba7b").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffba7b19"),
+		Border:     gothememe.Hex("#ffba7b4c"),
 		Text:       gothememe.Hex("#ffba7b"),
 	}
 }
 
 func (t *themeJellybeans) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e27373").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e27373").WithAlpha(0.3),
+		Background: gothememe.Hex("#e2737319"),
+		Border:     gothememe.Hex("#e273734c"),
 		Text:       gothememe.Hex("#e27373"),
 	}
 }
 
 func (t *themeJellybeans) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00988e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00988e").WithAlpha(0.3),
+		Background: gothememe.Hex("#00988e19"),
+		Border:     gothememe.Hex("#00988e4c"),
 		Text:       gothememe.Hex("#00988e"),
 	}
 }
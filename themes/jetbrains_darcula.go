@@ -47,32 +47,32 @@ func (t *themeJetbrainsDarcula) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeJetbrainsDarcula) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#126e00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#126e00").WithAlpha(0.3),
+		Background: gothememe.Hex("#126e0019"),
+		Border:     gothememe.Hex("#126e004c"),
 		Text:       gothememe.Hex("#126e00"),
 	}
 }
 
 func (t *themeJetbrainsDarcula) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c2c300").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c2c300").WithAlpha(0.3),
+		Background: gothememe.Hex("#c2c30019"),
+		Border:     gothememe.Hex("#c2c3004c"),
 		Text:       gothememe.Hex("#c2c300"),
 	}
 }
 
 func (t *themeJetbrainsDarcula) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fa5355").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fa5355").WithAlpha(0.3),
+		Background: gothememe.Hex("#fa535519"),
+		Border:     gothememe.Hex("#fa53554c"),
 		Text:       gothememe.Hex("#fa5355"),
 	}
 }
 
 func (t *themeJetbrainsDarcula) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#33c2c1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#33c2c1").WithAlpha(0.3),
+		Background: gothememe.Hex("#33c2c119"),
+		Border:     gothememe.Hex("#33c2c14c"),
 		Text:       gothememe.Hex("#33c2c1"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeJubi) BorderStrong() gothememe.Color { return gothememe.Hex("#c3d3
 // Semantic colors
 func (t *themeJubi) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#90a94b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#90a94b").WithAlpha(0.3),
+		Background: gothememe.Hex("#90a94b19"),
+		Border:     gothememe.Hex("#90a94b4c"),
 		Text:       gothememe.Hex("#90a94b"),
 	}
 }
 
 func (t *themeJubi) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6ebfc0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6ebfc0").WithAlpha(0.3),
+		Background: gothememe.Hex("#6ebfc019"),
+		Border:     gothememe.Hex("#6ebfc04c"),
 		Text:       gothememe.Hex("#6ebfc0"),
 	}
 }
 
 func (t *themeJubi) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cf7b98").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cf7b98").WithAlpha(0.3),
+		Background: gothememe.Hex("#cf7b9819"),
+		Border:     gothememe.Hex("#cf7b984c"),
 		Text:       gothememe.Hex("#cf7b98"),
 	}
 }
 
 func (t *themeJubi) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#75a7d2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#75a7d2").WithAlpha(0.3),
+		Background: gothememe.Hex("#75a7d219"),
+		Border:     gothememe.Hex("#75a7d24c"),
 		Text:       gothememe.Hex("#75a7d2"),
 	}
 }
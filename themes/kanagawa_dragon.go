@@ -45,32 +45,32 @@ func (t *themeKanagawaDragon) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeKanagawaDragon) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8a9a7b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8a9a7b").WithAlpha(0.3),
+		Background: gothememe.Hex("#8a9a7b19"),
+		Border:     gothememe.Hex("#8a9a7b4c"),
 		Text:       gothememe.Hex("#8a9a7b"),
 	}
 }
 
 func (t *themeKanagawaDragon) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c4b28a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c4b28a").WithAlpha(0.3),
+		Background: gothememe.Hex("#c4b28a19"),
+		Border:     gothememe.Hex("#c4b28a4c"),
 		Text:       gothememe.Hex("#c4b28a"),
 	}
 }
 
 func (t *themeKanagawaDragon) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c4746e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c4746e").WithAlpha(0.3),
+		Background: gothememe.Hex("#c4746e19"),
+		Border:     gothememe.Hex("#c4746e4c"),
 		Text:       gothememe.Hex("#c4746e"),
 	}
 }
 
 func (t *themeKanagawaDragon) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8ea4a2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8ea4a2").WithAlpha(0.3),
+		Background: gothememe.Hex("#8ea4a219"),
+		Border:     gothememe.Hex("#8ea4a24c"),
 		Text:       gothememe.Hex("#8ea4a2"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeKanagawaWave) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeKanagawaWave) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#76946a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#76946a").WithAlpha(0.3),
+		Background: gothememe.Hex("#76946a19"),
+		Border:     gothememe.Hex("#76946a4c"),
 		Text:       gothememe.Hex("#76946a"),
 	}
 }
 
 func (t *themeKanagawaWave) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c0a36e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c0a36e").WithAlpha(0.3),
+		Background: gothememe.Hex("#c0a36e19"),
+		Border:     gothememe.Hex("#c0a36e4c"),
 		Text:       gothememe.Hex("#c0a36e"),
 	}
 }
 
 func (t *themeKanagawaWave) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c34043").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c34043").WithAlpha(0.3),
+		Background: gothememe.Hex("#c3404319"),
+		Border:     gothememe.Hex("#c340434c"),
 		Text:       gothememe.Hex("#c34043"),
 	}
 }
 
 func (t *themeKanagawaWave) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6a9589").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6a9589").WithAlpha(0.3),
+		Background: gothememe.Hex("#6a958919"),
+		Border:     gothememe.Hex("#6a95894c"),
 		Text:       gothememe.Hex("#6a9589"),
 	}
 }
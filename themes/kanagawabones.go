@@ -45,32 +45,32 @@ func (t *themeKanagawabones) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeKanagawabones) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98bc6d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98bc6d").WithAlpha(0.3),
+		Background: gothememe.Hex("#98bc6d19"),
+		Border:     gothememe.Hex("#98bc6d4c"),
 		Text:       gothememe.Hex("#98bc6d"),
 	}
 }
 
 func (t *themeKanagawabones) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e5c283").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e5c283").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5c28319"),
+		Border:     gothememe.Hex("#e5c2834c"),
 		Text:       gothememe.Hex("#e5c283"),
 	}
 }
 
 func (t *themeKanagawabones) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e46a78").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e46a78").WithAlpha(0.3),
+		Background: gothememe.Hex("#e46a7819"),
+		Border:     gothememe.Hex("#e46a784c"),
 		Text:       gothememe.Hex("#e46a78"),
 	}
 }
 
 func (t *themeKanagawabones) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7eb3c9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7eb3c9").WithAlpha(0.3),
+		Background: gothememe.Hex("#7eb3c919"),
+		Border:     gothememe.Hex("#7eb3c94c"),
 		Text:       gothememe.Hex("#7eb3c9"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeKibble) BorderStrong() gothememe.Color { return gothememe.Hex("#e2
 // Semantic colors
 func (t *themeKibble) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#29cf13").WithAlpha(0.1),
-		Border:     gothememe.Hex("#29cf13").WithAlpha(0.3),
+		Background: gothememe.Hex("#29cf1319"),
+		Border:     gothememe.Hex("#29cf134c"),
 		Text:       gothememe.Hex("#29cf13"),
 	}
 }
 
 func (t *themeKibble) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d8e30e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d8e30e").WithAlpha(0.3),
+		Background: gothememe.Hex("#d8e30e19"),
+		Border:     gothememe.Hex("#d8e30e4c"),
 		Text:       gothememe.Hex("#d8e30e"),
 	}
 }
 
 func (t *themeKibble) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c70031").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c70031").WithAlpha(0.3),
+		Background: gothememe.Hex("#c7003119"),
+		Border:     gothememe.Hex("#c700314c"),
 		Text:       gothememe.Hex("#c70031"),
 	}
 }
 
 func (t *themeKibble) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0798ab").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0798ab").WithAlpha(0.3),
+		Background: gothememe.Hex("#0798ab19"),
+		Border:     gothememe.Hex("#0798ab4c"),
 		Text:       gothememe.Hex("#0798ab"),
 	}
 }
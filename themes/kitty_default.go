@@ -45,32 +45,32 @@ func (t *themeKittyDefault) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeKittyDefault) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#19cb00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#19cb00").WithAlpha(0.3),
+		Background: gothememe.Hex("#19cb0019"),
+		Border:     gothememe.Hex("#19cb004c"),
 		Text:       gothememe.Hex("#19cb00"),
 	}
 }
 
 func (t *themeKittyDefault) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cecb00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cecb00").WithAlpha(0.3),
+		Background: gothememe.Hex("#cecb0019"),
+		Border:     gothememe.Hex("#cecb004c"),
 		Text:       gothememe.Hex("#cecb00"),
 	}
 }
 
 func (t *themeKittyDefault) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc0403").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc0403").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc040319"),
+		Border:     gothememe.Hex("#cc04034c"),
 		Text:       gothememe.Hex("#cc0403"),
 	}
 }
 
 func (t *themeKittyDefault) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0dcdcd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0dcdcd").WithAlpha(0.3),
+		Background: gothememe.Hex("#0dcdcd19"),
+		Border:     gothememe.Hex("#0dcdcd4c"),
 		Text:       gothememe.Hex("#0dcdcd"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeKolorit) BorderStrong() gothememe.Color { return gothememe.Hex("#e
 // Semantic colors
 func (t *themeKolorit) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#47d7a1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#47d7a1").WithAlpha(0.3),
+		Background: gothememe.Hex("#47d7a119"),
+		Border:     gothememe.Hex("#47d7a14c"),
 		Text:       gothememe.Hex("#47d7a1"),
 	}
 }
 
 func (t *themeKolorit) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e8e562").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e8e562").WithAlpha(0.3),
+		Background: gothememe.Hex("#e8e56219"),
+		Border:     gothememe.Hex("#e8e5624c"),
 		Text:       gothememe.Hex("#e8e562"),
 	}
 }
 
 func (t *themeKolorit) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff5b82").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff5b82").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff5b8219"),
+		Border:     gothememe.Hex("#ff5b824c"),
 		Text:       gothememe.Hex("#ff5b82"),
 	}
 }
 
 func (t *themeKolorit) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#57e9eb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#57e9eb").WithAlpha(0.3),
+		Background: gothememe.Hex("#57e9eb19"),
+		Border:     gothememe.Hex("#57e9eb4c"),
 		Text:       gothememe.Hex("#57e9eb"),
 	}
 }
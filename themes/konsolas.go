@@ -43,32 +43,32 @@ func (t *themeKonsolas) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeKonsolas) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#18b218").WithAlpha(0.1),
-		Border:     gothememe.Hex("#18b218").WithAlpha(0.3),
+		Background: gothememe.Hex("#18b21819"),
+		Border:     gothememe.Hex("#18b2184c"),
 		Text:       gothememe.Hex("#18b218"),
 	}
 }
 
 func (t *themeKonsolas) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ebae1f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ebae1f").WithAlpha(0.3),
+		Background: gothememe.Hex("#ebae1f19"),
+		Border:     gothememe.Hex("#ebae1f4c"),
 		Text:       gothememe.Hex("#ebae1f"),
 	}
 }
 
 func (t *themeKonsolas) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aa1717").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aa1717").WithAlpha(0.3),
+		Background: gothememe.Hex("#aa171719"),
+		Border:     gothememe.Hex("#aa17174c"),
 		Text:       gothememe.Hex("#aa1717"),
 	}
 }
 
 func (t *themeKonsolas) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#42b0c8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#42b0c8").WithAlpha(0.3),
+		Background: gothememe.Hex("#42b0c819"),
+		Border:     gothememe.Hex("#42b0c84c"),
 		Text:       gothememe.Hex("#42b0c8"),
 	}
 }
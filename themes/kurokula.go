@@ -43,32 +43,32 @@ func (t *themeKurokula) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeKurokula) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#78b3a9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#78b3a9").WithAlpha(0.3),
+		Background: gothememe.Hex("#78b3a919"),
+		Border:     gothememe.Hex("#78b3a94c"),
 		Text:       gothememe.Hex("#78b3a9"),
 	}
 }
 
 func (t *themeKurokula) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e1b917").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e1b917").WithAlpha(0.3),
+		Background: gothememe.Hex("#e1b91719"),
+		Border:     gothememe.Hex("#e1b9174c"),
 		Text:       gothememe.Hex("#e1b917"),
 	}
 }
 
 func (t *themeKurokula) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c35a52").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c35a52").WithAlpha(0.3),
+		Background: gothememe.Hex("#c35a5219"),
+		Border:     gothememe.Hex("#c35a524c"),
 		Text:       gothememe.Hex("#c35a52"),
 	}
 }
 
 func (t *themeKurokula) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#867268").WithAlpha(0.1),
-		Border:     gothememe.Hex("#867268").WithAlpha(0.3),
+		Background: gothememe.Hex("#86726819"),
+		Border:     gothememe.Hex("#8672684c"),
 		Text:       gothememe.Hex("#867268"),
 	}
 }
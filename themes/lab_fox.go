@@ -43,32 +43,32 @@ func (t *themeLabFox) BorderStrong() gothememe.Color { return gothememe.Hex("#ff
 // Semantic colors
 func (t *themeLabFox) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3eb383").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3eb383").WithAlpha(0.3),
+		Background: gothememe.Hex("#3eb38319"),
+		Border:     gothememe.Hex("#3eb3834c"),
 		Text:       gothememe.Hex("#3eb383"),
 	}
 }
 
 func (t *themeLabFox) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fca121").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fca121").WithAlpha(0.3),
+		Background: gothememe.Hex("#fca12119"),
+		Border:     gothememe.Hex("#fca1214c"),
 		Text:       gothememe.Hex("#fca121"),
 	}
 }
 
 func (t *themeLabFox) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fc6d26").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fc6d26").WithAlpha(0.3),
+		Background: gothememe.Hex("#fc6d2619"),
+		Border:     gothememe.Hex("#fc6d264c"),
 		Text:       gothememe.Hex("#fc6d26"),
 	}
 }
 
 func (t *themeLabFox) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6e49cb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6e49cb").WithAlpha(0.3),
+		Background: gothememe.Hex("#6e49cb19"),
+		Border:     gothememe.Hex("#6e49cb4c"),
 		Text:       gothememe.Hex("#6e49cb"),
 	}
 }
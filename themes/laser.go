@@ -43,32 +43,32 @@ func (t *themeLaser) BorderStrong() gothememe.Color { return gothememe.Hex("#f1f
 // Semantic colors
 func (t *themeLaser) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b4fb73").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b4fb73").WithAlpha(0.3),
+		Background: gothememe.Hex("#b4fb7319"),
+		Border:     gothememe.Hex("#b4fb734c"),
 		Text:       gothememe.Hex("#b4fb73"),
 	}
 }
 
 func (t *themeLaser) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#09b4bd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#09b4bd").WithAlpha(0.3),
+		Background: gothememe.Hex("#09b4bd19"),
+		Border:     gothememe.Hex("#09b4bd4c"),
 		Text:       gothememe.Hex("#09b4bd"),
 	}
 }
 
 func (t *themeLaser) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff8373").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff8373").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff837319"),
+		Border:     gothememe.Hex("#ff83734c"),
 		Text:       gothememe.Hex("#ff8373"),
 	}
 }
 
 func (t *themeLaser) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d1d1fe").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d1d1fe").WithAlpha(0.3),
+		Background: gothememe.Hex("#d1d1fe19"),
+		Border:     gothememe.Hex("#d1d1fe4c"),
 		Text:       gothememe.Hex("#d1d1fe"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeLaterThisEvening) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeLaterThisEvening) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#afba67").WithAlpha(0.1),
-		Border:     gothememe.Hex("#afba67").WithAlpha(0.3),
+		Background: gothememe.Hex("#afba6719"),
+		Border:     gothememe.Hex("#afba674c"),
 		Text:       gothememe.Hex("#afba67"),
 	}
 }
 
 func (t *themeLaterThisEvening) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e5d289").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e5d289").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5d28919"),
+		Border:     gothememe.Hex("#e5d2894c"),
 		Text:       gothememe.Hex("#e5d289"),
 	}
 }
 
 func (t *themeLaterThisEvening) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d45a60").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d45a60").WithAlpha(0.3),
+		Background: gothememe.Hex("#d45a6019"),
+		Border:     gothememe.Hex("#d45a604c"),
 		Text:       gothememe.Hex("#d45a60"),
 	}
 }
 
 func (t *themeLaterThisEvening) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#91bfb7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#91bfb7").WithAlpha(0.3),
+		Background: gothememe.Hex("#91bfb719"),
+		Border:     gothememe.Hex("#91bfb74c"),
 		Text:       gothememe.Hex("#91bfb7"),
 	}
 }
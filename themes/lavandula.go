@@ -43,32 +43,32 @@ func (t *themeLavandula) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeLavandula) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#337e6f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#337e6f").WithAlpha(0.3),
+		Background: gothememe.Hex("#337e6f19"),
+		Border:     gothememe.Hex("#337e6f4c"),
 		Text:       gothememe.Hex("#337e6f"),
 	}
 }
 
 func (t *themeLavandula) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7f6f49").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7f6f49").WithAlpha(0.3),
+		Background: gothememe.Hex("#7f6f4919"),
+		Border:     gothememe.Hex("#7f6f494c"),
 		Text:       gothememe.Hex("#7f6f49"),
 	}
 }
 
 func (t *themeLavandula) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7d1625").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7d1625").WithAlpha(0.3),
+		Background: gothememe.Hex("#7d162519"),
+		Border:     gothememe.Hex("#7d16254c"),
 		Text:       gothememe.Hex("#7d1625"),
 	}
 }
 
 func (t *themeLavandula) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#58777f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#58777f").WithAlpha(0.3),
+		Background: gothememe.Hex("#58777f19"),
+		Border:     gothememe.Hex("#58777f4c"),
 		Text:       gothememe.Hex("#58777f"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeLightOwl) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeLightOwl) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#08916a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#08916a").WithAlpha(0.3),
+		Background: gothememe.Hex("#08916a19"),
+		Border:     gothememe.Hex("#08916a4c"),
 		Text:       gothememe.Hex("#08916a"),
 	}
 }
 
 func (t *themeLightOwl) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e0af02").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e0af02").WithAlpha(0.3),
+		Background: gothememe.Hex("#e0af0219"),
+		Border:     gothememe.Hex("#e0af024c"),
 		Text:       gothememe.Hex("#e0af02"),
 	}
 }
 
 func (t *themeLightOwl) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#de3d3b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#de3d3b").WithAlpha(0.3),
+		Background: gothememe.Hex("#de3d3b19"),
+		Border:     gothememe.Hex("#de3d3b4c"),
 		Text:       gothememe.Hex("#de3d3b"),
 	}
 }
 
 func (t *themeLightOwl) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2aa298").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2aa298").WithAlpha(0.3),
+		Background: gothememe.Hex("#2aa29819"),
+		Border:     gothememe.Hex("#2aa2984c"),
 		Text:       gothememe.Hex("#2aa298"),
 	}
 }
@@ -61,32 +61,32 @@ func (t *themeLiquidCarbonTransparent) BorderStrong() gothememe.Color {
 // Semantic colors
 func (t *themeLiquidCarbonTransparent) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#559a70").WithAlpha(0.1),
-		Border:     gothememe.Hex("#559a70").WithAlpha(0.3),
+		Background: gothememe.Hex("#559a7019"),
+		Border:     gothememe.Hex("#559a704c"),
 		Text:       gothememe.Hex("#559a70"),
 	}
 }
 
 func (t *themeLiquidCarbonTransparent) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ccac00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ccac00").WithAlpha(0.3),
+		Background: gothememe.Hex("#ccac0019"),
+		Border:     gothememe.Hex("#ccac004c"),
 		Text:       gothememe.Hex("#ccac00"),
 	}
 }
 
 func (t *themeLiquidCarbonTransparent) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff3030").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff3030").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff303019"),
+		Border:     gothememe.Hex("#ff30304c"),
 		Text:       gothememe.Hex("#ff3030"),
 	}
 }
 
 func (t *themeLiquidCarbonTransparent) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7ac4cc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7ac4cc").WithAlpha(0.3),
+		Background: gothememe.Hex("#7ac4cc19"),
+		Border:     gothememe.Hex("#7ac4cc4c"),
 		Text:       gothememe.Hex("#7ac4cc"),
 	}
 }
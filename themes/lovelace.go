@@ -43,32 +43,32 @@ func (t *themeLovelace) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeLovelace) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5adecd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5adecd").WithAlpha(0.3),
+		Background: gothememe.Hex("#5adecd19"),
+		Border:     gothememe.Hex("#5adecd4c"),
 		Text:       gothememe.Hex("#5adecd"),
 	}
 }
 
 func (t *themeLovelace) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f2a272").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f2a272").WithAlpha(0.3),
+		Background: gothememe.Hex("#f2a27219"),
+		Border:     gothememe.Hex("#f2a2724c"),
 		Text:       gothememe.Hex("#f2a272"),
 	}
 }
 
 func (t *themeLovelace) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f37f97").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f37f97").WithAlpha(0.3),
+		Background: gothememe.Hex("#f37f9719"),
+		Border:     gothememe.Hex("#f37f974c"),
 		Text:       gothememe.Hex("#f37f97"),
 	}
 }
 
 func (t *themeLovelace) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#79e6f3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#79e6f3").WithAlpha(0.3),
+		Background: gothememe.Hex("#79e6f319"),
+		Border:     gothememe.Hex("#79e6f34c"),
 		Text:       gothememe.Hex("#79e6f3"),
 	}
 }
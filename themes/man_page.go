@@ -43,32 +43,32 @@ func (t *themeManPage) BorderStrong() gothememe.Color { return gothememe.Hex("#b
 // Semantic colors
 func (t *themeManPage) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00a600").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00a600").WithAlpha(0.3),
+		Background: gothememe.Hex("#00a60019"),
+		Border:     gothememe.Hex("#00a6004c"),
 		Text:       gothememe.Hex("#00a600"),
 	}
 }
 
 func (t *themeManPage) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#999900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#999900").WithAlpha(0.3),
+		Background: gothememe.Hex("#99990019"),
+		Border:     gothememe.Hex("#9999004c"),
 		Text:       gothememe.Hex("#999900"),
 	}
 }
 
 func (t *themeManPage) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc0000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc0000").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc000019"),
+		Border:     gothememe.Hex("#cc00004c"),
 		Text:       gothememe.Hex("#cc0000"),
 	}
 }
 
 func (t *themeManPage) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00a6b2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00a6b2").WithAlpha(0.3),
+		Background: gothememe.Hex("#00a6b219"),
+		Border:     gothememe.Hex("#00a6b24c"),
 		Text:       gothememe.Hex("#00a6b2"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeMariana) BorderStrong() gothememe.Color { return gothememe.Hex("#f
 // Semantic colors
 func (t *themeMariana) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#99c794").WithAlpha(0.1),
-		Border:     gothememe.Hex("#99c794").WithAlpha(0.3),
+		Background: gothememe.Hex("#99c79419"),
+		Border:     gothememe.Hex("#99c7944c"),
 		Text:       gothememe.Hex("#99c794"),
 	}
 }
 
 func (t *themeMariana) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f9ae58").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f9ae58").WithAlpha(0.3),
+		Background: gothememe.Hex("#f9ae5819"),
+		Border:     gothememe.Hex("#f9ae584c"),
 		Text:       gothememe.Hex("#f9ae58"),
 	}
 }
 
 func (t *themeMariana) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ec5f66").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ec5f66").WithAlpha(0.3),
+		Background: gothememe.Hex("#ec5f6619"),
+		Border:     gothememe.Hex("#ec5f664c"),
 		Text:       gothememe.Hex("#ec5f66"),
 	}
 }
 
 func (t *themeMariana) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5fb4b4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5fb4b4").WithAlpha(0.3),
+		Background: gothememe.Hex("#5fb4b419"),
+		Border:     gothememe.Hex("#5fb4b44c"),
 		Text:       gothememe.Hex("#5fb4b4"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeMaterial) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeMaterial) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#457b24").WithAlpha(0.1),
-		Border:     gothememe.Hex("#457b24").WithAlpha(0.3),
+		Background: gothememe.Hex("#457b2419"),
+		Border:     gothememe.Hex("#457b244c"),
 		Text:       gothememe.Hex("#457b24"),
 	}
 }
 
 func (t *themeMaterial) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f6981e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f6981e").WithAlpha(0.3),
+		Background: gothememe.Hex("#f6981e19"),
+		Border:     gothememe.Hex("#f6981e4c"),
 		Text:       gothememe.Hex("#f6981e"),
 	}
 }
 
 func (t *themeMaterial) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b7141f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b7141f").WithAlpha(0.3),
+		Background: gothememe.Hex("#b7141f19"),
+		Border:     gothememe.Hex("#b7141f4c"),
 		Text:       gothememe.Hex("#b7141f"),
 	}
 }
 
 func (t *themeMaterial) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0e717c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0e717c").WithAlpha(0.3),
+		Background: gothememe.Hex("#0e717c19"),
+		Border:     gothememe.Hex("#0e717c4c"),
 		Text:       gothememe.Hex("#0e717c"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeMaterialDarker) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeMaterialDarker) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c3e88d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c3e88d").WithAlpha(0.3),
+		Background: gothememe.Hex("#c3e88d19"),
+		Border:     gothememe.Hex("#c3e88d4c"),
 		Text:       gothememe.Hex("#c3e88d"),
 	}
 }
 
 func (t *themeMaterialDarker) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffcb6b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffcb6b").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffcb6b19"),
+		Border:     gothememe.Hex("#ffcb6b4c"),
 		Text:       gothememe.Hex("#ffcb6b"),
 	}
 }
 
 func (t *themeMaterialDarker) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff5370").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff5370").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff537019"),
+		Border:     gothememe.Hex("#ff53704c"),
 		Text:       gothememe.Hex("#ff5370"),
 	}
 }
 
 func (t *themeMaterialDarker) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#89ddff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#89ddff").WithAlpha(0.3),
+		Background: gothememe.Hex("#89ddff19"),
+		Border:     gothememe.Hex("#89ddff4c"),
 		Text:       gothememe.Hex("#89ddff"),
 	}
 }
@@ -51,32 +51,32 @@ func (t *themeMaterialDesignColors) BorderStrong() gothememe.Color { return goth
 // Semantic colors
 func (t *themeMaterialDesignColors) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5cf19e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5cf19e").WithAlpha(0.3),
+		Background: gothememe.Hex("#5cf19e19"),
+		Border:     gothememe.Hex("#5cf19e4c"),
 		Text:       gothememe.Hex("#5cf19e"),
 	}
 }
 
 func (t *themeMaterialDesignColors) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fed032").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fed032").WithAlpha(0.3),
+		Background: gothememe.Hex("#fed03219"),
+		Border:     gothememe.Hex("#fed0324c"),
 		Text:       gothememe.Hex("#fed032"),
 	}
 }
 
 func (t *themeMaterialDesignColors) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fc3841").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fc3841").WithAlpha(0.3),
+		Background: gothememe.Hex("#fc384119"),
+		Border:     gothememe.Hex("#fc38414c"),
 		Text:       gothememe.Hex("#fc3841"),
 	}
 }
 
 func (t *themeMaterialDesignColors) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#59ffd1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#59ffd1").WithAlpha(0.3),
+		Background: gothememe.Hex("#59ffd119"),
+		Border:     gothememe.Hex("#59ffd14c"),
 		Text:       gothememe.Hex("#59ffd1"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeMathias) BorderStrong() gothememe.Color { return gothememe.Hex("#f
 // Semantic colors
 func (t *themeMathias) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a6e32d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a6e32d").WithAlpha(0.3),
+		Background: gothememe.Hex("#a6e32d19"),
+		Border:     gothememe.Hex("#a6e32d4c"),
 		Text:       gothememe.Hex("#a6e32d"),
 	}
 }
 
 func (t *themeMathias) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fc951e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fc951e").WithAlpha(0.3),
+		Background: gothememe.Hex("#fc951e19"),
+		Border:     gothememe.Hex("#fc951e4c"),
 		Text:       gothememe.Hex("#fc951e"),
 	}
 }
 
 func (t *themeMathias) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e52222").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e52222").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5222219"),
+		Border:     gothememe.Hex("#e522224c"),
 		Text:       gothememe.Hex("#e52222"),
 	}
 }
 
 func (t *themeMathias) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#67d9f0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#67d9f0").WithAlpha(0.3),
+		Background: gothememe.Hex("#67d9f019"),
+		Border:     gothememe.Hex("#67d9f04c"),
 		Text:       gothememe.Hex("#67d9f0"),
 	}
 }
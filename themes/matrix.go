@@ -43,32 +43,32 @@ func (t *themeMatrix) BorderStrong() gothememe.Color { return gothememe.Hex("#50
 // Semantic colors
 func (t *themeMatrix) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#82d967").WithAlpha(0.1),
-		Border:     gothememe.Hex("#82d967").WithAlpha(0.3),
+		Background: gothememe.Hex("#82d96719"),
+		Border:     gothememe.Hex("#82d9674c"),
 		Text:       gothememe.Hex("#82d967"),
 	}
 }
 
 func (t *themeMatrix) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffd700").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffd700").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffd70019"),
+		Border:     gothememe.Hex("#ffd7004c"),
 		Text:       gothememe.Hex("#ffd700"),
 	}
 }
 
 func (t *themeMatrix) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#23755a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#23755a").WithAlpha(0.3),
+		Background: gothememe.Hex("#23755a19"),
+		Border:     gothememe.Hex("#23755a4c"),
 		Text:       gothememe.Hex("#23755a"),
 	}
 }
 
 func (t *themeMatrix) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#50b45a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#50b45a").WithAlpha(0.3),
+		Background: gothememe.Hex("#50b45a19"),
+		Border:     gothememe.Hex("#50b45a4c"),
 		Text:       gothememe.Hex("#50b45a"),
 	}
 }
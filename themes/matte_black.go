@@ -43,32 +43,32 @@ func (t *themeMatteBlack) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeMatteBlack) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc107").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc107").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc10719"),
+		Border:     gothememe.Hex("#ffc1074c"),
 		Text:       gothememe.Hex("#ffc107"),
 	}
 }
 
 func (t *themeMatteBlack) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b91c1c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b91c1c").WithAlpha(0.3),
+		Background: gothememe.Hex("#b91c1c19"),
+		Border:     gothememe.Hex("#b91c1c4c"),
 		Text:       gothememe.Hex("#b91c1c"),
 	}
 }
 
 func (t *themeMatteBlack) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d35f5f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d35f5f").WithAlpha(0.3),
+		Background: gothememe.Hex("#d35f5f19"),
+		Border:     gothememe.Hex("#d35f5f4c"),
 		Text:       gothememe.Hex("#d35f5f"),
 	}
 }
 
 func (t *themeMatteBlack) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bebebe").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bebebe").WithAlpha(0.3),
+		Background: gothememe.Hex("#bebebe19"),
+		Border:     gothememe.Hex("#bebebe4c"),
 		Text:       gothememe.Hex("#bebebe"),
 	}
 }
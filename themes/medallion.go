@@ -43,32 +43,32 @@ func (t *themeMedallion) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeMedallion) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7c8b16").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7c8b16").WithAlpha(0.3),
+		Background: gothememe.Hex("#7c8b1619"),
+		Border:     gothememe.Hex("#7c8b164c"),
 		Text:       gothememe.Hex("#7c8b16"),
 	}
 }
 
 func (t *themeMedallion) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d3bd26").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d3bd26").WithAlpha(0.3),
+		Background: gothememe.Hex("#d3bd2619"),
+		Border:     gothememe.Hex("#d3bd264c"),
 		Text:       gothememe.Hex("#d3bd26"),
 	}
 }
 
 func (t *themeMedallion) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b64c00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b64c00").WithAlpha(0.3),
+		Background: gothememe.Hex("#b64c0019"),
+		Border:     gothememe.Hex("#b64c004c"),
 		Text:       gothememe.Hex("#b64c00"),
 	}
 }
 
 func (t *themeMedallion) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#916c25").WithAlpha(0.1),
-		Border:     gothememe.Hex("#916c25").WithAlpha(0.3),
+		Background: gothememe.Hex("#916c2519"),
+		Border:     gothememe.Hex("#916c254c"),
 		Text:       gothememe.Hex("#916c25"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeMelangeDark) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeMelangeDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#78997a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#78997a").WithAlpha(0.3),
+		Background: gothememe.Hex("#78997a19"),
+		Border:     gothememe.Hex("#78997a4c"),
 		Text:       gothememe.Hex("#78997a"),
 	}
 }
 
 func (t *themeMelangeDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e49b5d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e49b5d").WithAlpha(0.3),
+		Background: gothememe.Hex("#e49b5d19"),
+		Border:     gothememe.Hex("#e49b5d4c"),
 		Text:       gothememe.Hex("#e49b5d"),
 	}
 }
 
 func (t *themeMelangeDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bd8183").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bd8183").WithAlpha(0.3),
+		Background: gothememe.Hex("#bd818319"),
+		Border:     gothememe.Hex("#bd81834c"),
 		Text:       gothememe.Hex("#bd8183"),
 	}
 }
 
 func (t *themeMelangeDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7b9695").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7b9695").WithAlpha(0.3),
+		Background: gothememe.Hex("#7b969519"),
+		Border:     gothememe.Hex("#7b96954c"),
 		Text:       gothememe.Hex("#7b9695"),
 	}
 }
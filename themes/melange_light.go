@@ -45,32 +45,32 @@ func (t *themeMelangeLight) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeMelangeLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6e9b72").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6e9b72").WithAlpha(0.3),
+		Background: gothememe.Hex("#6e9b7219"),
+		Border:     gothememe.Hex("#6e9b724c"),
 		Text:       gothememe.Hex("#6e9b72"),
 	}
 }
 
 func (t *themeMelangeLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bc5c00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bc5c00").WithAlpha(0.3),
+		Background: gothememe.Hex("#bc5c0019"),
+		Border:     gothememe.Hex("#bc5c004c"),
 		Text:       gothememe.Hex("#bc5c00"),
 	}
 }
 
 func (t *themeMelangeLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c77b8b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c77b8b").WithAlpha(0.3),
+		Background: gothememe.Hex("#c77b8b19"),
+		Border:     gothememe.Hex("#c77b8b4c"),
 		Text:       gothememe.Hex("#c77b8b"),
 	}
 }
 
 func (t *themeMelangeLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#739797").WithAlpha(0.1),
-		Border:     gothememe.Hex("#739797").WithAlpha(0.3),
+		Background: gothememe.Hex("#73979719"),
+		Border:     gothememe.Hex("#7397974c"),
 		Text:       gothememe.Hex("#739797"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeMellifluous) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeMellifluous) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b3b393").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b3b393").WithAlpha(0.3),
+		Background: gothememe.Hex("#b3b39319"),
+		Border:     gothememe.Hex("#b3b3934c"),
 		Text:       gothememe.Hex("#b3b393"),
 	}
 }
 
 func (t *themeMellifluous) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cbaa89").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cbaa89").WithAlpha(0.3),
+		Background: gothememe.Hex("#cbaa8919"),
+		Border:     gothememe.Hex("#cbaa894c"),
 		Text:       gothememe.Hex("#cbaa89"),
 	}
 }
 
 func (t *themeMellifluous) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d29393").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d29393").WithAlpha(0.3),
+		Background: gothememe.Hex("#d2939319"),
+		Border:     gothememe.Hex("#d293934c"),
 		Text:       gothememe.Hex("#d29393"),
 	}
 }
 
 func (t *themeMellifluous) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c0af8c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c0af8c").WithAlpha(0.3),
+		Background: gothememe.Hex("#c0af8c19"),
+		Border:     gothememe.Hex("#c0af8c4c"),
 		Text:       gothememe.Hex("#c0af8c"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeMiasma) BorderStrong() gothememe.Color { return gothememe.Hex("#d7
 // Semantic colors
 func (t *themeMiasma) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5f875f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5f875f").WithAlpha(0.3),
+		Background: gothememe.Hex("#5f875f19"),
+		Border:     gothememe.Hex("#5f875f4c"),
 		Text:       gothememe.Hex("#5f875f"),
 	}
 }
 
 func (t *themeMiasma) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b36d43").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b36d43").WithAlpha(0.3),
+		Background: gothememe.Hex("#b36d4319"),
+		Border:     gothememe.Hex("#b36d434c"),
 		Text:       gothememe.Hex("#b36d43"),
 	}
 }
 
 func (t *themeMiasma) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#685742").WithAlpha(0.1),
-		Border:     gothememe.Hex("#685742").WithAlpha(0.3),
+		Background: gothememe.Hex("#68574219"),
+		Border:     gothememe.Hex("#6857424c"),
 		Text:       gothememe.Hex("#685742"),
 	}
 }
 
 func (t *themeMiasma) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c9a554").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c9a554").WithAlpha(0.3),
+		Background: gothememe.Hex("#c9a55419"),
+		Border:     gothememe.Hex("#c9a5544c"),
 		Text:       gothememe.Hex("#c9a554"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeMidnightInMojave) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeMidnightInMojave) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#32d74b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#32d74b").WithAlpha(0.3),
+		Background: gothememe.Hex("#32d74b19"),
+		Border:     gothememe.Hex("#32d74b4c"),
 		Text:       gothememe.Hex("#32d74b"),
 	}
 }
 
 func (t *themeMidnightInMojave) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffd60a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffd60a").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffd60a19"),
+		Border:     gothememe.Hex("#ffd60a4c"),
 		Text:       gothememe.Hex("#ffd60a"),
 	}
 }
 
 func (t *themeMidnightInMojave) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff453a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff453a").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff453a19"),
+		Border:     gothememe.Hex("#ff453a4c"),
 		Text:       gothememe.Hex("#ff453a"),
 	}
 }
 
 func (t *themeMidnightInMojave) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5ac8fa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5ac8fa").WithAlpha(0.3),
+		Background: gothememe.Hex("#5ac8fa19"),
+		Border:     gothememe.Hex("#5ac8fa4c"),
 		Text:       gothememe.Hex("#5ac8fa"),
 	}
 }
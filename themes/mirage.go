@@ -43,32 +43,32 @@ func (t *themeMirage) BorderStrong() gothememe.Color { return gothememe.Hex("#ff
 // Semantic colors
 func (t *themeMirage) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#85cc95").WithAlpha(0.1),
-		Border:     gothememe.Hex("#85cc95").WithAlpha(0.3),
+		Background: gothememe.Hex("#85cc9519"),
+		Border:     gothememe.Hex("#85cc954c"),
 		Text:       gothememe.Hex("#85cc95"),
 	}
 }
 
 func (t *themeMirage) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffd700").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffd700").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffd70019"),
+		Border:     gothememe.Hex("#ffd7004c"),
 		Text:       gothememe.Hex("#ffd700"),
 	}
 }
 
 func (t *themeMirage) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff9999").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff9999").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff999919"),
+		Border:     gothememe.Hex("#ff99994c"),
 		Text:       gothememe.Hex("#ff9999"),
 	}
 }
 
 func (t *themeMirage) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#21c7a8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#21c7a8").WithAlpha(0.3),
+		Background: gothememe.Hex("#21c7a819"),
+		Border:     gothememe.Hex("#21c7a84c"),
 		Text:       gothememe.Hex("#21c7a8"),
 	}
 }
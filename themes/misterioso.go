@@ -43,32 +43,32 @@ func (t *themeMisterioso) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeMisterioso) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#74af68").WithAlpha(0.1),
-		Border:     gothememe.Hex("#74af68").WithAlpha(0.3),
+		Background: gothememe.Hex("#74af6819"),
+		Border:     gothememe.Hex("#74af684c"),
 		Text:       gothememe.Hex("#74af68"),
 	}
 }
 
 func (t *themeMisterioso) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffad29").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffad29").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffad2919"),
+		Border:     gothememe.Hex("#ffad294c"),
 		Text:       gothememe.Hex("#ffad29"),
 	}
 }
 
 func (t *themeMisterioso) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff4242").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff4242").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff424219"),
+		Border:     gothememe.Hex("#ff42424c"),
 		Text:       gothememe.Hex("#ff4242"),
 	}
 }
 
 func (t *themeMisterioso) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#23d7d7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#23d7d7").WithAlpha(0.3),
+		Background: gothememe.Hex("#23d7d719"),
+		Border:     gothememe.Hex("#23d7d74c"),
 		Text:       gothememe.Hex("#23d7d7"),
 	}
 }
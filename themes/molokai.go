@@ -43,32 +43,32 @@ func (t *themeMolokai) BorderStrong() gothememe.Color { return gothememe.Hex("#b
 // Semantic colors
 func (t *themeMolokai) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98e123").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98e123").WithAlpha(0.3),
+		Background: gothememe.Hex("#98e12319"),
+		Border:     gothememe.Hex("#98e1234c"),
 		Text:       gothememe.Hex("#98e123"),
 	}
 }
 
 func (t *themeMolokai) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dfd460").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dfd460").WithAlpha(0.3),
+		Background: gothememe.Hex("#dfd46019"),
+		Border:     gothememe.Hex("#dfd4604c"),
 		Text:       gothememe.Hex("#dfd460"),
 	}
 }
 
 func (t *themeMolokai) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fa2573").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fa2573").WithAlpha(0.3),
+		Background: gothememe.Hex("#fa257319"),
+		Border:     gothememe.Hex("#fa25734c"),
 		Text:       gothememe.Hex("#fa2573"),
 	}
 }
 
 func (t *themeMolokai) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#43a8d0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#43a8d0").WithAlpha(0.3),
+		Background: gothememe.Hex("#43a8d019"),
+		Border:     gothememe.Hex("#43a8d04c"),
 		Text:       gothememe.Hex("#43a8d0"),
 	}
 }
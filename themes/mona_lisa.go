@@ -43,32 +43,32 @@ func (t *themeMonaLisa) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeMonaLisa) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#636232").WithAlpha(0.1),
-		Border:     gothememe.Hex("#636232").WithAlpha(0.3),
+		Background: gothememe.Hex("#63623219"),
+		Border:     gothememe.Hex("#6362324c"),
 		Text:       gothememe.Hex("#636232"),
 	}
 }
 
 func (t *themeMonaLisa) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c36e28").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c36e28").WithAlpha(0.3),
+		Background: gothememe.Hex("#c36e2819"),
+		Border:     gothememe.Hex("#c36e284c"),
 		Text:       gothememe.Hex("#c36e28"),
 	}
 }
 
 func (t *themeMonaLisa) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9b291c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9b291c").WithAlpha(0.3),
+		Background: gothememe.Hex("#9b291c19"),
+		Border:     gothememe.Hex("#9b291c4c"),
 		Text:       gothememe.Hex("#9b291c"),
 	}
 }
 
 func (t *themeMonaLisa) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#588056").WithAlpha(0.1),
-		Border:     gothememe.Hex("#588056").WithAlpha(0.3),
+		Background: gothememe.Hex("#58805619"),
+		Border:     gothememe.Hex("#5880564c"),
 		Text:       gothememe.Hex("#588056"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeMonokaiClassic) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeMonokaiClassic) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a6e22e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a6e22e").WithAlpha(0.3),
+		Background: gothememe.Hex("#a6e22e19"),
+		Border:     gothememe.Hex("#a6e22e4c"),
 		Text:       gothememe.Hex("#a6e22e"),
 	}
 }
 
 func (t *themeMonokaiClassic) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e6db74").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e6db74").WithAlpha(0.3),
+		Background: gothememe.Hex("#e6db7419"),
+		Border:     gothememe.Hex("#e6db744c"),
 		Text:       gothememe.Hex("#e6db74"),
 	}
 }
 
 func (t *themeMonokaiClassic) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f92672").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f92672").WithAlpha(0.3),
+		Background: gothememe.Hex("#f9267219"),
+		Border:     gothememe.Hex("#f926724c"),
 		Text:       gothememe.Hex("#f92672"),
 	}
 }
 
 func (t *themeMonokaiClassic) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#66d9ef").WithAlpha(0.1),
-		Border:     gothememe.Hex("#66d9ef").WithAlpha(0.3),
+		Background: gothememe.Hex("#66d9ef19"),
+		Border:     gothememe.Hex("#66d9ef4c"),
 		Text:       gothememe.Hex("#66d9ef"),
 	}
 }
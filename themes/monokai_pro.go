@@ -43,32 +43,32 @@ func (t *themeMonokaiPro) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeMonokaiPro) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a9dc76").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a9dc76").WithAlpha(0.3),
+		Background: gothememe.Hex("#a9dc7619"),
+		Border:     gothememe.Hex("#a9dc764c"),
 		Text:       gothememe.Hex("#a9dc76"),
 	}
 }
 
 func (t *themeMonokaiPro) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffd866").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffd866").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffd86619"),
+		Border:     gothememe.Hex("#ffd8664c"),
 		Text:       gothememe.Hex("#ffd866"),
 	}
 }
 
 func (t *themeMonokaiPro) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff6188").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff6188").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff618819"),
+		Border:     gothememe.Hex("#ff61884c"),
 		Text:       gothememe.Hex("#ff6188"),
 	}
 }
 
 func (t *themeMonokaiPro) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#78dce8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#78dce8").WithAlpha(0.3),
+		Background: gothememe.Hex("#78dce819"),
+		Border:     gothememe.Hex("#78dce84c"),
 		Text:       gothememe.Hex("#78dce8"),
 	}
 }
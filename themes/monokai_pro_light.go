@@ -45,32 +45,32 @@ func (t *themeMonokaiProLight) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeMonokaiProLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#269d69").WithAlpha(0.1),
-		Border:     gothememe.Hex("#269d69").WithAlpha(0.3),
+		Background: gothememe.Hex("#269d6919"),
+		Border:     gothememe.Hex("#269d694c"),
 		Text:       gothememe.Hex("#269d69"),
 	}
 }
 
 func (t *themeMonokaiProLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc7a0a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc7a0a").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc7a0a19"),
+		Border:     gothememe.Hex("#cc7a0a4c"),
 		Text:       gothememe.Hex("#cc7a0a"),
 	}
 }
 
 func (t *themeMonokaiProLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e14775").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e14775").WithAlpha(0.3),
+		Background: gothememe.Hex("#e1477519"),
+		Border:     gothememe.Hex("#e147754c"),
 		Text:       gothememe.Hex("#e14775"),
 	}
 }
 
 func (t *themeMonokaiProLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1c8ca8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1c8ca8").WithAlpha(0.3),
+		Background: gothememe.Hex("#1c8ca819"),
+		Border:     gothememe.Hex("#1c8ca84c"),
 		Text:       gothememe.Hex("#1c8ca8"),
 	}
 }
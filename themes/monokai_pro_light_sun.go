@@ -47,32 +47,32 @@ func (t *themeMonokaiProLightSun) BorderStrong() gothememe.Color { return gothem
 // Semantic colors
 func (t *themeMonokaiProLightSun) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#218871").WithAlpha(0.1),
-		Border:     gothememe.Hex("#218871").WithAlpha(0.3),
+		Background: gothememe.Hex("#21887119"),
+		Border:     gothememe.Hex("#2188714c"),
 		Text:       gothememe.Hex("#218871"),
 	}
 }
 
 func (t *themeMonokaiProLightSun) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b16803").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b16803").WithAlpha(0.3),
+		Background: gothememe.Hex("#b1680319"),
+		Border:     gothememe.Hex("#b168034c"),
 		Text:       gothememe.Hex("#b16803"),
 	}
 }
 
 func (t *themeMonokaiProLightSun) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ce4770").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ce4770").WithAlpha(0.3),
+		Background: gothememe.Hex("#ce477019"),
+		Border:     gothememe.Hex("#ce47704c"),
 		Text:       gothememe.Hex("#ce4770"),
 	}
 }
 
 func (t *themeMonokaiProLightSun) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2473b6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2473b6").WithAlpha(0.3),
+		Background: gothememe.Hex("#2473b619"),
+		Border:     gothememe.Hex("#2473b64c"),
 		Text:       gothememe.Hex("#2473b6"),
 	}
 }
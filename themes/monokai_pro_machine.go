@@ -47,32 +47,32 @@ func (t *themeMonokaiProMachine) BorderStrong() gothememe.Color { return gotheme
 // Semantic colors
 func (t *themeMonokaiProMachine) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a2e57b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a2e57b").WithAlpha(0.3),
+		Background: gothememe.Hex("#a2e57b19"),
+		Border:     gothememe.Hex("#a2e57b4c"),
 		Text:       gothememe.Hex("#a2e57b"),
 	}
 }
 
 func (t *themeMonokaiProMachine) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffed72").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffed72").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffed7219"),
+		Border:     gothememe.Hex("#ffed724c"),
 		Text:       gothememe.Hex("#ffed72"),
 	}
 }
 
 func (t *themeMonokaiProMachine) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff6d7e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff6d7e").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff6d7e19"),
+		Border:     gothememe.Hex("#ff6d7e4c"),
 		Text:       gothememe.Hex("#ff6d7e"),
 	}
 }
 
 func (t *themeMonokaiProMachine) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7cd5f1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7cd5f1").WithAlpha(0.3),
+		Background: gothememe.Hex("#7cd5f119"),
+		Border:     gothememe.Hex("#7cd5f14c"),
 		Text:       gothememe.Hex("#7cd5f1"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeMonokaiProOctagon) BorderStrong() gothememe.Color { return gotheme
 // Semantic colors
 func (t *themeMonokaiProOctagon) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bad761").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bad761").WithAlpha(0.3),
+		Background: gothememe.Hex("#bad76119"),
+		Border:     gothememe.Hex("#bad7614c"),
 		Text:       gothememe.Hex("#bad761"),
 	}
 }
 
 func (t *themeMonokaiProOctagon) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffd76d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffd76d").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffd76d19"),
+		Border:     gothememe.Hex("#ffd76d4c"),
 		Text:       gothememe.Hex("#ffd76d"),
 	}
 }
 
 func (t *themeMonokaiProOctagon) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff657a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff657a").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff657a19"),
+		Border:     gothememe.Hex("#ff657a4c"),
 		Text:       gothememe.Hex("#ff657a"),
 	}
 }
 
 func (t *themeMonokaiProOctagon) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9cd1bb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9cd1bb").WithAlpha(0.3),
+		Background: gothememe.Hex("#9cd1bb19"),
+		Border:     gothememe.Hex("#9cd1bb4c"),
 		Text:       gothememe.Hex("#9cd1bb"),
 	}
 }
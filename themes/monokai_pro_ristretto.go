@@ -49,32 +49,32 @@ func (t *themeMonokaiProRistretto) BorderStrong() gothememe.Color { return gothe
 // Semantic colors
 func (t *themeMonokaiProRistretto) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#adda78").WithAlpha(0.1),
-		Border:     gothememe.Hex("#adda78").WithAlpha(0.3),
+		Background: gothememe.Hex("#adda7819"),
+		Border:     gothememe.Hex("#adda784c"),
 		Text:       gothememe.Hex("#adda78"),
 	}
 }
 
 func (t *themeMonokaiProRistretto) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f9cc6c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f9cc6c").WithAlpha(0.3),
+		Background: gothememe.Hex("#f9cc6c19"),
+		Border:     gothememe.Hex("#f9cc6c4c"),
 		Text:       gothememe.Hex("#f9cc6c"),
 	}
 }
 
 func (t *themeMonokaiProRistretto) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fd6883").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fd6883").WithAlpha(0.3),
+		Background: gothememe.Hex("#fd688319"),
+		Border:     gothememe.Hex("#fd68834c"),
 		Text:       gothememe.Hex("#fd6883"),
 	}
 }
 
 func (t *themeMonokaiProRistretto) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#85dacc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#85dacc").WithAlpha(0.3),
+		Background: gothememe.Hex("#85dacc19"),
+		Border:     gothememe.Hex("#85dacc4c"),
 		Text:       gothememe.Hex("#85dacc"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeMonokaiProSpectrum) BorderStrong() gothememe.Color { return gothem
 // Semantic colors
 func (t *themeMonokaiProSpectrum) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7bd88f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7bd88f").WithAlpha(0.3),
+		Background: gothememe.Hex("#7bd88f19"),
+		Border:     gothememe.Hex("#7bd88f4c"),
 		Text:       gothememe.Hex("#7bd88f"),
 	}
 }
 
 func (t *themeMonokaiProSpectrum) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fce566").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fce566").WithAlpha(0.3),
+		Background: gothememe.Hex("#fce56619"),
+		Border:     gothememe.Hex("#fce5664c"),
 		Text:       gothememe.Hex("#fce566"),
 	}
 }
 
 func (t *themeMonokaiProSpectrum) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fc618d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fc618d").WithAlpha(0.3),
+		Background: gothememe.Hex("#fc618d19"),
+		Border:     gothememe.Hex("#fc618d4c"),
 		Text:       gothememe.Hex("#fc618d"),
 	}
 }
 
 func (t *themeMonokaiProSpectrum) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5ad4e6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5ad4e6").WithAlpha(0.3),
+		Background: gothememe.Hex("#5ad4e619"),
+		Border:     gothememe.Hex("#5ad4e64c"),
 		Text:       gothememe.Hex("#5ad4e6"),
 	}
 }
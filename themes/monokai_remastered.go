@@ -47,32 +47,32 @@ func (t *themeMonokaiRemastered) BorderStrong() gothememe.Color { return gotheme
 // Semantic colors
 func (t *themeMonokaiRemastered) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98e024").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98e024").WithAlpha(0.3),
+		Background: gothememe.Hex("#98e02419"),
+		Border:     gothememe.Hex("#98e0244c"),
 		Text:       gothememe.Hex("#98e024"),
 	}
 }
 
 func (t *themeMonokaiRemastered) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fd971f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fd971f").WithAlpha(0.3),
+		Background: gothememe.Hex("#fd971f19"),
+		Border:     gothememe.Hex("#fd971f4c"),
 		Text:       gothememe.Hex("#fd971f"),
 	}
 }
 
 func (t *themeMonokaiRemastered) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f4005f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f4005f").WithAlpha(0.3),
+		Background: gothememe.Hex("#f4005f19"),
+		Border:     gothememe.Hex("#f4005f4c"),
 		Text:       gothememe.Hex("#f4005f"),
 	}
 }
 
 func (t *themeMonokaiRemastered) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#58d1eb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#58d1eb").WithAlpha(0.3),
+		Background: gothememe.Hex("#58d1eb19"),
+		Border:     gothememe.Hex("#58d1eb4c"),
 		Text:       gothememe.Hex("#58d1eb"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeMonokaiSoda) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeMonokaiSoda) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98e024").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98e024").WithAlpha(0.3),
+		Background: gothememe.Hex("#98e02419"),
+		Border:     gothememe.Hex("#98e0244c"),
 		Text:       gothememe.Hex("#98e024"),
 	}
 }
 
 func (t *themeMonokaiSoda) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fa8419").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fa8419").WithAlpha(0.3),
+		Background: gothememe.Hex("#fa841919"),
+		Border:     gothememe.Hex("#fa84194c"),
 		Text:       gothememe.Hex("#fa8419"),
 	}
 }
 
 func (t *themeMonokaiSoda) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f4005f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f4005f").WithAlpha(0.3),
+		Background: gothememe.Hex("#f4005f19"),
+		Border:     gothememe.Hex("#f4005f4c"),
 		Text:       gothememe.Hex("#f4005f"),
 	}
 }
 
 func (t *themeMonokaiSoda) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#58d1eb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#58d1eb").WithAlpha(0.3),
+		Background: gothememe.Hex("#58d1eb19"),
+		Border:     gothememe.Hex("#58d1eb4c"),
 		Text:       gothememe.Hex("#58d1eb"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeMonokaiVivid) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeMonokaiVivid) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98e123").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98e123").WithAlpha(0.3),
+		Background: gothememe.Hex("#98e12319"),
+		Border:     gothememe.Hex("#98e1234c"),
 		Text:       gothememe.Hex("#98e123"),
 	}
 }
 
 func (t *themeMonokaiVivid) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fff30a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fff30a").WithAlpha(0.3),
+		Background: gothememe.Hex("#fff30a19"),
+		Border:     gothememe.Hex("#fff30a4c"),
 		Text:       gothememe.Hex("#fff30a"),
 	}
 }
 
 func (t *themeMonokaiVivid) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fa2934").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fa2934").WithAlpha(0.3),
+		Background: gothememe.Hex("#fa293419"),
+		Border:     gothememe.Hex("#fa29344c"),
 		Text:       gothememe.Hex("#fa2934"),
 	}
 }
 
 func (t *themeMonokaiVivid) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#01b6ed").WithAlpha(0.1),
-		Border:     gothememe.Hex("#01b6ed").WithAlpha(0.3),
+		Background: gothememe.Hex("#01b6ed19"),
+		Border:     gothememe.Hex("#01b6ed4c"),
 		Text:       gothememe.Hex("#01b6ed"),
 	}
 }
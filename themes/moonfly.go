@@ -43,32 +43,32 @@ func (t *themeMoonfly) BorderStrong() gothememe.Color { return gothememe.Hex("#c
 // Semantic colors
 func (t *themeMoonfly) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8cc85f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8cc85f").WithAlpha(0.3),
+		Background: gothememe.Hex("#8cc85f19"),
+		Border:     gothememe.Hex("#8cc85f4c"),
 		Text:       gothememe.Hex("#8cc85f"),
 	}
 }
 
 func (t *themeMoonfly) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e3c78a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e3c78a").WithAlpha(0.3),
+		Background: gothememe.Hex("#e3c78a19"),
+		Border:     gothememe.Hex("#e3c78a4c"),
 		Text:       gothememe.Hex("#e3c78a"),
 	}
 }
 
 func (t *themeMoonfly) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff5454").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff5454").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff545419"),
+		Border:     gothememe.Hex("#ff54544c"),
 		Text:       gothememe.Hex("#ff5454"),
 	}
 }
 
 func (t *themeMoonfly) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#79dac8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#79dac8").WithAlpha(0.3),
+		Background: gothememe.Hex("#79dac819"),
+		Border:     gothememe.Hex("#79dac84c"),
 		Text:       gothememe.Hex("#79dac8"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeN0tch2k) BorderStrong() gothememe.Color { return gothememe.Hex("#d
 // Semantic colors
 func (t *themeN0tch2k) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#666666").WithAlpha(0.1),
-		Border:     gothememe.Hex("#666666").WithAlpha(0.3),
+		Background: gothememe.Hex("#66666619"),
+		Border:     gothememe.Hex("#6666664c"),
 		Text:       gothememe.Hex("#666666"),
 	}
 }
 
 func (t *themeN0tch2k) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a98051").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a98051").WithAlpha(0.3),
+		Background: gothememe.Hex("#a9805119"),
+		Border:     gothememe.Hex("#a980514c"),
 		Text:       gothememe.Hex("#a98051"),
 	}
 }
 
 func (t *themeN0tch2k) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a95551").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a95551").WithAlpha(0.3),
+		Background: gothememe.Hex("#a9555119"),
+		Border:     gothememe.Hex("#a955514c"),
 		Text:       gothememe.Hex("#a95551"),
 	}
 }
 
 func (t *themeN0tch2k) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c9c9c9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c9c9c9").WithAlpha(0.3),
+		Background: gothememe.Hex("#c9c9c919"),
+		Border:     gothememe.Hex("#c9c9c94c"),
 		Text:       gothememe.Hex("#c9c9c9"),
 	}
 }
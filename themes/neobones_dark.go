@@ -45,32 +45,32 @@ func (t *themeNeobonesDark) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeNeobonesDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#90ff6b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#90ff6b").WithAlpha(0.3),
+		Background: gothememe.Hex("#90ff6b19"),
+		Border:     gothememe.Hex("#90ff6b4c"),
 		Text:       gothememe.Hex("#90ff6b"),
 	}
 }
 
 func (t *themeNeobonesDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b77e64").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b77e64").WithAlpha(0.3),
+		Background: gothememe.Hex("#b77e6419"),
+		Border:     gothememe.Hex("#b77e644c"),
 		Text:       gothememe.Hex("#b77e64"),
 	}
 }
 
 func (t *themeNeobonesDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#de6e7c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#de6e7c").WithAlpha(0.3),
+		Background: gothememe.Hex("#de6e7c19"),
+		Border:     gothememe.Hex("#de6e7c4c"),
 		Text:       gothememe.Hex("#de6e7c"),
 	}
 }
 
 func (t *themeNeobonesDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#66a5ad").WithAlpha(0.1),
-		Border:     gothememe.Hex("#66a5ad").WithAlpha(0.3),
+		Background: gothememe.Hex("#66a5ad19"),
+		Border:     gothememe.Hex("#66a5ad4c"),
 		Text:       gothememe.Hex("#66a5ad"),
 	}
 }
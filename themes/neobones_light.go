@@ -45,32 +45,32 @@ func (t *themeNeobonesLight) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeNeobonesLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#567a30").WithAlpha(0.1),
-		Border:     gothememe.Hex("#567a30").WithAlpha(0.3),
+		Background: gothememe.Hex("#567a3019"),
+		Border:     gothememe.Hex("#567a304c"),
 		Text:       gothememe.Hex("#567a30"),
 	}
 }
 
 func (t *themeNeobonesLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#944927").WithAlpha(0.1),
-		Border:     gothememe.Hex("#944927").WithAlpha(0.3),
+		Background: gothememe.Hex("#94492719"),
+		Border:     gothememe.Hex("#9449274c"),
 		Text:       gothememe.Hex("#944927"),
 	}
 }
 
 func (t *themeNeobonesLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a8334c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a8334c").WithAlpha(0.3),
+		Background: gothememe.Hex("#a8334c19"),
+		Border:     gothememe.Hex("#a8334c4c"),
 		Text:       gothememe.Hex("#a8334c"),
 	}
 }
 
 func (t *themeNeobonesLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3b8992").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3b8992").WithAlpha(0.3),
+		Background: gothememe.Hex("#3b899219"),
+		Border:     gothememe.Hex("#3b89924c"),
 		Text:       gothememe.Hex("#3b8992"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeNeon) BorderStrong() gothememe.Color { return gothememe.Hex("#c7c7
 // Semantic colors
 func (t *themeNeon) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5ffa74").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5ffa74").WithAlpha(0.3),
+		Background: gothememe.Hex("#5ffa7419"),
+		Border:     gothememe.Hex("#5ffa744c"),
 		Text:       gothememe.Hex("#5ffa74"),
 	}
 }
 
 func (t *themeNeon) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fffc7e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fffc7e").WithAlpha(0.3),
+		Background: gothememe.Hex("#fffc7e19"),
+		Border:     gothememe.Hex("#fffc7e4c"),
 		Text:       gothememe.Hex("#fffc7e"),
 	}
 }
 
 func (t *themeNeon) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff3045").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff3045").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff304519"),
+		Border:     gothememe.Hex("#ff30454c"),
 		Text:       gothememe.Hex("#ff3045"),
 	}
 }
 
 func (t *themeNeon) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00fffc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00fffc").WithAlpha(0.3),
+		Background: gothememe.Hex("#00fffc19"),
+		Border:     gothememe.Hex("#00fffc4c"),
 		Text:       gothememe.Hex("#00fffc"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeNeopolitan) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeNeopolitan) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#61ce3c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#61ce3c").WithAlpha(0.3),
+		Background: gothememe.Hex("#61ce3c19"),
+		Border:     gothememe.Hex("#61ce3c4c"),
 		Text:       gothememe.Hex("#61ce3c"),
 	}
 }
 
 func (t *themeNeopolitan) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fbde2d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fbde2d").WithAlpha(0.3),
+		Background: gothememe.Hex("#fbde2d19"),
+		Border:     gothememe.Hex("#fbde2d4c"),
 		Text:       gothememe.Hex("#fbde2d"),
 	}
 }
 
 func (t *themeNeopolitan) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9a1a1a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9a1a1a").WithAlpha(0.3),
+		Background: gothememe.Hex("#9a1a1a19"),
+		Border:     gothememe.Hex("#9a1a1a4c"),
 		Text:       gothememe.Hex("#9a1a1a"),
 	}
 }
 
 func (t *themeNeopolitan) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8da6ce").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8da6ce").WithAlpha(0.3),
+		Background: gothememe.Hex("#8da6ce19"),
+		Border:     gothememe.Hex("#8da6ce4c"),
 		Text:       gothememe.Hex("#8da6ce"),
 	}
 }
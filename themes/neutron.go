@@ -43,32 +43,32 @@ func (t *themeNeutron) BorderStrong() gothememe.Color { return gothememe.Hex("#e
 // Semantic colors
 func (t *themeNeutron) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5ab977").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5ab977").WithAlpha(0.3),
+		Background: gothememe.Hex("#5ab97719"),
+		Border:     gothememe.Hex("#5ab9774c"),
 		Text:       gothememe.Hex("#5ab977"),
 	}
 }
 
 func (t *themeNeutron) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#deb566").WithAlpha(0.1),
-		Border:     gothememe.Hex("#deb566").WithAlpha(0.3),
+		Background: gothememe.Hex("#deb56619"),
+		Border:     gothememe.Hex("#deb5664c"),
 		Text:       gothememe.Hex("#deb566"),
 	}
 }
 
 func (t *themeNeutron) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b54036").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b54036").WithAlpha(0.3),
+		Background: gothememe.Hex("#b5403619"),
+		Border:     gothememe.Hex("#b540364c"),
 		Text:       gothememe.Hex("#b54036"),
 	}
 }
 
 func (t *themeNeutron) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3f94a8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3f94a8").WithAlpha(0.3),
+		Background: gothememe.Hex("#3f94a819"),
+		Border:     gothememe.Hex("#3f94a84c"),
 		Text:       gothememe.Hex("#3f94a8"),
 	}
 }
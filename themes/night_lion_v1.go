@@ -45,32 +45,32 @@ func (t *themeNightLionV1) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeNightLionV1) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5fde8f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5fde8f").WithAlpha(0.3),
+		Background: gothememe.Hex("#5fde8f19"),
+		Border:     gothememe.Hex("#5fde8f4c"),
 		Text:       gothememe.Hex("#5fde8f"),
 	}
 }
 
 func (t *themeNightLionV1) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f3f167").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f3f167").WithAlpha(0.3),
+		Background: gothememe.Hex("#f3f16719"),
+		Border:     gothememe.Hex("#f3f1674c"),
 		Text:       gothememe.Hex("#f3f167"),
 	}
 }
 
 func (t *themeNightLionV1) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bb0000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bb0000").WithAlpha(0.3),
+		Background: gothememe.Hex("#bb000019"),
+		Border:     gothememe.Hex("#bb00004c"),
 		Text:       gothememe.Hex("#bb0000"),
 	}
 }
 
 func (t *themeNightLionV1) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00dadf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00dadf").WithAlpha(0.3),
+		Background: gothememe.Hex("#00dadf19"),
+		Border:     gothememe.Hex("#00dadf4c"),
 		Text:       gothememe.Hex("#00dadf"),
 	}
 }
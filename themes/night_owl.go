@@ -43,32 +43,32 @@ func (t *themeNightOwl) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeNightOwl) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#22da6e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#22da6e").WithAlpha(0.3),
+		Background: gothememe.Hex("#22da6e19"),
+		Border:     gothememe.Hex("#22da6e4c"),
 		Text:       gothememe.Hex("#22da6e"),
 	}
 }
 
 func (t *themeNightOwl) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#addb67").WithAlpha(0.1),
-		Border:     gothememe.Hex("#addb67").WithAlpha(0.3),
+		Background: gothememe.Hex("#addb6719"),
+		Border:     gothememe.Hex("#addb674c"),
 		Text:       gothememe.Hex("#addb67"),
 	}
 }
 
 func (t *themeNightOwl) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ef5350").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ef5350").WithAlpha(0.3),
+		Background: gothememe.Hex("#ef535019"),
+		Border:     gothememe.Hex("#ef53504c"),
 		Text:       gothememe.Hex("#ef5350"),
 	}
 }
 
 func (t *themeNightOwl) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#21c7a8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#21c7a8").WithAlpha(0.3),
+		Background: gothememe.Hex("#21c7a819"),
+		Border:     gothememe.Hex("#21c7a84c"),
 		Text:       gothememe.Hex("#21c7a8"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeNightOwlishLight) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeNightOwlishLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2aa298").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2aa298").WithAlpha(0.3),
+		Background: gothememe.Hex("#2aa29819"),
+		Border:     gothememe.Hex("#2aa2984c"),
 		Text:       gothememe.Hex("#2aa298"),
 	}
 }
 
 func (t *themeNightOwlishLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#daaa01").WithAlpha(0.1),
-		Border:     gothememe.Hex("#daaa01").WithAlpha(0.3),
+		Background: gothememe.Hex("#daaa0119"),
+		Border:     gothememe.Hex("#daaa014c"),
 		Text:       gothememe.Hex("#daaa01"),
 	}
 }
 
 func (t *themeNightOwlishLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d3423e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d3423e").WithAlpha(0.3),
+		Background: gothememe.Hex("#d3423e19"),
+		Border:     gothememe.Hex("#d3423e4c"),
 		Text:       gothememe.Hex("#d3423e"),
 	}
 }
 
 func (t *themeNightOwlishLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#08916a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#08916a").WithAlpha(0.3),
+		Background: gothememe.Hex("#08916a19"),
+		Border:     gothememe.Hex("#08916a4c"),
 		Text:       gothememe.Hex("#08916a"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeNightfox) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeNightfox) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#81b29a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#81b29a").WithAlpha(0.3),
+		Background: gothememe.Hex("#81b29a19"),
+		Border:     gothememe.Hex("#81b29a4c"),
 		Text:       gothememe.Hex("#81b29a"),
 	}
 }
 
 func (t *themeNightfox) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dbc074").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dbc074").WithAlpha(0.3),
+		Background: gothememe.Hex("#dbc07419"),
+		Border:     gothememe.Hex("#dbc0744c"),
 		Text:       gothememe.Hex("#dbc074"),
 	}
 }
 
 func (t *themeNightfox) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c94f6d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c94f6d").WithAlpha(0.3),
+		Background: gothememe.Hex("#c94f6d19"),
+		Border:     gothememe.Hex("#c94f6d4c"),
 		Text:       gothememe.Hex("#c94f6d"),
 	}
 }
 
 func (t *themeNightfox) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#63cdcf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#63cdcf").WithAlpha(0.3),
+		Background: gothememe.Hex("#63cdcf19"),
+		Border:     gothememe.Hex("#63cdcf4c"),
 		Text:       gothememe.Hex("#63cdcf"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeNiji) BorderStrong() gothememe.Color { return gothememe.Hex("#ddd0
 // Semantic colors
 func (t *themeNiji) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#54ca74").WithAlpha(0.1),
-		Border:     gothememe.Hex("#54ca74").WithAlpha(0.3),
+		Background: gothememe.Hex("#54ca7419"),
+		Border:     gothememe.Hex("#54ca744c"),
 		Text:       gothememe.Hex("#54ca74"),
 	}
 }
 
 func (t *themeNiji) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fff700").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fff700").WithAlpha(0.3),
+		Background: gothememe.Hex("#fff70019"),
+		Border:     gothememe.Hex("#fff7004c"),
 		Text:       gothememe.Hex("#fff700"),
 	}
 }
 
 func (t *themeNiji) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d23e08").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d23e08").WithAlpha(0.3),
+		Background: gothememe.Hex("#d23e0819"),
+		Border:     gothememe.Hex("#d23e084c"),
 		Text:       gothememe.Hex("#d23e08"),
 	}
 }
 
 func (t *themeNiji) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1ef9f5").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1ef9f5").WithAlpha(0.3),
+		Background: gothememe.Hex("#1ef9f519"),
+		Border:     gothememe.Hex("#1ef9f54c"),
 		Text:       gothememe.Hex("#1ef9f5"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeNoClownFiesta) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeNoClownFiesta) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#90a959").WithAlpha(0.1),
-		Border:     gothememe.Hex("#90a959").WithAlpha(0.3),
+		Background: gothememe.Hex("#90a95919"),
+		Border:     gothememe.Hex("#90a9594c"),
 		Text:       gothememe.Hex("#90a959"),
 	}
 }
 
 func (t *themeNoClownFiesta) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f4bf75").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f4bf75").WithAlpha(0.3),
+		Background: gothememe.Hex("#f4bf7519"),
+		Border:     gothememe.Hex("#f4bf754c"),
 		Text:       gothememe.Hex("#f4bf75"),
 	}
 }
 
 func (t *themeNoClownFiesta) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b46958").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b46958").WithAlpha(0.3),
+		Background: gothememe.Hex("#b4695819"),
+		Border:     gothememe.Hex("#b469584c"),
 		Text:       gothememe.Hex("#b46958"),
 	}
 }
 
 func (t *themeNoClownFiesta) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#88afa2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#88afa2").WithAlpha(0.3),
+		Background: gothememe.Hex("#88afa219"),
+		Border:     gothememe.Hex("#88afa24c"),
 		Text:       gothememe.Hex("#88afa2"),
 	}
 }
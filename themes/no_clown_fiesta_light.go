@@ -18,7 +18,7 @@ func (t *themeNoClownFiestaLight) License() string     { return "MIT" }
 func (t *themeNoClownFiestaLight) Source() string {
 	return "https://github.com/mbadolato/iTerm2-Color-Schemes"
 }
-func (t *themeNoClownFiestaLight) IsDark() bool { return true }
+func (t *themeNoClownFiestaLight) IsDark() bool { return false }
 
 // Background colors
 func (t *themeNoClownFiestaLight) Background() gothememe.Color { return gothememe.Hex("#e1e1e1") }
@@ -47,32 +47,32 @@ func (t *themeNoClownFiestaLight) BorderStrong() gothememe.Color { return gothem
 // Semantic colors
 func (t *themeNoClownFiestaLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#677940").WithAlpha(0.1),
-		Border:     gothememe.Hex("#677940").WithAlpha(0.3),
+		Background: gothememe.Hex("#67794019"),
+		Border:     gothememe.Hex("#6779404c"),
 		Text:       gothememe.Hex("#677940"),
 	}
 }
 
 func (t *themeNoClownFiestaLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b89058").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b89058").WithAlpha(0.3),
+		Background: gothememe.Hex("#b8905819"),
+		Border:     gothememe.Hex("#b890584c"),
 		Text:       gothememe.Hex("#b89058"),
 	}
 }
 
 func (t *themeNoClownFiestaLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#874e42").WithAlpha(0.1),
-		Border:     gothememe.Hex("#874e42").WithAlpha(0.3),
+		Background: gothememe.Hex("#874e4219"),
+		Border:     gothememe.Hex("#874e424c"),
 		Text:       gothememe.Hex("#874e42"),
 	}
 }
 
 func (t *themeNoClownFiestaLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3e5f66").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3e5f66").WithAlpha(0.3),
+		Background: gothememe.Hex("#3e5f6619"),
+		Border:     gothememe.Hex("#3e5f664c"),
 		Text:       gothememe.Hex("#3e5f66"),
 	}
 }
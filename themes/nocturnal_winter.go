@@ -45,32 +45,32 @@ func (t *themeNocturnalWinter) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeNocturnalWinter) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#09cd7e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#09cd7e").WithAlpha(0.3),
+		Background: gothememe.Hex("#09cd7e19"),
+		Border:     gothememe.Hex("#09cd7e4c"),
 		Text:       gothememe.Hex("#09cd7e"),
 	}
 }
 
 func (t *themeNocturnalWinter) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f5f17a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f5f17a").WithAlpha(0.3),
+		Background: gothememe.Hex("#f5f17a19"),
+		Border:     gothememe.Hex("#f5f17a4c"),
 		Text:       gothememe.Hex("#f5f17a"),
 	}
 }
 
 func (t *themeNocturnalWinter) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f12d52").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f12d52").WithAlpha(0.3),
+		Background: gothememe.Hex("#f12d5219"),
+		Border:     gothememe.Hex("#f12d524c"),
 		Text:       gothememe.Hex("#f12d52"),
 	}
 }
 
 func (t *themeNocturnalWinter) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#09c87a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#09c87a").WithAlpha(0.3),
+		Background: gothememe.Hex("#09c87a19"),
+		Border:     gothememe.Hex("#09c87a4c"),
 		Text:       gothememe.Hex("#09c87a"),
 	}
 }
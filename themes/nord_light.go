@@ -43,32 +43,32 @@ func (t *themeNordLight) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeNordLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#96b17f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#96b17f").WithAlpha(0.3),
+		Background: gothememe.Hex("#96b17f19"),
+		Border:     gothememe.Hex("#96b17f4c"),
 		Text:       gothememe.Hex("#96b17f"),
 	}
 }
 
 func (t *themeNordLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c5a565").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c5a565").WithAlpha(0.3),
+		Background: gothememe.Hex("#c5a56519"),
+		Border:     gothememe.Hex("#c5a5654c"),
 		Text:       gothememe.Hex("#c5a565"),
 	}
 }
 
 func (t *themeNordLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bf616a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bf616a").WithAlpha(0.3),
+		Background: gothememe.Hex("#bf616a19"),
+		Border:     gothememe.Hex("#bf616a4c"),
 		Text:       gothememe.Hex("#bf616a"),
 	}
 }
 
 func (t *themeNordLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7bb3c3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7bb3c3").WithAlpha(0.3),
+		Background: gothememe.Hex("#7bb3c319"),
+		Border:     gothememe.Hex("#7bb3c34c"),
 		Text:       gothememe.Hex("#7bb3c3"),
 	}
 }
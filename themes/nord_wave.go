@@ -43,32 +43,32 @@ func (t *themeNordWave) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeNordWave) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a3be8c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a3be8c").WithAlpha(0.3),
+		Background: gothememe.Hex("#a3be8c19"),
+		Border:     gothememe.Hex("#a3be8c4c"),
 		Text:       gothememe.Hex("#a3be8c"),
 	}
 }
 
 func (t *themeNordWave) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ebcb8b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ebcb8b").WithAlpha(0.3),
+		Background: gothememe.Hex("#ebcb8b19"),
+		Border:     gothememe.Hex("#ebcb8b4c"),
 		Text:       gothememe.Hex("#ebcb8b"),
 	}
 }
 
 func (t *themeNordWave) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bf616a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bf616a").WithAlpha(0.3),
+		Background: gothememe.Hex("#bf616a19"),
+		Border:     gothememe.Hex("#bf616a4c"),
 		Text:       gothememe.Hex("#bf616a"),
 	}
 }
 
 func (t *themeNordWave) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#88c0d0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#88c0d0").WithAlpha(0.3),
+		Background: gothememe.Hex("#88c0d019"),
+		Border:     gothememe.Hex("#88c0d04c"),
 		Text:       gothememe.Hex("#88c0d0"),
 	}
 }
@@ -16,7 +16,7 @@ func (t *themeNovel) Description() string { return "Novel color theme" }
 func (t *themeNovel) Author() string      { return "iTerm2-Color-Schemes" }
 func (t *themeNovel) License() string     { return "MIT" }
 func (t *themeNovel) Source() string      { return "https://github.com/mbadolato/iTerm2-Color-Schemes" }
-func (t *themeNovel) IsDark() bool        { return true }
+func (t *themeNovel) IsDark() bool        { return false }
 
 // Background colors
 func (t *themeNovel) Background() gothememe.Color          { return gothememe.Hex("#dfdbc3") }
@@ -43,32 +43,32 @@ func (t *themeNovel) BorderStrong() gothememe.Color { return gothememe.Hex("#a6a
 // Semantic colors
 func (t *themeNovel) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#009600").WithAlpha(0.1),
-		Border:     gothememe.Hex("#009600").WithAlpha(0.3),
+		Background: gothememe.Hex("#00960019"),
+		Border:     gothememe.Hex("#0096004c"),
 		Text:       gothememe.Hex("#009600"),
 	}
 }
 
 func (t *themeNovel) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d06b00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d06b00").WithAlpha(0.3),
+		Background: gothememe.Hex("#d06b0019"),
+		Border:     gothememe.Hex("#d06b004c"),
 		Text:       gothememe.Hex("#d06b00"),
 	}
 }
 
 func (t *themeNovel) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc0000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc0000").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc000019"),
+		Border:     gothememe.Hex("#cc00004c"),
 		Text:       gothememe.Hex("#cc0000"),
 	}
 }
 
 func (t *themeNovel) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0087cc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0087cc").WithAlpha(0.3),
+		Background: gothememe.Hex("#0087cc19"),
+		Border:     gothememe.Hex("#0087cc4c"),
 		Text:       gothememe.Hex("#0087cc"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeNovmbr) BorderStrong() gothememe.Color { return gothememe.Hex("#c7
 // Semantic colors
 func (t *themeNovmbr) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9dac5f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9dac5f").WithAlpha(0.3),
+		Background: gothememe.Hex("#9dac5f19"),
+		Border:     gothememe.Hex("#9dac5f4c"),
 		Text:       gothememe.Hex("#9dac5f"),
 	}
 }
 
 func (t *themeNovmbr) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cca75f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cca75f").WithAlpha(0.3),
+		Background: gothememe.Hex("#cca75f19"),
+		Border:     gothememe.Hex("#cca75f4c"),
 		Text:       gothememe.Hex("#cca75f"),
 	}
 }
 
 func (t *themeNovmbr) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9f6434").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9f6434").WithAlpha(0.3),
+		Background: gothememe.Hex("#9f643419"),
+		Border:     gothememe.Hex("#9f64344c"),
 		Text:       gothememe.Hex("#9f6434"),
 	}
 }
 
 func (t *themeNovmbr) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#52877f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#52877f").WithAlpha(0.3),
+		Background: gothememe.Hex("#52877f19"),
+		Border:     gothememe.Hex("#52877f4c"),
 		Text:       gothememe.Hex("#52877f"),
 	}
 }
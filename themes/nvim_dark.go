@@ -43,32 +43,32 @@ func (t *themeNvimDark) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeNvimDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b3f6c0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b3f6c0").WithAlpha(0.3),
+		Background: gothememe.Hex("#b3f6c019"),
+		Border:     gothememe.Hex("#b3f6c04c"),
 		Text:       gothememe.Hex("#b3f6c0"),
 	}
 }
 
 func (t *themeNvimDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fce094").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fce094").WithAlpha(0.3),
+		Background: gothememe.Hex("#fce09419"),
+		Border:     gothememe.Hex("#fce0944c"),
 		Text:       gothememe.Hex("#fce094"),
 	}
 }
 
 func (t *themeNvimDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc0b9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc0b9").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc0b919"),
+		Border:     gothememe.Hex("#ffc0b94c"),
 		Text:       gothememe.Hex("#ffc0b9"),
 	}
 }
 
 func (t *themeNvimDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8cf8f7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8cf8f7").WithAlpha(0.3),
+		Background: gothememe.Hex("#8cf8f719"),
+		Border:     gothememe.Hex("#8cf8f74c"),
 		Text:       gothememe.Hex("#8cf8f7"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeNvimLight) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeNvimLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#005523").WithAlpha(0.1),
-		Border:     gothememe.Hex("#005523").WithAlpha(0.3),
+		Background: gothememe.Hex("#00552319"),
+		Border:     gothememe.Hex("#0055234c"),
 		Text:       gothememe.Hex("#005523"),
 	}
 }
 
 func (t *themeNvimLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6b5300").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6b5300").WithAlpha(0.3),
+		Background: gothememe.Hex("#6b530019"),
+		Border:     gothememe.Hex("#6b53004c"),
 		Text:       gothememe.Hex("#6b5300"),
 	}
 }
 
 func (t *themeNvimLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#590008").WithAlpha(0.1),
-		Border:     gothememe.Hex("#590008").WithAlpha(0.3),
+		Background: gothememe.Hex("#59000819"),
+		Border:     gothememe.Hex("#5900084c"),
 		Text:       gothememe.Hex("#590008"),
 	}
 }
 
 func (t *themeNvimLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#007373").WithAlpha(0.1),
-		Border:     gothememe.Hex("#007373").WithAlpha(0.3),
+		Background: gothememe.Hex("#00737319"),
+		Border:     gothememe.Hex("#0073734c"),
 		Text:       gothememe.Hex("#007373"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeObsidian) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeObsidian) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00bb00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00bb00").WithAlpha(0.3),
+		Background: gothememe.Hex("#00bb0019"),
+		Border:     gothememe.Hex("#00bb004c"),
 		Text:       gothememe.Hex("#00bb00"),
 	}
 }
 
 func (t *themeObsidian) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fecd22").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fecd22").WithAlpha(0.3),
+		Background: gothememe.Hex("#fecd2219"),
+		Border:     gothememe.Hex("#fecd224c"),
 		Text:       gothememe.Hex("#fecd22"),
 	}
 }
 
 func (t *themeObsidian) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b30d0e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b30d0e").WithAlpha(0.3),
+		Background: gothememe.Hex("#b30d0e19"),
+		Border:     gothememe.Hex("#b30d0e4c"),
 		Text:       gothememe.Hex("#b30d0e"),
 	}
 }
 
 func (t *themeObsidian) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00bbbb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00bbbb").WithAlpha(0.3),
+		Background: gothememe.Hex("#00bbbb19"),
+		Border:     gothememe.Hex("#00bbbb4c"),
 		Text:       gothememe.Hex("#00bbbb"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeOcean) BorderStrong() gothememe.Color { return gothememe.Hex("#bfb
 // Semantic colors
 func (t *themeOcean) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00a600").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00a600").WithAlpha(0.3),
+		Background: gothememe.Hex("#00a60019"),
+		Border:     gothememe.Hex("#00a6004c"),
 		Text:       gothememe.Hex("#00a600"),
 	}
 }
 
 func (t *themeOcean) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#999900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#999900").WithAlpha(0.3),
+		Background: gothememe.Hex("#99990019"),
+		Border:     gothememe.Hex("#9999004c"),
 		Text:       gothememe.Hex("#999900"),
 	}
 }
 
 func (t *themeOcean) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e64c4c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e64c4c").WithAlpha(0.3),
+		Background: gothememe.Hex("#e64c4c19"),
+		Border:     gothememe.Hex("#e64c4c4c"),
 		Text:       gothememe.Hex("#e64c4c"),
 	}
 }
 
 func (t *themeOcean) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00a6b2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00a6b2").WithAlpha(0.3),
+		Background: gothememe.Hex("#00a6b219"),
+		Border:     gothememe.Hex("#00a6b24c"),
 		Text:       gothememe.Hex("#00a6b2"),
 	}
 }
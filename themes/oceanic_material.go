@@ -45,32 +45,32 @@ func (t *themeOceanicMaterial) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeOceanicMaterial) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#40a33f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#40a33f").WithAlpha(0.3),
+		Background: gothememe.Hex("#40a33f19"),
+		Border:     gothememe.Hex("#40a33f4c"),
 		Text:       gothememe.Hex("#40a33f"),
 	}
 }
 
 func (t *themeOceanicMaterial) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffea2e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffea2e").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffea2e19"),
+		Border:     gothememe.Hex("#ffea2e4c"),
 		Text:       gothememe.Hex("#ffea2e"),
 	}
 }
 
 func (t *themeOceanicMaterial) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ee2b2a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ee2b2a").WithAlpha(0.3),
+		Background: gothememe.Hex("#ee2b2a19"),
+		Border:     gothememe.Hex("#ee2b2a4c"),
 		Text:       gothememe.Hex("#ee2b2a"),
 	}
 }
 
 func (t *themeOceanicMaterial) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#16afca").WithAlpha(0.1),
-		Border:     gothememe.Hex("#16afca").WithAlpha(0.3),
+		Background: gothememe.Hex("#16afca19"),
+		Border:     gothememe.Hex("#16afca4c"),
 		Text:       gothememe.Hex("#16afca"),
 	}
 }
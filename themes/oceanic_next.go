@@ -45,32 +45,32 @@ func (t *themeOceanicNext) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeOceanicNext) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#99c794").WithAlpha(0.1),
-		Border:     gothememe.Hex("#99c794").WithAlpha(0.3),
+		Background: gothememe.Hex("#99c79419"),
+		Border:     gothememe.Hex("#99c7944c"),
 		Text:       gothememe.Hex("#99c794"),
 	}
 }
 
 func (t *themeOceanicNext) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fac863").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fac863").WithAlpha(0.3),
+		Background: gothememe.Hex("#fac86319"),
+		Border:     gothememe.Hex("#fac8634c"),
 		Text:       gothememe.Hex("#fac863"),
 	}
 }
 
 func (t *themeOceanicNext) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ec5f67").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ec5f67").WithAlpha(0.3),
+		Background: gothememe.Hex("#ec5f6719"),
+		Border:     gothememe.Hex("#ec5f674c"),
 		Text:       gothememe.Hex("#ec5f67"),
 	}
 }
 
 func (t *themeOceanicNext) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5fb3b3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5fb3b3").WithAlpha(0.3),
+		Background: gothememe.Hex("#5fb3b319"),
+		Border:     gothememe.Hex("#5fb3b34c"),
 		Text:       gothememe.Hex("#5fb3b3"),
 	}
 }
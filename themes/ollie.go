@@ -43,32 +43,32 @@ func (t *themeOllie) BorderStrong() gothememe.Color { return gothememe.Hex("#8a8
 // Semantic colors
 func (t *themeOllie) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#31ac61").WithAlpha(0.1),
-		Border:     gothememe.Hex("#31ac61").WithAlpha(0.3),
+		Background: gothememe.Hex("#31ac6119"),
+		Border:     gothememe.Hex("#31ac614c"),
 		Text:       gothememe.Hex("#31ac61"),
 	}
 }
 
 func (t *themeOllie) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ac4300").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ac4300").WithAlpha(0.3),
+		Background: gothememe.Hex("#ac430019"),
+		Border:     gothememe.Hex("#ac43004c"),
 		Text:       gothememe.Hex("#ac4300"),
 	}
 }
 
 func (t *themeOllie) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ac2e31").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ac2e31").WithAlpha(0.3),
+		Background: gothememe.Hex("#ac2e3119"),
+		Border:     gothememe.Hex("#ac2e314c"),
 		Text:       gothememe.Hex("#ac2e31"),
 	}
 }
 
 func (t *themeOllie) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1fa6ac").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1fa6ac").WithAlpha(0.3),
+		Background: gothememe.Hex("#1fa6ac19"),
+		Border:     gothememe.Hex("#1fa6ac4c"),
 		Text:       gothememe.Hex("#1fa6ac"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeOneDarkTwo) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeOneDarkTwo) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98c379").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98c379").WithAlpha(0.3),
+		Background: gothememe.Hex("#98c37919"),
+		Border:     gothememe.Hex("#98c3794c"),
 		Text:       gothememe.Hex("#98c379"),
 	}
 }
 
 func (t *themeOneDarkTwo) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#eac786").WithAlpha(0.1),
-		Border:     gothememe.Hex("#eac786").WithAlpha(0.3),
+		Background: gothememe.Hex("#eac78619"),
+		Border:     gothememe.Hex("#eac7864c"),
 		Text:       gothememe.Hex("#eac786"),
 	}
 }
 
 func (t *themeOneDarkTwo) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e27881").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e27881").WithAlpha(0.3),
+		Background: gothememe.Hex("#e2788119"),
+		Border:     gothememe.Hex("#e278814c"),
 		Text:       gothememe.Hex("#e27881"),
 	}
 }
 
 func (t *themeOneDarkTwo) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#62bac6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#62bac6").WithAlpha(0.3),
+		Background: gothememe.Hex("#62bac619"),
+		Border:     gothememe.Hex("#62bac64c"),
 		Text:       gothememe.Hex("#62bac6"),
 	}
 }
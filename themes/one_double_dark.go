@@ -45,32 +45,32 @@ func (t *themeOneDoubleDark) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeOneDoubleDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8cc570").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8cc570").WithAlpha(0.3),
+		Background: gothememe.Hex("#8cc57019"),
+		Border:     gothememe.Hex("#8cc5704c"),
 		Text:       gothememe.Hex("#8cc570"),
 	}
 }
 
 func (t *themeOneDoubleDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ecbe70").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ecbe70").WithAlpha(0.3),
+		Background: gothememe.Hex("#ecbe7019"),
+		Border:     gothememe.Hex("#ecbe704c"),
 		Text:       gothememe.Hex("#ecbe70"),
 	}
 }
 
 func (t *themeOneDoubleDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f16372").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f16372").WithAlpha(0.3),
+		Background: gothememe.Hex("#f1637219"),
+		Border:     gothememe.Hex("#f163724c"),
 		Text:       gothememe.Hex("#f16372"),
 	}
 }
 
 func (t *themeOneDoubleDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#17b9c4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#17b9c4").WithAlpha(0.3),
+		Background: gothememe.Hex("#17b9c419"),
+		Border:     gothememe.Hex("#17b9c44c"),
 		Text:       gothememe.Hex("#17b9c4"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeOneDoubleLight) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeOneDoubleLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#25a343").WithAlpha(0.1),
-		Border:     gothememe.Hex("#25a343").WithAlpha(0.3),
+		Background: gothememe.Hex("#25a34319"),
+		Border:     gothememe.Hex("#25a3434c"),
 		Text:       gothememe.Hex("#25a343"),
 	}
 }
 
 func (t *themeOneDoubleLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc8100").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc8100").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc810019"),
+		Border:     gothememe.Hex("#cc81004c"),
 		Text:       gothememe.Hex("#cc8100"),
 	}
 }
 
 func (t *themeOneDoubleLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f74840").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f74840").WithAlpha(0.3),
+		Background: gothememe.Hex("#f7484019"),
+		Border:     gothememe.Hex("#f748404c"),
 		Text:       gothememe.Hex("#f74840"),
 	}
 }
 
 func (t *themeOneDoubleLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#009ab7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#009ab7").WithAlpha(0.3),
+		Background: gothememe.Hex("#009ab719"),
+		Border:     gothememe.Hex("#009ab74c"),
 		Text:       gothememe.Hex("#009ab7"),
 	}
 }
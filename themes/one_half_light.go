@@ -45,32 +45,32 @@ func (t *themeOneHalfLight) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeOneHalfLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#50a14f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#50a14f").WithAlpha(0.3),
+		Background: gothememe.Hex("#50a14f19"),
+		Border:     gothememe.Hex("#50a14f4c"),
 		Text:       gothememe.Hex("#50a14f"),
 	}
 }
 
 func (t *themeOneHalfLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c18401").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c18401").WithAlpha(0.3),
+		Background: gothememe.Hex("#c1840119"),
+		Border:     gothememe.Hex("#c184014c"),
 		Text:       gothememe.Hex("#c18401"),
 	}
 }
 
 func (t *themeOneHalfLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e45649").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e45649").WithAlpha(0.3),
+		Background: gothememe.Hex("#e4564919"),
+		Border:     gothememe.Hex("#e456494c"),
 		Text:       gothememe.Hex("#e45649"),
 	}
 }
 
 func (t *themeOneHalfLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0997b3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0997b3").WithAlpha(0.3),
+		Background: gothememe.Hex("#0997b319"),
+		Border:     gothememe.Hex("#0997b34c"),
 		Text:       gothememe.Hex("#0997b3"),
 	}
 }
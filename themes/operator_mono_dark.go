@@ -47,32 +47,32 @@ func (t *themeOperatorMonoDark) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeOperatorMonoDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4d7b3a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4d7b3a").WithAlpha(0.3),
+		Background: gothememe.Hex("#4d7b3a19"),
+		Border:     gothememe.Hex("#4d7b3a4c"),
 		Text:       gothememe.Hex("#4d7b3a"),
 	}
 }
 
 func (t *themeOperatorMonoDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d4d697").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d4d697").WithAlpha(0.3),
+		Background: gothememe.Hex("#d4d69719"),
+		Border:     gothememe.Hex("#d4d6974c"),
 		Text:       gothememe.Hex("#d4d697"),
 	}
 }
 
 func (t *themeOperatorMonoDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ca372d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ca372d").WithAlpha(0.3),
+		Background: gothememe.Hex("#ca372d19"),
+		Border:     gothememe.Hex("#ca372d4c"),
 		Text:       gothememe.Hex("#ca372d"),
 	}
 }
 
 func (t *themeOperatorMonoDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#72d5c6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#72d5c6").WithAlpha(0.3),
+		Background: gothememe.Hex("#72d5c619"),
+		Border:     gothememe.Hex("#72d5c64c"),
 		Text:       gothememe.Hex("#72d5c6"),
 	}
 }
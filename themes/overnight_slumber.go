@@ -47,32 +47,32 @@ func (t *themeOvernightSlumber) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeOvernightSlumber) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#85cc95").WithAlpha(0.1),
-		Border:     gothememe.Hex("#85cc95").WithAlpha(0.3),
+		Background: gothememe.Hex("#85cc9519"),
+		Border:     gothememe.Hex("#85cc954c"),
 		Text:       gothememe.Hex("#85cc95"),
 	}
 }
 
 func (t *themeOvernightSlumber) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffcb8b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffcb8b").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffcb8b19"),
+		Border:     gothememe.Hex("#ffcb8b4c"),
 		Text:       gothememe.Hex("#ffcb8b"),
 	}
 }
 
 func (t *themeOvernightSlumber) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffa7c4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffa7c4").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffa7c419"),
+		Border:     gothememe.Hex("#ffa7c44c"),
 		Text:       gothememe.Hex("#ffa7c4"),
 	}
 }
 
 func (t *themeOvernightSlumber) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#78ccf0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#78ccf0").WithAlpha(0.3),
+		Background: gothememe.Hex("#78ccf019"),
+		Border:     gothememe.Hex("#78ccf04c"),
 		Text:       gothememe.Hex("#78ccf0"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeOwl) BorderStrong() gothememe.Color { return gothememe.Hex("#deded
 // Semantic colors
 func (t *themeOwl) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#989898").WithAlpha(0.1),
-		Border:     gothememe.Hex("#989898").WithAlpha(0.3),
+		Background: gothememe.Hex("#98989819"),
+		Border:     gothememe.Hex("#9898984c"),
 		Text:       gothememe.Hex("#989898"),
 	}
 }
 
 func (t *themeOwl) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cacaca").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cacaca").WithAlpha(0.3),
+		Background: gothememe.Hex("#cacaca19"),
+		Border:     gothememe.Hex("#cacaca4c"),
 		Text:       gothememe.Hex("#cacaca"),
 	}
 }
 
 func (t *themeOwl) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5a5a5a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5a5a5a").WithAlpha(0.3),
+		Background: gothememe.Hex("#5a5a5a19"),
+		Border:     gothememe.Hex("#5a5a5a4c"),
 		Text:       gothememe.Hex("#5a5a5a"),
 	}
 }
 
 func (t *themeOwl) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7f7f7f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7f7f7f").WithAlpha(0.3),
+		Background: gothememe.Hex("#7f7f7f19"),
+		Border:     gothememe.Hex("#7f7f7f4c"),
 		Text:       gothememe.Hex("#7f7f7f"),
 	}
 }
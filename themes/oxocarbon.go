@@ -43,32 +43,32 @@ func (t *themeOxocarbon) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeOxocarbon) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00b4ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00b4ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#00b4ff19"),
+		Border:     gothememe.Hex("#00b4ff4c"),
 		Text:       gothememe.Hex("#00b4ff"),
 	}
 }
 
 func (t *themeOxocarbon) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff4297").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff4297").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff429719"),
+		Border:     gothememe.Hex("#ff42974c"),
 		Text:       gothememe.Hex("#ff4297"),
 	}
 }
 
 func (t *themeOxocarbon) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00dfdb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00dfdb").WithAlpha(0.3),
+		Background: gothememe.Hex("#00dfdb19"),
+		Border:     gothememe.Hex("#00dfdb4c"),
 		Text:       gothememe.Hex("#00dfdb"),
 	}
 }
 
 func (t *themeOxocarbon) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff74b8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff74b8").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff74b819"),
+		Border:     gothememe.Hex("#ff74b84c"),
 		Text:       gothememe.Hex("#ff74b8"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themePaleNightHc) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themePaleNightHc) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c3e88d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c3e88d").WithAlpha(0.3),
+		Background: gothememe.Hex("#c3e88d19"),
+		Border:     gothememe.Hex("#c3e88d4c"),
 		Text:       gothememe.Hex("#c3e88d"),
 	}
 }
 
 func (t *themePaleNightHc) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffcb6b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffcb6b").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffcb6b19"),
+		Border:     gothememe.Hex("#ffcb6b4c"),
 		Text:       gothememe.Hex("#ffcb6b"),
 	}
 }
 
 func (t *themePaleNightHc) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f07178").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f07178").WithAlpha(0.3),
+		Background: gothememe.Hex("#f0717819"),
+		Border:     gothememe.Hex("#f071784c"),
 		Text:       gothememe.Hex("#f07178"),
 	}
 }
 
 func (t *themePaleNightHc) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#89ddff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#89ddff").WithAlpha(0.3),
+		Background: gothememe.Hex("#89ddff19"),
+		Border:     gothememe.Hex("#89ddff4c"),
 		Text:       gothememe.Hex("#89ddff"),
 	}
 }
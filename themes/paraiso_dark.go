@@ -45,32 +45,32 @@ func (t *themeParaisoDark) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeParaisoDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#48b685").WithAlpha(0.1),
-		Border:     gothememe.Hex("#48b685").WithAlpha(0.3),
+		Background: gothememe.Hex("#48b68519"),
+		Border:     gothememe.Hex("#48b6854c"),
 		Text:       gothememe.Hex("#48b685"),
 	}
 }
 
 func (t *themeParaisoDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fec418").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fec418").WithAlpha(0.3),
+		Background: gothememe.Hex("#fec41819"),
+		Border:     gothememe.Hex("#fec4184c"),
 		Text:       gothememe.Hex("#fec418"),
 	}
 }
 
 func (t *themeParaisoDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ef6155").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ef6155").WithAlpha(0.3),
+		Background: gothememe.Hex("#ef615519"),
+		Border:     gothememe.Hex("#ef61554c"),
 		Text:       gothememe.Hex("#ef6155"),
 	}
 }
 
 func (t *themeParaisoDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5bc4bf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5bc4bf").WithAlpha(0.3),
+		Background: gothememe.Hex("#5bc4bf19"),
+		Border:     gothememe.Hex("#5bc4bf4c"),
 		Text:       gothememe.Hex("#5bc4bf"),
 	}
 }
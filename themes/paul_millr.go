@@ -43,32 +43,32 @@ func (t *themePaulMillr) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themePaulMillr) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#79ff0f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#79ff0f").WithAlpha(0.3),
+		Background: gothememe.Hex("#79ff0f19"),
+		Border:     gothememe.Hex("#79ff0f4c"),
 		Text:       gothememe.Hex("#79ff0f"),
 	}
 }
 
 func (t *themePaulMillr) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e7bf00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e7bf00").WithAlpha(0.3),
+		Background: gothememe.Hex("#e7bf0019"),
+		Border:     gothememe.Hex("#e7bf004c"),
 		Text:       gothememe.Hex("#e7bf00"),
 	}
 }
 
 func (t *themePaulMillr) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff0000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff0000").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff000019"),
+		Border:     gothememe.Hex("#ff00004c"),
 		Text:       gothememe.Hex("#ff0000"),
 	}
 }
 
 func (t *themePaulMillr) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#66ccff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#66ccff").WithAlpha(0.3),
+		Background: gothememe.Hex("#66ccff19"),
+		Border:     gothememe.Hex("#66ccff4c"),
 		Text:       gothememe.Hex("#66ccff"),
 	}
 }
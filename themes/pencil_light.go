@@ -45,32 +45,32 @@ func (t *themePencilLight) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themePencilLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#10a778").WithAlpha(0.1),
-		Border:     gothememe.Hex("#10a778").WithAlpha(0.3),
+		Background: gothememe.Hex("#10a77819"),
+		Border:     gothememe.Hex("#10a7784c"),
 		Text:       gothememe.Hex("#10a778"),
 	}
 }
 
 func (t *themePencilLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a89c14").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a89c14").WithAlpha(0.3),
+		Background: gothememe.Hex("#a89c1419"),
+		Border:     gothememe.Hex("#a89c144c"),
 		Text:       gothememe.Hex("#a89c14"),
 	}
 }
 
 func (t *themePencilLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c30771").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c30771").WithAlpha(0.3),
+		Background: gothememe.Hex("#c3077119"),
+		Border:     gothememe.Hex("#c307714c"),
 		Text:       gothememe.Hex("#c30771"),
 	}
 }
 
 func (t *themePencilLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#20a5ba").WithAlpha(0.1),
-		Border:     gothememe.Hex("#20a5ba").WithAlpha(0.3),
+		Background: gothememe.Hex("#20a5ba19"),
+		Border:     gothememe.Hex("#20a5ba4c"),
 		Text:       gothememe.Hex("#20a5ba"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themePeppermint) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themePeppermint) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#89d287").WithAlpha(0.1),
-		Border:     gothememe.Hex("#89d287").WithAlpha(0.3),
+		Background: gothememe.Hex("#89d28719"),
+		Border:     gothememe.Hex("#89d2874c"),
 		Text:       gothememe.Hex("#89d287"),
 	}
 }
 
 func (t *themePeppermint) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dab853").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dab853").WithAlpha(0.3),
+		Background: gothememe.Hex("#dab85319"),
+		Border:     gothememe.Hex("#dab8534c"),
 		Text:       gothememe.Hex("#dab853"),
 	}
 }
 
 func (t *themePeppermint) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e74669").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e74669").WithAlpha(0.3),
+		Background: gothememe.Hex("#e7466919"),
+		Border:     gothememe.Hex("#e746694c"),
 		Text:       gothememe.Hex("#e74669"),
 	}
 }
 
 func (t *themePeppermint) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#65aaaf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#65aaaf").WithAlpha(0.3),
+		Background: gothememe.Hex("#65aaaf19"),
+		Border:     gothememe.Hex("#65aaaf4c"),
 		Text:       gothememe.Hex("#65aaaf"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themePhalaGreenDark) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themePhalaGreenDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00b100").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00b100").WithAlpha(0.3),
+		Background: gothememe.Hex("#00b10019"),
+		Border:     gothememe.Hex("#00b1004c"),
 		Text:       gothememe.Hex("#00b100"),
 	}
 }
 
 func (t *themePhalaGreenDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a9a700").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a9a700").WithAlpha(0.3),
+		Background: gothememe.Hex("#a9a70019"),
+		Border:     gothememe.Hex("#a9a7004c"),
 		Text:       gothememe.Hex("#a9a700"),
 	}
 }
 
 func (t *themePhalaGreenDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ab1500").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ab1500").WithAlpha(0.3),
+		Background: gothememe.Hex("#ab150019"),
+		Border:     gothememe.Hex("#ab15004c"),
 		Text:       gothememe.Hex("#ab1500"),
 	}
 }
 
 func (t *themePhalaGreenDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00b4c0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00b4c0").WithAlpha(0.3),
+		Background: gothememe.Hex("#00b4c019"),
+		Border:     gothememe.Hex("#00b4c04c"),
 		Text:       gothememe.Hex("#00b4c0"),
 	}
 }
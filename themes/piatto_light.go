@@ -45,32 +45,32 @@ func (t *themePiattoLight) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themePiattoLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#66781e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#66781e").WithAlpha(0.3),
+		Background: gothememe.Hex("#66781e19"),
+		Border:     gothememe.Hex("#66781e4c"),
 		Text:       gothememe.Hex("#66781e"),
 	}
 }
 
 func (t *themePiattoLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cd6f34").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cd6f34").WithAlpha(0.3),
+		Background: gothememe.Hex("#cd6f3419"),
+		Border:     gothememe.Hex("#cd6f344c"),
 		Text:       gothememe.Hex("#cd6f34"),
 	}
 }
 
 func (t *themePiattoLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b23771").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b23771").WithAlpha(0.3),
+		Background: gothememe.Hex("#b2377119"),
+		Border:     gothememe.Hex("#b237714c"),
 		Text:       gothememe.Hex("#b23771"),
 	}
 }
 
 func (t *themePiattoLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#66781e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#66781e").WithAlpha(0.3),
+		Background: gothememe.Hex("#66781e19"),
+		Border:     gothememe.Hex("#66781e4c"),
 		Text:       gothememe.Hex("#66781e"),
 	}
 }
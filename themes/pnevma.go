@@ -43,32 +43,32 @@ func (t *themePnevma) BorderStrong() gothememe.Color { return gothememe.Hex("#d0
 // Semantic colors
 func (t *themePnevma) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#90a57d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#90a57d").WithAlpha(0.3),
+		Background: gothememe.Hex("#90a57d19"),
+		Border:     gothememe.Hex("#90a57d4c"),
 		Text:       gothememe.Hex("#90a57d"),
 	}
 }
 
 func (t *themePnevma) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d7af87").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d7af87").WithAlpha(0.3),
+		Background: gothememe.Hex("#d7af8719"),
+		Border:     gothememe.Hex("#d7af874c"),
 		Text:       gothememe.Hex("#d7af87"),
 	}
 }
 
 func (t *themePnevma) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a36666").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a36666").WithAlpha(0.3),
+		Background: gothememe.Hex("#a3666619"),
+		Border:     gothememe.Hex("#a366664c"),
 		Text:       gothememe.Hex("#a36666"),
 	}
 }
 
 func (t *themePnevma) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8adbb4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8adbb4").WithAlpha(0.3),
+		Background: gothememe.Hex("#8adbb419"),
+		Border:     gothememe.Hex("#8adbb44c"),
 		Text:       gothememe.Hex("#8adbb4"),
 	}
 }
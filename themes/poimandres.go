@@ -43,32 +43,32 @@ func (t *themePoimandres) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themePoimandres) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5de4c7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5de4c7").WithAlpha(0.3),
+		Background: gothememe.Hex("#5de4c719"),
+		Border:     gothememe.Hex("#5de4c74c"),
 		Text:       gothememe.Hex("#5de4c7"),
 	}
 }
 
 func (t *themePoimandres) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fffac2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fffac2").WithAlpha(0.3),
+		Background: gothememe.Hex("#fffac219"),
+		Border:     gothememe.Hex("#fffac24c"),
 		Text:       gothememe.Hex("#fffac2"),
 	}
 }
 
 func (t *themePoimandres) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d0679d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d0679d").WithAlpha(0.3),
+		Background: gothememe.Hex("#d0679d19"),
+		Border:     gothememe.Hex("#d0679d4c"),
 		Text:       gothememe.Hex("#d0679d"),
 	}
 }
 
 func (t *themePoimandres) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#add7ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#add7ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#add7ff19"),
+		Border:     gothememe.Hex("#add7ff4c"),
 		Text:       gothememe.Hex("#add7ff"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themePoimandresStorm) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themePoimandresStorm) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5de4c7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5de4c7").WithAlpha(0.3),
+		Background: gothememe.Hex("#5de4c719"),
+		Border:     gothememe.Hex("#5de4c74c"),
 		Text:       gothememe.Hex("#5de4c7"),
 	}
 }
 
 func (t *themePoimandresStorm) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fffac2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fffac2").WithAlpha(0.3),
+		Background: gothememe.Hex("#fffac219"),
+		Border:     gothememe.Hex("#fffac24c"),
 		Text:       gothememe.Hex("#fffac2"),
 	}
 }
 
 func (t *themePoimandresStorm) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d0679d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d0679d").WithAlpha(0.3),
+		Background: gothememe.Hex("#d0679d19"),
+		Border:     gothememe.Hex("#d0679d4c"),
 		Text:       gothememe.Hex("#d0679d"),
 	}
 }
 
 func (t *themePoimandresStorm) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#89ddff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#89ddff").WithAlpha(0.3),
+		Background: gothememe.Hex("#89ddff19"),
+		Border:     gothememe.Hex("#89ddff4c"),
 		Text:       gothememe.Hex("#89ddff"),
 	}
 }
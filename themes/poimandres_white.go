@@ -45,32 +45,32 @@ func (t *themePoimandresWhite) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themePoimandresWhite) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#01dab2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#01dab2").WithAlpha(0.3),
+		Background: gothememe.Hex("#01dab219"),
+		Border:     gothememe.Hex("#01dab24c"),
 		Text:       gothememe.Hex("#01dab2"),
 	}
 }
 
 func (t *themePoimandresWhite) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e5ba4e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e5ba4e").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5ba4e19"),
+		Border:     gothememe.Hex("#e5ba4e4c"),
 		Text:       gothememe.Hex("#e5ba4e"),
 	}
 }
 
 func (t *themePoimandresWhite) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff2090").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff2090").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff209019"),
+		Border:     gothememe.Hex("#ff20904c"),
 		Text:       gothememe.Hex("#ff2090"),
 	}
 }
 
 func (t *themePoimandresWhite) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8abacd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8abacd").WithAlpha(0.3),
+		Background: gothememe.Hex("#8abacd19"),
+		Border:     gothememe.Hex("#8abacd4c"),
 		Text:       gothememe.Hex("#8abacd"),
 	}
 }
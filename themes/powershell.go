@@ -43,32 +43,32 @@ func (t *themePowershell) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themePowershell) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#098003").WithAlpha(0.1),
-		Border:     gothememe.Hex("#098003").WithAlpha(0.3),
+		Background: gothememe.Hex("#09800319"),
+		Border:     gothememe.Hex("#0980034c"),
 		Text:       gothememe.Hex("#098003"),
 	}
 }
 
 func (t *themePowershell) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c4a000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c4a000").WithAlpha(0.3),
+		Background: gothememe.Hex("#c4a00019"),
+		Border:     gothememe.Hex("#c4a0004c"),
 		Text:       gothememe.Hex("#c4a000"),
 	}
 }
 
 func (t *themePowershell) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#981a22").WithAlpha(0.1),
-		Border:     gothememe.Hex("#981a22").WithAlpha(0.3),
+		Background: gothememe.Hex("#981a2219"),
+		Border:     gothememe.Hex("#981a224c"),
 		Text:       gothememe.Hex("#981a22"),
 	}
 }
 
 func (t *themePowershell) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0e807f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0e807f").WithAlpha(0.3),
+		Background: gothememe.Hex("#0e807f19"),
+		Border:     gothememe.Hex("#0e807f4c"),
 		Text:       gothememe.Hex("#0e807f"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themePrimary) BorderStrong() gothememe.Color { return gothememe.Hex("#b
 // Semantic colors
 func (t *themePrimary) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0f9d58").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0f9d58").WithAlpha(0.3),
+		Background: gothememe.Hex("#0f9d5819"),
+		Border:     gothememe.Hex("#0f9d584c"),
 		Text:       gothememe.Hex("#0f9d58"),
 	}
 }
 
 func (t *themePrimary) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f4b400").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f4b400").WithAlpha(0.3),
+		Background: gothememe.Hex("#f4b40019"),
+		Border:     gothememe.Hex("#f4b4004c"),
 		Text:       gothememe.Hex("#f4b400"),
 	}
 }
 
 func (t *themePrimary) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#db4437").WithAlpha(0.1),
-		Border:     gothememe.Hex("#db4437").WithAlpha(0.3),
+		Background: gothememe.Hex("#db443719"),
+		Border:     gothememe.Hex("#db44374c"),
 		Text:       gothememe.Hex("#db4437"),
 	}
 }
 
 func (t *themePrimary) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4285f4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4285f4").WithAlpha(0.3),
+		Background: gothememe.Hex("#4285f419"),
+		Border:     gothememe.Hex("#4285f44c"),
 		Text:       gothememe.Hex("#4285f4"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeProLight) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeProLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#50d148").WithAlpha(0.1),
-		Border:     gothememe.Hex("#50d148").WithAlpha(0.3),
+		Background: gothememe.Hex("#50d14819"),
+		Border:     gothememe.Hex("#50d1484c"),
 		Text:       gothememe.Hex("#50d148"),
 	}
 }
 
 func (t *themeProLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c6c440").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c6c440").WithAlpha(0.3),
+		Background: gothememe.Hex("#c6c44019"),
+		Border:     gothememe.Hex("#c6c4404c"),
 		Text:       gothememe.Hex("#c6c440"),
 	}
 }
 
 func (t *themeProLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e5492b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e5492b").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5492b19"),
+		Border:     gothememe.Hex("#e5492b4c"),
 		Text:       gothememe.Hex("#e5492b"),
 	}
 }
 
 func (t *themeProLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4ed2de").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4ed2de").WithAlpha(0.3),
+		Background: gothememe.Hex("#4ed2de19"),
+		Border:     gothememe.Hex("#4ed2de4c"),
 		Text:       gothememe.Hex("#4ed2de"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themePurpleRain) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themePurpleRain) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9be205").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9be205").WithAlpha(0.3),
+		Background: gothememe.Hex("#9be20519"),
+		Border:     gothememe.Hex("#9be2054c"),
 		Text:       gothememe.Hex("#9be205"),
 	}
 }
 
 func (t *themePurpleRain) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc400").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc400").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc40019"),
+		Border:     gothememe.Hex("#ffc4004c"),
 		Text:       gothememe.Hex("#ffc400"),
 	}
 }
 
 func (t *themePurpleRain) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff260e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff260e").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff260e19"),
+		Border:     gothememe.Hex("#ff260e4c"),
 		Text:       gothememe.Hex("#ff260e"),
 	}
 }
 
 func (t *themePurpleRain) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00deef").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00deef").WithAlpha(0.3),
+		Background: gothememe.Hex("#00deef19"),
+		Border:     gothememe.Hex("#00deef4c"),
 		Text:       gothememe.Hex("#00deef"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themePurplepeter) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themePurplepeter) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#99b481").WithAlpha(0.1),
-		Border:     gothememe.Hex("#99b481").WithAlpha(0.3),
+		Background: gothememe.Hex("#99b48119"),
+		Border:     gothememe.Hex("#99b4814c"),
 		Text:       gothememe.Hex("#99b481"),
 	}
 }
 
 func (t *themePurplepeter) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#efdfac").WithAlpha(0.1),
-		Border:     gothememe.Hex("#efdfac").WithAlpha(0.3),
+		Background: gothememe.Hex("#efdfac19"),
+		Border:     gothememe.Hex("#efdfac4c"),
 		Text:       gothememe.Hex("#efdfac"),
 	}
 }
 
 func (t *themePurplepeter) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff796d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff796d").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff796d19"),
+		Border:     gothememe.Hex("#ff796d4c"),
 		Text:       gothememe.Hex("#ff796d"),
 	}
 }
 
 func (t *themePurplepeter) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ba8cff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ba8cff").WithAlpha(0.3),
+		Background: gothememe.Hex("#ba8cff19"),
+		Border:     gothememe.Hex("#ba8cff4c"),
 		Text:       gothememe.Hex("#ba8cff"),
 	}
 }
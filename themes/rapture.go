@@ -43,32 +43,32 @@ func (t *themeRapture) BorderStrong() gothememe.Color { return gothememe.Hex("#c
 // Semantic colors
 func (t *themeRapture) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7afde1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7afde1").WithAlpha(0.3),
+		Background: gothememe.Hex("#7afde119"),
+		Border:     gothememe.Hex("#7afde14c"),
 		Text:       gothememe.Hex("#7afde1"),
 	}
 }
 
 func (t *themeRapture) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fff09b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fff09b").WithAlpha(0.3),
+		Background: gothememe.Hex("#fff09b19"),
+		Border:     gothememe.Hex("#fff09b4c"),
 		Text:       gothememe.Hex("#fff09b"),
 	}
 }
 
 func (t *themeRapture) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fc644d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fc644d").WithAlpha(0.3),
+		Background: gothememe.Hex("#fc644d19"),
+		Border:     gothememe.Hex("#fc644d4c"),
 		Text:       gothememe.Hex("#fc644d"),
 	}
 }
 
 func (t *themeRapture) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#64e0ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#64e0ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#64e0ff19"),
+		Border:     gothememe.Hex("#64e0ff4c"),
 		Text:       gothememe.Hex("#64e0ff"),
 	}
 }
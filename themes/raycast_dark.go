@@ -45,32 +45,32 @@ func (t *themeRaycastDark) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeRaycastDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#59d499").WithAlpha(0.1),
-		Border:     gothememe.Hex("#59d499").WithAlpha(0.3),
+		Background: gothememe.Hex("#59d49919"),
+		Border:     gothememe.Hex("#59d4994c"),
 		Text:       gothememe.Hex("#59d499"),
 	}
 }
 
 func (t *themeRaycastDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc531").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc531").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc53119"),
+		Border:     gothememe.Hex("#ffc5314c"),
 		Text:       gothememe.Hex("#ffc531"),
 	}
 }
 
 func (t *themeRaycastDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff5360").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff5360").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff536019"),
+		Border:     gothememe.Hex("#ff53604c"),
 		Text:       gothememe.Hex("#ff5360"),
 	}
 }
 
 func (t *themeRaycastDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#52eee5").WithAlpha(0.1),
-		Border:     gothememe.Hex("#52eee5").WithAlpha(0.3),
+		Background: gothememe.Hex("#52eee519"),
+		Border:     gothememe.Hex("#52eee54c"),
 		Text:       gothememe.Hex("#52eee5"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeRaycastLight) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeRaycastLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#006b4f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#006b4f").WithAlpha(0.3),
+		Background: gothememe.Hex("#006b4f19"),
+		Border:     gothememe.Hex("#006b4f4c"),
 		Text:       gothememe.Hex("#006b4f"),
 	}
 }
 
 func (t *themeRaycastLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f8a300").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f8a300").WithAlpha(0.3),
+		Background: gothememe.Hex("#f8a30019"),
+		Border:     gothememe.Hex("#f8a3004c"),
 		Text:       gothememe.Hex("#f8a300"),
 	}
 }
 
 func (t *themeRaycastLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b12424").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b12424").WithAlpha(0.3),
+		Background: gothememe.Hex("#b1242419"),
+		Border:     gothememe.Hex("#b124244c"),
 		Text:       gothememe.Hex("#b12424"),
 	}
 }
 
 func (t *themeRaycastLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3eb8bf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3eb8bf").WithAlpha(0.3),
+		Background: gothememe.Hex("#3eb8bf19"),
+		Border:     gothememe.Hex("#3eb8bf4c"),
 		Text:       gothememe.Hex("#3eb8bf"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeRebecca) BorderStrong() gothememe.Color { return gothememe.Hex("#e
 // Semantic colors
 func (t *themeRebecca) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#04dbb5").WithAlpha(0.1),
-		Border:     gothememe.Hex("#04dbb5").WithAlpha(0.3),
+		Background: gothememe.Hex("#04dbb519"),
+		Border:     gothememe.Hex("#04dbb54c"),
 		Text:       gothememe.Hex("#04dbb5"),
 	}
 }
 
 func (t *themeRebecca) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f2e7b7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f2e7b7").WithAlpha(0.3),
+		Background: gothememe.Hex("#f2e7b719"),
+		Border:     gothememe.Hex("#f2e7b74c"),
 		Text:       gothememe.Hex("#f2e7b7"),
 	}
 }
 
 func (t *themeRebecca) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dd7755").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dd7755").WithAlpha(0.3),
+		Background: gothememe.Hex("#dd775519"),
+		Border:     gothememe.Hex("#dd77554c"),
 		Text:       gothememe.Hex("#dd7755"),
 	}
 }
 
 func (t *themeRebecca) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#56d3c2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#56d3c2").WithAlpha(0.3),
+		Background: gothememe.Hex("#56d3c219"),
+		Border:     gothememe.Hex("#56d3c24c"),
 		Text:       gothememe.Hex("#56d3c2"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeRedAlert) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeRedAlert) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#71be6b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#71be6b").WithAlpha(0.3),
+		Background: gothememe.Hex("#71be6b19"),
+		Border:     gothememe.Hex("#71be6b4c"),
 		Text:       gothememe.Hex("#71be6b"),
 	}
 }
 
 func (t *themeRedAlert) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#beb86b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#beb86b").WithAlpha(0.3),
+		Background: gothememe.Hex("#beb86b19"),
+		Border:     gothememe.Hex("#beb86b4c"),
 		Text:       gothememe.Hex("#beb86b"),
 	}
 }
 
 func (t *themeRedAlert) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d62e4e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d62e4e").WithAlpha(0.3),
+		Background: gothememe.Hex("#d62e4e19"),
+		Border:     gothememe.Hex("#d62e4e4c"),
 		Text:       gothememe.Hex("#d62e4e"),
 	}
 }
 
 func (t *themeRedAlert) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6bbeb8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6bbeb8").WithAlpha(0.3),
+		Background: gothememe.Hex("#6bbeb819"),
+		Border:     gothememe.Hex("#6bbeb84c"),
 		Text:       gothememe.Hex("#6bbeb8"),
 	}
 }
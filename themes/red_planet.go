@@ -43,32 +43,32 @@ func (t *themeRedPlanet) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeRedPlanet) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#728271").WithAlpha(0.1),
-		Border:     gothememe.Hex("#728271").WithAlpha(0.3),
+		Background: gothememe.Hex("#72827119"),
+		Border:     gothememe.Hex("#7282714c"),
 		Text:       gothememe.Hex("#728271"),
 	}
 }
 
 func (t *themeRedPlanet) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e8bf6a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e8bf6a").WithAlpha(0.3),
+		Background: gothememe.Hex("#e8bf6a19"),
+		Border:     gothememe.Hex("#e8bf6a4c"),
 		Text:       gothememe.Hex("#e8bf6a"),
 	}
 }
 
 func (t *themeRedPlanet) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8c3432").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8c3432").WithAlpha(0.3),
+		Background: gothememe.Hex("#8c343219"),
+		Border:     gothememe.Hex("#8c34324c"),
 		Text:       gothememe.Hex("#8c3432"),
 	}
 }
 
 func (t *themeRedPlanet) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5b8390").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5b8390").WithAlpha(0.3),
+		Background: gothememe.Hex("#5b839019"),
+		Border:     gothememe.Hex("#5b83904c"),
 		Text:       gothememe.Hex("#5b8390"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeRedSands) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeRedSands) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00bb00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00bb00").WithAlpha(0.3),
+		Background: gothememe.Hex("#00bb0019"),
+		Border:     gothememe.Hex("#00bb004c"),
 		Text:       gothememe.Hex("#00bb00"),
 	}
 }
 
 func (t *themeRedSands) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e7b000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e7b000").WithAlpha(0.3),
+		Background: gothememe.Hex("#e7b00019"),
+		Border:     gothememe.Hex("#e7b0004c"),
 		Text:       gothememe.Hex("#e7b000"),
 	}
 }
 
 func (t *themeRedSands) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff3f00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff3f00").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff3f0019"),
+		Border:     gothememe.Hex("#ff3f004c"),
 		Text:       gothememe.Hex("#ff3f00"),
 	}
 }
 
 func (t *themeRedSands) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00bbbb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00bbbb").WithAlpha(0.3),
+		Background: gothememe.Hex("#00bbbb19"),
+		Border:     gothememe.Hex("#00bbbb4c"),
 		Text:       gothememe.Hex("#00bbbb"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeRelaxed) BorderStrong() gothememe.Color { return gothememe.Hex("#d
 // Semantic colors
 func (t *themeRelaxed) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#909d63").WithAlpha(0.1),
-		Border:     gothememe.Hex("#909d63").WithAlpha(0.3),
+		Background: gothememe.Hex("#909d6319"),
+		Border:     gothememe.Hex("#909d634c"),
 		Text:       gothememe.Hex("#909d63"),
 	}
 }
 
 func (t *themeRelaxed) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ebc17a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ebc17a").WithAlpha(0.3),
+		Background: gothememe.Hex("#ebc17a19"),
+		Border:     gothememe.Hex("#ebc17a4c"),
 		Text:       gothememe.Hex("#ebc17a"),
 	}
 }
 
 func (t *themeRelaxed) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bc5653").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bc5653").WithAlpha(0.3),
+		Background: gothememe.Hex("#bc565319"),
+		Border:     gothememe.Hex("#bc56534c"),
 		Text:       gothememe.Hex("#bc5653"),
 	}
 }
 
 func (t *themeRelaxed) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c9dfff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c9dfff").WithAlpha(0.3),
+		Background: gothememe.Hex("#c9dfff19"),
+		Border:     gothememe.Hex("#c9dfff4c"),
 		Text:       gothememe.Hex("#c9dfff"),
 	}
 }
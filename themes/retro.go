@@ -43,32 +43,32 @@ func (t *themeRetro) BorderStrong() gothememe.Color { return gothememe.Hex("#13a
 // Semantic colors
 func (t *themeRetro) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#13a10e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#13a10e").WithAlpha(0.3),
+		Background: gothememe.Hex("#13a10e19"),
+		Border:     gothememe.Hex("#13a10e4c"),
 		Text:       gothememe.Hex("#13a10e"),
 	}
 }
 
 func (t *themeRetro) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#13a10e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#13a10e").WithAlpha(0.3),
+		Background: gothememe.Hex("#13a10e19"),
+		Border:     gothememe.Hex("#13a10e4c"),
 		Text:       gothememe.Hex("#13a10e"),
 	}
 }
 
 func (t *themeRetro) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#13a10e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#13a10e").WithAlpha(0.3),
+		Background: gothememe.Hex("#13a10e19"),
+		Border:     gothememe.Hex("#13a10e4c"),
 		Text:       gothememe.Hex("#13a10e"),
 	}
 }
 
 func (t *themeRetro) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#13a10e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#13a10e").WithAlpha(0.3),
+		Background: gothememe.Hex("#13a10e19"),
+		Border:     gothememe.Hex("#13a10e4c"),
 		Text:       gothememe.Hex("#13a10e"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeRetroLegends) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeRetroLegends) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#45eb45").WithAlpha(0.1),
-		Border:     gothememe.Hex("#45eb45").WithAlpha(0.3),
+		Background: gothememe.Hex("#45eb4519"),
+		Border:     gothememe.Hex("#45eb454c"),
 		Text:       gothememe.Hex("#45eb45"),
 	}
 }
 
 func (t *themeRetroLegends) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f7bf2b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f7bf2b").WithAlpha(0.3),
+		Background: gothememe.Hex("#f7bf2b19"),
+		Border:     gothememe.Hex("#f7bf2b4c"),
 		Text:       gothememe.Hex("#f7bf2b"),
 	}
 }
 
 func (t *themeRetroLegends) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#de5454").WithAlpha(0.1),
-		Border:     gothememe.Hex("#de5454").WithAlpha(0.3),
+		Background: gothememe.Hex("#de545419"),
+		Border:     gothememe.Hex("#de54544c"),
 		Text:       gothememe.Hex("#de5454"),
 	}
 }
 
 func (t *themeRetroLegends) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#40d9e6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#40d9e6").WithAlpha(0.3),
+		Background: gothememe.Hex("#40d9e619"),
+		Border:     gothememe.Hex("#40d9e64c"),
 		Text:       gothememe.Hex("#40d9e6"),
 	}
 }
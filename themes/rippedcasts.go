@@ -45,32 +45,32 @@ func (t *themeRippedcasts) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeRippedcasts) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a8ff60").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a8ff60").WithAlpha(0.3),
+		Background: gothememe.Hex("#a8ff6019"),
+		Border:     gothememe.Hex("#a8ff604c"),
 		Text:       gothememe.Hex("#a8ff60"),
 	}
 }
 
 func (t *themeRippedcasts) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bfbb1f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bfbb1f").WithAlpha(0.3),
+		Background: gothememe.Hex("#bfbb1f19"),
+		Border:     gothememe.Hex("#bfbb1f4c"),
 		Text:       gothememe.Hex("#bfbb1f"),
 	}
 }
 
 func (t *themeRippedcasts) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cdaf95").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cdaf95").WithAlpha(0.3),
+		Background: gothememe.Hex("#cdaf9519"),
+		Border:     gothememe.Hex("#cdaf954c"),
 		Text:       gothememe.Hex("#cdaf95"),
 	}
 }
 
 func (t *themeRippedcasts) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5a647e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5a647e").WithAlpha(0.3),
+		Background: gothememe.Hex("#5a647e19"),
+		Border:     gothememe.Hex("#5a647e4c"),
 		Text:       gothememe.Hex("#5a647e"),
 	}
 }
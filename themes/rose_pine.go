@@ -43,32 +43,32 @@ func (t *themeRosePine) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeRosePine) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#31748f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#31748f").WithAlpha(0.3),
+		Background: gothememe.Hex("#31748f19"),
+		Border:     gothememe.Hex("#31748f4c"),
 		Text:       gothememe.Hex("#31748f"),
 	}
 }
 
 func (t *themeRosePine) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f6c177").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f6c177").WithAlpha(0.3),
+		Background: gothememe.Hex("#f6c17719"),
+		Border:     gothememe.Hex("#f6c1774c"),
 		Text:       gothememe.Hex("#f6c177"),
 	}
 }
 
 func (t *themeRosePine) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#eb6f92").WithAlpha(0.1),
-		Border:     gothememe.Hex("#eb6f92").WithAlpha(0.3),
+		Background: gothememe.Hex("#eb6f9219"),
+		Border:     gothememe.Hex("#eb6f924c"),
 		Text:       gothememe.Hex("#eb6f92"),
 	}
 }
 
 func (t *themeRosePine) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ebbcba").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ebbcba").WithAlpha(0.3),
+		Background: gothememe.Hex("#ebbcba19"),
+		Border:     gothememe.Hex("#ebbcba4c"),
 		Text:       gothememe.Hex("#ebbcba"),
 	}
 }
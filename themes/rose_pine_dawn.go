@@ -45,32 +45,32 @@ func (t *themeRosePineDawn) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeRosePineDawn) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#286983").WithAlpha(0.1),
-		Border:     gothememe.Hex("#286983").WithAlpha(0.3),
+		Background: gothememe.Hex("#28698319"),
+		Border:     gothememe.Hex("#2869834c"),
 		Text:       gothememe.Hex("#286983"),
 	}
 }
 
 func (t *themeRosePineDawn) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ea9d34").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ea9d34").WithAlpha(0.3),
+		Background: gothememe.Hex("#ea9d3419"),
+		Border:     gothememe.Hex("#ea9d344c"),
 		Text:       gothememe.Hex("#ea9d34"),
 	}
 }
 
 func (t *themeRosePineDawn) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b4637a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b4637a").WithAlpha(0.3),
+		Background: gothememe.Hex("#b4637a19"),
+		Border:     gothememe.Hex("#b4637a4c"),
 		Text:       gothememe.Hex("#b4637a"),
 	}
 }
 
 func (t *themeRosePineDawn) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d7827e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d7827e").WithAlpha(0.3),
+		Background: gothememe.Hex("#d7827e19"),
+		Border:     gothememe.Hex("#d7827e4c"),
 		Text:       gothememe.Hex("#d7827e"),
 	}
 }
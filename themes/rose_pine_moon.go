@@ -45,32 +45,32 @@ func (t *themeRosePineMoon) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeRosePineMoon) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3e8fb0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3e8fb0").WithAlpha(0.3),
+		Background: gothememe.Hex("#3e8fb019"),
+		Border:     gothememe.Hex("#3e8fb04c"),
 		Text:       gothememe.Hex("#3e8fb0"),
 	}
 }
 
 func (t *themeRosePineMoon) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f6c177").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f6c177").WithAlpha(0.3),
+		Background: gothememe.Hex("#f6c17719"),
+		Border:     gothememe.Hex("#f6c1774c"),
 		Text:       gothememe.Hex("#f6c177"),
 	}
 }
 
 func (t *themeRosePineMoon) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#eb6f92").WithAlpha(0.1),
-		Border:     gothememe.Hex("#eb6f92").WithAlpha(0.3),
+		Background: gothememe.Hex("#eb6f9219"),
+		Border:     gothememe.Hex("#eb6f924c"),
 		Text:       gothememe.Hex("#eb6f92"),
 	}
 }
 
 func (t *themeRosePineMoon) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ea9a97").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ea9a97").WithAlpha(0.3),
+		Background: gothememe.Hex("#ea9a9719"),
+		Border:     gothememe.Hex("#ea9a974c"),
 		Text:       gothememe.Hex("#ea9a97"),
 	}
 }
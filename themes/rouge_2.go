@@ -43,32 +43,32 @@ func (t *themeRouge2) BorderStrong() gothememe.Color { return gothememe.Hex("#e8
 // Semantic colors
 func (t *themeRouge2) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#969e92").WithAlpha(0.1),
-		Border:     gothememe.Hex("#969e92").WithAlpha(0.3),
+		Background: gothememe.Hex("#969e9219"),
+		Border:     gothememe.Hex("#969e924c"),
 		Text:       gothememe.Hex("#969e92"),
 	}
 }
 
 func (t *themeRouge2) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dbcdab").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dbcdab").WithAlpha(0.3),
+		Background: gothememe.Hex("#dbcdab19"),
+		Border:     gothememe.Hex("#dbcdab4c"),
 		Text:       gothememe.Hex("#dbcdab"),
 	}
 }
 
 func (t *themeRouge2) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c6797e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c6797e").WithAlpha(0.3),
+		Background: gothememe.Hex("#c6797e19"),
+		Border:     gothememe.Hex("#c6797e4c"),
 		Text:       gothememe.Hex("#c6797e"),
 	}
 }
 
 func (t *themeRouge2) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8ab6c1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8ab6c1").WithAlpha(0.3),
+		Background: gothememe.Hex("#8ab6c119"),
+		Border:     gothememe.Hex("#8ab6c14c"),
 		Text:       gothememe.Hex("#8ab6c1"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeRoyal) BorderStrong() gothememe.Color { return gothememe.Hex("#524
 // Semantic colors
 func (t *themeRoyal) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#23801c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#23801c").WithAlpha(0.3),
+		Background: gothememe.Hex("#23801c19"),
+		Border:     gothememe.Hex("#23801c4c"),
 		Text:       gothememe.Hex("#23801c"),
 	}
 }
 
 func (t *themeRoyal) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b49d27").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b49d27").WithAlpha(0.3),
+		Background: gothememe.Hex("#b49d2719"),
+		Border:     gothememe.Hex("#b49d274c"),
 		Text:       gothememe.Hex("#b49d27"),
 	}
 }
 
 func (t *themeRoyal) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#91284c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#91284c").WithAlpha(0.3),
+		Background: gothememe.Hex("#91284c19"),
+		Border:     gothememe.Hex("#91284c4c"),
 		Text:       gothememe.Hex("#91284c"),
 	}
 }
 
 func (t *themeRoyal) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8aaabe").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8aaabe").WithAlpha(0.3),
+		Background: gothememe.Hex("#8aaabe19"),
+		Border:     gothememe.Hex("#8aaabe4c"),
 		Text:       gothememe.Hex("#8aaabe"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeRyuuko) BorderStrong() gothememe.Color { return gothememe.Hex("#ec
 // Semantic colors
 func (t *themeRyuuko) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#66907d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#66907d").WithAlpha(0.3),
+		Background: gothememe.Hex("#66907d19"),
+		Border:     gothememe.Hex("#66907d4c"),
 		Text:       gothememe.Hex("#66907d"),
 	}
 }
 
 func (t *themeRyuuko) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b1a990").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b1a990").WithAlpha(0.3),
+		Background: gothememe.Hex("#b1a99019"),
+		Border:     gothememe.Hex("#b1a9904c"),
 		Text:       gothememe.Hex("#b1a990"),
 	}
 }
 
 func (t *themeRyuuko) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#865f5b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#865f5b").WithAlpha(0.3),
+		Background: gothememe.Hex("#865f5b19"),
+		Border:     gothememe.Hex("#865f5b4c"),
 		Text:       gothememe.Hex("#865f5b"),
 	}
 }
 
 func (t *themeRyuuko) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#88b2ac").WithAlpha(0.1),
-		Border:     gothememe.Hex("#88b2ac").WithAlpha(0.3),
+		Background: gothememe.Hex("#88b2ac19"),
+		Border:     gothememe.Hex("#88b2ac4c"),
 		Text:       gothememe.Hex("#88b2ac"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeSakura) BorderStrong() gothememe.Color { return gothememe.Hex("#99
 // Semantic colors
 func (t *themeSakura) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#41af1a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#41af1a").WithAlpha(0.3),
+		Background: gothememe.Hex("#41af1a19"),
+		Border:     gothememe.Hex("#41af1a4c"),
 		Text:       gothememe.Hex("#41af1a"),
 	}
 }
 
 func (t *themeSakura) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bc7053").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bc7053").WithAlpha(0.3),
+		Background: gothememe.Hex("#bc705319"),
+		Border:     gothememe.Hex("#bc70534c"),
 		Text:       gothememe.Hex("#bc7053"),
 	}
 }
 
 func (t *themeSakura) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d52370").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d52370").WithAlpha(0.3),
+		Background: gothememe.Hex("#d5237019"),
+		Border:     gothememe.Hex("#d523704c"),
 		Text:       gothememe.Hex("#d52370"),
 	}
 }
 
 func (t *themeSakura) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#939393").WithAlpha(0.1),
-		Border:     gothememe.Hex("#939393").WithAlpha(0.3),
+		Background: gothememe.Hex("#93939319"),
+		Border:     gothememe.Hex("#9393934c"),
 		Text:       gothememe.Hex("#939393"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeScarletProtocol) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeScarletProtocol) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00dc84").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00dc84").WithAlpha(0.3),
+		Background: gothememe.Hex("#00dc8419"),
+		Border:     gothememe.Hex("#00dc844c"),
 		Text:       gothememe.Hex("#00dc84"),
 	}
 }
 
 func (t *themeScarletProtocol) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#faf945").WithAlpha(0.1),
-		Border:     gothememe.Hex("#faf945").WithAlpha(0.3),
+		Background: gothememe.Hex("#faf94519"),
+		Border:     gothememe.Hex("#faf9454c"),
 		Text:       gothememe.Hex("#faf945"),
 	}
 }
 
 func (t *themeScarletProtocol) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff0051").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff0051").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff005119"),
+		Border:     gothememe.Hex("#ff00514c"),
 		Text:       gothememe.Hex("#ff0051"),
 	}
 }
 
 func (t *themeScarletProtocol) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00c5c7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00c5c7").WithAlpha(0.3),
+		Background: gothememe.Hex("#00c5c719"),
+		Border:     gothememe.Hex("#00c5c74c"),
 		Text:       gothememe.Hex("#00c5c7"),
 	}
 }
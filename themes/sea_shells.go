@@ -43,32 +43,32 @@ func (t *themeSeaShells) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeSeaShells) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#027c9b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#027c9b").WithAlpha(0.3),
+		Background: gothememe.Hex("#027c9b19"),
+		Border:     gothememe.Hex("#027c9b4c"),
 		Text:       gothememe.Hex("#027c9b"),
 	}
 }
 
 func (t *themeSeaShells) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fca02f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fca02f").WithAlpha(0.3),
+		Background: gothememe.Hex("#fca02f19"),
+		Border:     gothememe.Hex("#fca02f4c"),
 		Text:       gothememe.Hex("#fca02f"),
 	}
 }
 
 func (t *themeSeaShells) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d15123").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d15123").WithAlpha(0.3),
+		Background: gothememe.Hex("#d1512319"),
+		Border:     gothememe.Hex("#d151234c"),
 		Text:       gothememe.Hex("#d15123"),
 	}
 }
 
 func (t *themeSeaShells) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#50a3b5").WithAlpha(0.1),
-		Border:     gothememe.Hex("#50a3b5").WithAlpha(0.3),
+		Background: gothememe.Hex("#50a3b519"),
+		Border:     gothememe.Hex("#50a3b54c"),
 		Text:       gothememe.Hex("#50a3b5"),
 	}
 }
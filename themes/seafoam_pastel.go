@@ -45,32 +45,32 @@ func (t *themeSeafoamPastel) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeSeafoamPastel) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#728c62").WithAlpha(0.1),
-		Border:     gothememe.Hex("#728c62").WithAlpha(0.3),
+		Background: gothememe.Hex("#728c6219"),
+		Border:     gothememe.Hex("#728c624c"),
 		Text:       gothememe.Hex("#728c62"),
 	}
 }
 
 func (t *themeSeafoamPastel) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ada16d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ada16d").WithAlpha(0.3),
+		Background: gothememe.Hex("#ada16d19"),
+		Border:     gothememe.Hex("#ada16d4c"),
 		Text:       gothememe.Hex("#ada16d"),
 	}
 }
 
 func (t *themeSeafoamPastel) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#825d4d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#825d4d").WithAlpha(0.3),
+		Background: gothememe.Hex("#825d4d19"),
+		Border:     gothememe.Hex("#825d4d4c"),
 		Text:       gothememe.Hex("#825d4d"),
 	}
 }
 
 func (t *themeSeafoamPastel) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#729494").WithAlpha(0.1),
-		Border:     gothememe.Hex("#729494").WithAlpha(0.3),
+		Background: gothememe.Hex("#72949419"),
+		Border:     gothememe.Hex("#7294944c"),
 		Text:       gothememe.Hex("#729494"),
 	}
 }
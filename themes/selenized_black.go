@@ -45,32 +45,32 @@ func (t *themeSelenizedBlack) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeSelenizedBlack) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#70b433").WithAlpha(0.1),
-		Border:     gothememe.Hex("#70b433").WithAlpha(0.3),
+		Background: gothememe.Hex("#70b43319"),
+		Border:     gothememe.Hex("#70b4334c"),
 		Text:       gothememe.Hex("#70b433"),
 	}
 }
 
 func (t *themeSelenizedBlack) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dbb32d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dbb32d").WithAlpha(0.3),
+		Background: gothememe.Hex("#dbb32d19"),
+		Border:     gothememe.Hex("#dbb32d4c"),
 		Text:       gothememe.Hex("#dbb32d"),
 	}
 }
 
 func (t *themeSelenizedBlack) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ed4a46").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ed4a46").WithAlpha(0.3),
+		Background: gothememe.Hex("#ed4a4619"),
+		Border:     gothememe.Hex("#ed4a464c"),
 		Text:       gothememe.Hex("#ed4a46"),
 	}
 }
 
 func (t *themeSelenizedBlack) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3fc5b7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3fc5b7").WithAlpha(0.3),
+		Background: gothememe.Hex("#3fc5b719"),
+		Border:     gothememe.Hex("#3fc5b74c"),
 		Text:       gothememe.Hex("#3fc5b7"),
 	}
 }
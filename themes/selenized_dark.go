@@ -45,32 +45,32 @@ func (t *themeSelenizedDark) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeSelenizedDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#75b938").WithAlpha(0.1),
-		Border:     gothememe.Hex("#75b938").WithAlpha(0.3),
+		Background: gothememe.Hex("#75b93819"),
+		Border:     gothememe.Hex("#75b9384c"),
 		Text:       gothememe.Hex("#75b938"),
 	}
 }
 
 func (t *themeSelenizedDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dbb32d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dbb32d").WithAlpha(0.3),
+		Background: gothememe.Hex("#dbb32d19"),
+		Border:     gothememe.Hex("#dbb32d4c"),
 		Text:       gothememe.Hex("#dbb32d"),
 	}
 }
 
 func (t *themeSelenizedDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fa5750").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fa5750").WithAlpha(0.3),
+		Background: gothememe.Hex("#fa575019"),
+		Border:     gothememe.Hex("#fa57504c"),
 		Text:       gothememe.Hex("#fa5750"),
 	}
 }
 
 func (t *themeSelenizedDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#41c7b9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#41c7b9").WithAlpha(0.3),
+		Background: gothememe.Hex("#41c7b919"),
+		Border:     gothememe.Hex("#41c7b94c"),
 		Text:       gothememe.Hex("#41c7b9"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeSelenizedLight) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeSelenizedLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#489100").WithAlpha(0.1),
-		Border:     gothememe.Hex("#489100").WithAlpha(0.3),
+		Background: gothememe.Hex("#48910019"),
+		Border:     gothememe.Hex("#4891004c"),
 		Text:       gothememe.Hex("#489100"),
 	}
 }
 
 func (t *themeSelenizedLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ad8900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ad8900").WithAlpha(0.3),
+		Background: gothememe.Hex("#ad890019"),
+		Border:     gothememe.Hex("#ad89004c"),
 		Text:       gothememe.Hex("#ad8900"),
 	}
 }
 
 func (t *themeSelenizedLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d2212d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d2212d").WithAlpha(0.3),
+		Background: gothememe.Hex("#d2212d19"),
+		Border:     gothememe.Hex("#d2212d4c"),
 		Text:       gothememe.Hex("#d2212d"),
 	}
 }
 
 func (t *themeSelenizedLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#009c8f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#009c8f").WithAlpha(0.3),
+		Background: gothememe.Hex("#009c8f19"),
+		Border:     gothememe.Hex("#009c8f4c"),
 		Text:       gothememe.Hex("#009c8f"),
 	}
 }
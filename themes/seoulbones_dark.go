@@ -45,32 +45,32 @@ func (t *themeSeoulbonesDark) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeSeoulbonesDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#98bd99").WithAlpha(0.1),
-		Border:     gothememe.Hex("#98bd99").WithAlpha(0.3),
+		Background: gothememe.Hex("#98bd9919"),
+		Border:     gothememe.Hex("#98bd994c"),
 		Text:       gothememe.Hex("#98bd99"),
 	}
 }
 
 func (t *themeSeoulbonesDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffdf9b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffdf9b").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffdf9b19"),
+		Border:     gothememe.Hex("#ffdf9b4c"),
 		Text:       gothememe.Hex("#ffdf9b"),
 	}
 }
 
 func (t *themeSeoulbonesDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e388a3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e388a3").WithAlpha(0.3),
+		Background: gothememe.Hex("#e388a319"),
+		Border:     gothememe.Hex("#e388a34c"),
 		Text:       gothememe.Hex("#e388a3"),
 	}
 }
 
 func (t *themeSeoulbonesDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6fbdbe").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6fbdbe").WithAlpha(0.3),
+		Background: gothememe.Hex("#6fbdbe19"),
+		Border:     gothememe.Hex("#6fbdbe4c"),
 		Text:       gothememe.Hex("#6fbdbe"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeSeoulbonesLight) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeSeoulbonesLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#628562").WithAlpha(0.1),
-		Border:     gothememe.Hex("#628562").WithAlpha(0.3),
+		Background: gothememe.Hex("#62856219"),
+		Border:     gothememe.Hex("#6285624c"),
 		Text:       gothememe.Hex("#628562"),
 	}
 }
 
 func (t *themeSeoulbonesLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c48562").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c48562").WithAlpha(0.3),
+		Background: gothememe.Hex("#c4856219"),
+		Border:     gothememe.Hex("#c485624c"),
 		Text:       gothememe.Hex("#c48562"),
 	}
 }
 
 func (t *themeSeoulbonesLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dc5284").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dc5284").WithAlpha(0.3),
+		Background: gothememe.Hex("#dc528419"),
+		Border:     gothememe.Hex("#dc52844c"),
 		Text:       gothememe.Hex("#dc5284"),
 	}
 }
 
 func (t *themeSeoulbonesLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#008586").WithAlpha(0.1),
-		Border:     gothememe.Hex("#008586").WithAlpha(0.3),
+		Background: gothememe.Hex("#00858619"),
+		Border:     gothememe.Hex("#0085864c"),
 		Text:       gothememe.Hex("#008586"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeSeti) BorderStrong() gothememe.Color { return gothememe.Hex("#eeee
 // Semantic colors
 func (t *themeSeti) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8ec43d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8ec43d").WithAlpha(0.3),
+		Background: gothememe.Hex("#8ec43d19"),
+		Border:     gothememe.Hex("#8ec43d4c"),
 		Text:       gothememe.Hex("#8ec43d"),
 	}
 }
 
 func (t *themeSeti) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e0c64f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e0c64f").WithAlpha(0.3),
+		Background: gothememe.Hex("#e0c64f19"),
+		Border:     gothememe.Hex("#e0c64f4c"),
 		Text:       gothememe.Hex("#e0c64f"),
 	}
 }
 
 func (t *themeSeti) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c22832").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c22832").WithAlpha(0.3),
+		Background: gothememe.Hex("#c2283219"),
+		Border:     gothememe.Hex("#c228324c"),
 		Text:       gothememe.Hex("#c22832"),
 	}
 }
 
 func (t *themeSeti) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8ec43d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8ec43d").WithAlpha(0.3),
+		Background: gothememe.Hex("#8ec43d19"),
+		Border:     gothememe.Hex("#8ec43d4c"),
 		Text:       gothememe.Hex("#8ec43d"),
 	}
 }
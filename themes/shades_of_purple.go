@@ -45,32 +45,32 @@ func (t *themeShadesOfPurple) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeShadesOfPurple) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3ad900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3ad900").WithAlpha(0.3),
+		Background: gothememe.Hex("#3ad90019"),
+		Border:     gothememe.Hex("#3ad9004c"),
 		Text:       gothememe.Hex("#3ad900"),
 	}
 }
 
 func (t *themeShadesOfPurple) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffe700").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffe700").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffe70019"),
+		Border:     gothememe.Hex("#ffe7004c"),
 		Text:       gothememe.Hex("#ffe700"),
 	}
 }
 
 func (t *themeShadesOfPurple) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d90429").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d90429").WithAlpha(0.3),
+		Background: gothememe.Hex("#d9042919"),
+		Border:     gothememe.Hex("#d904294c"),
 		Text:       gothememe.Hex("#d90429"),
 	}
 }
 
 func (t *themeShadesOfPurple) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00c5c7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00c5c7").WithAlpha(0.3),
+		Background: gothememe.Hex("#00c5c719"),
+		Border:     gothememe.Hex("#00c5c74c"),
 		Text:       gothememe.Hex("#00c5c7"),
 	}
 }
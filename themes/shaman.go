@@ -43,32 +43,32 @@ func (t *themeShaman) BorderStrong() gothememe.Color { return gothememe.Hex("#40
 // Semantic colors
 func (t *themeShaman) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00a941").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00a941").WithAlpha(0.3),
+		Background: gothememe.Hex("#00a94119"),
+		Border:     gothememe.Hex("#00a9414c"),
 		Text:       gothememe.Hex("#00a941"),
 	}
 }
 
 func (t *themeShaman) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5e8baa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5e8baa").WithAlpha(0.3),
+		Background: gothememe.Hex("#5e8baa19"),
+		Border:     gothememe.Hex("#5e8baa4c"),
 		Text:       gothememe.Hex("#5e8baa"),
 	}
 }
 
 func (t *themeShaman) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b2302d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b2302d").WithAlpha(0.3),
+		Background: gothememe.Hex("#b2302d19"),
+		Border:     gothememe.Hex("#b2302d4c"),
 		Text:       gothememe.Hex("#b2302d"),
 	}
 }
 
 func (t *themeShaman) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5d7e19").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5d7e19").WithAlpha(0.3),
+		Background: gothememe.Hex("#5d7e1919"),
+		Border:     gothememe.Hex("#5d7e194c"),
 		Text:       gothememe.Hex("#5d7e19"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeSlate) BorderStrong() gothememe.Color { return gothememe.Hex("#02c
 // Semantic colors
 func (t *themeSlate) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#81d778").WithAlpha(0.1),
-		Border:     gothememe.Hex("#81d778").WithAlpha(0.3),
+		Background: gothememe.Hex("#81d77819"),
+		Border:     gothememe.Hex("#81d7784c"),
 		Text:       gothememe.Hex("#81d778"),
 	}
 }
 
 func (t *themeSlate) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c4c9c0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c4c9c0").WithAlpha(0.3),
+		Background: gothememe.Hex("#c4c9c019"),
+		Border:     gothememe.Hex("#c4c9c04c"),
 		Text:       gothememe.Hex("#c4c9c0"),
 	}
 }
 
 func (t *themeSlate) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e2a8bf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e2a8bf").WithAlpha(0.3),
+		Background: gothememe.Hex("#e2a8bf19"),
+		Border:     gothememe.Hex("#e2a8bf4c"),
 		Text:       gothememe.Hex("#e2a8bf"),
 	}
 }
 
 func (t *themeSlate) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#15ab9c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#15ab9c").WithAlpha(0.3),
+		Background: gothememe.Hex("#15ab9c19"),
+		Border:     gothememe.Hex("#15ab9c4c"),
 		Text:       gothememe.Hex("#15ab9c"),
 	}
 }
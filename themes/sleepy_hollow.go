@@ -45,32 +45,32 @@ func (t *themeSleepyHollow) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeSleepyHollow) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#91773f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#91773f").WithAlpha(0.3),
+		Background: gothememe.Hex("#91773f19"),
+		Border:     gothememe.Hex("#91773f4c"),
 		Text:       gothememe.Hex("#91773f"),
 	}
 }
 
 func (t *themeSleepyHollow) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b55600").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b55600").WithAlpha(0.3),
+		Background: gothememe.Hex("#b5560019"),
+		Border:     gothememe.Hex("#b556004c"),
 		Text:       gothememe.Hex("#b55600"),
 	}
 }
 
 func (t *themeSleepyHollow) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ba3934").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ba3934").WithAlpha(0.3),
+		Background: gothememe.Hex("#ba393419"),
+		Border:     gothememe.Hex("#ba39344c"),
 		Text:       gothememe.Hex("#ba3934"),
 	}
 }
 
 func (t *themeSleepyHollow) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8faea9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8faea9").WithAlpha(0.3),
+		Background: gothememe.Hex("#8faea919"),
+		Border:     gothememe.Hex("#8faea94c"),
 		Text:       gothememe.Hex("#8faea9"),
 	}
 }
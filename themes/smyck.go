@@ -43,32 +43,32 @@ func (t *themeSmyck) BorderStrong() gothememe.Color { return gothememe.Hex("#a1a
 // Semantic colors
 func (t *themeSmyck) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7da900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7da900").WithAlpha(0.3),
+		Background: gothememe.Hex("#7da90019"),
+		Border:     gothememe.Hex("#7da9004c"),
 		Text:       gothememe.Hex("#7da900"),
 	}
 }
 
 func (t *themeSmyck) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c4a500").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c4a500").WithAlpha(0.3),
+		Background: gothememe.Hex("#c4a50019"),
+		Border:     gothememe.Hex("#c4a5004c"),
 		Text:       gothememe.Hex("#c4a500"),
 	}
 }
 
 func (t *themeSmyck) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b84131").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b84131").WithAlpha(0.3),
+		Background: gothememe.Hex("#b8413119"),
+		Border:     gothememe.Hex("#b841314c"),
 		Text:       gothememe.Hex("#b84131"),
 	}
 }
 
 func (t *themeSmyck) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#207383").WithAlpha(0.1),
-		Border:     gothememe.Hex("#207383").WithAlpha(0.3),
+		Background: gothememe.Hex("#20738319"),
+		Border:     gothememe.Hex("#2073834c"),
 		Text:       gothememe.Hex("#207383"),
 	}
 }
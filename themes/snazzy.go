@@ -43,32 +43,32 @@ func (t *themeSnazzy) BorderStrong() gothememe.Color { return gothememe.Hex("#ed
 // Semantic colors
 func (t *themeSnazzy) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#50fb7c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#50fb7c").WithAlpha(0.3),
+		Background: gothememe.Hex("#50fb7c19"),
+		Border:     gothememe.Hex("#50fb7c4c"),
 		Text:       gothememe.Hex("#50fb7c"),
 	}
 }
 
 func (t *themeSnazzy) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f0fb8c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f0fb8c").WithAlpha(0.3),
+		Background: gothememe.Hex("#f0fb8c19"),
+		Border:     gothememe.Hex("#f0fb8c4c"),
 		Text:       gothememe.Hex("#f0fb8c"),
 	}
 }
 
 func (t *themeSnazzy) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fc4346").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fc4346").WithAlpha(0.3),
+		Background: gothememe.Hex("#fc434619"),
+		Border:     gothememe.Hex("#fc43464c"),
 		Text:       gothememe.Hex("#fc4346"),
 	}
 }
 
 func (t *themeSnazzy) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8be9fe").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8be9fe").WithAlpha(0.3),
+		Background: gothememe.Hex("#8be9fe19"),
+		Border:     gothememe.Hex("#8be9fe4c"),
 		Text:       gothememe.Hex("#8be9fe"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeSnazzySoft) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeSnazzySoft) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5af78e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5af78e").WithAlpha(0.3),
+		Background: gothememe.Hex("#5af78e19"),
+		Border:     gothememe.Hex("#5af78e4c"),
 		Text:       gothememe.Hex("#5af78e"),
 	}
 }
 
 func (t *themeSnazzySoft) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f3f99d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f3f99d").WithAlpha(0.3),
+		Background: gothememe.Hex("#f3f99d19"),
+		Border:     gothememe.Hex("#f3f99d4c"),
 		Text:       gothememe.Hex("#f3f99d"),
 	}
 }
 
 func (t *themeSnazzySoft) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff5c57").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff5c57").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff5c5719"),
+		Border:     gothememe.Hex("#ff5c574c"),
 		Text:       gothememe.Hex("#ff5c57"),
 	}
 }
 
 func (t *themeSnazzySoft) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9aedfe").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9aedfe").WithAlpha(0.3),
+		Background: gothememe.Hex("#9aedfe19"),
+		Border:     gothememe.Hex("#9aedfe4c"),
 		Text:       gothememe.Hex("#9aedfe"),
 	}
 }
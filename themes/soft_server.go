@@ -43,32 +43,32 @@ func (t *themeSoftServer) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeSoftServer) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9aa56a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9aa56a").WithAlpha(0.3),
+		Background: gothememe.Hex("#9aa56a19"),
+		Border:     gothememe.Hex("#9aa56a4c"),
 		Text:       gothememe.Hex("#9aa56a"),
 	}
 }
 
 func (t *themeSoftServer) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a3906a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a3906a").WithAlpha(0.3),
+		Background: gothememe.Hex("#a3906a19"),
+		Border:     gothememe.Hex("#a3906a4c"),
 		Text:       gothememe.Hex("#a3906a"),
 	}
 }
 
 func (t *themeSoftServer) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a2686a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a2686a").WithAlpha(0.3),
+		Background: gothememe.Hex("#a2686a19"),
+		Border:     gothememe.Hex("#a2686a4c"),
 		Text:       gothememe.Hex("#a2686a"),
 	}
 }
 
 func (t *themeSoftServer) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6ba58f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6ba58f").WithAlpha(0.3),
+		Background: gothememe.Hex("#6ba58f19"),
+		Border:     gothememe.Hex("#6ba58f4c"),
 		Text:       gothememe.Hex("#6ba58f"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeSolarizedDarcula) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeSolarizedDarcula) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#629655").WithAlpha(0.1),
-		Border:     gothememe.Hex("#629655").WithAlpha(0.3),
+		Background: gothememe.Hex("#62965519"),
+		Border:     gothememe.Hex("#6296554c"),
 		Text:       gothememe.Hex("#629655"),
 	}
 }
 
 func (t *themeSolarizedDarcula) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b68800").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b68800").WithAlpha(0.3),
+		Background: gothememe.Hex("#b6880019"),
+		Border:     gothememe.Hex("#b688004c"),
 		Text:       gothememe.Hex("#b68800"),
 	}
 }
 
 func (t *themeSolarizedDarcula) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f24840").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f24840").WithAlpha(0.3),
+		Background: gothememe.Hex("#f2484019"),
+		Border:     gothememe.Hex("#f248404c"),
 		Text:       gothememe.Hex("#f24840"),
 	}
 }
 
 func (t *themeSolarizedDarcula) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#15968d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#15968d").WithAlpha(0.3),
+		Background: gothememe.Hex("#15968d19"),
+		Border:     gothememe.Hex("#15968d4c"),
 		Text:       gothememe.Hex("#15968d"),
 	}
 }
@@ -69,32 +69,32 @@ func (t *themeSolarizedDarkHigherContrast) BorderStrong() gothememe.Color {
 // Semantic colors
 func (t *themeSolarizedDarkHigherContrast) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6cbe6c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6cbe6c").WithAlpha(0.3),
+		Background: gothememe.Hex("#6cbe6c19"),
+		Border:     gothememe.Hex("#6cbe6c4c"),
 		Text:       gothememe.Hex("#6cbe6c"),
 	}
 }
 
 func (t *themeSolarizedDarkHigherContrast) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a57706").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a57706").WithAlpha(0.3),
+		Background: gothememe.Hex("#a5770619"),
+		Border:     gothememe.Hex("#a577064c"),
 		Text:       gothememe.Hex("#a57706"),
 	}
 }
 
 func (t *themeSolarizedDarkHigherContrast) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d11c24").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d11c24").WithAlpha(0.3),
+		Background: gothememe.Hex("#d11c2419"),
+		Border:     gothememe.Hex("#d11c244c"),
 		Text:       gothememe.Hex("#d11c24"),
 	}
 }
 
 func (t *themeSolarizedDarkHigherContrast) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#259286").WithAlpha(0.1),
-		Border:     gothememe.Hex("#259286").WithAlpha(0.3),
+		Background: gothememe.Hex("#25928619"),
+		Border:     gothememe.Hex("#2592864c"),
 		Text:       gothememe.Hex("#259286"),
 	}
 }
@@ -51,32 +51,32 @@ func (t *themeSolarizedDarkPatched) BorderStrong() gothememe.Color { return goth
 // Semantic colors
 func (t *themeSolarizedDarkPatched) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#738a05").WithAlpha(0.1),
-		Border:     gothememe.Hex("#738a05").WithAlpha(0.3),
+		Background: gothememe.Hex("#738a0519"),
+		Border:     gothememe.Hex("#738a054c"),
 		Text:       gothememe.Hex("#738a05"),
 	}
 }
 
 func (t *themeSolarizedDarkPatched) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a57706").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a57706").WithAlpha(0.3),
+		Background: gothememe.Hex("#a5770619"),
+		Border:     gothememe.Hex("#a577064c"),
 		Text:       gothememe.Hex("#a57706"),
 	}
 }
 
 func (t *themeSolarizedDarkPatched) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d11c24").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d11c24").WithAlpha(0.3),
+		Background: gothememe.Hex("#d11c2419"),
+		Border:     gothememe.Hex("#d11c244c"),
 		Text:       gothememe.Hex("#d11c24"),
 	}
 }
 
 func (t *themeSolarizedDarkPatched) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#259286").WithAlpha(0.1),
-		Border:     gothememe.Hex("#259286").WithAlpha(0.3),
+		Background: gothememe.Hex("#25928619"),
+		Border:     gothememe.Hex("#2592864c"),
 		Text:       gothememe.Hex("#259286"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeSonokai) BorderStrong() gothememe.Color { return gothememe.Hex("#e
 // Semantic colors
 func (t *themeSonokai) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9ed072").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9ed072").WithAlpha(0.3),
+		Background: gothememe.Hex("#9ed07219"),
+		Border:     gothememe.Hex("#9ed0724c"),
 		Text:       gothememe.Hex("#9ed072"),
 	}
 }
 
 func (t *themeSonokai) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e7c664").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e7c664").WithAlpha(0.3),
+		Background: gothememe.Hex("#e7c66419"),
+		Border:     gothememe.Hex("#e7c6644c"),
 		Text:       gothememe.Hex("#e7c664"),
 	}
 }
 
 func (t *themeSonokai) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fc5d7c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fc5d7c").WithAlpha(0.3),
+		Background: gothememe.Hex("#fc5d7c19"),
+		Border:     gothememe.Hex("#fc5d7c4c"),
 		Text:       gothememe.Hex("#fc5d7c"),
 	}
 }
 
 func (t *themeSonokai) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f39660").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f39660").WithAlpha(0.3),
+		Background: gothememe.Hex("#f3966019"),
+		Border:     gothememe.Hex("#f396604c"),
 		Text:       gothememe.Hex("#f39660"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeSpacedust) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeSpacedust) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5cab96").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5cab96").WithAlpha(0.3),
+		Background: gothememe.Hex("#5cab9619"),
+		Border:     gothememe.Hex("#5cab964c"),
 		Text:       gothememe.Hex("#5cab96"),
 	}
 }
 
 func (t *themeSpacedust) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e3cd7b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e3cd7b").WithAlpha(0.3),
+		Background: gothememe.Hex("#e3cd7b19"),
+		Border:     gothememe.Hex("#e3cd7b4c"),
 		Text:       gothememe.Hex("#e3cd7b"),
 	}
 }
 
 func (t *themeSpacedust) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e35b00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e35b00").WithAlpha(0.3),
+		Background: gothememe.Hex("#e35b0019"),
+		Border:     gothememe.Hex("#e35b004c"),
 		Text:       gothememe.Hex("#e35b00"),
 	}
 }
 
 func (t *themeSpacedust) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#06afc7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#06afc7").WithAlpha(0.3),
+		Background: gothememe.Hex("#06afc719"),
+		Border:     gothememe.Hex("#06afc74c"),
 		Text:       gothememe.Hex("#06afc7"),
 	}
 }
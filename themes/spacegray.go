@@ -43,32 +43,32 @@ func (t *themeSpacegray) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeSpacegray) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#87b379").WithAlpha(0.1),
-		Border:     gothememe.Hex("#87b379").WithAlpha(0.3),
+		Background: gothememe.Hex("#87b37919"),
+		Border:     gothememe.Hex("#87b3794c"),
 		Text:       gothememe.Hex("#87b379"),
 	}
 }
 
 func (t *themeSpacegray) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e5c179").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e5c179").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5c17919"),
+		Border:     gothememe.Hex("#e5c1794c"),
 		Text:       gothememe.Hex("#e5c179"),
 	}
 }
 
 func (t *themeSpacegray) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b04b57").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b04b57").WithAlpha(0.3),
+		Background: gothememe.Hex("#b04b5719"),
+		Border:     gothememe.Hex("#b04b574c"),
 		Text:       gothememe.Hex("#b04b57"),
 	}
 }
 
 func (t *themeSpacegray) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#85a7a5").WithAlpha(0.1),
-		Border:     gothememe.Hex("#85a7a5").WithAlpha(0.3),
+		Background: gothememe.Hex("#85a7a519"),
+		Border:     gothememe.Hex("#85a7a54c"),
 		Text:       gothememe.Hex("#85a7a5"),
 	}
 }
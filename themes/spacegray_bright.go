@@ -45,32 +45,32 @@ func (t *themeSpacegrayBright) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeSpacegrayBright) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a0b56c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a0b56c").WithAlpha(0.3),
+		Background: gothememe.Hex("#a0b56c19"),
+		Border:     gothememe.Hex("#a0b56c4c"),
 		Text:       gothememe.Hex("#a0b56c"),
 	}
 }
 
 func (t *themeSpacegrayBright) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f6c987").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f6c987").WithAlpha(0.3),
+		Background: gothememe.Hex("#f6c98719"),
+		Border:     gothememe.Hex("#f6c9874c"),
 		Text:       gothememe.Hex("#f6c987"),
 	}
 }
 
 func (t *themeSpacegrayBright) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bc5553").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bc5553").WithAlpha(0.3),
+		Background: gothememe.Hex("#bc555319"),
+		Border:     gothememe.Hex("#bc55534c"),
 		Text:       gothememe.Hex("#bc5553"),
 	}
 }
 
 func (t *themeSpacegrayBright) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#85c9b8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#85c9b8").WithAlpha(0.3),
+		Background: gothememe.Hex("#85c9b819"),
+		Border:     gothememe.Hex("#85c9b84c"),
 		Text:       gothememe.Hex("#85c9b8"),
 	}
 }
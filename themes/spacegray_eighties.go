@@ -47,32 +47,32 @@ func (t *themeSpacegrayEighties) BorderStrong() gothememe.Color { return gotheme
 // Semantic colors
 func (t *themeSpacegrayEighties) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#81a764").WithAlpha(0.1),
-		Border:     gothememe.Hex("#81a764").WithAlpha(0.3),
+		Background: gothememe.Hex("#81a76419"),
+		Border:     gothememe.Hex("#81a7644c"),
 		Text:       gothememe.Hex("#81a764"),
 	}
 }
 
 func (t *themeSpacegrayEighties) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fec254").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fec254").WithAlpha(0.3),
+		Background: gothememe.Hex("#fec25419"),
+		Border:     gothememe.Hex("#fec2544c"),
 		Text:       gothememe.Hex("#fec254"),
 	}
 }
 
 func (t *themeSpacegrayEighties) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ec5f67").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ec5f67").WithAlpha(0.3),
+		Background: gothememe.Hex("#ec5f6719"),
+		Border:     gothememe.Hex("#ec5f674c"),
 		Text:       gothememe.Hex("#ec5f67"),
 	}
 }
 
 func (t *themeSpacegrayEighties) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#57c2c1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#57c2c1").WithAlpha(0.3),
+		Background: gothememe.Hex("#57c2c119"),
+		Border:     gothememe.Hex("#57c2c14c"),
 		Text:       gothememe.Hex("#57c2c1"),
 	}
 }
@@ -53,32 +53,32 @@ func (t *themeSpacegrayEightiesDull) BorderStrong() gothememe.Color { return got
 // Semantic colors
 func (t *themeSpacegrayEightiesDull) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#92b477").WithAlpha(0.1),
-		Border:     gothememe.Hex("#92b477").WithAlpha(0.3),
+		Background: gothememe.Hex("#92b47719"),
+		Border:     gothememe.Hex("#92b4774c"),
 		Text:       gothememe.Hex("#92b477"),
 	}
 }
 
 func (t *themeSpacegrayEightiesDull) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c6735a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c6735a").WithAlpha(0.3),
+		Background: gothememe.Hex("#c6735a19"),
+		Border:     gothememe.Hex("#c6735a4c"),
 		Text:       gothememe.Hex("#c6735a"),
 	}
 }
 
 func (t *themeSpacegrayEightiesDull) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b24a56").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b24a56").WithAlpha(0.3),
+		Background: gothememe.Hex("#b24a5619"),
+		Border:     gothememe.Hex("#b24a564c"),
 		Text:       gothememe.Hex("#b24a56"),
 	}
 }
 
 func (t *themeSpacegrayEightiesDull) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#80cdcb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#80cdcb").WithAlpha(0.3),
+		Background: gothememe.Hex("#80cdcb19"),
+		Border:     gothememe.Hex("#80cdcb4c"),
 		Text:       gothememe.Hex("#80cdcb"),
 	}
 }
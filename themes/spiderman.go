@@ -43,32 +43,32 @@ func (t *themeSpiderman) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeSpiderman) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e22928").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e22928").WithAlpha(0.3),
+		Background: gothememe.Hex("#e2292819"),
+		Border:     gothememe.Hex("#e229284c"),
 		Text:       gothememe.Hex("#e22928"),
 	}
 }
 
 func (t *themeSpiderman) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e24756").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e24756").WithAlpha(0.3),
+		Background: gothememe.Hex("#e2475619"),
+		Border:     gothememe.Hex("#e247564c"),
 		Text:       gothememe.Hex("#e24756"),
 	}
 }
 
 func (t *themeSpiderman) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e60813").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e60813").WithAlpha(0.3),
+		Background: gothememe.Hex("#e6081319"),
+		Border:     gothememe.Hex("#e608134c"),
 		Text:       gothememe.Hex("#e60813"),
 	}
 }
 
 func (t *themeSpiderman) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3256ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3256ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#3256ff19"),
+		Border:     gothememe.Hex("#3256ff4c"),
 		Text:       gothememe.Hex("#3256ff"),
 	}
 }
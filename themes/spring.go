@@ -43,32 +43,32 @@ func (t *themeSpring) BorderStrong() gothememe.Color { return gothememe.Hex("#bf
 // Semantic colors
 func (t *themeSpring) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1f8c3b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1f8c3b").WithAlpha(0.3),
+		Background: gothememe.Hex("#1f8c3b19"),
+		Border:     gothememe.Hex("#1f8c3b4c"),
 		Text:       gothememe.Hex("#1f8c3b"),
 	}
 }
 
 func (t *themeSpring) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1fc95b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1fc95b").WithAlpha(0.3),
+		Background: gothememe.Hex("#1fc95b19"),
+		Border:     gothememe.Hex("#1fc95b4c"),
 		Text:       gothememe.Hex("#1fc95b"),
 	}
 }
 
 func (t *themeSpring) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff4d83").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff4d83").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff4d8319"),
+		Border:     gothememe.Hex("#ff4d834c"),
 		Text:       gothememe.Hex("#ff4d83"),
 	}
 }
 
 func (t *themeSpring) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3e999f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3e999f").WithAlpha(0.3),
+		Background: gothememe.Hex("#3e999f19"),
+		Border:     gothememe.Hex("#3e999f4c"),
 		Text:       gothememe.Hex("#3e999f"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeSquare) BorderStrong() gothememe.Color { return gothememe.Hex("#f2
 // Semantic colors
 func (t *themeSquare) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b6377d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b6377d").WithAlpha(0.3),
+		Background: gothememe.Hex("#b6377d19"),
+		Border:     gothememe.Hex("#b6377d4c"),
 		Text:       gothememe.Hex("#b6377d"),
 	}
 }
 
 func (t *themeSquare) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ecebbe").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ecebbe").WithAlpha(0.3),
+		Background: gothememe.Hex("#ecebbe19"),
+		Border:     gothememe.Hex("#ecebbe4c"),
 		Text:       gothememe.Hex("#ecebbe"),
 	}
 }
 
 func (t *themeSquare) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e9897c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e9897c").WithAlpha(0.3),
+		Background: gothememe.Hex("#e9897c19"),
+		Border:     gothememe.Hex("#e9897c4c"),
 		Text:       gothememe.Hex("#e9897c"),
 	}
 }
 
 func (t *themeSquare) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c9caec").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c9caec").WithAlpha(0.3),
+		Background: gothememe.Hex("#c9caec19"),
+		Border:     gothememe.Hex("#c9caec4c"),
 		Text:       gothememe.Hex("#c9caec"),
 	}
 }
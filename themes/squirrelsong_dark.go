@@ -47,32 +47,32 @@ func (t *themeSquirrelsongDark) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeSquirrelsongDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#468336").WithAlpha(0.1),
-		Border:     gothememe.Hex("#468336").WithAlpha(0.3),
+		Background: gothememe.Hex("#46833619"),
+		Border:     gothememe.Hex("#4683364c"),
 		Text:       gothememe.Hex("#468336"),
 	}
 }
 
 func (t *themeSquirrelsongDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d4b139").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d4b139").WithAlpha(0.3),
+		Background: gothememe.Hex("#d4b13919"),
+		Border:     gothememe.Hex("#d4b1394c"),
 		Text:       gothememe.Hex("#d4b139"),
 	}
 }
 
 func (t *themeSquirrelsongDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ba4138").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ba4138").WithAlpha(0.3),
+		Background: gothememe.Hex("#ba413819"),
+		Border:     gothememe.Hex("#ba41384c"),
 		Text:       gothememe.Hex("#ba4138"),
 	}
 }
 
 func (t *themeSquirrelsongDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2f9794").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2f9794").WithAlpha(0.3),
+		Background: gothememe.Hex("#2f979419"),
+		Border:     gothememe.Hex("#2f97944c"),
 		Text:       gothememe.Hex("#2f9794"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeSrcery) BorderStrong() gothememe.Color { return gothememe.Hex("#ba
 // Semantic colors
 func (t *themeSrcery) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#519f50").WithAlpha(0.1),
-		Border:     gothememe.Hex("#519f50").WithAlpha(0.3),
+		Background: gothememe.Hex("#519f5019"),
+		Border:     gothememe.Hex("#519f504c"),
 		Text:       gothememe.Hex("#519f50"),
 	}
 }
 
 func (t *themeSrcery) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fbb829").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fbb829").WithAlpha(0.3),
+		Background: gothememe.Hex("#fbb82919"),
+		Border:     gothememe.Hex("#fbb8294c"),
 		Text:       gothememe.Hex("#fbb829"),
 	}
 }
 
 func (t *themeSrcery) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ef2f27").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ef2f27").WithAlpha(0.3),
+		Background: gothememe.Hex("#ef2f2719"),
+		Border:     gothememe.Hex("#ef2f274c"),
 		Text:       gothememe.Hex("#ef2f27"),
 	}
 }
 
 func (t *themeSrcery) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0aaeb3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0aaeb3").WithAlpha(0.3),
+		Background: gothememe.Hex("#0aaeb319"),
+		Border:     gothememe.Hex("#0aaeb34c"),
 		Text:       gothememe.Hex("#0aaeb3"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeStarlight) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeStarlight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#47b413").WithAlpha(0.1),
-		Border:     gothememe.Hex("#47b413").WithAlpha(0.3),
+		Background: gothememe.Hex("#47b41319"),
+		Border:     gothememe.Hex("#47b4134c"),
 		Text:       gothememe.Hex("#47b413"),
 	}
 }
 
 func (t *themeStarlight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e3c401").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e3c401").WithAlpha(0.3),
+		Background: gothememe.Hex("#e3c40119"),
+		Border:     gothememe.Hex("#e3c4014c"),
 		Text:       gothememe.Hex("#e3c401"),
 	}
 }
 
 func (t *themeStarlight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f62b5a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f62b5a").WithAlpha(0.3),
+		Background: gothememe.Hex("#f62b5a19"),
+		Border:     gothememe.Hex("#f62b5a4c"),
 		Text:       gothememe.Hex("#f62b5a"),
 	}
 }
 
 func (t *themeStarlight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#13c299").WithAlpha(0.1),
-		Border:     gothememe.Hex("#13c299").WithAlpha(0.3),
+		Background: gothememe.Hex("#13c29919"),
+		Border:     gothememe.Hex("#13c2994c"),
 		Text:       gothememe.Hex("#13c299"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeSublette) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeSublette) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#55ee77").WithAlpha(0.1),
-		Border:     gothememe.Hex("#55ee77").WithAlpha(0.3),
+		Background: gothememe.Hex("#55ee7719"),
+		Border:     gothememe.Hex("#55ee774c"),
 		Text:       gothememe.Hex("#55ee77"),
 	}
 }
 
 func (t *themeSublette) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffdd88").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffdd88").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffdd8819"),
+		Border:     gothememe.Hex("#ffdd884c"),
 		Text:       gothememe.Hex("#ffdd88"),
 	}
 }
 
 func (t *themeSublette) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ee5577").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ee5577").WithAlpha(0.3),
+		Background: gothememe.Hex("#ee557719"),
+		Border:     gothememe.Hex("#ee55774c"),
 		Text:       gothememe.Hex("#ee5577"),
 	}
 }
 
 func (t *themeSublette) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#44eeee").WithAlpha(0.1),
-		Border:     gothememe.Hex("#44eeee").WithAlpha(0.3),
+		Background: gothememe.Hex("#44eeee19"),
+		Border:     gothememe.Hex("#44eeee4c"),
 		Text:       gothememe.Hex("#44eeee"),
 	}
 }
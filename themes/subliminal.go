@@ -43,32 +43,32 @@ func (t *themeSubliminal) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeSubliminal) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a9cfa4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a9cfa4").WithAlpha(0.3),
+		Background: gothememe.Hex("#a9cfa419"),
+		Border:     gothememe.Hex("#a9cfa44c"),
 		Text:       gothememe.Hex("#a9cfa4"),
 	}
 }
 
 func (t *themeSubliminal) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffe2a9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffe2a9").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffe2a919"),
+		Border:     gothememe.Hex("#ffe2a94c"),
 		Text:       gothememe.Hex("#ffe2a9"),
 	}
 }
 
 func (t *themeSubliminal) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e15a60").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e15a60").WithAlpha(0.3),
+		Background: gothememe.Hex("#e15a6019"),
+		Border:     gothememe.Hex("#e15a604c"),
 		Text:       gothememe.Hex("#e15a60"),
 	}
 }
 
 func (t *themeSubliminal) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5fb3b3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5fb3b3").WithAlpha(0.3),
+		Background: gothememe.Hex("#5fb3b319"),
+		Border:     gothememe.Hex("#5fb3b34c"),
 		Text:       gothememe.Hex("#5fb3b3"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeSugarplum) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeSugarplum) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#53b397").WithAlpha(0.1),
-		Border:     gothememe.Hex("#53b397").WithAlpha(0.3),
+		Background: gothememe.Hex("#53b39719"),
+		Border:     gothememe.Hex("#53b3974c"),
 		Text:       gothememe.Hex("#53b397"),
 	}
 }
 
 func (t *themeSugarplum) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#249a84").WithAlpha(0.1),
-		Border:     gothememe.Hex("#249a84").WithAlpha(0.3),
+		Background: gothememe.Hex("#249a8419"),
+		Border:     gothememe.Hex("#249a844c"),
 		Text:       gothememe.Hex("#249a84"),
 	}
 }
 
 func (t *themeSugarplum) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5ca8dc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5ca8dc").WithAlpha(0.3),
+		Background: gothememe.Hex("#5ca8dc19"),
+		Border:     gothememe.Hex("#5ca8dc4c"),
 		Text:       gothememe.Hex("#5ca8dc"),
 	}
 }
 
 func (t *themeSugarplum) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f9f3f9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f9f3f9").WithAlpha(0.3),
+		Background: gothememe.Hex("#f9f3f919"),
+		Border:     gothememe.Hex("#f9f3f94c"),
 		Text:       gothememe.Hex("#f9f3f9"),
 	}
 }
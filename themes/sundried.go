@@ -43,32 +43,32 @@ func (t *themeSundried) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeSundried) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#587744").WithAlpha(0.1),
-		Border:     gothememe.Hex("#587744").WithAlpha(0.3),
+		Background: gothememe.Hex("#58774419"),
+		Border:     gothememe.Hex("#5877444c"),
 		Text:       gothememe.Hex("#587744"),
 	}
 }
 
 func (t *themeSundried) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9d602a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9d602a").WithAlpha(0.3),
+		Background: gothememe.Hex("#9d602a19"),
+		Border:     gothememe.Hex("#9d602a4c"),
 		Text:       gothememe.Hex("#9d602a"),
 	}
 }
 
 func (t *themeSundried) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a7463d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a7463d").WithAlpha(0.3),
+		Background: gothememe.Hex("#a7463d19"),
+		Border:     gothememe.Hex("#a7463d4c"),
 		Text:       gothememe.Hex("#a7463d"),
 	}
 }
 
 func (t *themeSundried) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9c814f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9c814f").WithAlpha(0.3),
+		Background: gothememe.Hex("#9c814f19"),
+		Border:     gothememe.Hex("#9c814f4c"),
 		Text:       gothememe.Hex("#9c814f"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeSymfonic) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeSymfonic) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#56db3a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#56db3a").WithAlpha(0.3),
+		Background: gothememe.Hex("#56db3a19"),
+		Border:     gothememe.Hex("#56db3a4c"),
 		Text:       gothememe.Hex("#56db3a"),
 	}
 }
 
 func (t *themeSymfonic) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff8400").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff8400").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff840019"),
+		Border:     gothememe.Hex("#ff84004c"),
 		Text:       gothememe.Hex("#ff8400"),
 	}
 }
 
 func (t *themeSymfonic) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dc322f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dc322f").WithAlpha(0.3),
+		Background: gothememe.Hex("#dc322f19"),
+		Border:     gothememe.Hex("#dc322f4c"),
 		Text:       gothememe.Hex("#dc322f"),
 	}
 }
 
 func (t *themeSymfonic) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ccccff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ccccff").WithAlpha(0.3),
+		Background: gothememe.Hex("#ccccff19"),
+		Border:     gothememe.Hex("#ccccff4c"),
 		Text:       gothememe.Hex("#ccccff"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeSynthwave) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeSynthwave) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1ebb2b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1ebb2b").WithAlpha(0.3),
+		Background: gothememe.Hex("#1ebb2b19"),
+		Border:     gothememe.Hex("#1ebb2b4c"),
 		Text:       gothememe.Hex("#1ebb2b"),
 	}
 }
 
 func (t *themeSynthwave) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fdf834").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fdf834").WithAlpha(0.3),
+		Background: gothememe.Hex("#fdf83419"),
+		Border:     gothememe.Hex("#fdf8344c"),
 		Text:       gothememe.Hex("#fdf834"),
 	}
 }
 
 func (t *themeSynthwave) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f6188f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f6188f").WithAlpha(0.3),
+		Background: gothememe.Hex("#f6188f19"),
+		Border:     gothememe.Hex("#f6188f4c"),
 		Text:       gothememe.Hex("#f6188f"),
 	}
 }
 
 func (t *themeSynthwave) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#12c3e2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#12c3e2").WithAlpha(0.3),
+		Background: gothememe.Hex("#12c3e219"),
+		Border:     gothememe.Hex("#12c3e24c"),
 		Text:       gothememe.Hex("#12c3e2"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeSynthwaveAlpha) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeSynthwaveAlpha) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00986c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00986c").WithAlpha(0.3),
+		Background: gothememe.Hex("#00986c19"),
+		Border:     gothememe.Hex("#00986c4c"),
 		Text:       gothememe.Hex("#00986c"),
 	}
 }
 
 func (t *themeSynthwaveAlpha) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#adad3e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#adad3e").WithAlpha(0.3),
+		Background: gothememe.Hex("#adad3e19"),
+		Border:     gothememe.Hex("#adad3e4c"),
 		Text:       gothememe.Hex("#adad3e"),
 	}
 }
 
 func (t *themeSynthwaveAlpha) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e60a70").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e60a70").WithAlpha(0.3),
+		Background: gothememe.Hex("#e60a7019"),
+		Border:     gothememe.Hex("#e60a704c"),
 		Text:       gothememe.Hex("#e60a70"),
 	}
 }
 
 func (t *themeSynthwaveAlpha) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00b0b1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00b0b1").WithAlpha(0.3),
+		Background: gothememe.Hex("#00b0b119"),
+		Border:     gothememe.Hex("#00b0b14c"),
 		Text:       gothememe.Hex("#00b0b1"),
 	}
 }
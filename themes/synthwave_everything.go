@@ -49,32 +49,32 @@ func (t *themeSynthwaveEverything) BorderStrong() gothememe.Color { return gothe
 // Semantic colors
 func (t *themeSynthwaveEverything) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#72f1b8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#72f1b8").WithAlpha(0.3),
+		Background: gothememe.Hex("#72f1b819"),
+		Border:     gothememe.Hex("#72f1b84c"),
 		Text:       gothememe.Hex("#72f1b8"),
 	}
 }
 
 func (t *themeSynthwaveEverything) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fede5d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fede5d").WithAlpha(0.3),
+		Background: gothememe.Hex("#fede5d19"),
+		Border:     gothememe.Hex("#fede5d4c"),
 		Text:       gothememe.Hex("#fede5d"),
 	}
 }
 
 func (t *themeSynthwaveEverything) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f97e72").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f97e72").WithAlpha(0.3),
+		Background: gothememe.Hex("#f97e7219"),
+		Border:     gothememe.Hex("#f97e724c"),
 		Text:       gothememe.Hex("#f97e72"),
 	}
 }
 
 func (t *themeSynthwaveEverything) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f772e0").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f772e0").WithAlpha(0.3),
+		Background: gothememe.Hex("#f772e019"),
+		Border:     gothememe.Hex("#f772e04c"),
 		Text:       gothememe.Hex("#f772e0"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeTangoAdapted) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeTangoAdapted) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#59d600").WithAlpha(0.1),
-		Border:     gothememe.Hex("#59d600").WithAlpha(0.3),
+		Background: gothememe.Hex("#59d60019"),
+		Border:     gothememe.Hex("#59d6004c"),
 		Text:       gothememe.Hex("#59d600"),
 	}
 }
 
 func (t *themeTangoAdapted) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e3be00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e3be00").WithAlpha(0.3),
+		Background: gothememe.Hex("#e3be0019"),
+		Border:     gothememe.Hex("#e3be004c"),
 		Text:       gothememe.Hex("#e3be00"),
 	}
 }
 
 func (t *themeTangoAdapted) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff0000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff0000").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff000019"),
+		Border:     gothememe.Hex("#ff00004c"),
 		Text:       gothememe.Hex("#ff0000"),
 	}
 }
 
 func (t *themeTangoAdapted) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00d0d6").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00d0d6").WithAlpha(0.3),
+		Background: gothememe.Hex("#00d0d619"),
+		Border:     gothememe.Hex("#00d0d64c"),
 		Text:       gothememe.Hex("#00d0d6"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeTangoHalfAdapted) BorderStrong() gothememe.Color { return gothemem
 // Semantic colors
 func (t *themeTangoHalfAdapted) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4cc300").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4cc300").WithAlpha(0.3),
+		Background: gothememe.Hex("#4cc30019"),
+		Border:     gothememe.Hex("#4cc3004c"),
 		Text:       gothememe.Hex("#4cc300"),
 	}
 }
 
 func (t *themeTangoHalfAdapted) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e2c000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e2c000").WithAlpha(0.3),
+		Background: gothememe.Hex("#e2c00019"),
+		Border:     gothememe.Hex("#e2c0004c"),
 		Text:       gothememe.Hex("#e2c000"),
 	}
 }
 
 func (t *themeTangoHalfAdapted) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff0000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff0000").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff000019"),
+		Border:     gothememe.Hex("#ff00004c"),
 		Text:       gothememe.Hex("#ff0000"),
 	}
 }
 
 func (t *themeTangoHalfAdapted) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00bdc3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00bdc3").WithAlpha(0.3),
+		Background: gothememe.Hex("#00bdc319"),
+		Border:     gothememe.Hex("#00bdc34c"),
 		Text:       gothememe.Hex("#00bdc3"),
 	}
 }
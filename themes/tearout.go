@@ -43,32 +43,32 @@ func (t *themeTearout) BorderStrong() gothememe.Color { return gothememe.Hex("#b
 // Semantic colors
 func (t *themeTearout) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#97976d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#97976d").WithAlpha(0.3),
+		Background: gothememe.Hex("#97976d19"),
+		Border:     gothememe.Hex("#97976d4c"),
 		Text:       gothememe.Hex("#97976d"),
 	}
 }
 
 func (t *themeTearout) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6c9861").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6c9861").WithAlpha(0.3),
+		Background: gothememe.Hex("#6c986119"),
+		Border:     gothememe.Hex("#6c98614c"),
 		Text:       gothememe.Hex("#6c9861"),
 	}
 }
 
 func (t *themeTearout) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc967b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc967b").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc967b19"),
+		Border:     gothememe.Hex("#cc967b4c"),
 		Text:       gothememe.Hex("#cc967b"),
 	}
 }
 
 func (t *themeTearout) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d7c483").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d7c483").WithAlpha(0.3),
+		Background: gothememe.Hex("#d7c48319"),
+		Border:     gothememe.Hex("#d7c4834c"),
 		Text:       gothememe.Hex("#d7c483"),
 	}
 }
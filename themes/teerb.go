@@ -43,32 +43,32 @@ func (t *themeTeerb) BorderStrong() gothememe.Color { return gothememe.Hex("#d0d
 // Semantic colors
 func (t *themeTeerb) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aed686").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aed686").WithAlpha(0.3),
+		Background: gothememe.Hex("#aed68619"),
+		Border:     gothememe.Hex("#aed6864c"),
 		Text:       gothememe.Hex("#aed686"),
 	}
 }
 
 func (t *themeTeerb) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d7af87").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d7af87").WithAlpha(0.3),
+		Background: gothememe.Hex("#d7af8719"),
+		Border:     gothememe.Hex("#d7af874c"),
 		Text:       gothememe.Hex("#d7af87"),
 	}
 }
 
 func (t *themeTeerb) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d68686").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d68686").WithAlpha(0.3),
+		Background: gothememe.Hex("#d6868619"),
+		Border:     gothememe.Hex("#d686864c"),
 		Text:       gothememe.Hex("#d68686"),
 	}
 }
 
 func (t *themeTeerb) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8adbb4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8adbb4").WithAlpha(0.3),
+		Background: gothememe.Hex("#8adbb419"),
+		Border:     gothememe.Hex("#8adbb44c"),
 		Text:       gothememe.Hex("#8adbb4"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeTerafox) BorderStrong() gothememe.Color { return gothememe.Hex("#e
 // Semantic colors
 func (t *themeTerafox) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7aa4a1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7aa4a1").WithAlpha(0.3),
+		Background: gothememe.Hex("#7aa4a119"),
+		Border:     gothememe.Hex("#7aa4a14c"),
 		Text:       gothememe.Hex("#7aa4a1"),
 	}
 }
 
 func (t *themeTerafox) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fda47f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fda47f").WithAlpha(0.3),
+		Background: gothememe.Hex("#fda47f19"),
+		Border:     gothememe.Hex("#fda47f4c"),
 		Text:       gothememe.Hex("#fda47f"),
 	}
 }
 
 func (t *themeTerafox) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e85c51").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e85c51").WithAlpha(0.3),
+		Background: gothememe.Hex("#e85c5119"),
+		Border:     gothememe.Hex("#e85c514c"),
 		Text:       gothememe.Hex("#e85c51"),
 	}
 }
 
 func (t *themeTerafox) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a1cdd8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a1cdd8").WithAlpha(0.3),
+		Background: gothememe.Hex("#a1cdd819"),
+		Border:     gothememe.Hex("#a1cdd84c"),
 		Text:       gothememe.Hex("#a1cdd8"),
 	}
 }
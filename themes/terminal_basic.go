@@ -45,32 +45,32 @@ func (t *themeTerminalBasic) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeTerminalBasic) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00a600").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00a600").WithAlpha(0.3),
+		Background: gothememe.Hex("#00a60019"),
+		Border:     gothememe.Hex("#00a6004c"),
 		Text:       gothememe.Hex("#00a600"),
 	}
 }
 
 func (t *themeTerminalBasic) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#999900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#999900").WithAlpha(0.3),
+		Background: gothememe.Hex("#99990019"),
+		Border:     gothememe.Hex("#9999004c"),
 		Text:       gothememe.Hex("#999900"),
 	}
 }
 
 func (t *themeTerminalBasic) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#990000").WithAlpha(0.1),
-		Border:     gothememe.Hex("#990000").WithAlpha(0.3),
+		Background: gothememe.Hex("#99000019"),
+		Border:     gothememe.Hex("#9900004c"),
 		Text:       gothememe.Hex("#990000"),
 	}
 }
 
 func (t *themeTerminalBasic) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00a6b2").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00a6b2").WithAlpha(0.3),
+		Background: gothememe.Hex("#00a6b219"),
+		Border:     gothememe.Hex("#00a6b24c"),
 		Text:       gothememe.Hex("#00a6b2"),
 	}
 }
@@ -47,32 +47,32 @@ func (t *themeTerminalBasicDark) BorderStrong() gothememe.Color { return gotheme
 // Semantic colors
 func (t *themeTerminalBasicDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#6ac44b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#6ac44b").WithAlpha(0.3),
+		Background: gothememe.Hex("#6ac44b19"),
+		Border:     gothememe.Hex("#6ac44b4c"),
 		Text:       gothememe.Hex("#6ac44b"),
 	}
 }
 
 func (t *themeTerminalBasicDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b8b74a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b8b74a").WithAlpha(0.3),
+		Background: gothememe.Hex("#b8b74a19"),
+		Border:     gothememe.Hex("#b8b74a4c"),
 		Text:       gothememe.Hex("#b8b74a"),
 	}
 }
 
 func (t *themeTerminalBasicDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c65339").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c65339").WithAlpha(0.3),
+		Background: gothememe.Hex("#c6533919"),
+		Border:     gothememe.Hex("#c653394c"),
 		Text:       gothememe.Hex("#c65339"),
 	}
 }
 
 func (t *themeTerminalBasicDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#69c1cf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#69c1cf").WithAlpha(0.3),
+		Background: gothememe.Hex("#69c1cf19"),
+		Border:     gothememe.Hex("#69c1cf4c"),
 		Text:       gothememe.Hex("#69c1cf"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeThayerBright) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeThayerBright) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4df840").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4df840").WithAlpha(0.3),
+		Background: gothememe.Hex("#4df84019"),
+		Border:     gothememe.Hex("#4df8404c"),
 		Text:       gothememe.Hex("#4df840"),
 	}
 }
 
 func (t *themeThayerBright) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f4fd22").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f4fd22").WithAlpha(0.3),
+		Background: gothememe.Hex("#f4fd2219"),
+		Border:     gothememe.Hex("#f4fd224c"),
 		Text:       gothememe.Hex("#f4fd22"),
 	}
 }
 
 func (t *themeThayerBright) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f92672").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f92672").WithAlpha(0.3),
+		Background: gothememe.Hex("#f9267219"),
+		Border:     gothememe.Hex("#f926724c"),
 		Text:       gothememe.Hex("#f92672"),
 	}
 }
 
 func (t *themeThayerBright) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#38c8b5").WithAlpha(0.1),
-		Border:     gothememe.Hex("#38c8b5").WithAlpha(0.3),
+		Background: gothememe.Hex("#38c8b519"),
+		Border:     gothememe.Hex("#38c8b54c"),
 		Text:       gothememe.Hex("#38c8b5"),
 	}
 }
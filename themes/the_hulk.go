@@ -43,32 +43,32 @@ func (t *themeTheHulk) BorderStrong() gothememe.Color { return gothememe.Hex("#d
 // Semantic colors
 func (t *themeTheHulk) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#13ce30").WithAlpha(0.1),
-		Border:     gothememe.Hex("#13ce30").WithAlpha(0.3),
+		Background: gothememe.Hex("#13ce3019"),
+		Border:     gothememe.Hex("#13ce304c"),
 		Text:       gothememe.Hex("#13ce30"),
 	}
 }
 
 func (t *themeTheHulk) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#63e457").WithAlpha(0.1),
-		Border:     gothememe.Hex("#63e457").WithAlpha(0.3),
+		Background: gothememe.Hex("#63e45719"),
+		Border:     gothememe.Hex("#63e4574c"),
 		Text:       gothememe.Hex("#63e457"),
 	}
 }
 
 func (t *themeTheHulk) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#269d1b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#269d1b").WithAlpha(0.3),
+		Background: gothememe.Hex("#269d1b19"),
+		Border:     gothememe.Hex("#269d1b4c"),
 		Text:       gothememe.Hex("#269d1b"),
 	}
 }
 
 func (t *themeTheHulk) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#378ca9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#378ca9").WithAlpha(0.3),
+		Background: gothememe.Hex("#378ca919"),
+		Border:     gothememe.Hex("#378ca94c"),
 		Text:       gothememe.Hex("#378ca9"),
 	}
 }
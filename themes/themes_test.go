@@ -1,7 +1,10 @@
 package themes
 
 import (
+	"encoding/json"
 	"testing"
+
+	"github.com/tj-smith47/gothememe"
 )
 
 func TestAll(t *testing.T) {
@@ -175,6 +178,64 @@ func TestAllThemesValid(t *testing.T) {
 	}
 }
 
+// TestThemesMatchConversion checks that every generated theme matches the
+// runtime conversion of its Windows Terminal scheme.
+func TestThemesMatchConversion(t *testing.T) {
+	t.Parallel()
+
+	hex := func(c gothememe.Color) string {
+		if c.IsEmpty() {
+			return ""
+		}
+		return c.Hex()
+	}
+	for _, theme := range All() {
+		if theme.Author() != "iTerm2-Color-Schemes" {
+			continue
+		}
+		t.Run(theme.ID(), func(t *testing.T) {
+			t.Parallel()
+
+			data, err := json.Marshal(map[string]string{
+				"name":                theme.DisplayName(),
+				"background":          hex(theme.Background()),
+				"foreground":          hex(theme.TextPrimary()),
+				"selectionBackground": hex(theme.Surface()),
+				"black":               hex(theme.Black()),
+				"red":                 hex(theme.Red()),
+				"green":               hex(theme.Green()),
+				"yellow":              hex(theme.Yellow()),
+				"blue":                hex(theme.Blue()),
+				"purple":              hex(theme.Purple()),
+				"cyan":                hex(theme.Cyan()),
+				"white":               hex(theme.White()),
+				"brightBlack":         hex(theme.BrightBlack()),
+				"brightRed":           hex(theme.BrightRed()),
+				"brightGreen":         hex(theme.BrightGreen()),
+				"brightYellow":        hex(theme.BrightYellow()),
+				"brightBlue":          hex(theme.BrightBlue()),
+				"brightPurple":        hex(theme.BrightPurple()),
+				"brightCyan":          hex(theme.BrightCyan()),
+				"brightWhite":         hex(theme.BrightWhite()),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			converted, err := gothememe.FromWindowsTerminal(data)
+			if err != nil {
+				t.Fatalf("FromWindowsTerminal() error: %v", err)
+			}
+
+			if theme.IsDark() != converted.IsDark() {
+				t.Errorf("IsDark() = %v, runtime conversion is %v", theme.IsDark(), converted.IsDark())
+			}
+			if gothememe.GenerateCSS(theme, gothememe.CSSOptions{}) != gothememe.GenerateCSS(converted, gothememe.CSSOptions{}) {
+				t.Error("colors differ from the runtime conversion")
+			}
+		})
+	}
+}
+
 // TestAllThemeMethodsCoverage calls every method on every theme to ensure coverage.
 // This is designed to maximize test coverage across all 451+ theme implementations.
 func TestAllThemeMethodsCoverage(t *testing.T) {
@@ -49,32 +49,32 @@ func (t *themeTinaciousDesignDark) BorderStrong() gothememe.Color { return gothe
 // Semantic colors
 func (t *themeTinaciousDesignDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00d364").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00d364").WithAlpha(0.3),
+		Background: gothememe.Hex("#00d36419"),
+		Border:     gothememe.Hex("#00d3644c"),
 		Text:       gothememe.Hex("#00d364"),
 	}
 }
 
 func (t *themeTinaciousDesignDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffcc66").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffcc66").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffcc6619"),
+		Border:     gothememe.Hex("#ffcc664c"),
 		Text:       gothememe.Hex("#ffcc66"),
 	}
 }
 
 func (t *themeTinaciousDesignDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff3399").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff3399").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff339919"),
+		Border:     gothememe.Hex("#ff33994c"),
 		Text:       gothememe.Hex("#ff3399"),
 	}
 }
 
 func (t *themeTinaciousDesignDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00ceca").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00ceca").WithAlpha(0.3),
+		Background: gothememe.Hex("#00ceca19"),
+		Border:     gothememe.Hex("#00ceca4c"),
 		Text:       gothememe.Hex("#00ceca"),
 	}
 }
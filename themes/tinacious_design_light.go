@@ -51,32 +51,32 @@ func (t *themeTinaciousDesignLight) BorderStrong() gothememe.Color { return goth
 // Semantic colors
 func (t *themeTinaciousDesignLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00d364").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00d364").WithAlpha(0.3),
+		Background: gothememe.Hex("#00d36419"),
+		Border:     gothememe.Hex("#00d3644c"),
 		Text:       gothememe.Hex("#00d364"),
 	}
 }
 
 func (t *themeTinaciousDesignLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e5b24d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e5b24d").WithAlpha(0.3),
+		Background: gothememe.Hex("#e5b24d19"),
+		Border:     gothememe.Hex("#e5b24d4c"),
 		Text:       gothememe.Hex("#e5b24d"),
 	}
 }
 
 func (t *themeTinaciousDesignLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff3399").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff3399").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff339919"),
+		Border:     gothememe.Hex("#ff33994c"),
 		Text:       gothememe.Hex("#ff3399"),
 	}
 }
 
 func (t *themeTinaciousDesignLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00ceca").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00ceca").WithAlpha(0.3),
+		Background: gothememe.Hex("#00ceca19"),
+		Border:     gothememe.Hex("#00ceca4c"),
 		Text:       gothememe.Hex("#00ceca"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeTokyonightDay) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeTokyonightDay) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#587539").WithAlpha(0.1),
-		Border:     gothememe.Hex("#587539").WithAlpha(0.3),
+		Background: gothememe.Hex("#58753919"),
+		Border:     gothememe.Hex("#5875394c"),
 		Text:       gothememe.Hex("#587539"),
 	}
 }
 
 func (t *themeTokyonightDay) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8c6c3e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8c6c3e").WithAlpha(0.3),
+		Background: gothememe.Hex("#8c6c3e19"),
+		Border:     gothememe.Hex("#8c6c3e4c"),
 		Text:       gothememe.Hex("#8c6c3e"),
 	}
 }
 
 func (t *themeTokyonightDay) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f52a65").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f52a65").WithAlpha(0.3),
+		Background: gothememe.Hex("#f52a6519"),
+		Border:     gothememe.Hex("#f52a654c"),
 		Text:       gothememe.Hex("#f52a65"),
 	}
 }
 
 func (t *themeTokyonightDay) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#007197").WithAlpha(0.1),
-		Border:     gothememe.Hex("#007197").WithAlpha(0.3),
+		Background: gothememe.Hex("#00719719"),
+		Border:     gothememe.Hex("#0071974c"),
 		Text:       gothememe.Hex("#007197"),
 	}
 }
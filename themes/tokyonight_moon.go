@@ -45,32 +45,32 @@ func (t *themeTokyonightMoon) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeTokyonightMoon) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c3e88d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c3e88d").WithAlpha(0.3),
+		Background: gothememe.Hex("#c3e88d19"),
+		Border:     gothememe.Hex("#c3e88d4c"),
 		Text:       gothememe.Hex("#c3e88d"),
 	}
 }
 
 func (t *themeTokyonightMoon) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffc777").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffc777").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffc77719"),
+		Border:     gothememe.Hex("#ffc7774c"),
 		Text:       gothememe.Hex("#ffc777"),
 	}
 }
 
 func (t *themeTokyonightMoon) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff757f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff757f").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff757f19"),
+		Border:     gothememe.Hex("#ff757f4c"),
 		Text:       gothememe.Hex("#ff757f"),
 	}
 }
 
 func (t *themeTokyonightMoon) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#86e1fc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#86e1fc").WithAlpha(0.3),
+		Background: gothememe.Hex("#86e1fc19"),
+		Border:     gothememe.Hex("#86e1fc4c"),
 		Text:       gothememe.Hex("#86e1fc"),
 	}
 }
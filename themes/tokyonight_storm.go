@@ -45,32 +45,32 @@ func (t *themeTokyonightStorm) BorderStrong() gothememe.Color { return gothememe
 // Semantic colors
 func (t *themeTokyonightStorm) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9ece6a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9ece6a").WithAlpha(0.3),
+		Background: gothememe.Hex("#9ece6a19"),
+		Border:     gothememe.Hex("#9ece6a4c"),
 		Text:       gothememe.Hex("#9ece6a"),
 	}
 }
 
 func (t *themeTokyonightStorm) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e0af68").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e0af68").WithAlpha(0.3),
+		Background: gothememe.Hex("#e0af6819"),
+		Border:     gothememe.Hex("#e0af684c"),
 		Text:       gothememe.Hex("#e0af68"),
 	}
 }
 
 func (t *themeTokyonightStorm) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f7768e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f7768e").WithAlpha(0.3),
+		Background: gothememe.Hex("#f7768e19"),
+		Border:     gothememe.Hex("#f7768e4c"),
 		Text:       gothememe.Hex("#f7768e"),
 	}
 }
 
 func (t *themeTokyonightStorm) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7dcfff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7dcfff").WithAlpha(0.3),
+		Background: gothememe.Hex("#7dcfff19"),
+		Border:     gothememe.Hex("#7dcfff4c"),
 		Text:       gothememe.Hex("#7dcfff"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeTomorrow) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeTomorrow) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#718c00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#718c00").WithAlpha(0.3),
+		Background: gothememe.Hex("#718c0019"),
+		Border:     gothememe.Hex("#718c004c"),
 		Text:       gothememe.Hex("#718c00"),
 	}
 }
 
 func (t *themeTomorrow) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#eab700").WithAlpha(0.1),
-		Border:     gothememe.Hex("#eab700").WithAlpha(0.3),
+		Background: gothememe.Hex("#eab70019"),
+		Border:     gothememe.Hex("#eab7004c"),
 		Text:       gothememe.Hex("#eab700"),
 	}
 }
 
 func (t *themeTomorrow) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c82829").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c82829").WithAlpha(0.3),
+		Background: gothememe.Hex("#c8282919"),
+		Border:     gothememe.Hex("#c828294c"),
 		Text:       gothememe.Hex("#c82829"),
 	}
 }
 
 func (t *themeTomorrow) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3e999f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3e999f").WithAlpha(0.3),
+		Background: gothememe.Hex("#3e999f19"),
+		Border:     gothememe.Hex("#3e999f4c"),
 		Text:       gothememe.Hex("#3e999f"),
 	}
 }
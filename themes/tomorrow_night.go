@@ -45,32 +45,32 @@ func (t *themeTomorrowNight) BorderStrong() gothememe.Color { return gothememe.H
 // Semantic colors
 func (t *themeTomorrowNight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b5bd68").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b5bd68").WithAlpha(0.3),
+		Background: gothememe.Hex("#b5bd6819"),
+		Border:     gothememe.Hex("#b5bd684c"),
 		Text:       gothememe.Hex("#b5bd68"),
 	}
 }
 
 func (t *themeTomorrowNight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f0c674").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f0c674").WithAlpha(0.3),
+		Background: gothememe.Hex("#f0c67419"),
+		Border:     gothememe.Hex("#f0c6744c"),
 		Text:       gothememe.Hex("#f0c674"),
 	}
 }
 
 func (t *themeTomorrowNight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc6666").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc6666").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc666619"),
+		Border:     gothememe.Hex("#cc66664c"),
 		Text:       gothememe.Hex("#cc6666"),
 	}
 }
 
 func (t *themeTomorrowNight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8abeb7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8abeb7").WithAlpha(0.3),
+		Background: gothememe.Hex("#8abeb719"),
+		Border:     gothememe.Hex("#8abeb74c"),
 		Text:       gothememe.Hex("#8abeb7"),
 	}
 }
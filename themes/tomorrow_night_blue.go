@@ -47,32 +47,32 @@ func (t *themeTomorrowNightBlue) BorderStrong() gothememe.Color { return gotheme
 // Semantic colors
 func (t *themeTomorrowNightBlue) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d1f1a9").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d1f1a9").WithAlpha(0.3),
+		Background: gothememe.Hex("#d1f1a919"),
+		Border:     gothememe.Hex("#d1f1a94c"),
 		Text:       gothememe.Hex("#d1f1a9"),
 	}
 }
 
 func (t *themeTomorrowNightBlue) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffeead").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffeead").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffeead19"),
+		Border:     gothememe.Hex("#ffeead4c"),
 		Text:       gothememe.Hex("#ffeead"),
 	}
 }
 
 func (t *themeTomorrowNightBlue) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff9da4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff9da4").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff9da419"),
+		Border:     gothememe.Hex("#ff9da44c"),
 		Text:       gothememe.Hex("#ff9da4"),
 	}
 }
 
 func (t *themeTomorrowNightBlue) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#99ffff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#99ffff").WithAlpha(0.3),
+		Background: gothememe.Hex("#99ffff19"),
+		Border:     gothememe.Hex("#99ffff4c"),
 		Text:       gothememe.Hex("#99ffff"),
 	}
 }
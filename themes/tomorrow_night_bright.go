@@ -49,32 +49,32 @@ func (t *themeTomorrowNightBright) BorderStrong() gothememe.Color { return gothe
 // Semantic colors
 func (t *themeTomorrowNightBright) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b9ca4a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b9ca4a").WithAlpha(0.3),
+		Background: gothememe.Hex("#b9ca4a19"),
+		Border:     gothememe.Hex("#b9ca4a4c"),
 		Text:       gothememe.Hex("#b9ca4a"),
 	}
 }
 
 func (t *themeTomorrowNightBright) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e7c547").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e7c547").WithAlpha(0.3),
+		Background: gothememe.Hex("#e7c54719"),
+		Border:     gothememe.Hex("#e7c5474c"),
 		Text:       gothememe.Hex("#e7c547"),
 	}
 }
 
 func (t *themeTomorrowNightBright) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d54e53").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d54e53").WithAlpha(0.3),
+		Background: gothememe.Hex("#d54e5319"),
+		Border:     gothememe.Hex("#d54e534c"),
 		Text:       gothememe.Hex("#d54e53"),
 	}
 }
 
 func (t *themeTomorrowNightBright) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#70c0b1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#70c0b1").WithAlpha(0.3),
+		Background: gothememe.Hex("#70c0b119"),
+		Border:     gothememe.Hex("#70c0b14c"),
 		Text:       gothememe.Hex("#70c0b1"),
 	}
 }
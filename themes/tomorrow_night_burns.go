@@ -47,32 +47,32 @@ func (t *themeTomorrowNightBurns) BorderStrong() gothememe.Color { return gothem
 // Semantic colors
 func (t *themeTomorrowNightBurns) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a63c40").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a63c40").WithAlpha(0.3),
+		Background: gothememe.Hex("#a63c4019"),
+		Border:     gothememe.Hex("#a63c404c"),
 		Text:       gothememe.Hex("#a63c40"),
 	}
 }
 
 func (t *themeTomorrowNightBurns) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d3494e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d3494e").WithAlpha(0.3),
+		Background: gothememe.Hex("#d3494e19"),
+		Border:     gothememe.Hex("#d3494e4c"),
 		Text:       gothememe.Hex("#d3494e"),
 	}
 }
 
 func (t *themeTomorrowNightBurns) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#832e31").WithAlpha(0.1),
-		Border:     gothememe.Hex("#832e31").WithAlpha(0.3),
+		Background: gothememe.Hex("#832e3119"),
+		Border:     gothememe.Hex("#832e314c"),
 		Text:       gothememe.Hex("#832e31"),
 	}
 }
 
 func (t *themeTomorrowNightBurns) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ba8586").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ba8586").WithAlpha(0.3),
+		Background: gothememe.Hex("#ba858619"),
+		Border:     gothememe.Hex("#ba85864c"),
 		Text:       gothememe.Hex("#ba8586"),
 	}
 }
@@ -53,32 +53,32 @@ func (t *themeTomorrowNightEighties) BorderStrong() gothememe.Color { return got
 // Semantic colors
 func (t *themeTomorrowNightEighties) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#99cc99").WithAlpha(0.1),
-		Border:     gothememe.Hex("#99cc99").WithAlpha(0.3),
+		Background: gothememe.Hex("#99cc9919"),
+		Border:     gothememe.Hex("#99cc994c"),
 		Text:       gothememe.Hex("#99cc99"),
 	}
 }
 
 func (t *themeTomorrowNightEighties) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffcc66").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffcc66").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffcc6619"),
+		Border:     gothememe.Hex("#ffcc664c"),
 		Text:       gothememe.Hex("#ffcc66"),
 	}
 }
 
 func (t *themeTomorrowNightEighties) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f2777a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f2777a").WithAlpha(0.3),
+		Background: gothememe.Hex("#f2777a19"),
+		Border:     gothememe.Hex("#f2777a4c"),
 		Text:       gothememe.Hex("#f2777a"),
 	}
 }
 
 func (t *themeTomorrowNightEighties) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#66cccc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#66cccc").WithAlpha(0.3),
+		Background: gothememe.Hex("#66cccc19"),
+		Border:     gothememe.Hex("#66cccc4c"),
 		Text:       gothememe.Hex("#66cccc"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeToyChest) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeToyChest) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#1a9172").WithAlpha(0.1),
-		Border:     gothememe.Hex("#1a9172").WithAlpha(0.3),
+		Background: gothememe.Hex("#1a917219"),
+		Border:     gothememe.Hex("#1a91724c"),
 		Text:       gothememe.Hex("#1a9172"),
 	}
 }
 
 func (t *themeToyChest) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#db8e27").WithAlpha(0.1),
-		Border:     gothememe.Hex("#db8e27").WithAlpha(0.3),
+		Background: gothememe.Hex("#db8e2719"),
+		Border:     gothememe.Hex("#db8e274c"),
 		Text:       gothememe.Hex("#db8e27"),
 	}
 }
 
 func (t *themeToyChest) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#be2d26").WithAlpha(0.1),
-		Border:     gothememe.Hex("#be2d26").WithAlpha(0.3),
+		Background: gothememe.Hex("#be2d2619"),
+		Border:     gothememe.Hex("#be2d264c"),
 		Text:       gothememe.Hex("#be2d26"),
 	}
 }
 
 func (t *themeToyChest) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#35a08f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#35a08f").WithAlpha(0.3),
+		Background: gothememe.Hex("#35a08f19"),
+		Border:     gothememe.Hex("#35a08f4c"),
 		Text:       gothememe.Hex("#35a08f"),
 	}
 }
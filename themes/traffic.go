@@ -43,32 +43,32 @@ func (t *themeTraffic) BorderStrong() gothememe.Color { return gothememe.Hex("#c
 // Semantic colors
 func (t *themeTraffic) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#637268").WithAlpha(0.1),
-		Border:     gothememe.Hex("#637268").WithAlpha(0.3),
+		Background: gothememe.Hex("#63726819"),
+		Border:     gothememe.Hex("#6372684c"),
 		Text:       gothememe.Hex("#637268"),
 	}
 }
 
 func (t *themeTraffic) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c79e84").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c79e84").WithAlpha(0.3),
+		Background: gothememe.Hex("#c79e8419"),
+		Border:     gothememe.Hex("#c79e844c"),
 		Text:       gothememe.Hex("#c79e84"),
 	}
 }
 
 func (t *themeTraffic) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#934e46").WithAlpha(0.1),
-		Border:     gothememe.Hex("#934e46").WithAlpha(0.3),
+		Background: gothememe.Hex("#934e4619"),
+		Border:     gothememe.Hex("#934e464c"),
 		Text:       gothememe.Hex("#934e46"),
 	}
 }
 
 func (t *themeTraffic) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5c6f7d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5c6f7d").WithAlpha(0.3),
+		Background: gothememe.Hex("#5c6f7d19"),
+		Border:     gothememe.Hex("#5c6f7d4c"),
 		Text:       gothememe.Hex("#5c6f7d"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeTreehouse) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeTreehouse) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#44a900").WithAlpha(0.1),
-		Border:     gothememe.Hex("#44a900").WithAlpha(0.3),
+		Background: gothememe.Hex("#44a90019"),
+		Border:     gothememe.Hex("#44a9004c"),
 		Text:       gothememe.Hex("#44a900"),
 	}
 }
 
 func (t *themeTreehouse) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aa820c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aa820c").WithAlpha(0.3),
+		Background: gothememe.Hex("#aa820c19"),
+		Border:     gothememe.Hex("#aa820c4c"),
 		Text:       gothememe.Hex("#aa820c"),
 	}
 }
 
 func (t *themeTreehouse) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b2270e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b2270e").WithAlpha(0.3),
+		Background: gothememe.Hex("#b2270e19"),
+		Border:     gothememe.Hex("#b2270e4c"),
 		Text:       gothememe.Hex("#b2270e"),
 	}
 }
 
 func (t *themeTreehouse) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b25a1e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b25a1e").WithAlpha(0.3),
+		Background: gothememe.Hex("#b25a1e19"),
+		Border:     gothememe.Hex("#b25a1e4c"),
 		Text:       gothememe.Hex("#b25a1e"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeTwilight) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeTwilight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#afb97a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#afb97a").WithAlpha(0.3),
+		Background: gothememe.Hex("#afb97a19"),
+		Border:     gothememe.Hex("#afb97a4c"),
 		Text:       gothememe.Hex("#afb97a"),
 	}
 }
 
 func (t *themeTwilight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c2a86c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c2a86c").WithAlpha(0.3),
+		Background: gothememe.Hex("#c2a86c19"),
+		Border:     gothememe.Hex("#c2a86c4c"),
 		Text:       gothememe.Hex("#c2a86c"),
 	}
 }
 
 func (t *themeTwilight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c06d44").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c06d44").WithAlpha(0.3),
+		Background: gothememe.Hex("#c06d4419"),
+		Border:     gothememe.Hex("#c06d444c"),
 		Text:       gothememe.Hex("#c06d44"),
 	}
 }
 
 func (t *themeTwilight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#778385").WithAlpha(0.1),
-		Border:     gothememe.Hex("#778385").WithAlpha(0.3),
+		Background: gothememe.Hex("#77838519"),
+		Border:     gothememe.Hex("#7783854c"),
 		Text:       gothememe.Hex("#778385"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeUltraViolent) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeUltraViolent) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b6ff00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b6ff00").WithAlpha(0.3),
+		Background: gothememe.Hex("#b6ff0019"),
+		Border:     gothememe.Hex("#b6ff004c"),
 		Text:       gothememe.Hex("#b6ff00"),
 	}
 }
 
 func (t *themeUltraViolent) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fff727").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fff727").WithAlpha(0.3),
+		Background: gothememe.Hex("#fff72719"),
+		Border:     gothememe.Hex("#fff7274c"),
 		Text:       gothememe.Hex("#fff727"),
 	}
 }
 
 func (t *themeUltraViolent) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff0090").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff0090").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff009019"),
+		Border:     gothememe.Hex("#ff00904c"),
 		Text:       gothememe.Hex("#ff0090"),
 	}
 }
 
 func (t *themeUltraViolent) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#0effbb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#0effbb").WithAlpha(0.3),
+		Background: gothememe.Hex("#0effbb19"),
+		Border:     gothememe.Hex("#0effbb4c"),
 		Text:       gothememe.Hex("#0effbb"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeUnderTheSea) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeUnderTheSea) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00a941").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00a941").WithAlpha(0.3),
+		Background: gothememe.Hex("#00a94119"),
+		Border:     gothememe.Hex("#00a9414c"),
 		Text:       gothememe.Hex("#00a941"),
 	}
 }
 
 func (t *themeUnderTheSea) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#59819c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#59819c").WithAlpha(0.3),
+		Background: gothememe.Hex("#59819c19"),
+		Border:     gothememe.Hex("#59819c4c"),
 		Text:       gothememe.Hex("#59819c"),
 	}
 }
 
 func (t *themeUnderTheSea) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b2302d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b2302d").WithAlpha(0.3),
+		Background: gothememe.Hex("#b2302d19"),
+		Border:     gothememe.Hex("#b2302d4c"),
 		Text:       gothememe.Hex("#b2302d"),
 	}
 }
 
 func (t *themeUnderTheSea) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5d7e19").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5d7e19").WithAlpha(0.3),
+		Background: gothememe.Hex("#5d7e1919"),
+		Border:     gothememe.Hex("#5d7e194c"),
 		Text:       gothememe.Hex("#5d7e19"),
 	}
 }
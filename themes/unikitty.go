@@ -43,32 +43,32 @@ func (t *themeUnikitty) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeUnikitty) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c7ff98").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c7ff98").WithAlpha(0.3),
+		Background: gothememe.Hex("#c7ff9819"),
+		Border:     gothememe.Hex("#c7ff984c"),
 		Text:       gothememe.Hex("#c7ff98"),
 	}
 }
 
 func (t *themeUnikitty) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fff964").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fff964").WithAlpha(0.3),
+		Background: gothememe.Hex("#fff96419"),
+		Border:     gothememe.Hex("#fff9644c"),
 		Text:       gothememe.Hex("#fff964"),
 	}
 }
 
 func (t *themeUnikitty) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a80f20").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a80f20").WithAlpha(0.3),
+		Background: gothememe.Hex("#a80f2019"),
+		Border:     gothememe.Hex("#a80f204c"),
 		Text:       gothememe.Hex("#a80f20"),
 	}
 }
 
 func (t *themeUnikitty) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#9effef").WithAlpha(0.1),
-		Border:     gothememe.Hex("#9effef").WithAlpha(0.3),
+		Background: gothememe.Hex("#9effef19"),
+		Border:     gothememe.Hex("#9effef4c"),
 		Text:       gothememe.Hex("#9effef"),
 	}
 }
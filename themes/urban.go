@@ -43,32 +43,32 @@ func (t *themeUrban) BorderStrong() gothememe.Color { return gothememe.Hex("#c0a
 // Semantic colors
 func (t *themeUrban) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#74934e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#74934e").WithAlpha(0.3),
+		Background: gothememe.Hex("#74934e19"),
+		Border:     gothememe.Hex("#74934e4c"),
 		Text:       gothememe.Hex("#74934e"),
 	}
 }
 
 func (t *themeUrban) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ae835a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ae835a").WithAlpha(0.3),
+		Background: gothememe.Hex("#ae835a19"),
+		Border:     gothememe.Hex("#ae835a4c"),
 		Text:       gothememe.Hex("#ae835a"),
 	}
 }
 
 func (t *themeUrban) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#87404f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#87404f").WithAlpha(0.3),
+		Background: gothememe.Hex("#87404f19"),
+		Border:     gothememe.Hex("#87404f4c"),
 		Text:       gothememe.Hex("#87404f"),
 	}
 }
 
 func (t *themeUrban) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#625464").WithAlpha(0.1),
-		Border:     gothememe.Hex("#625464").WithAlpha(0.3),
+		Background: gothememe.Hex("#62546419"),
+		Border:     gothememe.Hex("#6254644c"),
 		Text:       gothememe.Hex("#625464"),
 	}
 }
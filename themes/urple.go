@@ -43,32 +43,32 @@ func (t *themeUrple) BorderStrong() gothememe.Color { return gothememe.Hex("#877
 // Semantic colors
 func (t *themeUrple) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#37a415").WithAlpha(0.1),
-		Border:     gothememe.Hex("#37a415").WithAlpha(0.3),
+		Background: gothememe.Hex("#37a41519"),
+		Border:     gothememe.Hex("#37a4154c"),
 		Text:       gothememe.Hex("#37a415"),
 	}
 }
 
 func (t *themeUrple) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ad5c42").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ad5c42").WithAlpha(0.3),
+		Background: gothememe.Hex("#ad5c4219"),
+		Border:     gothememe.Hex("#ad5c424c"),
 		Text:       gothememe.Hex("#ad5c42"),
 	}
 }
 
 func (t *themeUrple) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b0425b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b0425b").WithAlpha(0.3),
+		Background: gothememe.Hex("#b0425b19"),
+		Border:     gothememe.Hex("#b0425b4c"),
 		Text:       gothememe.Hex("#b0425b"),
 	}
 }
 
 func (t *themeUrple) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#808080").WithAlpha(0.1),
-		Border:     gothememe.Hex("#808080").WithAlpha(0.3),
+		Background: gothememe.Hex("#80808019"),
+		Border:     gothememe.Hex("#8080804c"),
 		Text:       gothememe.Hex("#808080"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeVague) BorderStrong() gothememe.Color { return gothememe.Hex("#cdc
 // Semantic colors
 func (t *themeVague) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7fa563").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7fa563").WithAlpha(0.3),
+		Background: gothememe.Hex("#7fa56319"),
+		Border:     gothememe.Hex("#7fa5634c"),
 		Text:       gothememe.Hex("#7fa563"),
 	}
 }
 
 func (t *themeVague) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f3be7c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f3be7c").WithAlpha(0.3),
+		Background: gothememe.Hex("#f3be7c19"),
+		Border:     gothememe.Hex("#f3be7c4c"),
 		Text:       gothememe.Hex("#f3be7c"),
 	}
 }
 
 func (t *themeVague) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d8647e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d8647e").WithAlpha(0.3),
+		Background: gothememe.Hex("#d8647e19"),
+		Border:     gothememe.Hex("#d8647e4c"),
 		Text:       gothememe.Hex("#d8647e"),
 	}
 }
 
 func (t *themeVague) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#aeaed1").WithAlpha(0.1),
-		Border:     gothememe.Hex("#aeaed1").WithAlpha(0.3),
+		Background: gothememe.Hex("#aeaed119"),
+		Border:     gothememe.Hex("#aeaed14c"),
 		Text:       gothememe.Hex("#aeaed1"),
 	}
 }
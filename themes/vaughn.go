@@ -43,32 +43,32 @@ func (t *themeVaughn) BorderStrong() gothememe.Color { return gothememe.Hex("#70
 // Semantic colors
 func (t *themeVaughn) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#60b48a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#60b48a").WithAlpha(0.3),
+		Background: gothememe.Hex("#60b48a19"),
+		Border:     gothememe.Hex("#60b48a4c"),
 		Text:       gothememe.Hex("#60b48a"),
 	}
 }
 
 func (t *themeVaughn) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dfaf8f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dfaf8f").WithAlpha(0.3),
+		Background: gothememe.Hex("#dfaf8f19"),
+		Border:     gothememe.Hex("#dfaf8f4c"),
 		Text:       gothememe.Hex("#dfaf8f"),
 	}
 }
 
 func (t *themeVaughn) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#705050").WithAlpha(0.1),
-		Border:     gothememe.Hex("#705050").WithAlpha(0.3),
+		Background: gothememe.Hex("#70505019"),
+		Border:     gothememe.Hex("#7050504c"),
 		Text:       gothememe.Hex("#705050"),
 	}
 }
 
 func (t *themeVaughn) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8cd0d3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8cd0d3").WithAlpha(0.3),
+		Background: gothememe.Hex("#8cd0d319"),
+		Border:     gothememe.Hex("#8cd0d34c"),
 		Text:       gothememe.Hex("#8cd0d3"),
 	}
 }
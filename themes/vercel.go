@@ -43,32 +43,32 @@ func (t *themeVercel) BorderStrong() gothememe.Color { return gothememe.Hex("#fe
 // Semantic colors
 func (t *themeVercel) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#29a948").WithAlpha(0.1),
-		Border:     gothememe.Hex("#29a948").WithAlpha(0.3),
+		Background: gothememe.Hex("#29a94819"),
+		Border:     gothememe.Hex("#29a9484c"),
 		Text:       gothememe.Hex("#29a948"),
 	}
 }
 
 func (t *themeVercel) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffae00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffae00").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffae0019"),
+		Border:     gothememe.Hex("#ffae004c"),
 		Text:       gothememe.Hex("#ffae00"),
 	}
 }
 
 func (t *themeVercel) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fc0036").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fc0036").WithAlpha(0.3),
+		Background: gothememe.Hex("#fc003619"),
+		Border:     gothememe.Hex("#fc00364c"),
 		Text:       gothememe.Hex("#fc0036"),
 	}
 }
 
 func (t *themeVercel) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00ac96").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00ac96").WithAlpha(0.3),
+		Background: gothememe.Hex("#00ac9619"),
+		Border:     gothememe.Hex("#00ac964c"),
 		Text:       gothememe.Hex("#00ac96"),
 	}
 }
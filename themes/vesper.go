@@ -43,32 +43,32 @@ func (t *themeVesper) BorderStrong() gothememe.Color { return gothememe.Hex("#a0
 // Semantic colors
 func (t *themeVesper) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#90b99f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#90b99f").WithAlpha(0.3),
+		Background: gothememe.Hex("#90b99f19"),
+		Border:     gothememe.Hex("#90b99f4c"),
 		Text:       gothememe.Hex("#90b99f"),
 	}
 }
 
 func (t *themeVesper) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e6b99d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e6b99d").WithAlpha(0.3),
+		Background: gothememe.Hex("#e6b99d19"),
+		Border:     gothememe.Hex("#e6b99d4c"),
 		Text:       gothememe.Hex("#e6b99d"),
 	}
 }
 
 func (t *themeVesper) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f5a191").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f5a191").WithAlpha(0.3),
+		Background: gothememe.Hex("#f5a19119"),
+		Border:     gothememe.Hex("#f5a1914c"),
 		Text:       gothememe.Hex("#f5a191"),
 	}
 }
 
 func (t *themeVesper) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ea83a5").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ea83a5").WithAlpha(0.3),
+		Background: gothememe.Hex("#ea83a519"),
+		Border:     gothememe.Hex("#ea83a54c"),
 		Text:       gothememe.Hex("#ea83a5"),
 	}
 }
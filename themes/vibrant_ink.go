@@ -43,32 +43,32 @@ func (t *themeVibrantInk) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeVibrantInk) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ccff04").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ccff04").WithAlpha(0.3),
+		Background: gothememe.Hex("#ccff0419"),
+		Border:     gothememe.Hex("#ccff044c"),
 		Text:       gothememe.Hex("#ccff04"),
 	}
 }
 
 func (t *themeVibrantInk) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffcc00").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffcc00").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffcc0019"),
+		Border:     gothememe.Hex("#ffcc004c"),
 		Text:       gothememe.Hex("#ffcc00"),
 	}
 }
 
 func (t *themeVibrantInk) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff6600").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff6600").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff660019"),
+		Border:     gothememe.Hex("#ff66004c"),
 		Text:       gothememe.Hex("#ff6600"),
 	}
 }
 
 func (t *themeVibrantInk) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#44b4cc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#44b4cc").WithAlpha(0.3),
+		Background: gothememe.Hex("#44b4cc19"),
+		Border:     gothememe.Hex("#44b4cc4c"),
 		Text:       gothememe.Hex("#44b4cc"),
 	}
 }
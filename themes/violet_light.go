@@ -45,32 +45,32 @@ func (t *themeVioletLight) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeVioletLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#85981c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#85981c").WithAlpha(0.3),
+		Background: gothememe.Hex("#85981c19"),
+		Border:     gothememe.Hex("#85981c4c"),
 		Text:       gothememe.Hex("#85981c"),
 	}
 }
 
 func (t *themeVioletLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b4881d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b4881d").WithAlpha(0.3),
+		Background: gothememe.Hex("#b4881d19"),
+		Border:     gothememe.Hex("#b4881d4c"),
 		Text:       gothememe.Hex("#b4881d"),
 	}
 }
 
 func (t *themeVioletLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c94c22").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c94c22").WithAlpha(0.3),
+		Background: gothememe.Hex("#c94c2219"),
+		Border:     gothememe.Hex("#c94c224c"),
 		Text:       gothememe.Hex("#c94c22"),
 	}
 }
 
 func (t *themeVioletLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#32a198").WithAlpha(0.1),
-		Border:     gothememe.Hex("#32a198").WithAlpha(0.3),
+		Background: gothememe.Hex("#32a19819"),
+		Border:     gothememe.Hex("#32a1984c"),
 		Text:       gothememe.Hex("#32a198"),
 	}
 }
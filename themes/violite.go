@@ -43,32 +43,32 @@ func (t *themeViolite) BorderStrong() gothememe.Color { return gothememe.Hex("#e
 // Semantic colors
 func (t *themeViolite) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#79ecb3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#79ecb3").WithAlpha(0.3),
+		Background: gothememe.Hex("#79ecb319"),
+		Border:     gothememe.Hex("#79ecb34c"),
 		Text:       gothememe.Hex("#79ecb3"),
 	}
 }
 
 func (t *themeViolite) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ece279").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ece279").WithAlpha(0.3),
+		Background: gothememe.Hex("#ece27919"),
+		Border:     gothememe.Hex("#ece2794c"),
 		Text:       gothememe.Hex("#ece279"),
 	}
 }
 
 func (t *themeViolite) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ec7979").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ec7979").WithAlpha(0.3),
+		Background: gothememe.Hex("#ec797919"),
+		Border:     gothememe.Hex("#ec79794c"),
 		Text:       gothememe.Hex("#ec7979"),
 	}
 }
 
 func (t *themeViolite) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#79ecec").WithAlpha(0.1),
-		Border:     gothememe.Hex("#79ecec").WithAlpha(0.3),
+		Background: gothememe.Hex("#79ecec19"),
+		Border:     gothememe.Hex("#79ecec4c"),
 		Text:       gothememe.Hex("#79ecec"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeWarmNeon) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeWarmNeon) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#39b13a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#39b13a").WithAlpha(0.3),
+		Background: gothememe.Hex("#39b13a19"),
+		Border:     gothememe.Hex("#39b13a4c"),
 		Text:       gothememe.Hex("#39b13a"),
 	}
 }
 
 func (t *themeWarmNeon) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#dae145").WithAlpha(0.1),
-		Border:     gothememe.Hex("#dae145").WithAlpha(0.3),
+		Background: gothememe.Hex("#dae14519"),
+		Border:     gothememe.Hex("#dae1454c"),
 		Text:       gothememe.Hex("#dae145"),
 	}
 }
 
 func (t *themeWarmNeon) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e24346").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e24346").WithAlpha(0.3),
+		Background: gothememe.Hex("#e2434619"),
+		Border:     gothememe.Hex("#e243464c"),
 		Text:       gothememe.Hex("#e24346"),
 	}
 }
 
 func (t *themeWarmNeon) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2abbd4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2abbd4").WithAlpha(0.3),
+		Background: gothememe.Hex("#2abbd419"),
+		Border:     gothememe.Hex("#2abbd44c"),
 		Text:       gothememe.Hex("#2abbd4"),
 	}
 }
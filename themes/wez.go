@@ -43,32 +43,32 @@ func (t *themeWez) BorderStrong() gothememe.Color { return gothememe.Hex("#ccccc
 // Semantic colors
 func (t *themeWez) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#55cc55").WithAlpha(0.1),
-		Border:     gothememe.Hex("#55cc55").WithAlpha(0.3),
+		Background: gothememe.Hex("#55cc5519"),
+		Border:     gothememe.Hex("#55cc554c"),
 		Text:       gothememe.Hex("#55cc55"),
 	}
 }
 
 func (t *themeWez) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cdcd55").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cdcd55").WithAlpha(0.3),
+		Background: gothememe.Hex("#cdcd5519"),
+		Border:     gothememe.Hex("#cdcd554c"),
 		Text:       gothememe.Hex("#cdcd55"),
 	}
 }
 
 func (t *themeWez) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cc5555").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cc5555").WithAlpha(0.3),
+		Background: gothememe.Hex("#cc555519"),
+		Border:     gothememe.Hex("#cc55554c"),
 		Text:       gothememe.Hex("#cc5555"),
 	}
 }
 
 func (t *themeWez) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7acaca").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7acaca").WithAlpha(0.3),
+		Background: gothememe.Hex("#7acaca19"),
+		Border:     gothememe.Hex("#7acaca4c"),
 		Text:       gothememe.Hex("#7acaca"),
 	}
 }
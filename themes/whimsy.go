@@ -43,32 +43,32 @@ func (t *themeWhimsy) BorderStrong() gothememe.Color { return gothememe.Hex("#ff
 // Semantic colors
 func (t *themeWhimsy) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5eca89").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5eca89").WithAlpha(0.3),
+		Background: gothememe.Hex("#5eca8919"),
+		Border:     gothememe.Hex("#5eca894c"),
 		Text:       gothememe.Hex("#5eca89"),
 	}
 }
 
 func (t *themeWhimsy) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#fdd877").WithAlpha(0.1),
-		Border:     gothememe.Hex("#fdd877").WithAlpha(0.3),
+		Background: gothememe.Hex("#fdd87719"),
+		Border:     gothememe.Hex("#fdd8774c"),
 		Text:       gothememe.Hex("#fdd877"),
 	}
 }
 
 func (t *themeWhimsy) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ef6487").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ef6487").WithAlpha(0.3),
+		Background: gothememe.Hex("#ef648719"),
+		Border:     gothememe.Hex("#ef64874c"),
 		Text:       gothememe.Hex("#ef6487"),
 	}
 }
 
 func (t *themeWhimsy) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#43c1be").WithAlpha(0.1),
-		Border:     gothememe.Hex("#43c1be").WithAlpha(0.3),
+		Background: gothememe.Hex("#43c1be19"),
+		Border:     gothememe.Hex("#43c1be4c"),
 		Text:       gothememe.Hex("#43c1be"),
 	}
 }
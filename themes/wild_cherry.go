@@ -43,32 +43,32 @@ func (t *themeWildCherry) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeWildCherry) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#2ab250").WithAlpha(0.1),
-		Border:     gothememe.Hex("#2ab250").WithAlpha(0.3),
+		Background: gothememe.Hex("#2ab25019"),
+		Border:     gothememe.Hex("#2ab2504c"),
 		Text:       gothememe.Hex("#2ab250"),
 	}
 }
 
 func (t *themeWildCherry) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ffd16f").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ffd16f").WithAlpha(0.3),
+		Background: gothememe.Hex("#ffd16f19"),
+		Border:     gothememe.Hex("#ffd16f4c"),
 		Text:       gothememe.Hex("#ffd16f"),
 	}
 }
 
 func (t *themeWildCherry) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d94085").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d94085").WithAlpha(0.3),
+		Background: gothememe.Hex("#d9408519"),
+		Border:     gothememe.Hex("#d940854c"),
 		Text:       gothememe.Hex("#d94085"),
 	}
 }
 
 func (t *themeWildCherry) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#c1b8b7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#c1b8b7").WithAlpha(0.3),
+		Background: gothememe.Hex("#c1b8b719"),
+		Border:     gothememe.Hex("#c1b8b74c"),
 		Text:       gothememe.Hex("#c1b8b7"),
 	}
 }
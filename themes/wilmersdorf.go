@@ -45,32 +45,32 @@ func (t *themeWilmersdorf) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeWilmersdorf) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7ebebd").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7ebebd").WithAlpha(0.3),
+		Background: gothememe.Hex("#7ebebd19"),
+		Border:     gothememe.Hex("#7ebebd4c"),
 		Text:       gothememe.Hex("#7ebebd"),
 	}
 }
 
 func (t *themeWilmersdorf) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cccccc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cccccc").WithAlpha(0.3),
+		Background: gothememe.Hex("#cccccc19"),
+		Border:     gothememe.Hex("#cccccc4c"),
 		Text:       gothememe.Hex("#cccccc"),
 	}
 }
 
 func (t *themeWilmersdorf) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e06383").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e06383").WithAlpha(0.3),
+		Background: gothememe.Hex("#e0638319"),
+		Border:     gothememe.Hex("#e063834c"),
 		Text:       gothememe.Hex("#e06383"),
 	}
 }
 
 func (t *themeWilmersdorf) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#5b94ab").WithAlpha(0.1),
-		Border:     gothememe.Hex("#5b94ab").WithAlpha(0.3),
+		Background: gothememe.Hex("#5b94ab19"),
+		Border:     gothememe.Hex("#5b94ab4c"),
 		Text:       gothememe.Hex("#5b94ab"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeWombat) BorderStrong() gothememe.Color { return gothememe.Hex("#de
 // Semantic colors
 func (t *themeWombat) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b1e969").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b1e969").WithAlpha(0.3),
+		Background: gothememe.Hex("#b1e96919"),
+		Border:     gothememe.Hex("#b1e9694c"),
 		Text:       gothememe.Hex("#b1e969"),
 	}
 }
 
 func (t *themeWombat) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ebd99c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ebd99c").WithAlpha(0.3),
+		Background: gothememe.Hex("#ebd99c19"),
+		Border:     gothememe.Hex("#ebd99c4c"),
 		Text:       gothememe.Hex("#ebd99c"),
 	}
 }
 
 func (t *themeWombat) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff615a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff615a").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff615a19"),
+		Border:     gothememe.Hex("#ff615a4c"),
 		Text:       gothememe.Hex("#ff615a"),
 	}
 }
 
 func (t *themeWombat) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#82fff7").WithAlpha(0.1),
-		Border:     gothememe.Hex("#82fff7").WithAlpha(0.3),
+		Background: gothememe.Hex("#82fff719"),
+		Border:     gothememe.Hex("#82fff74c"),
 		Text:       gothememe.Hex("#82fff7"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeWryan) BorderStrong() gothememe.Color { return gothememe.Hex("#899
 // Semantic colors
 func (t *themeWryan) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#287373").WithAlpha(0.1),
-		Border:     gothememe.Hex("#287373").WithAlpha(0.3),
+		Background: gothememe.Hex("#28737319"),
+		Border:     gothememe.Hex("#2873734c"),
 		Text:       gothememe.Hex("#287373"),
 	}
 }
 
 func (t *themeWryan) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7c7c99").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7c7c99").WithAlpha(0.3),
+		Background: gothememe.Hex("#7c7c9919"),
+		Border:     gothememe.Hex("#7c7c994c"),
 		Text:       gothememe.Hex("#7c7c99"),
 	}
 }
 
 func (t *themeWryan) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8c4665").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8c4665").WithAlpha(0.3),
+		Background: gothememe.Hex("#8c466519"),
+		Border:     gothememe.Hex("#8c46654c"),
 		Text:       gothememe.Hex("#8c4665"),
 	}
 }
 
 func (t *themeWryan) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#31658c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#31658c").WithAlpha(0.3),
+		Background: gothememe.Hex("#31658c19"),
+		Border:     gothememe.Hex("#31658c4c"),
 		Text:       gothememe.Hex("#31658c"),
 	}
 }
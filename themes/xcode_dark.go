@@ -43,32 +43,32 @@ func (t *themeXcodeDark) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeXcodeDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#78c2b3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#78c2b3").WithAlpha(0.3),
+		Background: gothememe.Hex("#78c2b319"),
+		Border:     gothememe.Hex("#78c2b34c"),
 		Text:       gothememe.Hex("#78c2b3"),
 	}
 }
 
 func (t *themeXcodeDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d9c97c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d9c97c").WithAlpha(0.3),
+		Background: gothememe.Hex("#d9c97c19"),
+		Border:     gothememe.Hex("#d9c97c4c"),
 		Text:       gothememe.Hex("#d9c97c"),
 	}
 }
 
 func (t *themeXcodeDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff8170").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff8170").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff817019"),
+		Border:     gothememe.Hex("#ff81704c"),
 		Text:       gothememe.Hex("#ff8170"),
 	}
 }
 
 func (t *themeXcodeDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b281eb").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b281eb").WithAlpha(0.3),
+		Background: gothememe.Hex("#b281eb19"),
+		Border:     gothememe.Hex("#b281eb4c"),
 		Text:       gothememe.Hex("#b281eb"),
 	}
 }
@@ -45,32 +45,32 @@ func (t *themeXcodeDarkHc) BorderStrong() gothememe.Color { return gothememe.Hex
 // Semantic colors
 func (t *themeXcodeDarkHc) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#83c9bc").WithAlpha(0.1),
-		Border:     gothememe.Hex("#83c9bc").WithAlpha(0.3),
+		Background: gothememe.Hex("#83c9bc19"),
+		Border:     gothememe.Hex("#83c9bc4c"),
 		Text:       gothememe.Hex("#83c9bc"),
 	}
 }
 
 func (t *themeXcodeDarkHc) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d9c668").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d9c668").WithAlpha(0.3),
+		Background: gothememe.Hex("#d9c66819"),
+		Border:     gothememe.Hex("#d9c6684c"),
 		Text:       gothememe.Hex("#d9c668"),
 	}
 }
 
 func (t *themeXcodeDarkHc) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ff8a7a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ff8a7a").WithAlpha(0.3),
+		Background: gothememe.Hex("#ff8a7a19"),
+		Border:     gothememe.Hex("#ff8a7a4c"),
 		Text:       gothememe.Hex("#ff8a7a"),
 	}
 }
 
 func (t *themeXcodeDarkHc) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#cda1ff").WithAlpha(0.1),
-		Border:     gothememe.Hex("#cda1ff").WithAlpha(0.3),
+		Background: gothememe.Hex("#cda1ff19"),
+		Border:     gothememe.Hex("#cda1ff4c"),
 		Text:       gothememe.Hex("#cda1ff"),
 	}
 }
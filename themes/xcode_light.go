@@ -43,32 +43,32 @@ func (t *themeXcodeLight) BorderStrong() gothememe.Color { return gothememe.Hex(
 // Semantic colors
 func (t *themeXcodeLight) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3e8087").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3e8087").WithAlpha(0.3),
+		Background: gothememe.Hex("#3e808719"),
+		Border:     gothememe.Hex("#3e80874c"),
 		Text:       gothememe.Hex("#3e8087"),
 	}
 }
 
 func (t *themeXcodeLight) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#78492a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#78492a").WithAlpha(0.3),
+		Background: gothememe.Hex("#78492a19"),
+		Border:     gothememe.Hex("#78492a4c"),
 		Text:       gothememe.Hex("#78492a"),
 	}
 }
 
 func (t *themeXcodeLight) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d12f1b").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d12f1b").WithAlpha(0.3),
+		Background: gothememe.Hex("#d12f1b19"),
+		Border:     gothememe.Hex("#d12f1b4c"),
 		Text:       gothememe.Hex("#d12f1b"),
 	}
 }
 
 func (t *themeXcodeLight) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#804fb8").WithAlpha(0.1),
-		Border:     gothememe.Hex("#804fb8").WithAlpha(0.3),
+		Background: gothememe.Hex("#804fb819"),
+		Border:     gothememe.Hex("#804fb84c"),
 		Text:       gothememe.Hex("#804fb8"),
 	}
 }
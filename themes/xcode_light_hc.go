@@ -45,32 +45,32 @@ func (t *themeXcodeLightHc) BorderStrong() gothememe.Color { return gothememe.He
 // Semantic colors
 func (t *themeXcodeLightHc) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#355d61").WithAlpha(0.1),
-		Border:     gothememe.Hex("#355d61").WithAlpha(0.3),
+		Background: gothememe.Hex("#355d6119"),
+		Border:     gothememe.Hex("#355d614c"),
 		Text:       gothememe.Hex("#355d61"),
 	}
 }
 
 func (t *themeXcodeLightHc) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#78492a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#78492a").WithAlpha(0.3),
+		Background: gothememe.Hex("#78492a19"),
+		Border:     gothememe.Hex("#78492a4c"),
 		Text:       gothememe.Hex("#78492a"),
 	}
 }
 
 func (t *themeXcodeLightHc) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#ad1805").WithAlpha(0.1),
-		Border:     gothememe.Hex("#ad1805").WithAlpha(0.3),
+		Background: gothememe.Hex("#ad180519"),
+		Border:     gothememe.Hex("#ad18054c"),
 		Text:       gothememe.Hex("#ad1805"),
 	}
 }
 
 func (t *themeXcodeLightHc) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#703daa").WithAlpha(0.1),
-		Border:     gothememe.Hex("#703daa").WithAlpha(0.3),
+		Background: gothememe.Hex("#703daa19"),
+		Border:     gothememe.Hex("#703daa4c"),
 		Text:       gothememe.Hex("#703daa"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeXcodeWwdc) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeXcodeWwdc) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#94c66e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#94c66e").WithAlpha(0.3),
+		Background: gothememe.Hex("#94c66e19"),
+		Border:     gothememe.Hex("#94c66e4c"),
 		Text:       gothememe.Hex("#94c66e"),
 	}
 }
 
 func (t *themeXcodeWwdc) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#d28e5d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#d28e5d").WithAlpha(0.3),
+		Background: gothememe.Hex("#d28e5d19"),
+		Border:     gothememe.Hex("#d28e5d4c"),
 		Text:       gothememe.Hex("#d28e5d"),
 	}
 }
 
 func (t *themeXcodeWwdc) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#bb383a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#bb383a").WithAlpha(0.3),
+		Background: gothememe.Hex("#bb383a19"),
+		Border:     gothememe.Hex("#bb383a4c"),
 		Text:       gothememe.Hex("#bb383a"),
 	}
 }
 
 func (t *themeXcodeWwdc) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#00aba4").WithAlpha(0.1),
-		Border:     gothememe.Hex("#00aba4").WithAlpha(0.3),
+		Background: gothememe.Hex("#00aba419"),
+		Border:     gothememe.Hex("#00aba44c"),
 		Text:       gothememe.Hex("#00aba4"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeZenbones) BorderStrong() gothememe.Color { return gothememe.Hex("#
 // Semantic colors
 func (t *themeZenbones) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#4f6c31").WithAlpha(0.1),
-		Border:     gothememe.Hex("#4f6c31").WithAlpha(0.3),
+		Background: gothememe.Hex("#4f6c3119"),
+		Border:     gothememe.Hex("#4f6c314c"),
 		Text:       gothememe.Hex("#4f6c31"),
 	}
 }
 
 func (t *themeZenbones) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#944927").WithAlpha(0.1),
-		Border:     gothememe.Hex("#944927").WithAlpha(0.3),
+		Background: gothememe.Hex("#94492719"),
+		Border:     gothememe.Hex("#9449274c"),
 		Text:       gothememe.Hex("#944927"),
 	}
 }
 
 func (t *themeZenbones) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#a8334c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#a8334c").WithAlpha(0.3),
+		Background: gothememe.Hex("#a8334c19"),
+		Border:     gothememe.Hex("#a8334c4c"),
 		Text:       gothememe.Hex("#a8334c"),
 	}
 }
 
 func (t *themeZenbones) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#3b8992").WithAlpha(0.1),
-		Border:     gothememe.Hex("#3b8992").WithAlpha(0.3),
+		Background: gothememe.Hex("#3b899219"),
+		Border:     gothememe.Hex("#3b89924c"),
 		Text:       gothememe.Hex("#3b8992"),
 	}
 }
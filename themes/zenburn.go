@@ -43,32 +43,32 @@ func (t *themeZenburn) BorderStrong() gothememe.Color { return gothememe.Hex("#d
 // Semantic colors
 func (t *themeZenburn) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#60b48a").WithAlpha(0.1),
-		Border:     gothememe.Hex("#60b48a").WithAlpha(0.3),
+		Background: gothememe.Hex("#60b48a19"),
+		Border:     gothememe.Hex("#60b48a4c"),
 		Text:       gothememe.Hex("#60b48a"),
 	}
 }
 
 func (t *themeZenburn) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#f0dfaf").WithAlpha(0.1),
-		Border:     gothememe.Hex("#f0dfaf").WithAlpha(0.3),
+		Background: gothememe.Hex("#f0dfaf19"),
+		Border:     gothememe.Hex("#f0dfaf4c"),
 		Text:       gothememe.Hex("#f0dfaf"),
 	}
 }
 
 func (t *themeZenburn) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#7d5d5d").WithAlpha(0.1),
-		Border:     gothememe.Hex("#7d5d5d").WithAlpha(0.3),
+		Background: gothememe.Hex("#7d5d5d19"),
+		Border:     gothememe.Hex("#7d5d5d4c"),
 		Text:       gothememe.Hex("#7d5d5d"),
 	}
 }
 
 func (t *themeZenburn) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#8cd0d3").WithAlpha(0.1),
-		Border:     gothememe.Hex("#8cd0d3").WithAlpha(0.3),
+		Background: gothememe.Hex("#8cd0d319"),
+		Border:     gothememe.Hex("#8cd0d34c"),
 		Text:       gothememe.Hex("#8cd0d3"),
 	}
 }
@@ -43,32 +43,32 @@ func (t *themeZenburned) BorderStrong() gothememe.Color { return gothememe.Hex("
 // Semantic colors
 func (t *themeZenburned) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#819b69").WithAlpha(0.1),
-		Border:     gothememe.Hex("#819b69").WithAlpha(0.3),
+		Background: gothememe.Hex("#819b6919"),
+		Border:     gothememe.Hex("#819b694c"),
 		Text:       gothememe.Hex("#819b69"),
 	}
 }
 
 func (t *themeZenburned) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b77e64").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b77e64").WithAlpha(0.3),
+		Background: gothememe.Hex("#b77e6419"),
+		Border:     gothememe.Hex("#b77e644c"),
 		Text:       gothememe.Hex("#b77e64"),
 	}
 }
 
 func (t *themeZenburned) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#e3716e").WithAlpha(0.1),
-		Border:     gothememe.Hex("#e3716e").WithAlpha(0.3),
+		Background: gothememe.Hex("#e3716e19"),
+		Border:     gothememe.Hex("#e3716e4c"),
 		Text:       gothememe.Hex("#e3716e"),
 	}
 }
 
 func (t *themeZenburned) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#66a5ad").WithAlpha(0.1),
-		Border:     gothememe.Hex("#66a5ad").WithAlpha(0.3),
+		Background: gothememe.Hex("#66a5ad19"),
+		Border:     gothememe.Hex("#66a5ad4c"),
 		Text:       gothememe.Hex("#66a5ad"),
 	}
 }
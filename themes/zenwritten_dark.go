@@ -45,32 +45,32 @@ func (t *themeZenwrittenDark) BorderStrong() gothememe.Color { return gothememe.
 // Semantic colors
 func (t *themeZenwrittenDark) Success() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#819b69").WithAlpha(0.1),
-		Border:     gothememe.Hex("#819b69").WithAlpha(0.3),
+		Background: gothememe.Hex("#819b6919"),
+		Border:     gothememe.Hex("#819b694c"),
 		Text:       gothememe.Hex("#819b69"),
 	}
 }
 
 func (t *themeZenwrittenDark) Warning() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#b77e64").WithAlpha(0.1),
-		Border:     gothememe.Hex("#b77e64").WithAlpha(0.3),
+		Background: gothememe.Hex("#b77e6419"),
+		Border:     gothememe.Hex("#b77e644c"),
 		Text:       gothememe.Hex("#b77e64"),
 	}
 }
 
 func (t *themeZenwrittenDark) Error() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#de6e7c").WithAlpha(0.1),
-		Border:     gothememe.Hex("#de6e7c").WithAlpha(0.3),
+		Background: gothememe.Hex("#de6e7c19"),
+		Border:     gothememe.Hex("#de6e7c4c"),
 		Text:       gothememe.Hex("#de6e7c"),
 	}
 }
 
 func (t *themeZenwrittenDark) Info() gothememe.SemanticColor {
 	return gothememe.SemanticColor{
-		Background: gothememe.Hex("#66a5ad").WithAlpha(0.1),
-		Border:     gothememe.Hex("#66a5ad").WithAlpha(0.3),
+		Background: gothememe.Hex("#66a5ad19"),
+		Border:     gothememe.Hex("#66a5ad4c"),
 		Text:       gothememe.Hex("#66a5ad"),
 	}
 }
@@ -3,6 +3,12 @@ package gothememe
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tj-smith47/gothememe/pkg/contrast"
 )
 
 // DesignToken represents a DTCG v1 compliant design token.
@@ -25,8 +31,22 @@ type TokenOptions struct {
 
 	// Indent is the JSON indentation string (default: "  ").
 	Indent string
+
+	// Aliases adds a primitive tier under "palette", with a token per
+	// distinct color, and writes every role as a reference to it, such as
+	// "{palette.blue}" for color.accent.primary. Roles that repeat another
+	// role, such as brand, reference that role's token instead.
+	Aliases bool
+
+	// Extensions adds gothememe metadata under $extensions["dev.gothememe"]:
+	// the theme's ID, name, mode and provenance on the document, in place of
+	// the meta group, and each role's key and WCAG contrast on its token.
+	Extensions bool
 }
 
+// TokenExtension is the key of the gothememe entry in $extensions.
+const TokenExtension = "dev.gothememe"
+
 // DefaultTokenOptions returns sensible default token options.
 func DefaultTokenOptions() TokenOptions {
 	return TokenOptions{
@@ -41,7 +61,7 @@ func GenerateDesignTokens(t Theme, opts TokenOptions) (string, error) {
 		opts.Indent = "  "
 	}
 
-	tokens := buildTokenStructure(t, opts)
+	tokens := buildTokenStructure(t, opts, "")
 
 	var data []byte
 	var err error
@@ -59,8 +79,9 @@ func GenerateDesignTokens(t Theme, opts TokenOptions) (string, error) {
 	return string(data), nil
 }
 
-// buildTokenStructure creates the hierarchical token structure.
-func buildTokenStructure(t Theme, opts TokenOptions) map[string]interface{} {
+// buildTokenStructure creates the hierarchical token structure. Aliases are
+// written relative to root, the path of the structure in its document.
+func buildTokenStructure(t Theme, opts TokenOptions, root string) map[string]interface{} {
 	makeToken := func(c Color, desc string) map[string]interface{} {
 		token := map[string]interface{}{
 			"$value": c.Hex(),
@@ -179,9 +200,143 @@ func buildTokenStructure(t Theme, opts TokenOptions) map[string]interface{} {
 		},
 	}
 
+	if opts.Aliases {
+		palette, names := tokenPalette(t)
+		tokens["palette"] = palette
+		for _, role := range colorRoles {
+			token := roleToken(tokens, role.key)
+			if role.get(t).IsEmpty() {
+				continue
+			}
+			if source, ok := roleAlias(t, role.key); ok {
+				token["$value"] = "{" + root + roleTokenPaths[source] + "}"
+			} else {
+				token["$value"] = "{" + root + "palette." + names[role.key] + "}"
+			}
+		}
+	}
+	if opts.Extensions {
+		delete(tokens, "meta")
+		tokens["$extensions"] = map[string]interface{}{TokenExtension: themeExtension(t)}
+		contrasts := tokenContrasts(t)
+		for _, role := range colorRoles {
+			ext := map[string]interface{}{"role": role.key}
+			if c, ok := contrasts[role.key]; ok {
+				ext["contrast"] = c
+			}
+			roleToken(tokens, role.key)["$extensions"] = map[string]interface{}{TokenExtension: ext}
+		}
+	}
+
 	return tokens
 }
 
+// roleToken returns the token of a role in a structure built by
+// buildTokenStructure.
+func roleToken(tokens map[string]interface{}, key string) map[string]interface{} {
+	node := tokens
+	for _, part := range strings.Split(roleTokenPaths[key], ".") {
+		node, _ = node[part].(map[string]interface{}) //nolint:errcheck // the structure holds every role path
+	}
+	return node
+}
+
+// tokenPalette returns the primitive tier of t, a token for each distinct
+// color, and the palette token name of each role. A color is named after the
+// first role that has it, ANSI roles first, so the accent of most themes
+// becomes a reference to its ANSI color, such as {palette.blue}.
+func tokenPalette(t Theme) (palette map[string]interface{}, names map[string]string) {
+	ansi := make([]colorRole, 0, len(colorRoles))
+	var rest []colorRole
+	for _, role := range colorRoles {
+		if colorRoleCategory(role.key) == "ANSI" {
+			ansi = append(ansi, role)
+		} else {
+			rest = append(rest, role)
+		}
+	}
+
+	palette = map[string]interface{}{"$type": "color"}
+	names = map[string]string{}
+	byColor := map[string]string{}
+	for _, role := range append(ansi, rest...) {
+		c := role.get(t)
+		if c.IsEmpty() {
+			continue
+		}
+		name, ok := byColor[c.Hex()]
+		if !ok {
+			name = strings.ReplaceAll(role.key, "_", "-")
+			byColor[c.Hex()] = name
+			palette[name] = map[string]interface{}{"$value": c.Hex()}
+		}
+		names[role.key] = name
+	}
+	return palette, names
+}
+
+// themeExtension returns the document metadata of t under
+// $extensions["dev.gothememe"].
+func themeExtension(t Theme) map[string]interface{} {
+	mode := "light"
+	if t.IsDark() {
+		mode = "dark"
+	}
+	ext := map[string]interface{}{
+		"id":        t.ID(),
+		"name":      t.DisplayName(),
+		"mode":      mode,
+		"generator": "gothememe",
+	}
+	for key, value := range map[string]string{
+		"description": t.Description(),
+		"author":      t.Author(),
+		"license":     t.License(),
+		"source":      t.Source(),
+	} {
+		if value != "" {
+			ext[key] = value
+		}
+	}
+	return ext
+}
+
+// tokenContrast is the WCAG contrast of a role against a background role.
+type tokenContrast struct {
+	Against string  `json:"against"`
+	Ratio   float64 `json:"ratio"`
+	Level   string  `json:"level"`
+}
+
+// tokenContrasts returns the contrast of every foreground role of the
+// standard contrast pairs against their backgrounds, keyed by role.
+// Translucent colors are composited over the background first.
+func tokenContrasts(t Theme) map[string][]tokenContrast {
+	keys := map[string]string{}
+	for _, role := range colorRoles {
+		keys[pascalCase(role.key)] = role.key
+	}
+
+	contrasts := map[string][]tokenContrast{}
+	for _, p := range getColorPairsFromTheme(t) {
+		fgKey := keys[strings.ReplaceAll(p.FgName, ".", "")]
+		bgKey := keys[strings.ReplaceAll(p.BgName, ".", "")]
+		fg, bg := Hex(p.FgHex), Hex(p.BgHex)
+		if fgKey == "" || bgKey == "" || fg.IsEmpty() || bg.IsEmpty() {
+			continue
+		}
+		bg = bg.opaque(t.Background())
+		fg = fg.opaque(bg)
+		ratio := contrast.RatioHex(fg.Hex(), bg.Hex())
+		contrasts[fgKey] = append(contrasts[fgKey], tokenContrast{
+			Against: bgKey,
+			Ratio:   math.Round(ratio*100) / 100,
+			Level:   contrast.CheckHex(fg.Hex(), bg.Hex()).String(),
+		})
+	}
+	return contrasts
+}
+
 // GenerateAllDesignTokens generates design tokens for multiple themes.
 func GenerateAllDesignTokens(themes []Theme, opts TokenOptions) (string, error) {
 	if opts.Indent == "" {
@@ -192,7 +347,7 @@ func GenerateAllDesignTokens(themes []Theme, opts TokenOptions) (string, error)
 	allTokens["$description"] = "Design tokens collection"
 
 	for _, t := range themes {
-		allTokens[t.ID()] = buildTokenStructure(t, opts)
+		allTokens[t.ID()] = buildTokenStructure(t, opts, t.ID()+".")
 	}
 
 	var data []byte
@@ -210,3 +365,92 @@ func GenerateAllDesignTokens(themes []Theme, opts TokenOptions) (string, error)
 
 	return string(data), nil
 }
+
+// tokenResolver is the shape of the resolver document written by
+// [WriteDesignTokenSet].
+type tokenResolver struct {
+	Name            string                           `json:"name"`
+	Version         string                           `json:"version"`
+	Modifiers       map[string]tokenResolverModifier `json:"modifiers"`
+	ResolutionOrder []tokenRef                       `json:"resolutionOrder"`
+	Extensions      map[string]tokenSetManifest      `json:"$extensions"`
+}
+
+// tokenResolverModifier switches between token files by context.
+type tokenResolverModifier struct {
+	Contexts map[string][]tokenRef `json:"contexts"`
+	Default  string                `json:"default"`
+}
+
+// tokenRef is a JSON reference to a file or to part of the document.
+type tokenRef struct {
+	Ref string `json:"$ref"`
+}
+
+// tokenSetManifest lists the themes of a token set.
+type tokenSetManifest struct {
+	Themes []tokenSetTheme `json:"themes"`
+}
+
+// tokenSetTheme is one theme of a token set manifest.
+type tokenSetTheme struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Mode string `json:"mode"`
+	File string `json:"file"`
+}
+
+// WriteDesignTokenSet writes each theme to dir as its own DTCG file, such as
+// dracula.tokens.json, together with themes.resolver.json, a resolver
+// document in the shape of the DTCG resolver module with a "theme" modifier
+// whose contexts select each theme's file, the first being the default. Its
+// $extensions["dev.gothememe"] entry lists the themes with their name, mode
+// and file for tools that only need a manifest. The directory is created if
+// needed.
+func WriteDesignTokenSet(dir string, opts TokenOptions, themes ...Theme) error {
+	themes, err := uniqueThemes(themes)
+	if err != nil {
+		return err
+	}
+
+	files := map[string]string{}
+	resolver := tokenResolver{
+		Name:            "gothememe themes",
+		Version:         "2025.10",
+		Modifiers:       map[string]tokenResolverModifier{"theme": {Contexts: map[string][]tokenRef{}, Default: themes[0].ID()}},
+		ResolutionOrder: []tokenRef{{"#/modifiers/theme"}},
+	}
+	var manifest tokenSetManifest
+	for _, t := range themes {
+		tokens, err := GenerateDesignTokens(t, opts)
+		if err != nil {
+			return err
+		}
+		name := t.ID() + ".tokens.json"
+		files[name] = tokens + "\n"
+
+		resolver.Modifiers["theme"].Contexts[t.ID()] = []tokenRef{{name}}
+		mode := "light"
+		if t.IsDark() {
+			mode = "dark"
+		}
+		manifest.Themes = append(manifest.Themes, tokenSetTheme{t.ID(), t.DisplayName(), mode, name})
+	}
+	resolver.Extensions = map[string]tokenSetManifest{TokenExtension: manifest}
+
+	data, err := json.MarshalIndent(resolver, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal token resolver: %w", err)
+	}
+	files["themes.resolver.json"] = string(data) + "\n"
+
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec // G301: token folders are meant to be shared
+		return fmt.Errorf("writing design token set: %w", err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil { //nolint:gosec // G306: token files are meant to be shared
+			return fmt.Errorf("writing design token set: %w", err)
+		}
+	}
+	return nil
+}
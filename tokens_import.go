@@ -0,0 +1,425 @@
+package gothememe
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// TokenImportOptions configures design token parsing.
+type TokenImportOptions struct {
+	// ID overrides the theme ID. Defaults to the meta.id token, then "imported".
+	ID string
+
+	// Name overrides the display name. Defaults to the meta.name token, then ID.
+	Name string
+
+	// Root selects the group that holds the theme's tokens, as a dot-separated
+	// path. Use it to pick one theme from GenerateAllDesignTokens output
+	// (Root: "dracula") or one set from a Tokens Studio export. Aliases may
+	// still reference tokens outside the root.
+	Root string
+
+	// Mapping maps token paths (dot-separated, relative to Root) to color role
+	// keys as listed by [ColorRoles]. Entries are applied after the default
+	// gothememe paths, so they can add roles or override the defaults. Use it
+	// for token files exported from Figma or Tokens Studio, e.g.
+	//
+	//	Mapping: map[string]string{
+	//	    "colors.neutral.900": "background",
+	//	    "colors.neutral.50":  "text_primary",
+	//	    "colors.brand.500":   "accent",
+	//	}
+	Mapping map[string]string
+}
+
+// defaultTokenPaths maps the token paths written by GenerateDesignTokens back
+// to color roles.
+var defaultTokenPaths = map[string]string{
+	"color.background.primary":          "background",
+	"color.background.secondary":        "background_secondary",
+	"color.surface.primary":             "surface",
+	"color.surface.secondary":           "surface_secondary",
+	"color.text.primary":                "text_primary",
+	"color.text.secondary":              "text_secondary",
+	"color.text.muted":                  "text_muted",
+	"color.text.inverted":               "text_inverted",
+	"color.accent.primary":              "accent",
+	"color.accent.secondary":            "accent_secondary",
+	"color.brand":                       "brand",
+	"color.border.default":              "border",
+	"color.border.subtle":               "border_subtle",
+	"color.border.strong":               "border_strong",
+	"color.semantic.success.background": "success_background",
+	"color.semantic.success.border":     "success_border",
+	"color.semantic.success.text":       "success_text",
+	"color.semantic.warning.background": "warning_background",
+	"color.semantic.warning.border":     "warning_border",
+	"color.semantic.warning.text":       "warning_text",
+	"color.semantic.error.background":   "error_background",
+	"color.semantic.error.border":       "error_border",
+	"color.semantic.error.text":         "error_text",
+	"color.semantic.info.background":    "info_background",
+	"color.semantic.info.border":        "info_border",
+	"color.semantic.info.text":          "info_text",
+	"color.ansi.black":                  "black",
+	"color.ansi.red":                    "red",
+	"color.ansi.green":                  "green",
+	"color.ansi.yellow":                 "yellow",
+	"color.ansi.blue":                   "blue",
+	"color.ansi.purple":                 "purple",
+	"color.ansi.cyan":                   "cyan",
+	"color.ansi.white":                  "white",
+	"color.ansi.bright-black":           "bright_black",
+	"color.ansi.bright-red":             "bright_red",
+	"color.ansi.bright-green":           "bright_green",
+	"color.ansi.bright-yellow":          "bright_yellow",
+	"color.ansi.bright-blue":            "bright_blue",
+	"color.ansi.bright-purple":          "bright_purple",
+	"color.ansi.bright-cyan":            "bright_cyan",
+	"color.ansi.bright-white":           "bright_white",
+	"color.code.background":             "code_background",
+	"color.code.text":                   "code_text",
+	"color.code.comment":                "code_comment",
+	"color.code.keyword":                "code_keyword",
+	"color.code.string":                 "code_string",
+	"color.code.number":                 "code_number",
+	"color.code.function":               "code_function",
+	"color.code.operator":               "code_operator",
+	"color.code.punctuation":            "code_punctuation",
+	"color.code.variable":               "code_variable",
+	"color.code.constant":               "code_constant",
+	"color.code.type":                   "code_type",
+}
+
+// roleTokenPaths maps each color role to its token path, the inverse of
+// defaultTokenPaths.
+var roleTokenPaths = func() map[string]string {
+	paths := make(map[string]string, len(defaultTokenPaths))
+	for path, role := range defaultTokenPaths {
+		paths[role] = path
+	}
+	return paths
+}()
+
+// designToken is a flattened token with its inherited type.
+type designToken struct {
+	value any
+	typ   string
+}
+
+// tokenSet holds every token in a document keyed by its dot-separated path.
+type tokenSet map[string]designToken
+
+var (
+	// tokenAliasPattern matches a value that is exactly one alias, e.g. "{color.brand}".
+	tokenAliasPattern = regexp.MustCompile(`^\{([^{}]+)\}$`)
+
+	// tokenRefPattern matches aliases embedded in a larger string value.
+	tokenRefPattern = regexp.MustCompile(`\{([^{}]+)\}`)
+
+	// tokenHexAlphaPattern matches the Tokens Studio rgba(#hex, alpha) form.
+	tokenHexAlphaPattern = regexp.MustCompile(`^rgba?\(\s*(#[0-9A-Fa-f]+)\s*,\s*([0-9.]+%?)\s*\)$`)
+)
+
+// ParseDesignTokens reads a DTCG design token document and rebuilds a Theme.
+// It understands the structure written by [GenerateDesignTokens], resolves
+// {group.token} aliases and inherits $type from enclosing groups.
+// Roles without a token are derived by [ThemeBuilder].
+func ParseDesignTokens(r io.Reader) (Theme, error) {
+	return ParseDesignTokensWithOptions(r, TokenImportOptions{})
+}
+
+// ParseDesignTokensWithOptions reads a design token document like
+// [ParseDesignTokens], with a custom root group and token-to-role mapping.
+// Both the "$value"/"$type" keys of the DTCG format and the "value"/"type"
+// keys used by Tokens Studio are accepted.
+func ParseDesignTokensWithOptions(r io.Reader, opts TokenImportOptions) (Theme, error) {
+	var doc map[string]any
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decoding design tokens: %w", err)
+	}
+
+	tokens := make(tokenSet)
+	tokens.collect(doc, "", "")
+
+	prefix := ""
+	if opts.Root != "" {
+		prefix = opts.Root + "."
+	}
+
+	// The default paths are applied first, so Mapping entries override
+	// them whichever way their paths sort.
+	mapping := maps.Clone(defaultTokenPaths)
+	maps.Copy(mapping, opts.Mapping)
+	var paths []string
+	for _, path := range slices.Sorted(maps.Keys(defaultTokenPaths)) {
+		if _, ok := opts.Mapping[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	paths = append(paths, slices.Sorted(maps.Keys(opts.Mapping))...)
+
+	// Metadata comes from the meta group, or from the gothememe extension
+	// of documents written with TokenOptions.Extensions.
+	ext := documentExtension(doc, opts.Root)
+	meta := func(key, fallback string) string {
+		if s, ok := ext[key].(string); ok && s != "" {
+			fallback = s
+		}
+		return tokens.metaString(prefix+"meta."+key, fallback)
+	}
+
+	id, name := opts.ID, opts.Name
+	if id == "" {
+		id = meta("id", "imported")
+	}
+	if name == "" {
+		name = meta("name", id)
+	}
+
+	b := NewThemeBuilder(id, name).
+		WithDescription(meta("description", "")).
+		WithAuthor(meta("author", "")).
+		WithLicense(meta("license", "")).
+		WithSource(meta("source", ""))
+
+	if v, _, err := tokens.resolve(prefix+"meta.isDark", nil); err == nil {
+		if isDark, ok := v.(bool); ok {
+			b.withMode(isDark)
+		}
+	} else if mode, ok := ext["mode"].(string); ok {
+		b.withMode(mode == "dark")
+	}
+
+	applied := 0
+	for _, path := range paths {
+		key := mapping[path]
+		role, ok := lookupColorRole(key)
+		if !ok {
+			return nil, fmt.Errorf("mapping for %q: unknown color role %q", path, key)
+		}
+		if _, exists := tokens[prefix+path]; !exists {
+			continue
+		}
+
+		c, err := tokens.color(prefix + path)
+		if err != nil {
+			return nil, fmt.Errorf("token %q: %w", prefix+path, err)
+		}
+		if c.IsEmpty() {
+			continue
+		}
+		role.set(b, c)
+		applied++
+	}
+
+	if applied == 0 {
+		return nil, errors.New("no color tokens matched the token mapping")
+	}
+
+	return b.Build(), nil
+}
+
+// documentExtension returns the gothememe entry of the $extensions of the
+// group at root, or nil when it has none.
+func documentExtension(doc map[string]any, root string) map[string]any {
+	group := doc
+	if root != "" {
+		for _, part := range strings.Split(root, ".") {
+			group, _ = group[part].(map[string]any) //nolint:errcheck // a missing group has no extension
+		}
+	}
+	exts, _ := group["$extensions"].(map[string]any) //nolint:errcheck // a missing $extensions has no entry
+	ext, _ := exts[TokenExtension].(map[string]any)  //nolint:errcheck // a missing entry is nil
+	return ext
+}
+
+// collect flattens a token group into the set, propagating group $type.
+func (ts tokenSet) collect(group map[string]any, prefix, inheritedType string) {
+	if t, ok := group["$type"].(string); ok {
+		inheritedType = t
+	}
+
+	for key, child := range group {
+		if strings.HasPrefix(key, "$") {
+			continue
+		}
+		node, ok := child.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		if value, typ, isToken := tokenValue(node); isToken {
+			if typ == "" {
+				typ = inheritedType
+			}
+			ts[path] = designToken{value: value, typ: typ}
+			continue
+		}
+		ts.collect(node, path, inheritedType)
+	}
+}
+
+// tokenValue reports whether node is a token rather than a group and returns
+// its value and declared type.
+func tokenValue(node map[string]any) (value any, typ string, ok bool) {
+	if v, found := node["$value"]; found {
+		typ, _ = node["$type"].(string) //nolint:errcheck // a missing or non-string $type means "inherit"
+		return v, typ, true
+	}
+
+	// Tokens Studio legacy format: {"value": ..., "type": ...}
+	v, found := node["value"]
+	if !found {
+		return nil, "", false
+	}
+	typ, hasType := node["type"].(string)
+	if _, isGroup := v.(map[string]any); isGroup && !hasType {
+		return nil, "", false
+	}
+	return v, typ, true
+}
+
+// resolve returns the fully dereferenced value and type of the token at path.
+func (ts tokenSet) resolve(path string, seen []string) (any, string, error) {
+	if slices.Contains(seen, path) {
+		return nil, "", fmt.Errorf("circular alias: %s -> %s", strings.Join(seen, " -> "), path)
+	}
+	tok, ok := ts[path]
+	if !ok {
+		return nil, "", fmt.Errorf("unresolved alias {%s}", path)
+	}
+	seen = append(seen, path)
+
+	s, isString := tok.value.(string)
+	if !isString {
+		return tok.value, tok.typ, nil
+	}
+
+	if m := tokenAliasPattern.FindStringSubmatch(strings.TrimSpace(s)); m != nil {
+		value, typ, err := ts.resolve(m[1], seen)
+		if err != nil {
+			return nil, "", err
+		}
+		if tok.typ != "" {
+			typ = tok.typ
+		}
+		return value, typ, nil
+	}
+
+	var resolveErr error
+	expanded := tokenRefPattern.ReplaceAllStringFunc(s, func(ref string) string {
+		value, _, err := ts.resolve(ref[1:len(ref)-1], seen)
+		if err != nil {
+			resolveErr = err
+			return ref
+		}
+		if c, ok := value.(map[string]any); ok {
+			if col, err := colorFromTokenObject(c); err == nil {
+				return col.Hex()
+			}
+		}
+		return fmt.Sprint(value)
+	})
+	if resolveErr != nil {
+		return nil, "", resolveErr
+	}
+	return expanded, tok.typ, nil
+}
+
+// color resolves the token at path and converts it to a Color. An empty
+// value, as written by GenerateDesignTokens for unset roles, yields an empty
+// Color.
+func (ts tokenSet) color(path string) (Color, error) {
+	value, typ, err := ts.resolve(path, nil)
+	if err != nil {
+		return Color{}, err
+	}
+	if typ != "" && typ != "color" {
+		return Color{}, fmt.Errorf("token has type %q, want color", typ)
+	}
+
+	switch v := value.(type) {
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return Color{}, nil
+		}
+		return parseTokenColor(v)
+	case map[string]any:
+		return colorFromTokenObject(v)
+	default:
+		return Color{}, fmt.Errorf("unsupported color value %v", value)
+	}
+}
+
+// parseTokenColor parses a color string, additionally accepting the
+// rgba(#hex, alpha) form Tokens Studio produces when an alias is wrapped in
+// rgba().
+func parseTokenColor(s string) (Color, error) {
+	m := tokenHexAlphaPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return ParseColor(s)
+	}
+	c, err := ParseColor(m[1])
+	if err != nil {
+		return Color{}, err
+	}
+	return applyAlphaArg(c, []string{"", "", "", m[2]})
+}
+
+// metaString resolves a string metadata token, returning fallback when absent.
+func (ts tokenSet) metaString(path, fallback string) string {
+	value, _, err := ts.resolve(path, nil)
+	if err != nil {
+		return fallback
+	}
+	if s, ok := value.(string); ok && s != "" {
+		return s
+	}
+	return fallback
+}
+
+// colorFromTokenObject converts a DTCG structured color value such as
+// {"colorSpace": "srgb", "components": [1, 0.5, 0], "alpha": 1, "hex": "#ff8000"}.
+func colorFromTokenObject(v map[string]any) (Color, error) {
+	var c Color
+	if hex, ok := v["hex"].(string); ok {
+		parsed, err := ParseColor(hex)
+		if err != nil {
+			return Color{}, err
+		}
+		c = parsed
+	} else {
+		space, _ := v["colorSpace"].(string) //nolint:errcheck // validated below
+		components, ok := v["components"].([]any)
+		if !ok || len(components) != 3 || (space != "srgb" && space != "") {
+			return Color{}, fmt.Errorf("unsupported color object (colorSpace %q)", space)
+		}
+		var ch [3]uint8
+		for i, comp := range components {
+			f, ok := comp.(float64)
+			if !ok {
+				return Color{}, fmt.Errorf("invalid color component %v", comp)
+			}
+			ch[i] = clampByte(f * 255)
+		}
+		c = RGB(ch[0], ch[1], ch[2])
+	}
+
+	if alpha, ok := v["alpha"].(float64); ok && alpha < 1 {
+		c = c.WithAlpha(math.Max(0, alpha))
+	}
+	return c, nil
+}
@@ -0,0 +1,320 @@
+package gothememe
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseDesignTokensRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	original := NewThemeBuilder("roundtrip", "Round Trip").
+		WithDescription("A test theme").
+		WithAuthor("Tester").
+		WithLicense("MIT").
+		WithSource("https://example.com").
+		WithIsDark(true).
+		WithBackground(Hex("#282a36")).
+		WithTextPrimary(Hex("#f8f8f2")).
+		WithAccent(Hex("#bd93f9")).
+		WithRed(Hex("#ff5555")).
+		WithCodeKeyword(Hex("#ff79c6")).
+		Build()
+
+	tokens, err := GenerateDesignTokens(original, DefaultTokenOptions())
+	if err != nil {
+		t.Fatalf("GenerateDesignTokens() error: %v", err)
+	}
+
+	got, err := ParseDesignTokens(strings.NewReader(tokens))
+	if err != nil {
+		t.Fatalf("ParseDesignTokens() error: %v", err)
+	}
+
+	if got.ID() != "roundtrip" || got.DisplayName() != "Round Trip" {
+		t.Errorf("identity = %q/%q, want roundtrip/Round Trip", got.ID(), got.DisplayName())
+	}
+	if got.Author() != "Tester" || got.License() != "MIT" || got.Source() != "https://example.com" {
+		t.Errorf("metadata not restored: author=%q license=%q source=%q", got.Author(), got.License(), got.Source())
+	}
+	if !got.IsDark() {
+		t.Error("IsDark() = false, want true")
+	}
+	assertSameColors(t, original, got)
+}
+
+func TestParseDesignTokensAllThemesRoot(t *testing.T) {
+	t.Parallel()
+
+	themes := []Theme{
+		NewThemeBuilder("one", "One").WithBackground(Hex("#000000")).WithTextPrimary(Hex("#ffffff")).Build(),
+		NewThemeBuilder("two", "Two").WithBackground(Hex("#ffffff")).WithTextPrimary(Hex("#000000")).Build(),
+	}
+
+	tokens, err := GenerateAllDesignTokens(themes, DefaultTokenOptions())
+	if err != nil {
+		t.Fatalf("GenerateAllDesignTokens() error: %v", err)
+	}
+
+	got, err := ParseDesignTokensWithOptions(strings.NewReader(tokens), TokenImportOptions{Root: "two"})
+	if err != nil {
+		t.Fatalf("ParseDesignTokensWithOptions() error: %v", err)
+	}
+	if got.ID() != "two" {
+		t.Errorf("ID() = %q, want two", got.ID())
+	}
+	assertSameColors(t, themes[1], got)
+}
+
+func TestParseDesignTokensAliases(t *testing.T) {
+	t.Parallel()
+
+	doc := `{
+		"palette": {
+			"$type": "color",
+			"purple": {"$value": "#bd93f9"},
+			"dark": {"$value": "#282a36"},
+			"light": {"$value": "#f8f8f2"}
+		},
+		"color": {
+			"$type": "color",
+			"background": {"primary": {"$value": "{palette.dark}"}},
+			"text": {"primary": {"$value": "{palette.light}"}},
+			"accent": {
+				"primary": {"$value": "{palette.purple}"},
+				"secondary": {"$value": "{color.accent.primary}"}
+			},
+			"border": {"default": {"$value": "rgba({palette.light}, 0.2)"}}
+		}
+	}`
+
+	got, err := ParseDesignTokens(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("ParseDesignTokens() error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  Color
+		want string
+	}{
+		{"background", got.Background(), "#282a36"},
+		{"text primary", got.TextPrimary(), "#f8f8f2"},
+		{"accent", got.Accent(), "#bd93f9"},
+		{"chained alias", got.AccentSecondary(), "#bd93f9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if tt.got.Hex() != tt.want {
+				t.Errorf("got %s, want %s", tt.got.Hex(), tt.want)
+			}
+		})
+	}
+
+	if _, _, _, a := got.Border().RGBAComponents(); a < 50 || a > 52 {
+		t.Errorf("embedded alias border alpha = %d, want 51", a)
+	}
+	if got.ID() != "imported" {
+		t.Errorf("ID() = %q, want imported", got.ID())
+	}
+}
+
+func TestParseDesignTokensCustomMapping(t *testing.T) {
+	t.Parallel()
+
+	// Tokens Studio style: legacy value/type keys and top-level sets.
+	doc := `{
+		"global": {
+			"colors": {
+				"neutral": {
+					"900": {"value": "#101014", "type": "color"},
+					"50": {"value": "#f4f4f5", "type": "color"}
+				},
+				"brand": {
+					"500": {"value": "#6366f1", "type": "color"}
+				}
+			}
+		},
+		"dark": {
+			"bg": {"value": "{colors.neutral.900}", "type": "color"}
+		},
+		"$themes": [],
+		"$metadata": {"tokenSetOrder": ["global", "dark"]}
+	}`
+
+	got, err := ParseDesignTokensWithOptions(strings.NewReader(doc), TokenImportOptions{
+		ID:   "studio",
+		Name: "Studio",
+		Mapping: map[string]string{
+			"dark.bg":                   "background",
+			"global.colors.neutral.50":  "text-primary",
+			"global.colors.brand.500":   "accent",
+			"global.colors.neutral.900": "code_background",
+		},
+	})
+	// colors.neutral.900 is not a full path, so the alias is unresolved.
+	if err == nil || !strings.Contains(err.Error(), "unresolved alias") {
+		t.Fatalf("expected unresolved alias error, got %v (theme %v)", err, got)
+	}
+
+	doc = strings.Replace(doc, "{colors.neutral.900}", "{global.colors.neutral.900}", 1)
+	got, err = ParseDesignTokensWithOptions(strings.NewReader(doc), TokenImportOptions{
+		ID: "studio",
+		Mapping: map[string]string{
+			"dark.bg":                  "background",
+			"global.colors.neutral.50": "text-primary",
+			"global.colors.brand.500":  "accent",
+		},
+	})
+	if err != nil {
+		t.Fatalf("ParseDesignTokensWithOptions() error: %v", err)
+	}
+
+	if got.DisplayName() != "studio" {
+		t.Errorf("DisplayName() = %q, want ID fallback", got.DisplayName())
+	}
+	if got.Background().Hex() != "#101014" {
+		t.Errorf("Background() = %s, want #101014", got.Background().Hex())
+	}
+	if got.TextPrimary().Hex() != "#f4f4f5" {
+		t.Errorf("TextPrimary() = %s, want #f4f4f5", got.TextPrimary().Hex())
+	}
+	if got.Accent().Hex() != "#6366f1" {
+		t.Errorf("Accent() = %s, want #6366f1", got.Accent().Hex())
+	}
+	if !got.IsDark() {
+		t.Error("IsDark() = false, want detection from dark background")
+	}
+	if got.CodeBackground().IsEmpty() {
+		t.Error("CodeBackground() should be derived")
+	}
+}
+
+func TestParseDesignTokensMappingOverridesDefaults(t *testing.T) {
+	t.Parallel()
+
+	// "bg.primary" sorts before "color.background.primary", yet it wins.
+	doc := `{
+		"bg": {"primary": {"$value": "#fdf6e3", "$type": "color"}},
+		"color": {
+			"background": {"primary": {"$value": "#002b36", "$type": "color"}},
+			"text": {"primary": {"$value": "#657b83", "$type": "color"}}
+		},
+		"meta": {"isDark": {"$value": false}}
+	}`
+	got, err := ParseDesignTokensWithOptions(strings.NewReader(doc), TokenImportOptions{
+		Mapping: map[string]string{"bg.primary": "background"},
+	})
+	if err != nil {
+		t.Fatalf("ParseDesignTokensWithOptions() error: %v", err)
+	}
+	if got.Background().Hex() != "#fdf6e3" {
+		t.Errorf("Background() = %s, want the mapped #fdf6e3", got.Background().Hex())
+	}
+	if got.TextPrimary().Hex() != "#657b83" {
+		t.Errorf("TextPrimary() = %s, want the default path's #657b83", got.TextPrimary().Hex())
+	}
+
+	// meta.isDark false is kept even over a background that reads as dark.
+	got, err = ParseDesignTokens(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("ParseDesignTokens() error: %v", err)
+	}
+	if got.Background().Hex() != "#002b36" || got.IsDark() {
+		t.Errorf("Background() = %s, IsDark() = %v, want #002b36 and light", got.Background().Hex(), got.IsDark())
+	}
+}
+
+func TestParseDesignTokensColorObjects(t *testing.T) {
+	t.Parallel()
+
+	doc := `{
+		"color": {
+			"$type": "color",
+			"background": {"primary": {"$value": {"colorSpace": "srgb", "components": [0, 0, 0]}}},
+			"text": {"primary": {"$value": {"colorSpace": "srgb", "components": [1, 1, 1], "hex": "#fafafa"}}},
+			"accent": {"primary": {"$value": {"colorSpace": "srgb", "components": [1, 0, 0], "alpha": 0.5}}}
+		}
+	}`
+
+	got, err := ParseDesignTokens(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("ParseDesignTokens() error: %v", err)
+	}
+	if got.Background().Hex() != "#000000" {
+		t.Errorf("Background() = %s, want #000000", got.Background().Hex())
+	}
+	if got.TextPrimary().Hex() != "#fafafa" {
+		t.Errorf("TextPrimary() = %s, want hex field #fafafa", got.TextPrimary().Hex())
+	}
+	if r, _, _ := got.Accent().RGB(); r != 255 {
+		t.Errorf("Accent() red = %d, want 255", r)
+	}
+	if _, _, _, a := got.Accent().RGBAComponents(); a < 127 || a > 128 {
+		t.Errorf("Accent() alpha = %d, want 128", a)
+	}
+}
+
+func TestParseDesignTokensErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		doc     string
+		opts    TokenImportOptions
+		wantErr string
+	}{
+		{
+			name:    "invalid json",
+			doc:     `{`,
+			wantErr: "decoding design tokens",
+		},
+		{
+			name:    "no matching tokens",
+			doc:     `{"other": {"$value": "#fff", "$type": "color"}}`,
+			wantErr: "no color tokens",
+		},
+		{
+			name: "circular alias",
+			doc: `{"color": {"$type": "color", "brand": {"$value": "{color.accent.primary}"},
+				"accent": {"primary": {"$value": "{color.brand}"}}}}`,
+			wantErr: "circular alias",
+		},
+		{
+			name:    "unresolved alias",
+			doc:     `{"color": {"brand": {"$value": "{missing.token}", "$type": "color"}}}`,
+			wantErr: "unresolved alias {missing.token}",
+		},
+		{
+			name:    "wrong type",
+			doc:     `{"color": {"$type": "dimension", "brand": {"$value": "4px"}}}`,
+			wantErr: `type "dimension"`,
+		},
+		{
+			name:    "invalid color",
+			doc:     `{"color": {"brand": {"$value": "not-a-color", "$type": "color"}}}`,
+			wantErr: `token "color.brand"`,
+		},
+		{
+			name:    "unknown role",
+			doc:     `{"x": {"$value": "#fff", "$type": "color"}}`,
+			opts:    TokenImportOptions{Mapping: map[string]string{"x": "nope"}},
+			wantErr: `unknown color role "nope"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseDesignTokensWithOptions(strings.NewReader(tt.doc), tt.opts)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
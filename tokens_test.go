@@ -2,6 +2,10 @@ package gothememe
 
 import (
 	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -188,3 +192,210 @@ func TestDesignTokensWithDescriptions(t *testing.T) {
 		t.Error("GenerateDesignTokens() with descriptions should include $description")
 	}
 }
+
+func TestDesignTokensAliases(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	tokens, err := GenerateDesignTokens(theme, TokenOptions{Aliases: true})
+	if err != nil {
+		t.Fatalf("GenerateDesignTokens() error: %v", err)
+	}
+
+	var doc struct {
+		Palette map[string]any `json:"palette"`
+		Color   struct {
+			Brand  map[string]any            `json:"brand"`
+			Accent map[string]map[string]any `json:"accent"`
+			Code   map[string]map[string]any `json:"code"`
+		} `json:"color"`
+	}
+	if err := json.Unmarshal([]byte(tokens), &doc); err != nil {
+		t.Fatalf("GenerateDesignTokens() produced invalid JSON: %v", err)
+	}
+
+	for key, want := range map[string]any{
+		"brand":          doc.Color.Brand["$value"],
+		"accent":         doc.Color.Accent["primary"]["$value"],
+		"code_keyword":   doc.Color.Code["keyword"]["$value"],
+		"code_comment":   doc.Color.Code["comment"]["$value"],
+		"palette.blue":   doc.Palette["blue"],
+		"palette.$type":  doc.Palette["$type"],
+		"palette.accent": doc.Palette["accent"],
+	} {
+		expected := map[string]any{
+			"brand":          "{color.accent.primary}",
+			"accent":         "{palette.blue}",
+			"code_keyword":   "{color.ansi.purple}",
+			"code_comment":   "{color.text.muted}",
+			"palette.blue":   map[string]any{"$value": "#89b4fa"},
+			"palette.$type":  "color",
+			"palette.accent": nil,
+		}[key]
+		if !reflect.DeepEqual(want, expected) {
+			t.Errorf("%s = %v, want %v", key, want, expected)
+		}
+	}
+
+	// Each distinct color is a single primitive.
+	seen := map[string]string{}
+	for name, token := range doc.Palette {
+		if name == "$type" {
+			continue
+		}
+		value := fmt.Sprint(token)
+		if other, ok := seen[value]; ok {
+			t.Errorf("palette.%s repeats palette.%s", name, other)
+		}
+		seen[value] = name
+	}
+
+	got, err := ParseDesignTokens(strings.NewReader(tokens))
+	if err != nil {
+		t.Fatalf("ParseDesignTokens() error: %v", err)
+	}
+	assertSameColors(t, theme, got)
+}
+
+func TestDesignTokensAliasesAllThemes(t *testing.T) {
+	t.Parallel()
+
+	themes := []Theme{
+		fullTestTheme(t),
+		NewThemeBuilder("paper", "Paper").WithBackground(Hex("#fdf6e3")).WithTextPrimary(Hex("#657b83")).Build(),
+	}
+	tokens, err := GenerateAllDesignTokens(themes, TokenOptions{Aliases: true})
+	if err != nil {
+		t.Fatalf("GenerateAllDesignTokens() error: %v", err)
+	}
+	if !strings.Contains(tokens, `"{paper.palette.background}"`) {
+		t.Error("aliases are not relative to the theme's group")
+	}
+	for _, want := range themes {
+		got, err := ParseDesignTokensWithOptions(strings.NewReader(tokens), TokenImportOptions{Root: want.ID()})
+		if err != nil {
+			t.Fatalf("ParseDesignTokensWithOptions(%s) error: %v", want.ID(), err)
+		}
+		assertSameColors(t, want, got)
+	}
+}
+
+func TestDesignTokensExtensions(t *testing.T) {
+	t.Parallel()
+
+	theme := NewThemeBuilder("acme", "Acme").
+		WithBackground(Hex("#ffffff")).
+		WithTextPrimary(Hex("#000000")).
+		WithAccent(Hex("#0066cc")).
+		WithAuthor("Acme Corp").
+		Build()
+	tokens, err := GenerateDesignTokens(theme, TokenOptions{Extensions: true})
+	if err != nil {
+		t.Fatalf("GenerateDesignTokens() error: %v", err)
+	}
+
+	var doc struct {
+		Meta       any                       `json:"meta"`
+		Extensions map[string]map[string]any `json:"$extensions"`
+		Color      struct {
+			Text map[string]struct {
+				Extensions map[string]struct {
+					Role     string          `json:"role"`
+					Contrast []tokenContrast `json:"contrast"`
+				} `json:"$extensions"`
+			} `json:"text"`
+		} `json:"color"`
+	}
+	if err := json.Unmarshal([]byte(tokens), &doc); err != nil {
+		t.Fatalf("GenerateDesignTokens() produced invalid JSON: %v", err)
+	}
+	if doc.Meta != nil {
+		t.Error("the meta group should give way to $extensions")
+	}
+	wantDoc := map[string]any{
+		"id":        "acme",
+		"name":      "Acme",
+		"mode":      "light",
+		"author":    "Acme Corp",
+		"generator": "gothememe",
+	}
+	if got := doc.Extensions[TokenExtension]; !reflect.DeepEqual(got, wantDoc) {
+		t.Errorf("document extension = %v, want %v", got, wantDoc)
+	}
+
+	ext := doc.Color.Text["primary"].Extensions[TokenExtension]
+	if ext.Role != "text_primary" {
+		t.Errorf("text.primary role = %q", ext.Role)
+	}
+	if len(ext.Contrast) == 0 || ext.Contrast[0] != (tokenContrast{"background", 21, "AAA"}) {
+		t.Errorf("text.primary contrast = %+v", ext.Contrast)
+	}
+
+	// The importer reads the metadata back from the extension.
+	got, err := ParseDesignTokens(strings.NewReader(tokens))
+	if err != nil {
+		t.Fatalf("ParseDesignTokens() error: %v", err)
+	}
+	if got.ID() != "acme" || got.DisplayName() != "Acme" || got.Author() != "Acme Corp" || got.IsDark() {
+		t.Errorf("metadata = %q, %q, %q, dark %v", got.ID(), got.DisplayName(), got.Author(), got.IsDark())
+	}
+	assertSameColors(t, theme, got)
+}
+
+func TestWriteDesignTokenSet(t *testing.T) {
+	t.Parallel()
+
+	themes := []Theme{
+		fullTestTheme(t),
+		NewThemeBuilder("paper", "Paper").WithBackground(Hex("#fdf6e3")).WithTextPrimary(Hex("#657b83")).Build(),
+	}
+	dir := filepath.Join(t.TempDir(), "tokens")
+	opts := TokenOptions{Aliases: true, Extensions: true}
+	if err := WriteDesignTokenSet(dir, opts, themes...); err != nil {
+		t.Fatalf("WriteDesignTokenSet() error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "themes.resolver.json"))
+	if err != nil {
+		t.Fatalf("reading resolver: %v", err)
+	}
+	var resolver tokenResolver
+	if err := json.Unmarshal(data, &resolver); err != nil {
+		t.Fatalf("resolver is not valid JSON: %v", err)
+	}
+	wantModifier := tokenResolverModifier{
+		Contexts: map[string][]tokenRef{
+			"catppuccin_mocha": {{"catppuccin_mocha.tokens.json"}},
+			"paper":            {{"paper.tokens.json"}},
+		},
+		Default: "catppuccin_mocha",
+	}
+	if !reflect.DeepEqual(resolver.Modifiers["theme"], wantModifier) {
+		t.Errorf("theme modifier = %+v, want %+v", resolver.Modifiers["theme"], wantModifier)
+	}
+	wantThemes := []tokenSetTheme{
+		{"catppuccin_mocha", "Catppuccin Mocha", "dark", "catppuccin_mocha.tokens.json"},
+		{"paper", "Paper", "light", "paper.tokens.json"},
+	}
+	if got := resolver.Extensions[TokenExtension].Themes; !reflect.DeepEqual(got, wantThemes) {
+		t.Errorf("manifest = %+v, want %+v", got, wantThemes)
+	}
+
+	for _, want := range themes {
+		data, err := os.ReadFile(filepath.Join(dir, want.ID()+".tokens.json"))
+		if err != nil {
+			t.Fatalf("reading %s tokens: %v", want.ID(), err)
+		}
+		expected, err := GenerateDesignTokens(want, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != expected+"\n" {
+			t.Errorf("%s.tokens.json differs from GenerateDesignTokens()", want.ID())
+		}
+	}
+
+	if err := WriteDesignTokenSet(t.TempDir(), opts); err == nil {
+		t.Error("WriteDesignTokenSet() without themes should fail")
+	}
+}
@@ -0,0 +1,142 @@
+package gothememe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TypeScriptOptions configures TypeScript output.
+type TypeScriptOptions struct {
+	// Prefix of the CSS variables named by the CssVar type (default:
+	// "theme"). It must match the Prefix the theme CSS was generated with.
+	Prefix string
+
+	// Declarations writes only the type declarations of the module, for a
+	// .d.ts file that types the same module for JavaScript users.
+	Declarations bool
+}
+
+// DefaultTypeScriptOptions returns sensible default TypeScript options.
+func DefaultTypeScriptOptions() TypeScriptOptions {
+	return TypeScriptOptions{Prefix: "theme"}
+}
+
+// GenerateTypeScript generates a TypeScript module with a typed const per
+// theme, such as draculaTheme, holding its ID, display name, mode and the
+// colors of every role, plus a themes object keyed by theme ID. The ThemeId
+// type is the union of the theme IDs and CssVar the union of the theme's CSS
+// variable names, such as "--theme-accent", which the cssVar helper turns
+// into a var() reference, so renaming a variable breaks the build instead of
+// the page. Roles a theme leaves empty are "transparent", as in its CSS.
+func GenerateTypeScript(themes []Theme, opts TypeScriptOptions) (string, error) {
+	if opts.Prefix == "" {
+		opts.Prefix = DefaultTypeScriptOptions().Prefix
+	}
+	themes, err := uniqueThemes(themes)
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, len(themes))
+	seen := map[string]string{}
+	for i, t := range themes {
+		names[i] = lowerFirst(pascalCase(t.ID())) + "Theme"
+		if other, ok := seen[names[i]]; ok {
+			return "", fmt.Errorf("themes %q and %q share the TypeScript name %s", other, t.ID(), names[i])
+		}
+		seen[names[i]] = t.ID()
+	}
+
+	vars := generateVariables(themes[0], CSSOptions{})
+	var sb strings.Builder
+	sb.WriteString("// Generated by gothememe. Do not edit.\n\n")
+
+	sb.WriteString("/** The ID of a generated theme. */\nexport type ThemeId =\n")
+	for i, t := range themes {
+		fmt.Fprintf(&sb, "  | %q%s\n", t.ID(), tsTerminator(i, len(themes)))
+	}
+
+	sb.WriteString("\n/** The name of a theme CSS variable. */\nexport type CssVar =\n")
+	for i, v := range vars {
+		fmt.Fprintf(&sb, "  | \"--%s-%s\"%s\n", opts.Prefix, v.name, tsTerminator(i, len(vars)))
+	}
+
+	sb.WriteString("\n/** The colors of a theme, keyed by role. */\nexport interface ThemeColors {\n")
+	for _, v := range vars {
+		fmt.Fprintf(&sb, "  /** var(--%s-%s) */\n  readonly %s: string;\n", opts.Prefix, v.name, tsColorKey(v.name))
+	}
+	sb.WriteString("}\n")
+
+	sb.WriteString("\n/** A generated theme. */\nexport interface Theme {\n")
+	sb.WriteString("  readonly id: ThemeId;\n  readonly displayName: string;\n  readonly isDark: boolean;\n  readonly colors: ThemeColors;\n}\n")
+
+	for i, t := range themes {
+		fmt.Fprintf(&sb, "\n/** %s */\n", t.DisplayName())
+		if opts.Declarations {
+			fmt.Fprintf(&sb, "export declare const %s: Theme;\n", names[i])
+			continue
+		}
+		fmt.Fprintf(&sb, "export const %s: Theme = {\n", names[i])
+		fmt.Fprintf(&sb, "  id: %q,\n  displayName: %q,\n  isDark: %t,\n  colors: {\n", t.ID(), t.DisplayName(), t.IsDark())
+		for _, v := range generateVariables(t, CSSOptions{}) {
+			fmt.Fprintf(&sb, "    %s: %q,\n", tsColorKey(v.name), v.value)
+		}
+		sb.WriteString("  },\n};\n")
+	}
+
+	sb.WriteString("\n/** Every generated theme, keyed by ID. */\n")
+	if opts.Declarations {
+		sb.WriteString("export declare const themes: Readonly<Record<ThemeId, Theme>>;\n")
+	} else {
+		sb.WriteString("export const themes: Readonly<Record<ThemeId, Theme>> = {\n")
+		for i, t := range themes {
+			fmt.Fprintf(&sb, "  %q: %s,\n", t.ID(), names[i])
+		}
+		sb.WriteString("};\n")
+	}
+
+	sb.WriteString("\n/** Returns a var() reference to a theme CSS variable, such as var(--" + opts.Prefix + "-accent). */\n")
+	if opts.Declarations {
+		sb.WriteString("export declare function cssVar(name: CssVar): string;\n")
+	} else {
+		sb.WriteString("export function cssVar(name: CssVar): string {\n  return `var(${name})`;\n}\n")
+	}
+	return sb.String(), nil
+}
+
+// tsTerminator ends the last member of a union type.
+func tsTerminator(i, n int) string {
+	if i == n-1 {
+		return ";"
+	}
+	return ""
+}
+
+// tsColorKey returns the ThemeColors key of a CSS variable name, such as
+// textPrimary for text-primary.
+func tsColorKey(name string) string {
+	return lowerFirst(pascalCase(name))
+}
+
+// exportTypeScript writes the TypeScript module of the themes, referencing
+// the variables by the CSS Prefix.
+func exportTypeScript(w io.Writer, themes []Theme, opts ExportOptions) error {
+	return writeTypeScript(w, themes, TypeScriptOptions{Prefix: opts.CSS.Prefix})
+}
+
+// exportTypeScriptDeclarations writes the type declarations of the
+// TypeScript module of the themes.
+func exportTypeScriptDeclarations(w io.Writer, themes []Theme, opts ExportOptions) error {
+	return writeTypeScript(w, themes, TypeScriptOptions{Prefix: opts.CSS.Prefix, Declarations: true})
+}
+
+// writeTypeScript writes the TypeScript output of the themes to w.
+func writeTypeScript(w io.Writer, themes []Theme, opts TypeScriptOptions) error {
+	source, err := GenerateTypeScript(themes, opts)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, source)
+	return err
+}
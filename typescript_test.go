@@ -0,0 +1,154 @@
+package gothememe
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGenerateTypeScript(t *testing.T) {
+	t.Parallel()
+
+	dark, light := figmaTestThemes(t)
+	ts, err := GenerateTypeScript([]Theme{dark, light}, TypeScriptOptions{})
+	if err != nil {
+		t.Fatalf("GenerateTypeScript() error: %v", err)
+	}
+
+	for _, want := range []string{
+		"export type ThemeId =\n  | \"catppuccin_mocha\"\n  | \"paper\";\n",
+		"  | \"--theme-background\"\n",
+		"  | \"--theme-code-type\";\n",
+		"  /** var(--theme-text-primary) */\n  readonly textPrimary: string;\n",
+		"  readonly id: ThemeId;\n",
+		"/** Catppuccin Mocha */\nexport const catppuccinMochaTheme: Theme = {\n  id: \"catppuccin_mocha\",\n  displayName: \"Catppuccin Mocha\",\n  isDark: true,\n",
+		"    background: \"#1e1e2e\",\n",
+		"    successBackground: \"#a6e3a119\",\n",
+		"export const paperTheme: Theme = {",
+		"  \"paper\": paperTheme,\n",
+		"export function cssVar(name: CssVar): string {\n  return `var(${name})`;\n}\n",
+	} {
+		if !strings.Contains(ts, want) {
+			t.Errorf("GenerateTypeScript() missing %q", want)
+		}
+	}
+
+	// Every CSS variable is in the CssVar union and every role in each theme.
+	css := GenerateCSS(dark, DefaultCSSOptions())
+	for _, v := range generateVariables(dark, CSSOptions{}) {
+		name := "--theme-" + v.name
+		if !strings.Contains(css, name+":") {
+			t.Fatalf("CSS has no %s", name)
+		}
+		if !strings.Contains(ts, "| \""+name+"\"") {
+			t.Errorf("CssVar misses %s", name)
+		}
+		if got := strings.Count(ts, "    "+tsColorKey(v.name)+": "); got != 2 {
+			t.Errorf("%s is set by %d themes, want 2", tsColorKey(v.name), got)
+		}
+	}
+
+	// Roles a theme leaves empty are transparent, as in its CSS.
+	if !light.Red().IsEmpty() {
+		t.Fatal("paper should have no ANSI colors")
+	}
+	if !strings.Contains(ts, "    red: \"transparent\",\n") {
+		t.Error("empty roles should be transparent")
+	}
+}
+
+func TestGenerateTypeScriptDeclarations(t *testing.T) {
+	t.Parallel()
+
+	dark, light := figmaTestThemes(t)
+	opts := TypeScriptOptions{Prefix: "ui", Declarations: true}
+	dts, err := GenerateTypeScript([]Theme{dark, light}, opts)
+	if err != nil {
+		t.Fatalf("GenerateTypeScript() error: %v", err)
+	}
+
+	for _, want := range []string{
+		"  | \"--ui-accent\"\n",
+		"export declare const catppuccinMochaTheme: Theme;\n",
+		"export declare const paperTheme: Theme;\n",
+		"export declare const themes: Readonly<Record<ThemeId, Theme>>;\n",
+		"export declare function cssVar(name: CssVar): string;\n",
+	} {
+		if !strings.Contains(dts, want) {
+			t.Errorf("declarations missing %q", want)
+		}
+	}
+	for _, unwanted := range []string{"#1e1e2e", "return", " = {"} {
+		if strings.Contains(dts, unwanted) {
+			t.Errorf("declarations contain %q", unwanted)
+		}
+	}
+
+	// The declarations are the module without its values.
+	opts.Declarations = false
+	ts, err := GenerateTypeScript([]Theme{dark, light}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	typesOf := func(s string) string { return s[:strings.Index(s, "\n/** Catppuccin Mocha */")] }
+	if typesOf(dts) != typesOf(ts) {
+		t.Error("declarations and module declare different types")
+	}
+}
+
+func TestGenerateTypeScriptNames(t *testing.T) {
+	t.Parallel()
+
+	build := func(id string) Theme {
+		return NewThemeBuilder(id, id).WithBackground(Hex("#000000")).WithTextPrimary(Hex("#ffffff")).Build()
+	}
+	ts, err := GenerateTypeScript([]Theme{build("3024_night"), build("default")}, TypeScriptOptions{})
+	if err != nil {
+		t.Fatalf("GenerateTypeScript() error: %v", err)
+	}
+	for _, want := range []string{"export const theme3024NightTheme: Theme", "export const defaultTheme: Theme"} {
+		if !strings.Contains(ts, want) {
+			t.Errorf("GenerateTypeScript() missing %q", want)
+		}
+	}
+
+	if _, err := GenerateTypeScript([]Theme{build("one_dark"), build("one-dark")}, TypeScriptOptions{}); err == nil {
+		t.Error("GenerateTypeScript() should reject IDs that share a name")
+	}
+	if _, err := GenerateTypeScript(nil, TypeScriptOptions{}); !errors.Is(err, ErrNoThemes) {
+		t.Errorf("GenerateTypeScript(nil) error = %v, want ErrNoThemes", err)
+	}
+}
+
+func TestExportTypeScript(t *testing.T) {
+	t.Parallel()
+
+	dark, light := figmaTestThemes(t)
+	for _, tt := range []struct {
+		path   string
+		format OutputFormat
+		decl   bool
+	}{
+		{"src/themes.ts", FormatTypeScript, false},
+		{"types/themes.d.ts", FormatTypeScriptDeclarations, true},
+	} {
+		format, err := OutputFormatFromPath(tt.path)
+		if err != nil || format != tt.format {
+			t.Errorf("OutputFormatFromPath(%q) = %v, %v, want %v", tt.path, format, err, tt.format)
+		}
+
+		opts := DefaultExportOptions()
+		opts.CSS.Prefix = "app"
+		var sb strings.Builder
+		if err := ExportWithOptions(&sb, tt.format, opts, dark, light); err != nil {
+			t.Fatalf("Export(%v) error: %v", tt.format, err)
+		}
+		want, err := GenerateTypeScript([]Theme{dark, light}, TypeScriptOptions{Prefix: "app", Declarations: tt.decl})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sb.String() != want {
+			t.Errorf("Export(%v) output differs from GenerateTypeScript()", tt.format)
+		}
+	}
+}
@@ -0,0 +1,552 @@
+package gothememe
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Highlight attributes a role can take its color from.
+const (
+	vimFg = "fg"
+	vimBg = "bg"
+)
+
+// vimGroupRoles maps color roles to the highlight groups that supply them, in
+// order of preference. Classic Vim groups come first and Tree-sitter captures
+// are the fallback for Lua schemes that only style those.
+var vimGroupRoles = []struct {
+	role   string
+	attr   string
+	groups []string
+}{
+	{"background", vimBg, []string{"Normal"}},
+	{"background_secondary", vimBg, []string{"NormalFloat", "CursorLine", "ColorColumn"}},
+	{"surface", vimBg, []string{"Visual", "Pmenu"}},
+	{"surface_secondary", vimBg, []string{"StatusLine", "PmenuSel", "TabLine"}},
+	{"text_primary", vimFg, []string{"Normal"}},
+	{"text_secondary", vimFg, []string{"StatusLine", "Pmenu"}},
+	{"text_muted", vimFg, []string{"LineNr", "NonText", "Comment"}},
+	{"text_inverted", vimFg, []string{"Search", "IncSearch"}},
+	{"accent", vimFg, []string{"Directory", "Title", "Function"}},
+	{"accent_secondary", vimFg, []string{"Special", "Statement"}},
+	{"border", vimFg, []string{"WinSeparator", "VertSplit", "FloatBorder"}},
+	{"border_subtle", vimFg, []string{"Whitespace", "NonText"}},
+	{"border_strong", vimFg, []string{"FloatBorder"}},
+	{"success_text", vimFg, []string{"DiagnosticOk", "Added", "diffAdded", "GitSignsAdd"}},
+	{"warning_text", vimFg, []string{"DiagnosticWarn", "WarningMsg"}},
+	{"error_text", vimFg, []string{"DiagnosticError", "ErrorMsg", "Error"}},
+	{"info_text", vimFg, []string{"DiagnosticInfo", "DiagnosticHint"}},
+	{"code_background", vimBg, []string{"Normal"}},
+	{"code_text", vimFg, []string{"Normal"}},
+	{"code_comment", vimFg, []string{"Comment", "@comment"}},
+	{"code_keyword", vimFg, []string{"Keyword", "Statement", "@keyword"}},
+	{"code_string", vimFg, []string{"String", "@string"}},
+	{"code_number", vimFg, []string{"Number", "@number", "Constant"}},
+	{"code_function", vimFg, []string{"Function", "@function"}},
+	{"code_operator", vimFg, []string{"Operator", "@operator"}},
+	{"code_punctuation", vimFg, []string{"Delimiter", "@punctuation.delimiter", "@punctuation"}},
+	{"code_variable", vimFg, []string{"Identifier", "@variable"}},
+	{"code_constant", vimFg, []string{"Boolean", "@constant.builtin", "@constant", "Constant"}},
+	{"code_type", vimFg, []string{"Type", "@type"}},
+}
+
+// errVimNoColors is returned when a colorscheme sets no usable GUI colors.
+var errVimNoColors = errors.New("colorscheme defines no GUI colors")
+
+var (
+	// vimLuaPattern recognizes Lua colorschemes.
+	vimLuaPattern = regexp.MustCompile(`nvim_set_hl|\bvim\.(api|g|o|opt|cmd)\b`)
+
+	// vimLetPattern matches a global variable assignment in Vimscript.
+	vimLetPattern = regexp.MustCompile(`^g:(\w+)\s*=\s*(.+)$`)
+
+	// vimExecutePattern matches :execute with a single string literal.
+	vimExecutePattern = regexp.MustCompile(`^exe(?:c|cu|cut|cute)?\s+("[^"]*"|'[^']*')\s*$`)
+
+	// luaSetHlPattern matches the start of an nvim_set_hl call with a literal
+	// group name, up to the opening brace of its attribute table.
+	luaSetHlPattern = regexp.MustCompile(`set_hl\s*\(\s*[^,()]+,\s*(?:"([^"]*)"|'([^']*)')\s*,\s*\{`)
+
+	// luaGroupEntryPattern matches a `Group = {` or `["@capture"] = {` entry
+	// of a static highlight table.
+	luaGroupEntryPattern = regexp.MustCompile(`(?:\[\s*(?:"([^"]*)"|'([^']*)')\s*\]|\b([A-Za-z_]\w*))\s*=\s*\{`)
+
+	// luaFieldPattern matches a table field with a literal value.
+	luaFieldPattern = regexp.MustCompile(`\b(\w+)\s*=\s*("[^"]*"|'[^']*'|0[xX][0-9a-fA-F]+|\d+)`)
+
+	// luaGlobalPattern matches a vim.g assignment of a string literal.
+	luaGlobalPattern = regexp.MustCompile(`vim\.g(?:\.(\w+)|\[\s*["'](\w+)["']\s*\])\s*=\s*("[^"]*"|'[^']*')`)
+
+	// luaBackgroundPattern matches the 'background' option being set.
+	luaBackgroundPattern = regexp.MustCompile(`vim\.(?:o|go|opt)\.background\s*=\s*["'](\w+)["']`)
+
+	// luaCommandPattern matches vim.cmd with a string literal argument.
+	luaCommandPattern = regexp.MustCompile(`vim\.cmd\s*\(?\s*(?:\[\[((?s:.*?))\]\]|"([^"\n]*)"|'([^'\n]*)')`)
+)
+
+// vimGroup is a highlight group's GUI colors, or the group it links to.
+type vimGroup struct {
+	fg, bg string
+	link   string
+}
+
+// vimScheme collects the highlight groups and options a colorscheme sets.
+// Group names are stored lowercased, as Vim matches them case-insensitively.
+type vimScheme struct {
+	name     string
+	dark     bool
+	groups   map[string]vimGroup
+	terminal [16]string
+}
+
+// ParseVimColorscheme reads a Vim or Neovim colorscheme and maps its
+// highlight groups onto a Theme. Both Vimscript schemes (":highlight",
+// ":highlight link" and "g:terminal_color_N") and Lua schemes that call
+// nvim_set_hl with static tables are supported; the language is detected
+// from the content. Colors computed at runtime and cterm-only colors are
+// ignored, and roles the scheme doesn't set are derived by the builder.
+func ParseVimColorscheme(r io.Reader) (Theme, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading Vim colorscheme: %w", err)
+	}
+	return parseVimColorscheme(data, "imported")
+}
+
+// LoadVimColorschemeFile reads the Vim or Neovim colorscheme at path. The
+// theme is named after g:colors_name when the scheme sets it, otherwise
+// after the file.
+func LoadVimColorschemeFile(path string) (Theme, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: loading a caller-specified theme file is the purpose of this function
+	if err != nil {
+		return nil, fmt.Errorf("reading Vim colorscheme: %w", err)
+	}
+
+	theme, err := parseVimColorscheme(data, strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return theme, nil
+}
+
+// parseVimColorscheme decodes data as Lua or Vimscript and builds the theme,
+// named defaultName unless the scheme sets g:colors_name. The ID comes from
+// defaultName when g:colors_name has no ASCII letters or digits.
+func parseVimColorscheme(data []byte, defaultName string) (Theme, error) {
+	s := &vimScheme{groups: make(map[string]vimGroup)}
+	src := string(data)
+	if vimLuaPattern.MatchString(src) {
+		s.parseLua(src)
+	} else {
+		s.parseVimscript(src)
+	}
+	if s.name == "" {
+		s.name = defaultName
+	}
+	return s.build(importedThemeID(s.name, defaultName))
+}
+
+// parseVimscript applies the :highlight, :let and :set commands of src.
+// Continuation lines starting with a backslash are joined first, and "|"
+// separates commands on one line.
+func (s *vimScheme) parseVimscript(src string) {
+	var lines []string
+	for line := range strings.SplitSeq(src, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, `\`) && len(lines) > 0 {
+			lines[len(lines)-1] += " " + trimmed[1:]
+			continue
+		}
+		lines = append(lines, trimmed)
+	}
+	for _, line := range lines {
+		for cmd := range strings.SplitSeq(line, "|") {
+			s.vimCommand(strings.TrimSpace(cmd))
+		}
+	}
+}
+
+// vimCommand applies a single Vimscript command. Commands that don't set
+// colors are ignored.
+func (s *vimScheme) vimCommand(cmd string) {
+	if m := vimExecutePattern.FindStringSubmatch(cmd); m != nil {
+		s.vimCommand(m[1][1 : len(m[1])-1])
+		return
+	}
+
+	fields := strings.Fields(cmd)
+	if len(fields) == 0 || strings.HasPrefix(fields[0], `"`) {
+		return
+	}
+	name, args := strings.TrimSuffix(fields[0], "!"), fields[1:]
+	switch {
+	case isVimAbbrev(name, "highlight", 2):
+		s.vimHighlight(args)
+	case name == "let":
+		s.vimLet(strings.TrimSpace(strings.TrimPrefix(cmd, fields[0])))
+	case isVimAbbrev(name, "set", 2):
+		for _, arg := range args {
+			if v, ok := strings.CutPrefix(arg, "background="); ok {
+				s.dark = v == "dark"
+			} else if v, ok := strings.CutPrefix(arg, "bg="); ok {
+				s.dark = v == "dark"
+			}
+		}
+	}
+}
+
+// isVimAbbrev reports whether name is command or an abbreviation of it at
+// least minLen characters long, the way Vim accepts ":hi" for ":highlight".
+func isVimAbbrev(name, command string, minLen int) bool {
+	return len(name) >= minLen && len(name) <= len(command) && command[:len(name)] == name
+}
+
+// vimHighlight applies the arguments of a :highlight command.
+func (s *vimScheme) vimHighlight(args []string) {
+	for i, arg := range args {
+		if strings.HasPrefix(arg, `"`) {
+			args = args[:i]
+			break
+		}
+	}
+	if len(args) > 0 && (args[0] == "default" || args[0] == "def") {
+		args = args[1:]
+	}
+	if len(args) < 2 {
+		return
+	}
+
+	switch args[0] {
+	case "clear":
+		delete(s.groups, strings.ToLower(args[1]))
+	case "link":
+		if len(args) < 3 {
+			return
+		}
+		target := args[2]
+		if strings.EqualFold(target, "NONE") {
+			target = ""
+		}
+		s.groups[strings.ToLower(args[1])] = vimGroup{link: target}
+	default:
+		key := strings.ToLower(args[0])
+		g := s.groups[key]
+		g.link = ""
+		for _, attr := range args[1:] {
+			k, v, ok := strings.Cut(attr, "=")
+			if !ok {
+				continue
+			}
+			v = strings.Trim(v, `'"`)
+			switch strings.ToLower(k) {
+			case "guifg":
+				g.fg = v
+			case "guibg":
+				g.bg = v
+			}
+		}
+		s.groups[key] = g
+	}
+}
+
+// vimLet applies a global variable assignment: the scheme name and the
+// terminal colors, either as g:terminal_color_N or as the
+// g:terminal_ansi_colors list.
+func (s *vimScheme) vimLet(assignment string) {
+	m := vimLetPattern.FindStringSubmatch(assignment)
+	if m == nil {
+		return
+	}
+	name, value := m[1], strings.TrimSpace(m[2])
+	switch {
+	case name == "colors_name":
+		s.name = vimStringLiteral(value)
+	case name == "terminal_ansi_colors":
+		list := strings.Split(strings.Trim(value, "[]"), ",")
+		for i := 0; i < len(list) && i < len(s.terminal); i++ {
+			s.terminal[i] = vimStringLiteral(strings.TrimSpace(list[i]))
+		}
+	default:
+		s.setTerminalColor(name, vimStringLiteral(value))
+	}
+}
+
+// setTerminalColor sets the ANSI color named by a terminal_color_N variable.
+func (s *vimScheme) setTerminalColor(name, value string) {
+	n, ok := strings.CutPrefix(name, "terminal_color_")
+	if !ok {
+		return
+	}
+	if i, err := strconv.Atoi(n); err == nil && i >= 0 && i < len(s.terminal) {
+		s.terminal[i] = value
+	}
+}
+
+// vimStringLiteral returns the contents of a quoted Vimscript or Lua string,
+// or "" when v is not a string literal.
+func vimStringLiteral(v string) string {
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+		return v[1 : len(v)-1]
+	}
+	return ""
+}
+
+// parseLua applies the highlight definitions of a Lua colorscheme in source
+// order: nvim_set_hl calls and static group tables, which replace a group's
+// attributes, and vim.cmd strings, which run as Vimscript.
+func (s *vimScheme) parseLua(src string) {
+	src = stripLuaComments(src)
+
+	type step struct {
+		pos   int
+		apply func()
+	}
+	var steps []step
+	for _, m := range luaSetHlPattern.FindAllStringSubmatchIndex(src, -1) {
+		name := submatch(src, m, 1, 2)
+		body := luaTableBody(src, m[1])
+		steps = append(steps, step{m[0], func() { s.setLuaGroup(name, body) }})
+	}
+	for _, m := range luaGroupEntryPattern.FindAllStringSubmatchIndex(src, -1) {
+		name := submatch(src, m, 1, 2, 3)
+		body := luaTableBody(src, m[1])
+		steps = append(steps, step{m[0], func() { s.setLuaGroup(name, body) }})
+	}
+	for _, m := range luaCommandPattern.FindAllStringSubmatchIndex(src, -1) {
+		script := submatch(src, m, 1, 2, 3)
+		steps = append(steps, step{m[0], func() { s.parseVimscript(script) }})
+	}
+	for _, m := range luaGlobalPattern.FindAllStringSubmatch(src, -1) {
+		name, value := cmp.Or(m[1], m[2]), vimStringLiteral(m[3])
+		if name == "colors_name" {
+			s.name = value
+		} else {
+			s.setTerminalColor(name, value)
+		}
+	}
+	if m := luaBackgroundPattern.FindAllStringSubmatch(src, -1); m != nil {
+		s.dark = m[len(m)-1][1] == "dark"
+	}
+
+	slices.SortStableFunc(steps, func(a, b step) int { return cmp.Compare(a.pos, b.pos) })
+	for _, st := range steps {
+		st.apply()
+	}
+}
+
+// setLuaGroup replaces a group with the attributes of an nvim_set_hl table.
+// Tables without colors or a link, such as palettes or option tables, are
+// ignored. Values that aren't literals are skipped.
+func (s *vimScheme) setLuaGroup(name, body string) {
+	var (
+		g   vimGroup
+		set bool
+	)
+	for _, m := range luaFieldPattern.FindAllStringSubmatch(body, -1) {
+		value := luaColorLiteral(m[2])
+		switch m[1] {
+		case "fg", "foreground":
+			g.fg, set = value, true
+		case "bg", "background":
+			g.bg, set = value, true
+		case "link":
+			g.link, set = value, true
+		}
+	}
+	if set && name != "" {
+		s.groups[strings.ToLower(name)] = g
+	}
+}
+
+// luaColorLiteral converts a Lua string or integer color literal to the
+// string form used by :highlight.
+func luaColorLiteral(v string) string {
+	if s := vimStringLiteral(v); s != "" {
+		return s
+	}
+	n, err := strconv.ParseInt(v, 0, 32)
+	if err != nil || n < 0 || n > 0xffffff {
+		return ""
+	}
+	return fmt.Sprintf("#%06x", n)
+}
+
+// luaTableBody returns the top-level fields of the table whose contents
+// start at src[start:], with nested tables and the closing brace removed.
+func luaTableBody(src string, start int) string {
+	var b strings.Builder
+	depth := 0
+	var quote byte
+	for i := start; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && i+1 < len(src) {
+				if depth == 0 {
+					b.WriteByte(c)
+					b.WriteByte(src[i+1])
+				}
+				i++
+				continue
+			}
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '{':
+			depth++
+			continue
+		case c == '}':
+			if depth == 0 {
+				return b.String()
+			}
+			depth--
+			continue
+		}
+		if depth == 0 {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// stripLuaComments removes "--" line comments and "--[[ ]]" block comments,
+// leaving string literals, including [[long strings]], intact.
+func stripLuaComments(src string) string {
+	var b strings.Builder
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case c == '"' || c == '\'':
+			end := i + 1
+			for end < len(src) && src[end] != c && src[end] != '\n' {
+				if src[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			end = min(end, len(src)-1)
+			b.WriteString(src[i : end+1])
+			i = end
+		case strings.HasPrefix(src[i:], "[["):
+			end := strings.Index(src[i:], "]]")
+			if end < 0 {
+				b.WriteString(src[i:])
+				return b.String()
+			}
+			b.WriteString(src[i : i+end+2])
+			i += end + 1
+		case strings.HasPrefix(src[i:], "--[["):
+			end := strings.Index(src[i:], "]]")
+			if end < 0 {
+				return b.String()
+			}
+			i += end + 1
+		case strings.HasPrefix(src[i:], "--"):
+			end := strings.IndexByte(src[i:], '\n')
+			if end < 0 {
+				return b.String()
+			}
+			i += end - 1
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// submatch returns the first non-empty of the given capture groups of a
+// FindAllStringSubmatchIndex match.
+func submatch(src string, m []int, groups ...int) string {
+	for _, g := range groups {
+		if m[2*g] >= 0 && m[2*g+1] > m[2*g] {
+			return src[m[2*g]:m[2*g+1]]
+		}
+	}
+	return ""
+}
+
+// resolve follows a group's links and returns the group that defines its
+// colors. Link cycles resolve to no group.
+func (s *vimScheme) resolve(name string) (vimGroup, bool) {
+	for range len(s.groups) + 1 {
+		g, ok := s.groups[strings.ToLower(name)]
+		if !ok || g.link == "" {
+			return g, ok
+		}
+		name = g.link
+	}
+	return vimGroup{}, false
+}
+
+// color returns the GUI color of a group's attribute, resolving links and the
+// special values "fg" and "bg", which refer to the Normal group.
+func (s *vimScheme) color(group, attr string) string {
+	g, ok := s.resolve(group)
+	if !ok {
+		return ""
+	}
+	v := g.fg
+	if attr == vimBg {
+		v = g.bg
+	}
+	switch strings.ToLower(v) {
+	case "none":
+		return ""
+	case "fg", "foreground":
+		normal, _ := s.resolve("Normal") //nolint:errcheck // a missing Normal group has no colors
+		return normal.fg
+	case "bg", "background":
+		normal, _ := s.resolve("Normal") //nolint:errcheck // a missing Normal group has no colors
+		return normal.bg
+	}
+	return v
+}
+
+// build maps the highlight groups onto a Theme. Colors that aren't valid hex
+// or CSS colors, such as Vim color names, are skipped.
+func (s *vimScheme) build(id string) (Theme, error) {
+	b := NewThemeBuilder(id, s.name)
+	applied := 0
+	set := func(role colorRole, value string) bool {
+		if value == "" {
+			return false
+		}
+		c, err := ParseColor(value)
+		if err != nil {
+			return false
+		}
+		role.set(b, c)
+		applied++
+		return true
+	}
+
+	for _, m := range vimGroupRoles {
+		role, _ := lookupColorRole(m.role) //nolint:errcheck // role keys are fixed in this file
+		for _, group := range m.groups {
+			if set(role, s.color(group, m.attr)) {
+				break
+			}
+		}
+	}
+	for i, role := range ansiRoles {
+		set(role, s.terminal[i])
+	}
+
+	if applied == 0 {
+		return nil, errVimNoColors
+	}
+	if s.dark {
+		b.WithIsDark(true)
+	}
+	return b.Build(), nil
+}
@@ -0,0 +1,221 @@
+package gothememe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// nvimHighlight is one highlight group of an exported Neovim colorscheme.
+type nvimHighlight struct {
+	group      string
+	fg, bg, sp Color
+	attrs      []string
+}
+
+// nvimHighlights returns the highlight groups written for t: the core editor
+// groups, the classic syntax groups, diagnostics and Tree-sitter captures.
+// The groups [ParseVimColorscheme] reads first for each role are included,
+// so a written scheme loads back with the same colors.
+func nvimHighlights(t Theme) []nvimHighlight {
+	success, warning, errs, info := t.Success(), t.Warning(), t.Error(), t.Info()
+	fg := func(group string, c Color, attrs ...string) nvimHighlight {
+		return nvimHighlight{group: group, fg: c, attrs: attrs}
+	}
+	fgbg := func(group string, f, b Color, attrs ...string) nvimHighlight {
+		return nvimHighlight{group: group, fg: f, bg: b, attrs: attrs}
+	}
+	undercurl := func(group string, c Color) nvimHighlight {
+		return nvimHighlight{group: group, sp: c, attrs: []string{"undercurl"}}
+	}
+
+	groups := []nvimHighlight{
+		// Editor
+		fgbg("Normal", t.TextPrimary(), t.Background()),
+		fgbg("NormalFloat", t.TextPrimary(), t.BackgroundSecondary()),
+		fgbg("FloatBorder", t.BorderStrong(), t.BackgroundSecondary()),
+		fgbg("CursorLine", Color{}, t.BackgroundSecondary()),
+		fgbg("ColorColumn", Color{}, t.BackgroundSecondary()),
+		fgbg("Cursor", t.Background(), t.TextPrimary()),
+		fg("LineNr", t.TextMuted()),
+		fg("CursorLineNr", t.TextSecondary()),
+		fgbg("SignColumn", Color{}, t.Background()),
+		fgbg("Visual", Color{}, t.Surface()),
+		fgbg("Pmenu", t.TextSecondary(), t.Surface()),
+		fgbg("PmenuSel", t.TextPrimary(), t.SurfaceSecondary()),
+		fgbg("PmenuSbar", Color{}, t.SurfaceSecondary()),
+		fgbg("PmenuThumb", Color{}, t.BorderStrong()),
+		fgbg("StatusLine", t.TextSecondary(), t.SurfaceSecondary()),
+		fgbg("StatusLineNC", t.TextMuted(), t.BackgroundSecondary()),
+		fgbg("TabLine", t.TextMuted(), t.SurfaceSecondary()),
+		fgbg("TabLineSel", t.TextPrimary(), t.Background()),
+		fgbg("TabLineFill", Color{}, t.BackgroundSecondary()),
+		fg("WinSeparator", t.Border()),
+		fg("VertSplit", t.Border()),
+		fg("Whitespace", t.BorderSubtle()),
+		fg("NonText", t.BorderSubtle()),
+		fgbg("Folded", t.TextMuted(), t.BackgroundSecondary()),
+		fg("Conceal", t.TextMuted()),
+		fgbg("Search", t.TextInverted(), t.Accent()),
+		fgbg("IncSearch", t.TextInverted(), t.AccentSecondary()),
+		fgbg("CurSearch", t.TextInverted(), t.AccentSecondary()),
+		fg("MatchParen", t.Accent(), "bold"),
+		fg("Directory", t.Accent()),
+		fg("Title", t.Accent(), "bold"),
+		fg("ErrorMsg", errs.Text),
+		fg("WarningMsg", warning.Text),
+		fg("MoreMsg", success.Text),
+		fg("Question", info.Text),
+		fg("ModeMsg", t.TextSecondary()),
+		undercurl("SpellBad", errs.Text),
+		undercurl("SpellCap", warning.Text),
+		undercurl("SpellRare", info.Text),
+		undercurl("SpellLocal", info.Text),
+
+		// Diffs
+		fgbg("DiffAdd", Color{}, success.Background),
+		fgbg("DiffChange", Color{}, info.Background),
+		fgbg("DiffDelete", errs.Text, errs.Background),
+		fgbg("DiffText", Color{}, warning.Background, "bold"),
+		fg("Added", success.Text),
+		fg("Changed", info.Text),
+		fg("Removed", errs.Text),
+		fg("diffAdded", success.Text),
+		fg("diffRemoved", errs.Text),
+
+		// Diagnostics
+		fg("DiagnosticError", errs.Text),
+		fg("DiagnosticWarn", warning.Text),
+		fg("DiagnosticInfo", info.Text),
+		fg("DiagnosticHint", info.Text),
+		fg("DiagnosticOk", success.Text),
+		fgbg("DiagnosticVirtualTextError", errs.Text, errs.Background),
+		fgbg("DiagnosticVirtualTextWarn", warning.Text, warning.Background),
+		fgbg("DiagnosticVirtualTextInfo", info.Text, info.Background),
+		fgbg("DiagnosticVirtualTextHint", info.Text, info.Background),
+		fgbg("DiagnosticVirtualTextOk", success.Text, success.Background),
+		undercurl("DiagnosticUnderlineError", errs.Text),
+		undercurl("DiagnosticUnderlineWarn", warning.Text),
+		undercurl("DiagnosticUnderlineInfo", info.Text),
+		undercurl("DiagnosticUnderlineHint", info.Text),
+		undercurl("DiagnosticUnderlineOk", success.Text),
+
+		// Syntax
+		fg("Comment", t.CodeComment(), "italic"),
+		fg("Constant", t.CodeConstant()),
+		fg("String", t.CodeString()),
+		fg("Character", t.CodeString()),
+		fg("Number", t.CodeNumber()),
+		fg("Float", t.CodeNumber()),
+		fg("Boolean", t.CodeConstant()),
+		fg("Identifier", t.CodeVariable()),
+		fg("Function", t.CodeFunction()),
+		fg("Statement", t.CodeKeyword()),
+		fg("Keyword", t.CodeKeyword()),
+		fg("Conditional", t.CodeKeyword()),
+		fg("Repeat", t.CodeKeyword()),
+		fg("Label", t.CodeKeyword()),
+		fg("Exception", t.CodeKeyword()),
+		fg("PreProc", t.CodeKeyword()),
+		fg("Operator", t.CodeOperator()),
+		fg("Type", t.CodeType()),
+		fg("StorageClass", t.CodeType()),
+		fg("Structure", t.CodeType()),
+		fg("Typedef", t.CodeType()),
+		fg("Special", t.AccentSecondary()),
+		fg("Delimiter", t.CodePunctuation()),
+		fg("Underlined", t.Accent(), "underline"),
+		fg("Error", errs.Text),
+		fg("Todo", warning.Text, "bold"),
+
+		// Tree-sitter
+		fg("@comment", t.CodeComment(), "italic"),
+		fg("@keyword", t.CodeKeyword()),
+		fg("@keyword.function", t.CodeKeyword()),
+		fg("@keyword.return", t.CodeKeyword()),
+		fg("@keyword.conditional", t.CodeKeyword()),
+		fg("@keyword.repeat", t.CodeKeyword()),
+		fg("@string", t.CodeString()),
+		fg("@character", t.CodeString()),
+		fg("@number", t.CodeNumber()),
+		fg("@number.float", t.CodeNumber()),
+		fg("@boolean", t.CodeConstant()),
+		fg("@constant", t.CodeConstant()),
+		fg("@constant.builtin", t.CodeConstant()),
+		fg("@function", t.CodeFunction()),
+		fg("@function.call", t.CodeFunction()),
+		fg("@function.method", t.CodeFunction()),
+		fg("@function.builtin", t.CodeFunction()),
+		fg("@constructor", t.CodeType()),
+		fg("@operator", t.CodeOperator()),
+		fg("@punctuation", t.CodePunctuation()),
+		fg("@punctuation.delimiter", t.CodePunctuation()),
+		fg("@punctuation.bracket", t.CodePunctuation()),
+		fg("@variable", t.CodeVariable()),
+		fg("@variable.parameter", t.CodeVariable()),
+		fg("@property", t.CodeVariable()),
+		fg("@type", t.CodeType()),
+		fg("@type.builtin", t.CodeType()),
+		fg("@tag", t.CodeKeyword()),
+	}
+
+	// Neovim has no alpha channel, so translucent colors such as the
+	// semantic backgrounds are composited over the background.
+	bg := t.Background()
+	for i := range groups {
+		g := &groups[i]
+		g.fg, g.bg, g.sp = g.fg.opaque(bg), g.bg.opaque(bg), g.sp.opaque(bg)
+	}
+	return groups
+}
+
+// WriteNeovimColorscheme writes t to w as a self-contained Neovim Lua
+// colorscheme. Save it as colors/<id>.lua on the runtimepath and load it with
+// ":colorscheme <id>". It sets the core editor and syntax groups, diagnostics
+// from the semantic colors and Tree-sitter captures such as @keyword and
+// @function from the code roles with nvim_set_hl, and
+// vim.g.terminal_color_0 through 15 from the ANSI roles.
+func WriteNeovimColorscheme(w io.Writer, t Theme) error {
+	background := "light"
+	if t.IsDark() {
+		background = "dark"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "-- %s\n-- Generated by gothememe.\n\n", t.DisplayName())
+	sb.WriteString("vim.cmd(\"highlight clear\")\n")
+	sb.WriteString("if vim.fn.exists(\"syntax_on\") == 1 then\n  vim.cmd(\"syntax reset\")\nend\n\n")
+	fmt.Fprintf(&sb, "vim.o.background = %q\n", background)
+	sb.WriteString("vim.o.termguicolors = true\n")
+	fmt.Fprintf(&sb, "vim.g.colors_name = %s\n\n", quoteFileString(t.ID()))
+
+	for _, h := range nvimHighlights(t) {
+		var fields []string
+		for _, attr := range []struct {
+			key string
+			c   Color
+		}{{"fg", h.fg}, {"bg", h.bg}, {"sp", h.sp}} {
+			if !attr.c.IsEmpty() {
+				fields = append(fields, fmt.Sprintf("%s = %q", attr.key, attr.c.Hex()))
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		for _, attr := range h.attrs {
+			fields = append(fields, attr+" = true")
+		}
+		fmt.Fprintf(&sb, "vim.api.nvim_set_hl(0, %q, { %s })\n", h.group, strings.Join(fields, ", "))
+	}
+
+	bg := t.Background()
+	sb.WriteString("\n")
+	for i, role := range ansiRoles {
+		if c := role.get(t); !c.IsEmpty() {
+			fmt.Fprintf(&sb, "vim.g.terminal_color_%d = %q\n", i, c.opaque(bg).Hex())
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
@@ -0,0 +1,107 @@
+package gothememe
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestWriteNeovimColorschemeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	var sb strings.Builder
+	if err := WriteNeovimColorscheme(&sb, theme); err != nil {
+		t.Fatalf("WriteNeovimColorscheme() error: %v", err)
+	}
+
+	got, err := ParseVimColorscheme(strings.NewReader(sb.String()))
+	if err != nil {
+		t.Fatalf("ParseVimColorscheme() error: %v\n%s", err, sb.String())
+	}
+	// Semantic backgrounds and borders aren't read back from the scheme, so
+	// only the roles the importer maps are compared.
+	bg := theme.Background()
+	roles := ansiRoles[:]
+	for _, m := range vimGroupRoles {
+		role, _ := lookupColorRole(m.role) //nolint:errcheck // vimGroupRoles holds valid role keys
+		roles = append(roles, role)
+	}
+	for _, role := range roles {
+		if role.key == "code_background" || role.key == "code_text" {
+			continue
+		}
+		if w, g := role.get(theme).opaque(bg), role.get(got); w.Hex() != g.Hex() {
+			t.Errorf("%s = %s, want %s", role.key, g.Hex(), w.Hex())
+		}
+	}
+	if got.ID() != theme.ID() || !got.IsDark() {
+		t.Errorf("ID, dark = %q, %v, want %q, true", got.ID(), got.IsDark(), theme.ID())
+	}
+}
+
+func TestWriteNeovimColorschemeContent(t *testing.T) {
+	t.Parallel()
+
+	theme := NewThemeBuilder("paper", "Paper").
+		WithBackground(Hex("#fdf6e3")).
+		WithTextPrimary(Hex("#657b83")).
+		WithRed(Hex("#dc322f")).
+		WithPurple(Hex("#6c71c4")).
+		WithError(SemanticColor{Text: Hex("#dc322f"), Background: Hex("#dc322f").WithAlpha(0.5)}).
+		Build()
+
+	var sb strings.Builder
+	if err := WriteNeovimColorscheme(&sb, theme); err != nil {
+		t.Fatalf("WriteNeovimColorscheme() error: %v", err)
+	}
+	out := sb.String()
+
+	for _, want := range []string{
+		`vim.o.background = "light"`,
+		`vim.g.colors_name = "paper"`,
+		`vim.api.nvim_set_hl(0, "Normal", { fg = "#657b83", bg = "#fdf6e3" })`,
+		`vim.api.nvim_set_hl(0, "@keyword", { fg = "#6c71c4" })`,
+		`vim.api.nvim_set_hl(0, "DiagnosticError", { fg = "#dc322f" })`,
+		`vim.api.nvim_set_hl(0, "DiagnosticUnderlineError", { sp = "#dc322f", undercurl = true })`,
+		`vim.api.nvim_set_hl(0, "Comment", { fg = "` + theme.CodeComment().opaque(theme.Background()).Hex() + `", italic = true })`,
+		`vim.g.terminal_color_1 = "#dc322f"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output lacks %s:\n%s", want, out)
+		}
+	}
+
+	// The translucent error background is composited over the background.
+	wantBg := Hex("#dc322f").WithAlpha(0.5).opaque(Hex("#fdf6e3")).Hex()
+	if !strings.Contains(out, `"DiagnosticVirtualTextError", { fg = "#dc322f", bg = "`+wantBg+`" }`) {
+		t.Errorf("virtual text background is not composited to %s:\n%s", wantBg, out)
+	}
+	if strings.Contains(out, "{  }") || strings.Contains(out, "{ }") {
+		t.Errorf("output has an empty highlight group:\n%s", out)
+	}
+}
+
+func TestExportNeovim(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	if format, err := OutputFormatFromPath("colors/acme.lua"); err != nil || format != FormatNeovim {
+		t.Errorf("OutputFormatFromPath() = %s, %v, want neovim", format, err)
+	}
+
+	var want, got strings.Builder
+	if err := WriteNeovimColorscheme(&want, theme); err != nil {
+		t.Fatalf("WriteNeovimColorscheme() error: %v", err)
+	}
+	if err := Export(&got, FormatNeovim, theme); err != nil {
+		t.Fatalf("Export() error: %v", err)
+	}
+	if got.String() != want.String() {
+		t.Error("Export() output differs from WriteNeovimColorscheme()")
+	}
+	if err := Export(io.Discard, FormatNeovim, theme, theme); !errors.Is(err, ErrTooManyThemes) {
+		t.Errorf("Export() with two themes error = %v, want ErrTooManyThemes", err)
+	}
+}
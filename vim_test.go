@@ -0,0 +1,277 @@
+package gothememe
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// vimSample is a Vimscript colorscheme using the Catppuccin Mocha palette.
+const vimSample = `" Catppuccin Mocha, trimmed for tests
+hi clear
+if exists("syntax_on") | syntax reset | endif
+set background=dark
+let g:colors_name = "Catppuccin Mocha"
+
+hi Normal guifg=#cdd6f4 guibg=#1e1e2e ctermfg=15 ctermbg=0
+hi Visual guibg=#585b70 gui=bold
+hi StatusLine guifg=#bac2de guibg=#313244
+hi LineNr guifg=#7f849c
+hi Directory guifg=#89b4fa
+hi WinSeparator guifg=#11111b
+hi Comment guifg=#9399b2 gui=italic " the comment color
+hi Keyword guifg=#cba6f7
+hi String guifg=#a6e3a1
+hi Number guifg=#fab387
+hi Function guifg=#89b4fa
+hi Operator guifg=#89dceb
+hi Delimiter guifg=#9399b2
+hi Identifier guifg=#f2cdcd
+hi Boolean guifg=#fab387
+hi Type guifg=#f9e2af
+hi DiagnosticError guifg=#f38ba8
+hi DiagnosticWarn guifg=#f9e2af
+hi DiagnosticInfo guifg=#89dceb
+hi DiagnosticOk guifg=#a6e3a1
+hi! link Statement Keyword
+hi def link Constant Number
+
+let g:terminal_color_0 = "#45475a"
+let g:terminal_color_1 = "#f38ba8"
+let g:terminal_color_4 = "#89b4fa"
+let g:terminal_color_15 = "#a6adc8"
+`
+
+// luaSample is the same scheme written for Neovim in Lua.
+const luaSample = `-- Catppuccin Mocha, trimmed for tests
+vim.cmd("hi clear")
+vim.o.background = "dark"
+vim.g.colors_name = "Catppuccin Mocha"
+
+local set_hl = vim.api.nvim_set_hl
+
+--[[ Editor groups are set one call at a time; syntax groups come from a
+table below. ]]
+set_hl(0, "Normal", { fg = "#cdd6f4", bg = "#1e1e2e" })
+vim.api.nvim_set_hl(0, 'Visual', { bg = 0x585b70, bold = true })
+set_hl(0, "StatusLine", { fg = "#bac2de", bg = "#313244", cterm = { bold = true } })
+set_hl(0, "LineNr", { fg = "#7f849c" })
+set_hl(0, "Directory", { fg = "#89b4fa" })
+set_hl(0, "WinSeparator", { fg = "#11111b" }) -- borders
+
+local groups = {
+  Comment = { fg = "#9399b2", italic = true },
+  Keyword = { fg = "#cba6f7" },
+  ["@string"] = { fg = "#a6e3a1" },
+  String = { link = "@string" },
+  Number = { fg = "#fab387" },
+  Function = { fg = "#89b4fa" },
+  Operator = { fg = "#89dceb" },
+  Delimiter = { fg = "#9399b2" },
+  Identifier = { fg = "#f2cdcd" },
+  Boolean = { fg = "#fab387" },
+  Type = { fg = "#f9e2af" },
+  DiagnosticError = { fg = "#f38ba8" },
+  DiagnosticWarn = { fg = "#f9e2af" },
+  DiagnosticInfo = { fg = "#89dceb" },
+  DiagnosticOk = { fg = "#a6e3a1" },
+  Statement = { link = "Keyword" },
+  Constant = { link = "Number" },
+}
+for name, spec in pairs(groups) do
+  vim.api.nvim_set_hl(0, name, spec)
+end
+
+vim.g.terminal_color_0 = "#45475a"
+vim.g.terminal_color_1 = "#f38ba8"
+vim.g["terminal_color_4"] = "#89b4fa"
+vim.g.terminal_color_15 = "#a6adc8"
+`
+
+func TestParseVimColorscheme(t *testing.T) {
+	t.Parallel()
+
+	theme, err := ParseVimColorscheme(strings.NewReader(vimSample))
+	if err != nil {
+		t.Fatalf("ParseVimColorscheme() error: %v", err)
+	}
+	if theme.ID() != "catppuccin_mocha" || theme.DisplayName() != "Catppuccin Mocha" {
+		t.Errorf("ID, DisplayName = %q, %q", theme.ID(), theme.DisplayName())
+	}
+	if !theme.IsDark() {
+		t.Error("IsDark() = false, want true")
+	}
+
+	checks := []struct {
+		name string
+		got  Color
+		want string
+	}{
+		{"background", theme.Background(), "#1e1e2e"},
+		{"text primary", theme.TextPrimary(), "#cdd6f4"},
+		{"surface from Visual", theme.Surface(), "#585b70"},
+		{"surface secondary from StatusLine", theme.SurfaceSecondary(), "#313244"},
+		{"text secondary from StatusLine", theme.TextSecondary(), "#bac2de"},
+		{"text muted from LineNr", theme.TextMuted(), "#7f849c"},
+		{"accent from Directory", theme.Accent(), "#89b4fa"},
+		{"border from WinSeparator", theme.Border(), "#11111b"},
+		{"error text", theme.Error().Text, "#f38ba8"},
+		{"warning text", theme.Warning().Text, "#f9e2af"},
+		{"success text", theme.Success().Text, "#a6e3a1"},
+		{"code background", theme.CodeBackground(), "#1e1e2e"},
+		{"code comment", theme.CodeComment(), "#9399b2"},
+		{"code keyword", theme.CodeKeyword(), "#cba6f7"},
+		{"code string", theme.CodeString(), "#a6e3a1"},
+		{"code number", theme.CodeNumber(), "#fab387"},
+		{"code function", theme.CodeFunction(), "#89b4fa"},
+		{"code operator", theme.CodeOperator(), "#89dceb"},
+		{"code punctuation", theme.CodePunctuation(), "#9399b2"},
+		{"code variable", theme.CodeVariable(), "#f2cdcd"},
+		{"code constant", theme.CodeConstant(), "#fab387"},
+		{"code type", theme.CodeType(), "#f9e2af"},
+		{"ANSI black", theme.Black(), "#45475a"},
+		{"ANSI red", theme.Red(), "#f38ba8"},
+		{"ANSI blue", theme.Blue(), "#89b4fa"},
+		{"ANSI bright white", theme.BrightWhite(), "#a6adc8"},
+	}
+	for _, c := range checks {
+		if c.got.Hex() != c.want {
+			t.Errorf("%s = %s, want %s", c.name, c.got.Hex(), c.want)
+		}
+	}
+}
+
+func TestParseVimColorschemeLua(t *testing.T) {
+	t.Parallel()
+
+	vim, err := ParseVimColorscheme(strings.NewReader(vimSample))
+	if err != nil {
+		t.Fatalf("ParseVimColorscheme(vimscript) error: %v", err)
+	}
+	lua, err := ParseVimColorscheme(strings.NewReader(luaSample))
+	if err != nil {
+		t.Fatalf("ParseVimColorscheme(lua) error: %v", err)
+	}
+	if lua.ID() != vim.ID() || !lua.IsDark() {
+		t.Errorf("Lua ID, IsDark = %q, %v, want %q, true", lua.ID(), lua.IsDark(), vim.ID())
+	}
+	assertSameColors(t, vim, lua)
+}
+
+func TestParseVimColorschemeLinks(t *testing.T) {
+	t.Parallel()
+
+	src := `
+hi Normal guifg=#eeeeee guibg=#101010
+hi Todo guifg=#ff8800
+hi link Comment Todo
+hi Comment guifg=#777777
+hi link Keyword MyKeyword
+hi link MyKeyword Keyword
+hi String guifg=bg guibg=NONE
+exe "hi Type guifg=#00aaff"
+hi Function
+      \ guifg=#ffcc00
+      \ guibg=#000000
+hi link Number NONE
+hi Search guifg=fg | hi Title guifg=#ff00ff
+let g:terminal_ansi_colors = ['#000000', '#cc0000', '#00cc00']
+`
+	theme, err := ParseVimColorscheme(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("ParseVimColorscheme() error: %v", err)
+	}
+
+	checks := []struct {
+		name string
+		got  Color
+		want string
+	}{
+		{"attributes replace a link", theme.CodeComment(), "#777777"},
+		{"guifg=bg is the Normal background", theme.CodeString(), "#101010"},
+		{"execute with a literal", theme.CodeType(), "#00aaff"},
+		{"continuation lines", theme.CodeFunction(), "#ffcc00"},
+		{"guifg=fg is the Normal foreground", theme.TextInverted(), "#eeeeee"},
+		{"commands separated by a bar", theme.Accent(), "#ff00ff"},
+		{"terminal_ansi_colors", theme.Green(), "#00cc00"},
+	}
+	for _, c := range checks {
+		if c.got.Hex() != c.want {
+			t.Errorf("%s = %s, want %s", c.name, c.got.Hex(), c.want)
+		}
+	}
+	if theme.ID() != "imported" {
+		t.Errorf("ID() = %q, want imported", theme.ID())
+	}
+	// A link cycle and a cleared link supply no color, and the sample has
+	// no ANSI purple or yellow for the builder to derive them from.
+	if !theme.CodeKeyword().IsEmpty() || !theme.CodeNumber().IsEmpty() {
+		t.Errorf("keyword, number = %s, %s, want both unset", theme.CodeKeyword().Hex(), theme.CodeNumber().Hex())
+	}
+}
+
+func TestParseVimColorschemeErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{"empty", ""},
+		{"cterm only", "hi Normal ctermfg=15 ctermbg=0"},
+		{"color names", "hi Normal guifg=White guibg=Black"},
+		{"runtime colors", `local c = require("palette")
+vim.api.nvim_set_hl(0, "Normal", { fg = c.text, bg = c.base })`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := ParseVimColorscheme(strings.NewReader(tt.src)); err == nil {
+				t.Error("ParseVimColorscheme() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestLoadVimColorschemeFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "colors", "midnight.lua")
+	writeTestFile(t, path, `vim.api.nvim_set_hl(0, "Normal", { fg = "#c0c0c0", bg = "#000020" })`)
+
+	theme, err := LoadVimColorschemeFile(path)
+	if err != nil {
+		t.Fatalf("LoadVimColorschemeFile() error: %v", err)
+	}
+	if theme.ID() != "midnight" {
+		t.Errorf("ID() = %q, want midnight from the file name", theme.ID())
+	}
+	if theme.Background().Hex() != "#000020" {
+		t.Errorf("Background() = %s, want #000020", theme.Background().Hex())
+	}
+
+	// A g:colors_name without ASCII letters or digits takes the ID from the
+	// file name, then falls back to "imported".
+	for file, want := range map[string]string{"yozora.vim": "yozora", "夜空.vim": "imported"} {
+		path := filepath.Join(dir, "colors", file)
+		writeTestFile(t, path, "let g:colors_name = '夜空'\nhi Normal guifg=#c0c0c0 guibg=#000020\n")
+		theme, err := LoadVimColorschemeFile(path)
+		if err != nil {
+			t.Fatalf("LoadVimColorschemeFile(%s) error: %v", file, err)
+		}
+		if theme.ID() != want || theme.DisplayName() != "夜空" {
+			t.Errorf("LoadVimColorschemeFile(%s) = %q/%q, want %s/夜空", file, theme.ID(), theme.DisplayName(), want)
+		}
+	}
+
+	if _, err := LoadVimColorschemeFile(filepath.Join(dir, "missing.vim")); err == nil {
+		t.Error("LoadVimColorschemeFile(missing) error = nil, want error")
+	}
+	empty := filepath.Join(dir, "empty.vim")
+	writeTestFile(t, empty, `" nothing here`)
+	if _, err := LoadVimColorschemeFile(empty); err == nil || !strings.Contains(err.Error(), empty) {
+		t.Errorf("LoadVimColorschemeFile(empty) error = %v, want it to name the file", err)
+	}
+}
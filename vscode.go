@@ -0,0 +1,370 @@
+package gothememe
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// vscodeColorKeys maps color roles to the VS Code workbench color keys that
+// supply them, in order of preference.
+var vscodeColorKeys = []struct {
+	role string
+	keys []string
+}{
+	{"background", []string{"editor.background"}},
+	{"background_secondary", []string{"sideBar.background", "activityBar.background", "panel.background"}},
+	{"surface", []string{"editorWidget.background", "dropdown.background", "input.background", "editorHoverWidget.background"}},
+	{"surface_secondary", []string{"list.hoverBackground", "tab.inactiveBackground", "statusBar.background"}},
+	{"text_primary", []string{"editor.foreground", "foreground"}},
+	{"text_secondary", []string{"sideBar.foreground", "descriptionForeground", "foreground"}},
+	{"text_muted", []string{"editorLineNumber.foreground", "disabledForeground", "tab.inactiveForeground"}},
+	{"text_inverted", []string{"button.foreground", "badge.foreground"}},
+	{"accent", []string{"focusBorder", "button.background", "textLink.foreground"}},
+	{"accent_secondary", []string{"textLink.activeForeground", "badge.background", "activityBarBadge.background"}},
+	{"brand", []string{"activityBarBadge.background", "button.background", "focusBorder"}},
+	{"border", []string{"panel.border", "editorGroup.border", "sideBar.border", "contrastBorder"}},
+	{"border_subtle", []string{"editorIndentGuide.background", "editorIndentGuide.background1", "tab.border"}},
+	{"border_strong", []string{"contrastActiveBorder", "input.border", "editorIndentGuide.activeBackground"}},
+	{"success_text", []string{"testing.iconPassed", "gitDecoration.addedResourceForeground", "terminal.ansiGreen"}},
+	{"warning_text", []string{"editorWarning.foreground", "list.warningForeground", "terminal.ansiYellow"}},
+	{"error_text", []string{"errorForeground", "editorError.foreground", "list.errorForeground", "terminal.ansiRed"}},
+	{"info_text", []string{"editorInfo.foreground", "notificationsInfoIcon.foreground", "terminal.ansiCyan"}},
+	{"black", []string{"terminal.ansiBlack"}},
+	{"red", []string{"terminal.ansiRed"}},
+	{"green", []string{"terminal.ansiGreen"}},
+	{"yellow", []string{"terminal.ansiYellow"}},
+	{"blue", []string{"terminal.ansiBlue"}},
+	{"purple", []string{"terminal.ansiMagenta"}},
+	{"cyan", []string{"terminal.ansiCyan"}},
+	{"white", []string{"terminal.ansiWhite"}},
+	{"bright_black", []string{"terminal.ansiBrightBlack"}},
+	{"bright_red", []string{"terminal.ansiBrightRed"}},
+	{"bright_green", []string{"terminal.ansiBrightGreen"}},
+	{"bright_yellow", []string{"terminal.ansiBrightYellow"}},
+	{"bright_blue", []string{"terminal.ansiBrightBlue"}},
+	{"bright_purple", []string{"terminal.ansiBrightMagenta"}},
+	{"bright_cyan", []string{"terminal.ansiBrightCyan"}},
+	{"bright_white", []string{"terminal.ansiBrightWhite"}},
+	{"code_background", []string{"editor.background"}},
+	{"code_text", []string{"editor.foreground", "foreground"}},
+}
+
+// vscodeScopes maps code roles to the TextMate scopes whose color they take,
+// in order of preference. Each scope is resolved the way TextMate does: the
+// rule with the most specific matching selector wins. Exported themes label
+// each role's rule with name.
+var vscodeScopes = []struct {
+	role   string
+	name   string
+	scopes []string
+}{
+	{"code_comment", "Comments", []string{"comment.line", "comment"}},
+	{"code_keyword", "Keywords", []string{"keyword.control", "keyword", "storage.modifier"}},
+	{"code_string", "Strings", []string{"string.quoted", "string"}},
+	{"code_number", "Numbers", []string{"constant.numeric"}},
+	{"code_function", "Functions", []string{"entity.name.function", "support.function"}},
+	{"code_operator", "Operators", []string{"keyword.operator"}},
+	{"code_punctuation", "Punctuation", []string{"punctuation"}},
+	{"code_variable", "Variables", []string{"variable.other", "variable"}},
+	{"code_constant", "Constants", []string{"constant.language", "variable.other.constant", "constant"}},
+	{"code_type", "Types", []string{"entity.name.type", "support.type", "storage.type"}},
+}
+
+// errVSCodeNoColors is returned when a theme defines no usable colors.
+var errVSCodeNoColors = errors.New("VS Code theme defines no colors")
+
+// vscodeThemeFile is the on-disk shape of a VS Code color theme.
+type vscodeThemeFile struct {
+	Name        string            `json:"name"`
+	Type        string            `json:"type"`
+	Include     string            `json:"include"`
+	Colors      map[string]string `json:"colors"`
+	TokenColors json.RawMessage   `json:"tokenColors"`
+}
+
+// vscodeTokenRule is one entry of a theme's tokenColors array.
+type vscodeTokenRule struct {
+	Scope    json.RawMessage `json:"scope"`
+	Settings struct {
+		Foreground string `json:"foreground"`
+		Background string `json:"background"`
+	} `json:"settings"`
+}
+
+// vscodeTheme is a theme file merged with everything it includes.
+type vscodeTheme struct {
+	name   string
+	typ    string
+	colors map[string]string
+	rules  []scopeRule
+}
+
+// scopeRule is a tokenColors entry reduced to simple scope selectors.
+type scopeRule struct {
+	selectors  []string
+	foreground string
+	background string
+}
+
+// ParseVSCodeTheme reads a VS Code color theme (JSON with comments and
+// trailing commas) and maps its workbench colors and tokenColors onto a
+// Theme. Themes that use "include" must be loaded with [LoadVSCodeThemeFile]
+// so the included files can be found.
+func ParseVSCodeTheme(r io.Reader) (Theme, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading VS Code theme: %w", err)
+	}
+
+	file, err := decodeVSCodeTheme(data, "")
+	if err != nil {
+		return nil, err
+	}
+	if file.Include != "" {
+		return nil, fmt.Errorf("include %q: included files can only be resolved by LoadVSCodeThemeFile", file.Include)
+	}
+
+	vt, err := file.merge(vscodeTheme{colors: map[string]string{}}, "")
+	if err != nil {
+		return nil, err
+	}
+	return vt.build("imported")
+}
+
+// LoadVSCodeThemeFile reads the VS Code color theme at path, following its
+// "include" chain relative to each file. The theme ID is derived from the
+// theme's name, falling back to the file name.
+func LoadVSCodeThemeFile(path string) (Theme, error) {
+	vt, err := loadVSCodeTheme(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	file := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	theme, err := vt.build(importedThemeID(strings.TrimSuffix(file, "-color-theme"), ""))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return theme, nil
+}
+
+// loadVSCodeTheme reads a theme file and, recursively, the files it includes.
+// seen holds the chain of files already being loaded to detect cycles.
+func loadVSCodeTheme(path string, seen []string) (vscodeTheme, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return vscodeTheme{}, fmt.Errorf("resolving %s: %w", path, err)
+	}
+	if slices.Contains(seen, abs) {
+		return vscodeTheme{}, fmt.Errorf("%s: include cycle: %s", path, strings.Join(append(seen, abs), " -> "))
+	}
+	seen = append(seen, abs)
+
+	data, err := os.ReadFile(path) //nolint:gosec // G304: loading a caller-specified theme file is the purpose of this function
+	if err != nil {
+		return vscodeTheme{}, fmt.Errorf("reading VS Code theme: %w", err)
+	}
+
+	file, err := decodeVSCodeTheme(data, path)
+	if err != nil {
+		return vscodeTheme{}, err
+	}
+
+	base := vscodeTheme{colors: map[string]string{}}
+	if file.Include != "" {
+		base, err = loadVSCodeTheme(filepath.Join(filepath.Dir(path), file.Include), seen)
+		if err != nil {
+			return vscodeTheme{}, err
+		}
+	}
+	return file.merge(base, path)
+}
+
+// decodeVSCodeTheme parses JSONC theme data. path is only used in errors.
+func decodeVSCodeTheme(data []byte, path string) (vscodeThemeFile, error) {
+	clean := stripJSONC(data)
+
+	var file vscodeThemeFile
+	if err := json.Unmarshal(clean, &file); err != nil {
+		return vscodeThemeFile{}, &ThemeFileError{File: path, Line: jsonErrorLine(clean, err), Err: err}
+	}
+	return file, nil
+}
+
+// merge layers the file on top of an included base theme. Colors override
+// the base and token rules are appended, so later rules take precedence.
+func (f *vscodeThemeFile) merge(base vscodeTheme, path string) (vscodeTheme, error) {
+	if f.Name != "" {
+		base.name = f.Name
+	}
+	if f.Type != "" {
+		base.typ = f.Type
+	}
+	for key, value := range f.Colors {
+		base.colors[key] = value
+	}
+
+	if len(f.TokenColors) == 0 || bytes.Equal(f.TokenColors, []byte("null")) {
+		return base, nil
+	}
+
+	var rules []vscodeTokenRule
+	if err := json.Unmarshal(f.TokenColors, &rules); err != nil {
+		var file string
+		if json.Unmarshal(f.TokenColors, &file) == nil {
+			return vscodeTheme{}, &ThemeFileError{
+				File: path,
+				Err:  fmt.Errorf("tokenColors file %q: only inline tokenColors are supported", file),
+			}
+		}
+		return vscodeTheme{}, &ThemeFileError{File: path, Err: fmt.Errorf("tokenColors: %w", err)}
+	}
+
+	for _, rule := range rules {
+		selectors, err := parseScopeSelectors(rule.Scope)
+		if err != nil {
+			return vscodeTheme{}, &ThemeFileError{File: path, Err: err}
+		}
+		base.rules = append(base.rules, scopeRule{
+			selectors:  selectors,
+			foreground: rule.Settings.Foreground,
+			background: rule.Settings.Background,
+		})
+	}
+	return base, nil
+}
+
+// parseScopeSelectors normalizes a rule's scope, which may be a
+// comma-separated string or an array of strings. Descendant and exclusion
+// selectors are dropped since roles are matched against single scopes.
+func parseScopeSelectors(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var list []string
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		list = []string{single}
+	} else if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, fmt.Errorf("tokenColors scope must be a string or array of strings: %s", raw)
+	}
+
+	var selectors []string
+	for _, entry := range list {
+		for sel := range strings.SplitSeq(entry, ",") {
+			sel = strings.TrimSpace(sel)
+			if sel == "" || strings.Contains(sel, " ") || strings.HasPrefix(sel, "-") {
+				continue
+			}
+			selectors = append(selectors, sel)
+		}
+	}
+	if len(selectors) == 0 {
+		// A rule whose selectors were all complex must not be mistaken for
+		// the global settings rule.
+		return []string{}, nil
+	}
+	return selectors, nil
+}
+
+// scopeColor returns the foreground of the rule that best matches scope. A
+// selector matches when it equals the scope or is a dot-separated prefix of
+// it; longer selectors win and later rules break ties.
+func (vt *vscodeTheme) scopeColor(scope string) string {
+	best, bestLen := "", -1
+	for _, rule := range vt.rules {
+		if rule.foreground == "" {
+			continue
+		}
+		for _, sel := range rule.selectors {
+			if (scope == sel || strings.HasPrefix(scope, sel+".")) && len(sel) >= bestLen {
+				best, bestLen = rule.foreground, len(sel)
+			}
+		}
+	}
+	return best
+}
+
+// globalSettings returns the colors of the scope-less tokenColors rule that
+// older themes use for the editor defaults.
+func (vt *vscodeTheme) globalSettings() (fg, bg string) {
+	for _, rule := range vt.rules {
+		if rule.selectors == nil {
+			if rule.foreground != "" {
+				fg = rule.foreground
+			}
+			if rule.background != "" {
+				bg = rule.background
+			}
+		}
+	}
+	return fg, bg
+}
+
+// build maps the merged theme onto a Theme. Invalid color values are skipped,
+// matching how VS Code ignores them.
+func (vt *vscodeTheme) build(defaultID string) (Theme, error) {
+	id, name := importedThemeID(vt.name, defaultID), vt.name
+	if name == "" {
+		name = defaultID
+	}
+
+	b := NewThemeBuilder(id, name)
+	applied := 0
+	set := func(roleKey, value string) bool {
+		if value == "" {
+			return false
+		}
+		c, err := ParseColor(value)
+		if err != nil {
+			return false
+		}
+		role, _ := lookupColorRole(roleKey) //nolint:errcheck // role keys are fixed in this file
+		role.set(b, c)
+		applied++
+		return true
+	}
+
+	colors := maps.Clone(vt.colors)
+	globalFg, globalBg := vt.globalSettings()
+	if colors["editor.background"] == "" {
+		colors["editor.background"] = globalBg
+	}
+	if colors["editor.foreground"] == "" {
+		colors["editor.foreground"] = globalFg
+	}
+
+	for _, m := range vscodeColorKeys {
+		for _, key := range m.keys {
+			if set(m.role, colors[key]) {
+				break
+			}
+		}
+	}
+	for _, m := range vscodeScopes {
+		for _, scope := range m.scopes {
+			if set(m.role, vt.scopeColor(scope)) {
+				break
+			}
+		}
+	}
+
+	switch strings.ToLower(vt.typ) {
+	case "dark", "hc", "hc-black", "vs-dark":
+		b.WithIsDark(true)
+	}
+
+	if applied == 0 {
+		return nil, errVSCodeNoColors
+	}
+	return b.Build(), nil
+}
@@ -0,0 +1,367 @@
+package gothememe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// vscodeColor is one workbench color key and its value.
+type vscodeColor struct {
+	key string
+	c   Color
+}
+
+// vscodeWorkbenchColors returns the workbench colors written for t. The keys
+// cover what [ParseVSCodeTheme] reads first for each role, so a written theme
+// loads back with the same colors.
+func vscodeWorkbenchColors(t Theme) []vscodeColor {
+	success, warning, errs, info := t.Success(), t.Warning(), t.Error(), t.Info()
+
+	// Diff highlights are drawn over the text, so they use translucent
+	// versions of the semantic text colors like VS Code's own themes.
+	inserted, removed := success.Text, errs.Text
+	if inserted.IsEmpty() {
+		inserted = t.Green()
+	}
+	if removed.IsEmpty() {
+		removed = t.Red()
+	}
+	tint := func(c Color, alpha float64) Color {
+		if c.IsEmpty() {
+			return c
+		}
+		return c.WithAlpha(alpha)
+	}
+
+	colors := []vscodeColor{
+		{"foreground", t.TextPrimary()},
+		{"descriptionForeground", t.TextSecondary()},
+		{"disabledForeground", t.TextMuted()},
+		{"errorForeground", errs.Text},
+		{"focusBorder", t.Accent()},
+		{"textLink.foreground", t.Accent()},
+		{"textLink.activeForeground", t.AccentSecondary()},
+
+		{"editor.background", t.Background()},
+		{"editor.foreground", t.TextPrimary()},
+		{"editor.lineHighlightBackground", t.BackgroundSecondary()},
+		{"editor.selectionBackground", t.Surface()},
+		{"editorCursor.foreground", t.TextPrimary()},
+		{"editorLineNumber.foreground", t.TextMuted()},
+		{"editorLineNumber.activeForeground", t.TextSecondary()},
+		{"editorIndentGuide.background1", t.BorderSubtle()},
+		{"editorIndentGuide.activeBackground1", t.BorderStrong()},
+		{"editorWidget.background", t.Surface()},
+		{"editorHoverWidget.background", t.Surface()},
+		{"editorGroup.border", t.Border()},
+		{"editorGroupHeader.tabsBackground", t.BackgroundSecondary()},
+		{"editorError.foreground", errs.Text},
+		{"editorWarning.foreground", warning.Text},
+		{"editorInfo.foreground", info.Text},
+
+		{"diffEditor.insertedTextBackground", tint(inserted, 0.2)},
+		{"diffEditor.insertedLineBackground", tint(inserted, 0.1)},
+		{"diffEditor.removedTextBackground", tint(removed, 0.2)},
+		{"diffEditor.removedLineBackground", tint(removed, 0.1)},
+
+		{"sideBar.background", t.BackgroundSecondary()},
+		{"sideBar.foreground", t.TextSecondary()},
+		{"sideBar.border", t.Border()},
+		{"sideBarTitle.foreground", t.TextPrimary()},
+		{"activityBar.background", t.BackgroundSecondary()},
+		{"activityBar.foreground", t.TextPrimary()},
+		{"activityBarBadge.background", t.Brand()},
+		{"activityBarBadge.foreground", t.TextInverted()},
+		{"panel.background", t.BackgroundSecondary()},
+		{"panel.border", t.Border()},
+		{"titleBar.activeBackground", t.BackgroundSecondary()},
+		{"titleBar.activeForeground", t.TextPrimary()},
+
+		{"statusBar.background", t.SurfaceSecondary()},
+		{"statusBar.foreground", t.TextSecondary()},
+		{"statusBar.border", t.Border()},
+
+		{"tab.activeBackground", t.Background()},
+		{"tab.activeForeground", t.TextPrimary()},
+		{"tab.activeBorderTop", t.Accent()},
+		{"tab.inactiveBackground", t.BackgroundSecondary()},
+		{"tab.inactiveForeground", t.TextMuted()},
+		{"tab.border", t.BorderSubtle()},
+
+		{"list.hoverBackground", t.SurfaceSecondary()},
+		{"list.activeSelectionBackground", t.Surface()},
+		{"list.activeSelectionForeground", t.TextPrimary()},
+		{"list.errorForeground", errs.Text},
+		{"list.warningForeground", warning.Text},
+		{"input.background", t.Surface()},
+		{"input.foreground", t.TextPrimary()},
+		{"input.border", t.BorderStrong()},
+		{"dropdown.background", t.Surface()},
+		{"button.background", t.Accent()},
+		{"button.foreground", t.TextInverted()},
+		{"badge.background", t.AccentSecondary()},
+		{"badge.foreground", t.TextInverted()},
+
+		{"inputValidation.errorBackground", errs.Background},
+		{"inputValidation.errorBorder", errs.Border},
+		{"inputValidation.warningBackground", warning.Background},
+		{"inputValidation.warningBorder", warning.Border},
+		{"inputValidation.infoBackground", info.Background},
+		{"inputValidation.infoBorder", info.Border},
+		{"notificationsErrorIcon.foreground", errs.Text},
+		{"notificationsWarningIcon.foreground", warning.Text},
+		{"notificationsInfoIcon.foreground", info.Text},
+		{"testing.iconPassed", success.Text},
+		{"testing.iconFailed", errs.Text},
+		{"gitDecoration.addedResourceForeground", success.Text},
+		{"gitDecoration.modifiedResourceForeground", warning.Text},
+		{"gitDecoration.deletedResourceForeground", errs.Text},
+
+		{"terminal.background", t.Background()},
+		{"terminal.foreground", t.TextPrimary()},
+		{"terminal.selectionBackground", t.Surface()},
+		{"terminalCursor.foreground", t.TextPrimary()},
+	}
+	for i, name := range ansiNames {
+		title := strings.ToUpper(name[:1]) + name[1:]
+		colors = append(colors,
+			vscodeColor{"terminal.ansi" + title, ansiRoles[i].get(t)},
+			vscodeColor{"terminal.ansiBright" + title, ansiRoles[i+8].get(t)},
+		)
+	}
+	return colors
+}
+
+// WriteVSCodeTheme writes t to w as a VS Code color theme. The workbench
+// colors cover the editor, side bar, status bar, tabs, diff editor and
+// integrated terminal, with errors, warnings and diff highlights taken from
+// the semantic colors. The code roles become tokenColors rules for the same
+// TextMate scopes [ParseVSCodeTheme] reads, so the written theme loads back
+// with the same colors.
+func WriteVSCodeTheme(w io.Writer, t Theme) error {
+	typ := "light"
+	if t.IsDark() {
+		typ = "dark"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	sb.WriteString("  \"$schema\": \"vscode://schemas/color-theme\",\n")
+	fmt.Fprintf(&sb, "  \"name\": %s,\n", quoteFileString(t.DisplayName()))
+	fmt.Fprintf(&sb, "  \"type\": %q,\n", typ)
+	sb.WriteString("  \"semanticHighlighting\": true,\n")
+
+	sb.WriteString("  \"colors\": {")
+	first := true
+	for _, c := range vscodeWorkbenchColors(t) {
+		if c.c.IsEmpty() {
+			continue
+		}
+		if !first {
+			sb.WriteString(",")
+		}
+		first = false
+		fmt.Fprintf(&sb, "\n    %q: %q", c.key, c.c.Hex())
+	}
+	sb.WriteString("\n  },\n")
+
+	sb.WriteString("  \"tokenColors\": [")
+	first = true
+	for _, m := range vscodeScopes {
+		role, _ := lookupColorRole(m.role) //nolint:errcheck // vscodeScopes holds valid role keys
+		c := role.get(t)
+		if c.IsEmpty() {
+			continue
+		}
+		if !first {
+			sb.WriteString(",")
+		}
+		first = false
+		scopes := make([]string, len(m.scopes))
+		for i, s := range m.scopes {
+			scopes[i] = fmt.Sprintf("%q", s)
+		}
+		fmt.Fprintf(&sb, "\n    {\n      \"name\": %q,\n      \"scope\": [%s],\n      \"settings\": { \"foreground\": %q }\n    }",
+			m.name, strings.Join(scopes, ", "), c.Hex())
+	}
+	sb.WriteString("\n  ]\n}\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// VSCodeExtensionOptions configures [WriteVSCodeExtension].
+type VSCodeExtensionOptions struct {
+	// Name is the extension's package name (default: the ID of a single
+	// theme, or "gothememe-themes"). It must be lowercase without spaces.
+	Name string
+
+	// DisplayName is the name shown in the marketplace (default: the name of
+	// a single theme, or "GoThemeMe Themes").
+	DisplayName string
+
+	// Description is the one-line marketplace description.
+	Description string
+
+	// Publisher is the marketplace publisher ID (default: "gothememe").
+	Publisher string
+
+	// Version is the extension's semantic version (default: "1.0.0").
+	Version string
+
+	// Repository is the source repository URL. vsce asks for confirmation
+	// when it is missing unless run with --allow-missing-repository.
+	Repository string
+
+	// License is the SPDX license identifier, such as "MIT".
+	License string
+
+	// LicenseText is written to LICENSE when set. vsce asks for
+	// confirmation when there is no LICENSE file unless run with
+	// --skip-license.
+	LicenseText string
+}
+
+// vscodeExtensionManifest is the package.json of a theme extension.
+type vscodeExtensionManifest struct {
+	Name        string                 `json:"name"`
+	DisplayName string                 `json:"displayName"`
+	Description string                 `json:"description,omitempty"`
+	Version     string                 `json:"version"`
+	Publisher   string                 `json:"publisher"`
+	License     string                 `json:"license,omitempty"`
+	Repository  *vscodeRepository      `json:"repository,omitempty"`
+	Engines     map[string]string      `json:"engines"`
+	Categories  []string               `json:"categories"`
+	Contributes vscodeExtensionContrib `json:"contributes"`
+}
+
+// vscodeRepository is the repository field of package.json.
+type vscodeRepository struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// vscodeExtensionContrib is the contributes field of package.json.
+type vscodeExtensionContrib struct {
+	Themes []vscodeThemeContrib `json:"themes"`
+}
+
+// vscodeThemeContrib registers one color theme file.
+type vscodeThemeContrib struct {
+	Label   string `json:"label"`
+	UITheme string `json:"uiTheme"`
+	Path    string `json:"path"`
+}
+
+// WriteVSCodeExtension writes a complete VS Code theme extension for the
+// themes into dir: package.json, a README and one themes/<id>-color-theme.json
+// per theme, plus LICENSE when opts.LicenseText is set. The folder can be
+// packaged with "vsce package" without network access, or copied into
+// ~/.vscode/extensions to install it directly. Nil themes are skipped.
+func WriteVSCodeExtension(dir string, opts VSCodeExtensionOptions, themes ...Theme) error {
+	var list []Theme
+	for _, t := range themes {
+		if t != nil {
+			list = append(list, t)
+		}
+	}
+	if len(list) == 0 {
+		return ErrNoThemes
+	}
+
+	manifest := vscodeExtensionManifest{
+		Name:        opts.Name,
+		DisplayName: opts.DisplayName,
+		Description: opts.Description,
+		Version:     opts.Version,
+		Publisher:   opts.Publisher,
+		License:     opts.License,
+		Engines:     map[string]string{"vscode": "^1.70.0"},
+		Categories:  []string{"Themes"},
+	}
+	if manifest.Name == "" {
+		manifest.Name = "gothememe-themes"
+		if len(list) == 1 {
+			manifest.Name = strings.ReplaceAll(list[0].ID(), "_", "-")
+		}
+	}
+	if manifest.DisplayName == "" {
+		manifest.DisplayName = "GoThemeMe Themes"
+		if len(list) == 1 {
+			manifest.DisplayName = list[0].DisplayName()
+		}
+	}
+	if manifest.Version == "" {
+		manifest.Version = "1.0.0"
+	}
+	if manifest.Publisher == "" {
+		manifest.Publisher = "gothememe"
+	}
+	if opts.Repository != "" {
+		manifest.Repository = &vscodeRepository{Type: "git", URL: opts.Repository}
+	}
+
+	files := map[string][]byte{}
+	for _, t := range list {
+		path := "themes/" + strings.ReplaceAll(t.ID(), "_", "-") + "-color-theme.json"
+		if _, ok := files[path]; ok {
+			return fmt.Errorf("duplicate theme ID %q", t.ID())
+		}
+		var buf bytes.Buffer
+		if err := WriteVSCodeTheme(&buf, t); err != nil {
+			return err
+		}
+		files[path] = buf.Bytes()
+
+		uiTheme := "vs"
+		if t.IsDark() {
+			uiTheme = "vs-dark"
+		}
+		manifest.Contributes.Themes = append(manifest.Contributes.Themes, vscodeThemeContrib{
+			Label:   t.DisplayName(),
+			UITheme: uiTheme,
+			Path:    "./" + path,
+		})
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding package.json: %w", err)
+	}
+	files["package.json"] = append(data, '\n')
+	files["README.md"] = []byte(vscodeExtensionReadme(manifest))
+	if opts.LicenseText != "" {
+		files["LICENSE"] = []byte(opts.LicenseText)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "themes"), 0o755); err != nil { //nolint:gosec // G301: extension folders are meant to be shared
+		return fmt.Errorf("writing VS Code extension: %w", err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0o644); err != nil { //nolint:gosec // G306: extension files are meant to be shared
+			return fmt.Errorf("writing VS Code extension: %w", err)
+		}
+	}
+	return nil
+}
+
+// vscodeExtensionReadme returns the README shown on the extension's
+// marketplace page. vsce refuses to package without one.
+func vscodeExtensionReadme(m vscodeExtensionManifest) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s\n\n", m.DisplayName)
+	if m.Description != "" {
+		sb.WriteString(m.Description + "\n\n")
+	}
+	sb.WriteString("Color themes generated by [gothememe](https://github.com/tj-smith47/gothememe):\n\n")
+	for _, t := range m.Contributes.Themes {
+		fmt.Fprintf(&sb, "- %s\n", t.Label)
+	}
+	return sb.String()
+}
@@ -0,0 +1,208 @@
+package gothememe
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteVSCodeThemeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	var sb strings.Builder
+	if err := WriteVSCodeTheme(&sb, theme); err != nil {
+		t.Fatalf("WriteVSCodeTheme() error: %v", err)
+	}
+
+	got, err := ParseVSCodeTheme(strings.NewReader(sb.String()))
+	if err != nil {
+		t.Fatalf("ParseVSCodeTheme() error: %v\n%s", err, sb.String())
+	}
+	for _, role := range colorRoles {
+		if role.key == "code_background" || role.key == "code_text" {
+			continue
+		}
+		if w, g := role.get(theme), role.get(got); w.Hex() != g.Hex() {
+			t.Errorf("%s = %s, want %s", role.key, g.Hex(), w.Hex())
+		}
+	}
+	if got.DisplayName() != theme.DisplayName() || got.IsDark() != theme.IsDark() {
+		t.Errorf("name, dark = %q, %v, want %q, %v", got.DisplayName(), got.IsDark(), theme.DisplayName(), theme.IsDark())
+	}
+}
+
+func TestWriteVSCodeThemeContent(t *testing.T) {
+	t.Parallel()
+
+	theme := NewThemeBuilder("paper", "Paper \"Light\"").
+		WithBackground(Hex("#fdf6e3")).
+		WithTextPrimary(Hex("#657b83")).
+		WithGreen(Hex("#859900")).
+		WithRed(Hex("#dc322f")).
+		Build()
+
+	var sb strings.Builder
+	if err := WriteVSCodeTheme(&sb, theme); err != nil {
+		t.Fatalf("WriteVSCodeTheme() error: %v", err)
+	}
+
+	var file struct {
+		Name        string            `json:"name"`
+		Type        string            `json:"type"`
+		Colors      map[string]string `json:"colors"`
+		TokenColors []struct {
+			Scope    []string          `json:"scope"`
+			Settings map[string]string `json:"settings"`
+		} `json:"tokenColors"`
+	}
+	if err := json.Unmarshal([]byte(sb.String()), &file); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, sb.String())
+	}
+	if file.Name != `Paper "Light"` || file.Type != "light" {
+		t.Errorf("name, type = %q, %q", file.Name, file.Type)
+	}
+
+	wantColors := map[string]string{
+		"editor.background":                 "#fdf6e3",
+		"terminal.ansiGreen":                "#859900",
+		"diffEditor.insertedTextBackground": theme.Success().Text.WithAlpha(0.2).Hex(),
+		"diffEditor.removedTextBackground":  theme.Error().Text.WithAlpha(0.2).Hex(),
+		"editorError.foreground":            theme.Error().Text.Hex(),
+	}
+	for key, want := range wantColors {
+		if got := file.Colors[key]; got != want {
+			t.Errorf("colors[%q] = %q, want %q", key, got, want)
+		}
+	}
+	for key, value := range file.Colors {
+		if value == "" {
+			t.Errorf("colors[%q] is empty", key)
+		}
+	}
+	for _, rule := range file.TokenColors {
+		if len(rule.Scope) == 0 || rule.Settings["foreground"] == "" {
+			t.Errorf("token rule %v has no scope or foreground", rule)
+		}
+	}
+}
+
+func TestWriteVSCodeExtension(t *testing.T) {
+	t.Parallel()
+
+	dark := fullTestTheme(t)
+	light := NewThemeBuilder("paper", "Paper").
+		WithBackground(Hex("#fdf6e3")).
+		WithTextPrimary(Hex("#657b83")).
+		Build()
+
+	dir := t.TempDir()
+	opts := VSCodeExtensionOptions{
+		Name:        "acme-themes",
+		Publisher:   "acme",
+		Repository:  "https://example.com/acme/themes",
+		License:     "MIT",
+		LicenseText: "MIT License\n",
+	}
+	if err := WriteVSCodeExtension(dir, opts, dark, nil, light); err != nil {
+		t.Fatalf("WriteVSCodeExtension() error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		t.Fatalf("reading package.json: %v", err)
+	}
+	var manifest vscodeExtensionManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("package.json is not valid JSON: %v", err)
+	}
+	if manifest.Name != "acme-themes" || manifest.Publisher != "acme" || manifest.Version != "1.0.0" {
+		t.Errorf("manifest = %+v", manifest)
+	}
+	if manifest.Engines["vscode"] == "" || manifest.Repository == nil || manifest.Repository.URL != opts.Repository {
+		t.Errorf("manifest engines, repository = %v, %v", manifest.Engines, manifest.Repository)
+	}
+	if len(manifest.Contributes.Themes) != 2 {
+		t.Fatalf("contributes.themes = %+v, want 2 themes", manifest.Contributes.Themes)
+	}
+	if ui := manifest.Contributes.Themes[1].UITheme; ui != "vs" {
+		t.Errorf("light uiTheme = %q, want vs", ui)
+	}
+
+	for i, want := range []Theme{dark, light} {
+		contrib := manifest.Contributes.Themes[i]
+		got, err := LoadVSCodeThemeFile(filepath.Join(dir, contrib.Path))
+		if err != nil {
+			t.Fatalf("loading %s: %v", contrib.Path, err)
+		}
+		if got.Background().Hex() != want.Background().Hex() || contrib.Label != want.DisplayName() {
+			t.Errorf("%s: background, label = %s, %q", contrib.Path, got.Background().Hex(), contrib.Label)
+		}
+	}
+
+	for _, name := range []string{"README.md", "LICENSE"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("%s was not written: %v", name, err)
+		}
+	}
+}
+
+func TestWriteVSCodeExtensionDefaults(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := WriteVSCodeExtension(dir, VSCodeExtensionOptions{}, fullTestTheme(t)); err != nil {
+		t.Fatalf("WriteVSCodeExtension() error: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		t.Fatalf("reading package.json: %v", err)
+	}
+	var manifest vscodeExtensionManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("package.json is not valid JSON: %v", err)
+	}
+	if manifest.Name != "catppuccin-mocha" || manifest.DisplayName != fullTestTheme(t).DisplayName() || manifest.Publisher == "" {
+		t.Errorf("manifest = %+v", manifest)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "LICENSE")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("LICENSE written without LicenseText: %v", err)
+	}
+
+	if err := WriteVSCodeExtension(t.TempDir(), VSCodeExtensionOptions{}); !errors.Is(err, ErrNoThemes) {
+		t.Errorf("WriteVSCodeExtension() without themes error = %v, want ErrNoThemes", err)
+	}
+	theme := fullTestTheme(t)
+	if err := WriteVSCodeExtension(t.TempDir(), VSCodeExtensionOptions{}, theme, theme); err == nil {
+		t.Error("WriteVSCodeExtension() with duplicate IDs succeeded")
+	}
+}
+
+func TestExportVSCode(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	var want strings.Builder
+	if err := WriteVSCodeTheme(&want, theme); err != nil {
+		t.Fatalf("WriteVSCodeTheme() error: %v", err)
+	}
+
+	format, err := OutputFormatFromPath("acme-color-theme.json")
+	if err != nil || format != FormatVSCode {
+		t.Fatalf("OutputFormatFromPath() = %s, %v, want vscode", format, err)
+	}
+	var sb strings.Builder
+	if err := Export(&sb, FormatVSCode, theme); err != nil {
+		t.Fatalf("Export() error: %v", err)
+	}
+	if sb.String() != want.String() {
+		t.Error("Export() output differs from WriteVSCodeTheme()")
+	}
+	if err := Export(io.Discard, FormatVSCode, theme, theme); !errors.Is(err, ErrTooManyThemes) {
+		t.Errorf("Export() with two themes error = %v, want ErrTooManyThemes", err)
+	}
+}
@@ -0,0 +1,294 @@
+package gothememe
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testVSCodeTheme = `{
+	// Comments and trailing commas are allowed, as in VS Code.
+	"name": "Test Night",
+	"type": "dark",
+	"colors": {
+		"editor.background": "#1a1b26",
+		"editor.foreground": "#c0caf5",
+		"focusBorder": "#7aa2f7",
+		"sideBar.background": "#16161e",
+		"editorLineNumber.foreground": "#3b4261",
+		"errorForeground": "#f7768e",
+		"editorWarning.foreground": "#e0af68",
+		"panel.border": "#101014",
+		"terminal.ansiBlack": "#15161e",
+		"terminal.ansiRed": "#f7768e",
+		"terminal.ansiGreen": "#9ece6a",
+		"terminal.ansiMagenta": "#bb9af7",
+		"terminal.ansiBrightMagenta": "#bb9af7",
+		"editor.lineHighlightBackground": null,
+	},
+	"tokenColors": [
+		{"scope": "comment", "settings": {"foreground": "#565f89", "fontStyle": "italic"}},
+		{"scope": ["keyword", "storage.type"], "settings": {"foreground": "#bb9af7"}},
+		{"scope": "keyword.operator", "settings": {"foreground": "#89ddff"}},
+		{"scope": "string, string.quoted", "settings": {"foreground": "#9ece6a"}},
+		{"scope": "constant.numeric", "settings": {"foreground": "#ff9e64"}},
+		{"scope": ["entity.name.function", "support.function"], "settings": {"foreground": "#7aa2f7"}},
+		{"scope": "entity.name.type", "settings": {"foreground": "#2ac3de"}},
+		{"scope": "source.python keyword", "settings": {"foreground": "#ff0000"}},
+		{"scope": "-comment", "settings": {"foreground": "#ff0000"}},
+		/* later rules win ties */
+		{"scope": "comment", "settings": {"foreground": "#5a6390"}},
+	],
+}`
+
+func TestParseVSCodeTheme(t *testing.T) {
+	t.Parallel()
+
+	theme, err := ParseVSCodeTheme(strings.NewReader(testVSCodeTheme))
+	if err != nil {
+		t.Fatalf("ParseVSCodeTheme() error: %v", err)
+	}
+
+	if theme.ID() != "test_night" || theme.DisplayName() != "Test Night" {
+		t.Errorf("identity = %q/%q, want test_night/Test Night", theme.ID(), theme.DisplayName())
+	}
+	if !theme.IsDark() {
+		t.Error("IsDark() = false, want true")
+	}
+
+	tests := []struct {
+		name string
+		got  Color
+		want string
+	}{
+		{"background", theme.Background(), "#1a1b26"},
+		{"background secondary", theme.BackgroundSecondary(), "#16161e"},
+		{"text primary", theme.TextPrimary(), "#c0caf5"},
+		{"text muted", theme.TextMuted(), "#3b4261"},
+		{"accent", theme.Accent(), "#7aa2f7"},
+		{"border", theme.Border(), "#101014"},
+		{"error", theme.Error().Text, "#f7768e"},
+		{"warning", theme.Warning().Text, "#e0af68"},
+		{"success from terminal", theme.Success().Text, "#9ece6a"},
+		{"ansi black", theme.Black(), "#15161e"},
+		{"ansi magenta", theme.Purple(), "#bb9af7"},
+		{"code background", theme.CodeBackground(), "#1a1b26"},
+		{"code text", theme.CodeText(), "#c0caf5"},
+		{"code comment", theme.CodeComment(), "#5a6390"},
+		{"code keyword", theme.CodeKeyword(), "#bb9af7"},
+		{"code operator", theme.CodeOperator(), "#89ddff"},
+		{"code string", theme.CodeString(), "#9ece6a"},
+		{"code number", theme.CodeNumber(), "#ff9e64"},
+		{"code function", theme.CodeFunction(), "#7aa2f7"},
+		{"code type", theme.CodeType(), "#2ac3de"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if tt.got.Hex() != tt.want {
+				t.Errorf("got %s, want %s", tt.got.Hex(), tt.want)
+			}
+		})
+	}
+
+	if theme.Error().Background.IsEmpty() {
+		t.Error("error background should be derived from the text color")
+	}
+}
+
+func TestParseVSCodeThemeGlobalSettings(t *testing.T) {
+	t.Parallel()
+
+	// Older themes put editor colors in a scope-less tokenColors rule.
+	theme, err := ParseVSCodeTheme(strings.NewReader(`{
+		"tokenColors": [
+			{"settings": {"background": "#fdf6e3", "foreground": "#657b83"}},
+			{"scope": "keyword", "settings": {"foreground": "#859900"}}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("ParseVSCodeTheme() error: %v", err)
+	}
+	if theme.ID() != "imported" {
+		t.Errorf("ID() = %q, want imported", theme.ID())
+	}
+	if theme.Background().Hex() != "#fdf6e3" || theme.CodeText().Hex() != "#657b83" {
+		t.Errorf("global settings not applied: bg=%s fg=%s", theme.Background().Hex(), theme.CodeText().Hex())
+	}
+	if theme.IsDark() {
+		t.Error("IsDark() = true, want light detection")
+	}
+	if theme.CodeKeyword().Hex() != "#859900" {
+		t.Errorf("CodeKeyword() = %s, want #859900", theme.CodeKeyword().Hex())
+	}
+}
+
+func TestParseVSCodeThemeErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"invalid json", "{\n\"colors\": [\n}", "line"},
+		{"include", `{"include": "./base.json"}`, "LoadVSCodeThemeFile"},
+		{"tokenColors file", `{"tokenColors": "./syntax.tmTheme"}`, "only inline tokenColors"},
+		{"bad scope", `{"tokenColors": [{"scope": 1, "settings": {}}]}`, "scope must be"},
+		{"no colors", `{"name": "Empty"}`, "no colors"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseVSCodeTheme(strings.NewReader(tt.input))
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadVSCodeThemeFileInclude(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "base", "base.json"), `{
+		"name": "Base",
+		"type": "dark",
+		"colors": {"editor.background": "#000000", "editor.foreground": "#eeeeee", "focusBorder": "#0000ff"},
+		"tokenColors": [
+			{"scope": "comment", "settings": {"foreground": "#777777"}},
+			{"scope": "keyword", "settings": {"foreground": "#ff00ff"}}
+		]
+	}`)
+	writeTestFile(t, filepath.Join(dir, "base", "middle.json"), `{
+		"include": "./base.json",
+		"colors": {"focusBorder": "#00ff00"}
+	}`)
+	writeTestFile(t, filepath.Join(dir, "themes", "child-color-theme.json"), `{
+		// child overrides
+		"include": "../base/middle.json",
+		"tokenColors": [{"scope": "keyword", "settings": {"foreground": "#ffaa00"}}],
+	}`)
+
+	theme, err := LoadVSCodeThemeFile(filepath.Join(dir, "themes", "child-color-theme.json"))
+	if err != nil {
+		t.Fatalf("LoadVSCodeThemeFile() error: %v", err)
+	}
+
+	if theme.DisplayName() != "Base" {
+		t.Errorf("DisplayName() = %q, want inherited Base", theme.DisplayName())
+	}
+	if theme.Background().Hex() != "#000000" {
+		t.Errorf("Background() = %s, want #000000 from base", theme.Background().Hex())
+	}
+	if theme.Accent().Hex() != "#00ff00" {
+		t.Errorf("Accent() = %s, want #00ff00 from middle", theme.Accent().Hex())
+	}
+	if theme.CodeComment().Hex() != "#777777" {
+		t.Errorf("CodeComment() = %s, want #777777 from base", theme.CodeComment().Hex())
+	}
+	if theme.CodeKeyword().Hex() != "#ffaa00" {
+		t.Errorf("CodeKeyword() = %s, want child override #ffaa00", theme.CodeKeyword().Hex())
+	}
+}
+
+func TestLoadVSCodeThemeFileDefaultID(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "My Theme-color-theme.json")
+	writeTestFile(t, path, `{"colors": {"editor.background": "#202020"}}`)
+
+	theme, err := LoadVSCodeThemeFile(path)
+	if err != nil {
+		t.Fatalf("LoadVSCodeThemeFile() error: %v", err)
+	}
+	if theme.ID() != "my_theme" {
+		t.Errorf("ID() = %q, want my_theme", theme.ID())
+	}
+}
+
+func TestVSCodeThemeNonASCIIName(t *testing.T) {
+	t.Parallel()
+
+	const src = `{"name": "夜空", "colors": {"editor.background": "#202020"}}`
+	theme, err := ParseVSCodeTheme(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("ParseVSCodeTheme() error: %v", err)
+	}
+	if theme.ID() != "imported" || theme.DisplayName() != "夜空" {
+		t.Errorf("ID(), DisplayName() = %q, %q, want imported, 夜空", theme.ID(), theme.DisplayName())
+	}
+
+	dir := t.TempDir()
+	tests := []struct {
+		file string
+		want string
+	}{
+		{"night-sky-color-theme.json", "night_sky"},
+		{"夜空-color-theme.json", "imported"},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(dir, tt.file)
+		writeTestFile(t, path, src)
+		theme, err := LoadVSCodeThemeFile(path)
+		if err != nil {
+			t.Fatalf("LoadVSCodeThemeFile(%s) error: %v", tt.file, err)
+		}
+		if theme.ID() != tt.want {
+			t.Errorf("LoadVSCodeThemeFile(%s) ID() = %q, want %q", tt.file, theme.ID(), tt.want)
+		}
+	}
+}
+
+func TestLoadVSCodeThemeFileErrors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "a.json"), `{"include": "./b.json"}`)
+	writeTestFile(t, filepath.Join(dir, "b.json"), `{"include": "./a.json"}`)
+	writeTestFile(t, filepath.Join(dir, "missing.json"), `{"include": "./nope.json"}`)
+	writeTestFile(t, filepath.Join(dir, "broken.json"), "{\n\"colors\": {\"a\" \"b\"}\n}")
+
+	tests := []struct {
+		name    string
+		file    string
+		wantErr string
+	}{
+		{"cycle", "a.json", "include cycle"},
+		{"missing include", "missing.json", "nope.json"},
+		{"syntax error has file and line", "broken.json", "broken.json:2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := LoadVSCodeThemeFile(filepath.Join(dir, tt.file))
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// writeTestFile writes content to path, creating parent directories.
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,77 @@
+package gothememe
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// windowsTerminalKeys lists the color keys of a Windows Terminal scheme in
+// the order they are written. The ANSI keys follow terminal palette order;
+// Windows Terminal calls magenta "purple".
+var windowsTerminalKeys = []string{
+	"background", "foreground", "cursorColor", "selectionBackground",
+	"black", "red", "green", "yellow", "blue", "purple", "cyan", "white",
+	"brightBlack", "brightRed", "brightGreen", "brightYellow",
+	"brightBlue", "brightPurple", "brightCyan", "brightWhite",
+}
+
+// FromWindowsTerminal converts a Windows Terminal color scheme, as found in
+// the "schemes" list of settings.json and in iTerm2-Color-Schemes, into a
+// Theme. It applies the same role mapping as the themes generated by
+// themegen, so a scheme converted at runtime matches its built-in
+// counterpart. Comments and trailing commas are accepted. The theme ID is
+// the snake_case form of the scheme name, or "imported" if it has none or
+// the name has no ASCII letters or digits.
+func FromWindowsTerminal(data []byte) (Theme, error) {
+	s, err := decodeTerminalScheme(data, TerminalFormatWindowsTerminal)
+	if err != nil {
+		return nil, err
+	}
+
+	name := s.name
+	if name == "" {
+		name = "imported"
+	}
+	return s.theme(importedThemeID(name, ""), name), nil
+}
+
+// decodeWindowsTerminal parses a single Windows Terminal scheme object.
+func decodeWindowsTerminal(data []byte) (terminalScheme, error) {
+	var raw map[string]any
+	if err := json.Unmarshal(stripJSONC(data), &raw); err != nil {
+		if line := jsonErrorLine(data, err); line > 0 {
+			return terminalScheme{}, fmt.Errorf("line %d: %w", line, err)
+		}
+		return terminalScheme{}, err
+	}
+
+	s := terminalScheme{}
+	s.name, _ = raw["name"].(string) //nolint:errcheck // a missing or non-string name leaves the scheme unnamed
+	keys := terminalKeys{
+		"background":          &s.background,
+		"foreground":          &s.foreground,
+		"cursorColor":         &s.cursor,
+		"selectionBackground": &s.selection,
+	}
+	for i, key := range windowsTerminalKeys[4:] {
+		keys[key] = &s.ansi[i]
+	}
+
+	for _, key := range windowsTerminalKeys {
+		v, ok := raw[key]
+		if !ok {
+			continue
+		}
+		str, ok := v.(string)
+		if !ok {
+			return terminalScheme{}, fmt.Errorf("%s: value is %T, want string", key, v)
+		}
+		if str == "" {
+			continue
+		}
+		if err := keys.set(&s, key, str); err != nil {
+			return terminalScheme{}, err
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,113 @@
+package gothememe
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFromWindowsTerminal(t *testing.T) {
+	t.Parallel()
+
+	theme, err := FromWindowsTerminal([]byte(terminalSamples[TerminalFormatWindowsTerminal]))
+	if err != nil {
+		t.Fatalf("FromWindowsTerminal() error: %v", err)
+	}
+
+	if theme.ID() != "catppuccin_mocha" {
+		t.Errorf("ID() = %q, want catppuccin_mocha", theme.ID())
+	}
+	if theme.DisplayName() != "Catppuccin Mocha" {
+		t.Errorf("DisplayName() = %q, want Catppuccin Mocha", theme.DisplayName())
+	}
+	if !theme.IsDark() {
+		t.Error("IsDark() = false, want true")
+	}
+
+	checks := []struct {
+		name string
+		got  Color
+		want string
+	}{
+		{"background", theme.Background(), "#1e1e2e"},
+		{"background secondary from black", theme.BackgroundSecondary(), "#45475a"},
+		{"surface from selection", theme.Surface(), "#585b70"},
+		{"text secondary from white", theme.TextSecondary(), "#bac2de"},
+		{"purple", theme.Purple(), "#f5c2e7"},
+		{"accent secondary from purple", theme.AccentSecondary(), "#f5c2e7"},
+		{"success text from green", theme.Success().Text, "#a6e3a1"},
+		{"error background", theme.Error().Background, "#f38ba819"},
+		{"error border", theme.Error().Border, "#f38ba84c"},
+		{"constant from bright yellow", theme.CodeConstant(), "#f9e2af"},
+	}
+	for _, c := range checks {
+		if c.got.Hex() != c.want {
+			t.Errorf("%s = %s, want %s", c.name, c.got.Hex(), c.want)
+		}
+	}
+
+	// The runtime path and the terminal importer share the mapping.
+	parsed, err := ParseTerminalTheme(strings.NewReader(terminalSamples[TerminalFormatWindowsTerminal]), TerminalFormatAuto)
+	if err != nil {
+		t.Fatalf("ParseTerminalTheme() error: %v", err)
+	}
+	assertSameColors(t, theme, parsed)
+}
+
+func TestFromWindowsTerminalDefaults(t *testing.T) {
+	t.Parallel()
+
+	theme, err := FromWindowsTerminal([]byte(`{"background": "#fdf6e3", "foreground": "#657b83"}`))
+	if err != nil {
+		t.Fatalf("FromWindowsTerminal() error: %v", err)
+	}
+	if theme.ID() != "imported" {
+		t.Errorf("ID() = %q, want imported", theme.ID())
+	}
+	if theme.IsDark() {
+		t.Error("IsDark() = true, want false for a light background")
+	}
+	if theme.Surface().IsEmpty() {
+		t.Error("Surface() is empty, want a derived color when selectionBackground is missing")
+	}
+}
+
+func TestFromWindowsTerminalNonASCIIName(t *testing.T) {
+	t.Parallel()
+
+	theme, err := FromWindowsTerminal([]byte(`{"name": "東京", "background": "#1a1b26", "foreground": "#c0caf5"}`))
+	if err != nil {
+		t.Fatalf("FromWindowsTerminal() error: %v", err)
+	}
+	if theme.ID() != "imported" || theme.DisplayName() != "東京" {
+		t.Errorf("ID(), DisplayName() = %q, %q, want imported, 東京", theme.ID(), theme.DisplayName())
+	}
+}
+
+func TestFromWindowsTerminalErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"invalid json", "{\n\"name\": }", "line 2"},
+		{"not an object", `["#000000"]`, "windows-terminal"},
+		{"non-string color", `{"background": 0}`, "background: value is float64"},
+		{"invalid ansi color", `{"background": "#000000", "red": "nope"}`, "red"},
+		{"no colors", `{"name": "Empty"}`, "no background or foreground"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := FromWindowsTerminal([]byte(tt.input))
+			if err == nil {
+				t.Fatal("FromWindowsTerminal() error = nil, want error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("FromWindowsTerminal() error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
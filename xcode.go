@@ -0,0 +1,161 @@
+package gothememe
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// assetCatalogInfo is the info object every asset catalog Contents.json
+// holds.
+var assetCatalogInfo = map[string]any{"author": "xcode", "version": 1}
+
+// colorSetContents is the Contents.json of a .colorset folder.
+type colorSetContents struct {
+	Colors []colorSetColor `json:"colors"`
+	Info   map[string]any  `json:"info"`
+}
+
+// colorSetColor is one appearance of a color set.
+type colorSetColor struct {
+	Appearances []colorSetAppearance `json:"appearances,omitempty"`
+	Color       colorSetValue        `json:"color"`
+	Idiom       string               `json:"idiom"`
+}
+
+// colorSetAppearance qualifies a color set entry, such as the dark
+// appearance.
+type colorSetAppearance struct {
+	Appearance string `json:"appearance"`
+	Value      string `json:"value"`
+}
+
+// colorSetValue is a color of a color set with its components as Xcode
+// writes them.
+type colorSetValue struct {
+	ColorSpace string            `json:"color-space"`
+	Components map[string]string `json:"components"`
+}
+
+// newColorSetValue returns the color set value of c.
+func newColorSetValue(c Color) colorSetValue {
+	r, g, b, a := c.RGBAComponents()
+	return colorSetValue{
+		ColorSpace: "srgb",
+		Components: map[string]string{
+			"red":   fmt.Sprintf("0x%02X", r),
+			"green": fmt.Sprintf("0x%02X", g),
+			"blue":  fmt.Sprintf("0x%02X", b),
+			"alpha": fmt.Sprintf("%.3f", float64(a)/255),
+		},
+	}
+}
+
+// WriteAssetCatalog writes the colors of t to the Xcode asset catalog dir,
+// such as Colors.xcassets, which is created if needed. Every role becomes a
+// color set named after it in PascalCase, such as TextPrimary.colorset, for
+// Color("TextPrimary") in SwiftUI and UIColor(named:) in UIKit. When dark
+// is not nil, each color set also holds its color for the dark appearance,
+// so the colors follow the system appearance.
+func WriteAssetCatalog(dir string, t, dark Theme) error {
+	if t == nil {
+		return ErrNoThemes
+	}
+
+	files := map[string]any{
+		"Contents.json": map[string]any{"info": assetCatalogInfo},
+	}
+	for _, role := range colorRoles {
+		c := role.get(t)
+		if c.IsEmpty() {
+			continue
+		}
+		contents := colorSetContents{
+			Colors: []colorSetColor{{Color: newColorSetValue(c), Idiom: "universal"}},
+			Info:   assetCatalogInfo,
+		}
+		if dark != nil {
+			if dc := role.get(dark); !dc.IsEmpty() {
+				contents.Colors = append(contents.Colors, colorSetColor{
+					Appearances: []colorSetAppearance{{"luminosity", "dark"}},
+					Color:       newColorSetValue(dc),
+					Idiom:       "universal",
+				})
+			}
+		}
+		files[filepath.Join(pascalCase(role.key)+".colorset", "Contents.json")] = contents
+	}
+
+	for name, contents := range files {
+		data, err := json.MarshalIndent(contents, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding asset catalog: %w", err)
+		}
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { //nolint:gosec // G301: asset catalogs are meant to be shared
+			return fmt.Errorf("writing asset catalog: %w", err)
+		}
+		if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil { //nolint:gosec // G306: asset catalogs are meant to be shared
+			return fmt.Errorf("writing asset catalog: %w", err)
+		}
+	}
+	return nil
+}
+
+// WriteSwiftColors writes the themes to w as a Swift extension of SwiftUI's
+// Color with a namespace per theme, such as Color.CatppuccinMocha, holding
+// a constant for every role, such as Color.CatppuccinMocha.textPrimary.
+// For colors that follow the system appearance, use the color sets of
+// [WriteAssetCatalog] instead.
+func WriteSwiftColors(w io.Writer, themes ...Theme) error {
+	var body strings.Builder
+	for _, t := range themes {
+		if t == nil {
+			continue
+		}
+		fmt.Fprintf(&body, "\n    /// %s\n    enum %s {\n", t.DisplayName(), pascalCase(t.ID()))
+		for _, role := range colorRoles {
+			if c := role.get(t); !c.IsEmpty() {
+				fmt.Fprintf(&body, "        public static let %s = %s\n", lowerFirst(pascalCase(role.key)), swiftColor(c))
+			}
+		}
+		body.WriteString("    }\n")
+	}
+	if body.Len() == 0 {
+		return ErrNoThemes
+	}
+
+	var sb strings.Builder
+	sb.WriteString("// Generated by gothememe. Do not edit.\n\nimport SwiftUI\n\npublic extension Color {")
+	sb.WriteString(body.String())
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// swiftColor formats c as a SwiftUI Color initializer. Three decimals are
+// enough for the components to round back to the same byte.
+func swiftColor(c Color) string {
+	r, g, b, a := c.RGBAComponents()
+	return fmt.Sprintf("Color(.sRGB, red: %.3f, green: %.3f, blue: %.3f, opacity: %.3f)",
+		float64(r)/255, float64(g)/255, float64(b)/255, float64(a)/255)
+}
+
+// lowerFirst lowercases the first rune of s.
+func lowerFirst(s string) string {
+	r := []rune(s)
+	if len(r) == 0 {
+		return s
+	}
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+// exportSwift writes the Swift colors of the themes.
+func exportSwift(w io.Writer, themes []Theme, _ ExportOptions) error {
+	return WriteSwiftColors(w, themes...)
+}
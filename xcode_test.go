@@ -0,0 +1,122 @@
+package gothememe
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWriteAssetCatalog(t *testing.T) {
+	t.Parallel()
+
+	dark := fullTestTheme(t)
+	light := NewThemeBuilder("paper", "Paper").
+		WithBackground(Hex("#fdf6e3")).
+		WithTextPrimary(Hex("#657b83")).
+		Build()
+
+	dir := filepath.Join(t.TempDir(), "Colors.xcassets")
+	if err := WriteAssetCatalog(dir, light, dark); err != nil {
+		t.Fatalf("WriteAssetCatalog() error: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "Contents.json")); err != nil {
+		t.Errorf("catalog Contents.json missing: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "Background.colorset", "Contents.json"))
+	if err != nil {
+		t.Fatalf("reading color set: %v", err)
+	}
+	var got colorSetContents
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("color set is not valid JSON: %v", err)
+	}
+	want := colorSetContents{
+		Colors: []colorSetColor{
+			{
+				Color: colorSetValue{"srgb", map[string]string{"red": "0xFD", "green": "0xF6", "blue": "0xE3", "alpha": "1.000"}},
+				Idiom: "universal",
+			},
+			{
+				Appearances: []colorSetAppearance{{"luminosity", "dark"}},
+				Color:       colorSetValue{"srgb", map[string]string{"red": "0x1E", "green": "0x1E", "blue": "0x2E", "alpha": "1.000"}},
+				Idiom:       "universal",
+			},
+		},
+		Info: map[string]any{"author": "xcode", "version": float64(1)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Background.colorset = %+v, want %+v", got, want)
+	}
+
+	// Roles only the dark theme sets have no color set, and roles only the
+	// light theme sets have no dark appearance.
+	if _, err := os.Stat(filepath.Join(dir, "Accent.colorset")); !os.IsNotExist(err) {
+		t.Errorf("Accent.colorset written for an empty role: %v", err)
+	}
+
+	dir = t.TempDir()
+	if err := WriteAssetCatalog(dir, dark, nil); err != nil {
+		t.Fatalf("WriteAssetCatalog() without dark theme error: %v", err)
+	}
+	data, err = os.ReadFile(filepath.Join(dir, "SuccessBackground.colorset", "Contents.json"))
+	if err != nil {
+		t.Fatalf("reading color set: %v", err)
+	}
+	if strings.Contains(string(data), "appearances") || !strings.Contains(string(data), `"alpha": "0.098"`) {
+		t.Errorf("unexpected single-appearance color set:\n%s", data)
+	}
+
+	if err := WriteAssetCatalog(t.TempDir(), nil, dark); err == nil {
+		t.Error("WriteAssetCatalog() without a theme should fail")
+	}
+}
+
+func TestWriteSwiftColors(t *testing.T) {
+	t.Parallel()
+
+	theme := fullTestTheme(t)
+	var sb strings.Builder
+	if err := Export(&sb, FormatSwift, theme); err != nil {
+		t.Fatalf("Export(swift) error: %v", err)
+	}
+	out := sb.String()
+	for _, want := range []string{
+		"import SwiftUI\n",
+		"public extension Color {\n",
+		"    enum CatppuccinMocha {\n",
+		"        public static let textPrimary = Color(.sRGB, red: 0.804, green: 0.839, blue: 0.957, opacity: 1.000)\n",
+		"        public static let successBackground = Color(.sRGB, red: 0.651, green: 0.890, blue: 0.631, opacity: 0.098)\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output lacks %q:\n%s", want, out)
+		}
+	}
+
+	if got, err := OutputFormatFromPath("Theme+Colors.swift"); err != nil || got != FormatSwift {
+		t.Errorf("OutputFormatFromPath(.swift) = %s, %v", got, err)
+	}
+	if err := WriteSwiftColors(&sb); err == nil {
+		t.Error("WriteSwiftColors() without themes should fail")
+	}
+}
+
+func TestSwiftColorRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// Three decimals must round back to the original byte for every value.
+	for v := range 256 {
+		c := Hex(fmt.Sprintf("#%02x0000", v))
+		var r float64
+		if _, err := fmt.Sscanf(swiftColor(c), "Color(.sRGB, red: %f,", &r); err != nil {
+			t.Fatalf("parsing %q: %v", swiftColor(c), err)
+		}
+		if got := int(clampByte(r * 255)); got != v {
+			t.Fatalf("red %d written as %q reads back as %d", v, swiftColor(c), got)
+		}
+	}
+}